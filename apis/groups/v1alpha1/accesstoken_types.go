@@ -60,6 +60,24 @@ type AccessTokenParameters struct {
 	// Name of the group access token
 	// +required
 	Name string `json:"name"`
+
+	// RecreateOnDrift recreates the access token when its scopes, access
+	// level or expiry were changed outside of Crossplane, or when it was
+	// revoked. The new token is published to the connection secret before
+	// the replaced one is revoked.
+	// +optional
+	RecreateOnDrift *bool `json:"recreateOnDrift,omitempty"`
+
+	// SkipRevokeOnDelete keeps the access token valid when the managed resource
+	// is deleted, for credentials shared with systems outside of Crossplane.
+	// +optional
+	SkipRevokeOnDelete *bool `json:"skipRevokeOnDelete,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // AccessTokenObservation represents a access token.
@@ -68,6 +86,11 @@ type AccessTokenParameters struct {
 // https://docs.gitlab.com/ee/api/group_access_tokens.html
 type AccessTokenObservation struct {
 	TokenID *int `json:"id,omitempty"`
+
+	// RevokePendingID is the ID of the token replaced by the last
+	// recreation. It is revoked once its successor was published.
+	// +optional
+	RevokePendingID *int `json:"revokePendingId,omitempty"`
 }
 
 // A AccessTokenSpec defines the desired state of a Gitlab group.
@@ -106,3 +129,8 @@ type AccessTokenList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []AccessToken `json:"items"`
 }
+
+// GetExpiresAt of this AccessToken.
+func (mg *AccessToken) GetExpiresAt() *metav1.Time {
+	return mg.Spec.ForProvider.ExpiresAt
+}
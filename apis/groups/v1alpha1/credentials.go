@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// GetCredentialsSecretRef of this AccessToken.
+func (mg *AccessToken) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this DeployToken.
+func (mg *DeployToken) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Group.
+func (mg *Group) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this GroupShare.
+func (mg *GroupShare) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this LabelSet.
+func (mg *LabelSet) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Member.
+func (mg *Member) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this ProtectedEnvironment.
+func (mg *ProtectedEnvironment) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Variable.
+func (mg *Variable) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Milestone.
+func (mg *Milestone) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
@@ -54,7 +54,19 @@ type DeployTokenParameters struct {
 	// Must be at least one of read_repository, read_registry, write_registry,
 	// read_package_registry, or write_package_registry.
 	// +immutable
+	// +kubebuilder:validation:MinItems=1
 	Scopes []string `json:"scopes"`
+
+	// SkipRevokeOnDelete keeps the deploy token valid when the managed resource
+	// is deleted, for credentials shared with systems outside of Crossplane.
+	// +optional
+	SkipRevokeOnDelete *bool `json:"skipRevokeOnDelete,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // DeployTokenObservation represents a deploy token.
@@ -99,3 +111,8 @@ type DeployTokenList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []DeployToken `json:"items"`
 }
+
+// GetExpiresAt of this DeployToken.
+func (mg *DeployToken) GetExpiresAt() *metav1.Time {
+	return mg.Spec.ForProvider.ExpiresAt
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 )
 
 // VisibilityValue represents a visibility level within GitLab.
@@ -153,6 +155,23 @@ type GroupParameters struct {
 	// SharedWithGroups create links for sharing a group with another group.
 	// +optional
 	SharedWithGroups []SharedWithGroups `json:"sharedWithGroups,omitempty"`
+
+	// Timeouts of the calls made to Gitlab for this group.
+	// +optional
+	Timeouts *commonv1alpha1.Timeouts `json:"timeouts,omitempty"`
+
+	// ConfirmDelete must be true for the group to be deleted in Gitlab when
+	// this managed resource is deleted. The deletion is blocked otherwise,
+	// unless confirmed by the gitlab.crossplane.io/confirm-delete
+	// annotation.
+	// +optional
+	ConfirmDelete *bool `json:"confirmDelete,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // AccessLevelValue represents a permission level within GitLab.
@@ -282,6 +301,16 @@ type Group struct {
 	Status GroupStatus `json:"status,omitempty"`
 }
 
+// GetTimeouts of this Group.
+func (mg *Group) GetTimeouts() *commonv1alpha1.Timeouts {
+	return mg.Spec.ForProvider.Timeouts
+}
+
+// GetConfirmDelete of this Group.
+func (mg *Group) GetConfirmDelete() *bool {
+	return mg.Spec.ForProvider.ConfirmDelete
+}
+
 // +kubebuilder:object:root=true
 
 // GroupList contains a list of Group items
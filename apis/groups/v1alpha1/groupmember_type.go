@@ -17,11 +17,32 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// TypeDeletionBlocked indicates whether Gitlab refuses to remove a member.
+const TypeDeletionBlocked xpv1.ConditionType = "DeletionBlocked"
+
+// ReasonLastOwner means the member is the last owner of its group.
+const ReasonLastOwner xpv1.ConditionReason = "LastOwner"
+
+// LastOwner returns a condition that indicates the member cannot be removed
+// because it is the last owner of its group.
+func LastOwner() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonLastOwner,
+		Message:            "the last owner of a group cannot be removed, add another owner first",
+	}
+}
+
 // MemberSAMLIdentity represents the SAML Identity link for the group member.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/members.html#list-all-members-of-a-group-or-project
@@ -58,13 +79,49 @@ type MemberParameters struct {
 	// +optional
 	UserName *string `json:"userName,omitempty"`
 
-	// A valid access level.
+	// A valid access level. Valid values are 5 (Minimal Access), 10 (Guest),
+	// 20 (Reporter), 30 (Developer), 40 (Maintainer) and 50 (Owner). Minimal
+	// Access requires Gitlab EE and is only available in top-level groups.
 	// +immutable
+	// +kubebuilder:validation:Enum=5;10;20;30;40;50
 	AccessLevel AccessLevelValue `json:"accessLevel"`
 
 	// A date string in the format YEAR-MONTH-DAY.
 	// +optional
 	ExpiresAt *string `json:"expiresAt,omitempty"`
+
+	// AutoExtendBy extends the membership to this long after now when it is
+	// about to expire, keeping access time-bound but continuously renewed.
+	// Extensions happen within 14 days of expiry, or within half of
+	// AutoExtendBy if that is shorter. ExpiresAt is left as configured, the
+	// extended date is reported in ExtendedExpiresAt of the status.
+	// +optional
+	AutoExtendBy *metav1.Duration `json:"autoExtendBy,omitempty"`
+
+	// RequireSAMLProvider is the provider of a SAML identity the user must
+	// have, such as group_saml or saml, so that only SSO accounts are given
+	// access. The user is not added without one, and members without one
+	// are reported as unavailable. Identities other than the group SAML
+	// identity of a member are only visible to administrators.
+	// +optional
+	RequireSAMLProvider *string `json:"requireSamlProvider,omitempty"`
+
+	// UnassignIssuablesOnRemove unassigns the issues and merge requests of
+	// the group and its subgroups and projects from the user when the
+	// member is removed.
+	// +optional
+	UnassignIssuablesOnRemove *bool `json:"unassignIssuablesOnRemove,omitempty"`
+
+	// SkipSubresources keeps the memberships of the user in the subgroups
+	// and projects of the group when the member is removed.
+	// +optional
+	SkipSubresources *bool `json:"skipSubresources,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // MemberObservation represents a group member.
@@ -78,6 +135,10 @@ type MemberObservation struct {
 	AvatarURL         string              `json:"avatarURL,omitempty"`
 	WebURL            string              `json:"webURL,omitempty"`
 	GroupSAMLIdentity *MemberSAMLIdentity `json:"groupSamlIdentity,omitempty"`
+
+	// ExtendedExpiresAt is the date AutoExtendBy extended the membership to.
+	// It is used instead of ExpiresAt as long as it is later.
+	ExtendedExpiresAt *string `json:"extendedExpiresAt,omitempty"`
 }
 
 // A MemberSpec defines the desired state of a Gitlab Group Member.
@@ -119,3 +180,29 @@ type MemberList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Member `json:"items"`
 }
+
+// GetExpiresAt of this Member. Dates that cannot be parsed are ignored.
+func (mg *Member) GetExpiresAt() *metav1.Time {
+	at := mg.Spec.ForProvider.ExpiresAt
+	if mg.Status.AtProvider.ExtendedExpiresAt != nil {
+		at = mg.Status.AtProvider.ExtendedExpiresAt
+	}
+	if at == nil {
+		return nil
+	}
+	t, err := time.Parse(time.DateOnly, *at)
+	if err != nil {
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
+
+// GetAdminOnlyFields returns the paths of the fields of this Member that are
+// set and require an administrator token. Only the group SAML identity of a
+// member is visible without one.
+func (mg *Member) GetAdminOnlyFields() []string {
+	if p := mg.Spec.ForProvider.RequireSAMLProvider; p != nil && *p != "group_saml" {
+		return []string{"spec.forProvider.requireSamlProvider"}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GroupShareParameters define the desired state of the share of a Gitlab
+// Group with another group, which grants the members of the other group
+// access to the group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#share-groups-with-groups
+// At least 1 of [GroupID, GroupIDRef, GroupIDSelector] required.
+// At least 1 of [SharedWithGroupID, SharedWithGroupIDRef, SharedWithGroupIDSelector] required.
+type GroupShareParameters struct {
+	// The ID or URL-encoded path of the group that is shared.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=GroupIDRef
+	// +crossplane:generate:reference:selectorFieldName=GroupIDSelector
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// The ID of the group the group is shared with.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=SharedWithGroupIDRef
+	// +crossplane:generate:reference:selectorFieldName=SharedWithGroupIDSelector
+	SharedWithGroupID *string `json:"sharedWithGroupId,omitempty"`
+
+	// SharedWithGroupIDRef is a reference to a group to retrieve its
+	// SharedWithGroupID.
+	// +optional
+	// +immutable
+	SharedWithGroupIDRef *xpv1.Reference `json:"sharedWithGroupIdRef,omitempty"`
+
+	// SharedWithGroupIDSelector selects reference to a group to retrieve its
+	// SharedWithGroupID.
+	// +optional
+	// +immutable
+	SharedWithGroupIDSelector *xpv1.Selector `json:"sharedWithGroupIdSelector,omitempty"`
+
+	// GroupAccess is the access level granted to the members of the group
+	// the group is shared with. Valid values are 10 (Guest), 20 (Reporter),
+	// 30 (Developer), 40 (Maintainer) and 50 (Owner).
+	// +kubebuilder:validation:Enum=10;20;30;40;50
+	GroupAccess AccessLevelValue `json:"groupAccess"`
+
+	// ExpiresAt is the date the share expires, in the format
+	// YEAR-MONTH-DAY.
+	// +optional
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// GroupShareObservation represents the observed state of the share of a
+// Gitlab Group with another group.
+type GroupShareObservation struct {
+	GroupName        string `json:"groupName,omitempty"`
+	GroupFullPath    string `json:"groupFullPath,omitempty"`
+	GroupAccessLevel int    `json:"groupAccessLevel,omitempty"`
+	ExpiresAt        string `json:"expiresAt,omitempty"`
+}
+
+// A GroupShareSpec defines the desired state of the share of a Gitlab Group
+// with another group.
+type GroupShareSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GroupShareParameters `json:"forProvider"`
+}
+
+// A GroupShareStatus represents the observed state of the share of a Gitlab
+// Group with another group.
+type GroupShareStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GroupShareObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GroupShare is a managed resource that represents the share of a Gitlab
+// Group with another group. Gitlab cannot change a share, so a changed
+// access level or expiry shares the group again. The shares of a group
+// should not be managed by both GroupShares and the SharedWithGroups of the
+// Group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SHARED-WITH",type="string",JSONPath=".status.atProvider.groupFullPath"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type GroupShare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupShareSpec   `json:"spec"`
+	Status GroupShareStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupShareList contains a list of GroupShare items.
+type GroupShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupShare `json:"items"`
+}
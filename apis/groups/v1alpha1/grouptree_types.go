@@ -0,0 +1,139 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelGroupTree is the label identifying the GroupTree a Group was
+// rendered from.
+const LabelGroupTree = "groups.gitlab.crossplane.io/tree"
+
+// A GroupTreeNode is a subgroup of a GroupTree.
+type GroupTreeNode struct {
+	// Path of the subgroup relative to the root of the tree, e.g.
+	// platform/infra. The last segment is the path of the subgroup in
+	// Gitlab, the segments before it name its parent, which must be
+	// declared in the same tree. The Group resource is named after the tree
+	// and this path.
+	// +kubebuilder:validation:Pattern:=^[a-zA-Z0-9_.-]+(/[a-zA-Z0-9_.-]+)*$
+	Path string `json:"path"`
+
+	// Name of the subgroup. Defaults to the last segment of the path.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Description of the subgroup.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Visibility of the subgroup. Can not be more visible than its parent.
+	// +optional
+	// +kubebuilder:validation:Enum:=private;internal;public
+	Visibility *VisibilityValue `json:"visibility,omitempty"`
+}
+
+// A GroupTreeSpec defines a hierarchy of subgroups under a root group.
+type GroupTreeSpec struct {
+	// ProviderConfigReference specifies how the rendered Groups connect to
+	// Gitlab.
+	// +kubebuilder:default={"name": "default"}
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+
+	// DeletionPolicy of the rendered Groups. Orphan keeps the subgroups in
+	// Gitlab when they are removed from the tree or the tree is deleted.
+	// +optional
+	// +kubebuilder:default=Delete
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ConfirmDelete is passed to the rendered Groups. It must be true for
+	// subgroups to be deleted in Gitlab when they are removed from the tree
+	// or the tree is deleted. Their deletion is blocked otherwise.
+	// +optional
+	ConfirmDelete *bool `json:"confirmDelete,omitempty"`
+
+	// RootGroupID is the ID of the group the tree is created under. The
+	// subgroups of the first level are top-level groups if neither
+	// RootGroupID nor RootGroupRef is set.
+	// +optional
+	RootGroupID *int `json:"rootGroupId,omitempty"`
+
+	// RootGroupRef references the Group the tree is created under.
+	// +optional
+	RootGroupRef *xpv1.Reference `json:"rootGroupRef,omitempty"`
+
+	// Groups of the tree. A subgroup is created once its parent exists,
+	// regardless of the order they are declared in.
+	Groups []GroupTreeNode `json:"groups"`
+}
+
+// A GroupTreeGroup is a Group rendered from a GroupTree.
+type GroupTreeGroup struct {
+	Path  string `json:"path"`
+	Name  string `json:"name"`
+	ID    *int   `json:"id,omitempty"`
+	Ready bool   `json:"ready"`
+}
+
+// A GroupTreeStatus represents the observed state of a GroupTree.
+type GroupTreeStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+
+	// Groups rendered from the tree.
+	Groups []GroupTreeGroup `json:"groups,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GroupTree renders a Group for every subgroup of a nested hierarchy, each
+// referring to the Group of its parent, and manages them as a unit.
+// Subgroups removed from the tree are deleted, and all of them are deleted
+// with it, once confirmed by ConfirmDelete.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,gitlab}
+type GroupTree struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupTreeSpec   `json:"spec"`
+	Status GroupTreeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupTreeList contains a list of GroupTree items.
+type GroupTreeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupTree `json:"items"`
+}
+
+// GetCondition of this GroupTree.
+func (gt *GroupTree) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return gt.Status.GetCondition(ct)
+}
+
+// SetConditions of this GroupTree.
+func (gt *GroupTree) SetConditions(c ...xpv1.Condition) {
+	gt.Status.SetConditions(c...)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// MilestoneAutoClosePolicy closes a milestone once its due date has passed.
+type MilestoneAutoClosePolicy struct {
+	// RollOverOpenIssues moves the open issues of the milestone to the next
+	// milestone before closing it (default: true). The next milestone is the
+	// active milestone of the group with the earliest due date after the one
+	// of this milestone. The open issues stay if there is none.
+	// +optional
+	RollOverOpenIssues *bool `json:"rollOverOpenIssues,omitempty"`
+}
+
+// MilestoneParameters define the desired state of a Gitlab group milestone.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_milestones.html
+// At least 1 of [GroupID, GroupIDRef, GroupIDSelector] required.
+type MilestoneParameters struct {
+	// The ID or URL-encoded path of the group.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=GroupIDRef
+	// +crossplane:generate:reference:selectorFieldName=GroupIDSelector
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// Title of the milestone.
+	// +kubebuilder:validation:MinLength=1
+	Title string `json:"title"`
+
+	// Description of the milestone.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// StartDate is the date the milestone starts, in the format
+	// YEAR-MONTH-DAY.
+	// +optional
+	StartDate *string `json:"startDate,omitempty"`
+
+	// DueDate is the date the milestone is due, in the format
+	// YEAR-MONTH-DAY.
+	// +optional
+	DueDate *string `json:"dueDate,omitempty"`
+
+	// State of the milestone. Gitlab creates milestones active, the state
+	// is left to Gitlab and the AutoClosePolicy if not set.
+	// +kubebuilder:validation:Enum=active;closed
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// AutoClosePolicy closes the milestone the day after its due date. It
+	// is ignored while State is set.
+	// +optional
+	AutoClosePolicy *MilestoneAutoClosePolicy `json:"autoClosePolicy,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// MilestoneObservation represents the observed state of a Gitlab group
+// milestone.
+type MilestoneObservation struct {
+	// ID of the milestone at gitlab
+	ID int `json:"id,omitempty"`
+
+	// IID of the milestone within the group.
+	IID int `json:"iid,omitempty"`
+
+	// State of the milestone, active or closed.
+	State string `json:"state,omitempty"`
+
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A MilestoneSpec defines the desired state of a Gitlab group milestone.
+type MilestoneSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MilestoneParameters `json:"forProvider"`
+}
+
+// A MilestoneStatus represents the observed state of a Gitlab group
+// milestone.
+type MilestoneStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MilestoneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Milestone is a managed resource that represents a Gitlab milestone of a
+// group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TITLE",type="string",JSONPath=".spec.forProvider.title"
+// +kubebuilder:printcolumn:name="DUE",type="string",JSONPath=".spec.forProvider.dueDate"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Milestone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MilestoneSpec   `json:"spec"`
+	Status MilestoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MilestoneList contains a list of Milestone items.
+type MilestoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Milestone `json:"items"`
+}
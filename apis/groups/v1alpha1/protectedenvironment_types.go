@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EnvironmentAccess grants a role, a user or a group access to deploy to or
+// approve deployments to a protected environment. Exactly one of
+// AccessLevel, UserID and GroupID must be set.
+type EnvironmentAccess struct {
+	// AccessLevel is the role that has access.
+	// +kubebuilder:validation:Enum=30;40;60
+	// +optional
+	AccessLevel *AccessLevelValue `json:"accessLevel,omitempty"`
+
+	// UserID is the ID of the user that has access.
+	// +optional
+	UserID *int `json:"userId,omitempty"`
+
+	// GroupID is the ID of the group whose members have access.
+	// +optional
+	GroupID *int `json:"groupId,omitempty"`
+}
+
+// EnvironmentApprovalRule requires approvals of a role, a user or a group for
+// deployments to a protected environment.
+type EnvironmentApprovalRule struct {
+	EnvironmentAccess `json:",inline"`
+
+	// RequiredApprovals is the number of approvals required from this rule.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RequiredApprovals *int `json:"requiredApprovals,omitempty"`
+
+	// GroupInheritanceType 1 also accepts approvals of members inherited from
+	// ancestor groups of GroupID, 0 only of direct members.
+	// +kubebuilder:validation:Enum=0;1
+	// +optional
+	GroupInheritanceType *int `json:"groupInheritanceType,omitempty"`
+}
+
+// ProtectedEnvironmentParameters define the desired state of a Gitlab group
+// protected environment.
+// https://docs.gitlab.com/ee/api/group_protected_environments.html
+type ProtectedEnvironmentParameters struct {
+	// GroupID is the ID of the group to protect the environments of.
+	// +optional
+	// +immutable
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// DeploymentTier of the environments to protect in all projects of the
+	// group.
+	// +kubebuilder:validation:Enum=production;staging;testing;development;other
+	// +immutable
+	DeploymentTier string `json:"deploymentTier"`
+
+	// DeployAccessLevels are allowed to deploy to the environments.
+	// +kubebuilder:validation:MinItems=1
+	DeployAccessLevels []EnvironmentAccess `json:"deployAccessLevels"`
+
+	// RequiredApprovalCount is the number of approvals required for
+	// deployments to the environments. Use ApprovalRules instead on Gitlab
+	// 15.8 or later.
+	// +optional
+	RequiredApprovalCount *int `json:"requiredApprovalCount,omitempty"`
+
+	// ApprovalRules are required to approve deployments to the environments.
+	// +optional
+	ApprovalRules []EnvironmentApprovalRule `json:"approvalRules,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// EnvironmentAccessObservation is an access of a protected environment as
+// reported by Gitlab.
+type EnvironmentAccessObservation struct {
+	ID                     int    `json:"id"`
+	AccessLevel            int    `json:"accessLevel,omitempty"`
+	AccessLevelDescription string `json:"accessLevelDescription,omitempty"`
+	UserID                 int    `json:"userId,omitempty"`
+	GroupID                int    `json:"groupId,omitempty"`
+	RequiredApprovals      int    `json:"requiredApprovals,omitempty"`
+}
+
+// ProtectedEnvironmentObservation represents the observed state of a Gitlab
+// group protected environment.
+type ProtectedEnvironmentObservation struct {
+	DeployAccessLevels []EnvironmentAccessObservation `json:"deployAccessLevels,omitempty"`
+	ApprovalRules      []EnvironmentAccessObservation `json:"approvalRules,omitempty"`
+}
+
+// A ProtectedEnvironmentSpec defines the desired state of a Gitlab group
+// protected environment.
+type ProtectedEnvironmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProtectedEnvironmentParameters `json:"forProvider"`
+}
+
+// A ProtectedEnvironmentStatus represents the observed state of a Gitlab
+// group protected environment.
+type ProtectedEnvironmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProtectedEnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProtectedEnvironment is a managed resource that protects the
+// environments of a deployment tier in all projects of a Gitlab group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TIER",type="string",JSONPath=".spec.forProvider.deploymentTier"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ProtectedEnvironment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProtectedEnvironmentSpec   `json:"spec"`
+	Status ProtectedEnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProtectedEnvironmentList contains a list of ProtectedEnvironment items.
+type ProtectedEnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProtectedEnvironment `json:"items"`
+}
@@ -77,10 +77,55 @@ var (
 	VariableGroupVersionKind = SchemeGroupVersion.WithKind(VariableKind)
 )
 
+// LabelSet type metadata
+var (
+	LabelSetKind             = reflect.TypeOf(LabelSet{}).Name()
+	LabelSetGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: LabelSetKind}.String()
+	LabelSetKindAPIVersion   = LabelSetKind + "." + SchemeGroupVersion.String()
+	LabelSetGroupVersionKind = SchemeGroupVersion.WithKind(LabelSetKind)
+)
+
+// GroupShare type metadata
+var (
+	GroupShareKind             = reflect.TypeOf(GroupShare{}).Name()
+	GroupShareGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: GroupShareKind}.String()
+	GroupShareKindAPIVersion   = GroupShareKind + "." + SchemeGroupVersion.String()
+	GroupShareGroupVersionKind = SchemeGroupVersion.WithKind(GroupShareKind)
+)
+
+// ProtectedEnvironment type metadata
+var (
+	ProtectedEnvironmentKind             = reflect.TypeOf(ProtectedEnvironment{}).Name()
+	ProtectedEnvironmentGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: ProtectedEnvironmentKind}.String()
+	ProtectedEnvironmentKindAPIVersion   = ProtectedEnvironmentKind + "." + SchemeGroupVersion.String()
+	ProtectedEnvironmentGroupVersionKind = SchemeGroupVersion.WithKind(ProtectedEnvironmentKind)
+)
+
+// GroupTree type metadata
+var (
+	GroupTreeKind             = reflect.TypeOf(GroupTree{}).Name()
+	GroupTreeGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: GroupTreeKind}.String()
+	GroupTreeKindAPIVersion   = GroupTreeKind + "." + SchemeGroupVersion.String()
+	GroupTreeGroupVersionKind = SchemeGroupVersion.WithKind(GroupTreeKind)
+)
+
+// Milestone type metadata
+var (
+	MilestoneKind             = reflect.TypeOf(Milestone{}).Name()
+	MilestoneGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: MilestoneKind}.String()
+	MilestoneKindAPIVersion   = MilestoneKind + "." + SchemeGroupVersion.String()
+	MilestoneGroupVersionKind = SchemeGroupVersion.WithKind(MilestoneKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Group{}, &GroupList{})
 	SchemeBuilder.Register(&Member{}, &MemberList{})
 	SchemeBuilder.Register(&AccessToken{}, &AccessTokenList{})
 	SchemeBuilder.Register(&DeployToken{}, &DeployTokenList{})
 	SchemeBuilder.Register(&Variable{}, &VariableList{})
+	SchemeBuilder.Register(&LabelSet{}, &LabelSetList{})
+	SchemeBuilder.Register(&GroupShare{}, &GroupShareList{})
+	SchemeBuilder.Register(&ProtectedEnvironment{}, &ProtectedEnvironmentList{})
+	SchemeBuilder.Register(&GroupTree{}, &GroupTreeList{})
+	SchemeBuilder.Register(&Milestone{}, &MilestoneList{})
 }
@@ -66,10 +66,21 @@ type VariableParameters struct {
 	// +nullable
 	ValueSecretRef *xpv1.SecretKeySelector `json:"valueSecretRef,omitempty"`
 
+	// Description of a variable.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
 	// Masked enables or disables variable masking.
 	// +optional
 	Masked *bool `json:"masked,omitempty"`
 
+	// Hidden masks the variable and hides its value in the Gitlab UI and
+	// API. It can only be set when the variable is created, and changes of
+	// the value of a hidden variable are not detected.
+	// +optional
+	// +immutable
+	Hidden *bool `json:"hidden,omitempty"`
+
 	// Protected enables or disables variable protection.
 	// +optional
 	Protected *bool `json:"protected,omitempty"`
@@ -86,6 +97,12 @@ type VariableParameters struct {
 	// EnvironmentScope indicates the environment scope of a variable.
 	// +optional
 	EnvironmentScope *string `json:"environmentScope,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // A VariableSpec defines the desired state of a Gitlab Group CI
@@ -125,3 +142,11 @@ type VariableList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Variable `json:"items"`
 }
+
+// GetSecretRefs of this Variable.
+func (mg *Variable) GetSecretRefs() []xpv1.SecretKeySelector {
+	if ref := mg.Spec.ForProvider.ValueSecretRef; ref != nil {
+		return []xpv1.SecretKeySelector{*ref}
+	}
+	return nil
+}
@@ -21,7 +21,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apisv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -92,6 +94,11 @@ func (in *AccessTokenObservation) DeepCopyInto(out *AccessTokenObservation) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.RevokePendingID != nil {
+		in, out := &in.RevokePendingID, &out.RevokePendingID
+		*out = new(int)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenObservation.
@@ -136,6 +143,21 @@ func (in *AccessTokenParameters) DeepCopyInto(out *AccessTokenParameters) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RecreateOnDrift != nil {
+		in, out := &in.RecreateOnDrift, &out.RecreateOnDrift
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipRevokeOnDelete != nil {
+		in, out := &in.SkipRevokeOnDelete, &out.SkipRevokeOnDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenParameters.
@@ -303,6 +325,16 @@ func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SkipRevokeOnDelete != nil {
+		in, out := &in.SkipRevokeOnDelete, &out.SkipRevokeOnDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenParameters.
@@ -349,6 +381,77 @@ func (in *DeployTokenStatus) DeepCopy() *DeployTokenStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentAccess) DeepCopyInto(out *EnvironmentAccess) {
+	*out = *in
+	if in.AccessLevel != nil {
+		in, out := &in.AccessLevel, &out.AccessLevel
+		*out = new(AccessLevelValue)
+		**out = **in
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentAccess.
+func (in *EnvironmentAccess) DeepCopy() *EnvironmentAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentAccessObservation) DeepCopyInto(out *EnvironmentAccessObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentAccessObservation.
+func (in *EnvironmentAccessObservation) DeepCopy() *EnvironmentAccessObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentAccessObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentApprovalRule) DeepCopyInto(out *EnvironmentApprovalRule) {
+	*out = *in
+	in.EnvironmentAccess.DeepCopyInto(&out.EnvironmentAccess)
+	if in.RequiredApprovals != nil {
+		in, out := &in.RequiredApprovals, &out.RequiredApprovals
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupInheritanceType != nil {
+		in, out := &in.GroupInheritanceType, &out.GroupInheritanceType
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentApprovalRule.
+func (in *EnvironmentApprovalRule) DeepCopy() *EnvironmentApprovalRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentApprovalRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
@@ -593,69 +696,35 @@ func (in *GroupParameters) DeepCopyInto(out *GroupParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupParameters.
-func (in *GroupParameters) DeepCopy() *GroupParameters {
-	if in == nil {
-		return nil
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(apisv1alpha1.Timeouts)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(GroupParameters)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupSpec.
-func (in *GroupSpec) DeepCopy() *GroupSpec {
-	if in == nil {
-		return nil
+	if in.ConfirmDelete != nil {
+		in, out := &in.ConfirmDelete, &out.ConfirmDelete
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(GroupSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
-	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupStatus.
-func (in *GroupStatus) DeepCopy() *GroupStatus {
-	if in == nil {
-		return nil
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
 	}
-	out := new(GroupStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPGroupLink) DeepCopyInto(out *LDAPGroupLink) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPGroupLink.
-func (in *LDAPGroupLink) DeepCopy() *LDAPGroupLink {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupParameters.
+func (in *GroupParameters) DeepCopy() *GroupParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPGroupLink)
+	out := new(GroupParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Member) DeepCopyInto(out *Member) {
+func (in *GroupShare) DeepCopyInto(out *GroupShare) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -663,18 +732,18 @@ func (in *Member) DeepCopyInto(out *Member) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
-func (in *Member) DeepCopy() *Member {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupShare.
+func (in *GroupShare) DeepCopy() *GroupShare {
 	if in == nil {
 		return nil
 	}
-	out := new(Member)
+	out := new(GroupShare)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Member) DeepCopyObject() runtime.Object {
+func (in *GroupShare) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -682,31 +751,31 @@ func (in *Member) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberList) DeepCopyInto(out *MemberList) {
+func (in *GroupShareList) DeepCopyInto(out *GroupShareList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Member, len(*in))
+		*out = make([]GroupShare, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
-func (in *MemberList) DeepCopy() *MemberList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupShareList.
+func (in *GroupShareList) DeepCopy() *GroupShareList {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberList)
+	out := new(GroupShareList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MemberList) DeepCopyObject() runtime.Object {
+func (in *GroupShareList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -714,31 +783,26 @@ func (in *MemberList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+func (in *GroupShareObservation) DeepCopyInto(out *GroupShareObservation) {
 	*out = *in
-	if in.GroupSAMLIdentity != nil {
-		in, out := &in.GroupSAMLIdentity, &out.GroupSAMLIdentity
-		*out = new(MemberSAMLIdentity)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
-func (in *MemberObservation) DeepCopy() *MemberObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupShareObservation.
+func (in *GroupShareObservation) DeepCopy() *GroupShareObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberObservation)
+	out := new(GroupShareObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+func (in *GroupShareParameters) DeepCopyInto(out *GroupShareParameters) {
 	*out = *in
 	if in.GroupID != nil {
 		in, out := &in.GroupID, &out.GroupID
-		*out = new(int)
+		*out = new(string)
 		**out = **in
 	}
 	if in.GroupIDRef != nil {
@@ -751,132 +815,1126 @@ func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int)
-		**out = **in
-	}
-	if in.UserName != nil {
-		in, out := &in.UserName, &out.UserName
+	if in.SharedWithGroupID != nil {
+		in, out := &in.SharedWithGroupID, &out.SharedWithGroupID
 		*out = new(string)
 		**out = **in
 	}
+	if in.SharedWithGroupIDRef != nil {
+		in, out := &in.SharedWithGroupIDRef, &out.SharedWithGroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedWithGroupIDSelector != nil {
+		in, out := &in.SharedWithGroupIDSelector, &out.SharedWithGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExpiresAt != nil {
 		in, out := &in.ExpiresAt, &out.ExpiresAt
 		*out = new(string)
 		**out = **in
 	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
-func (in *MemberParameters) DeepCopy() *MemberParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupShareParameters.
+func (in *GroupShareParameters) DeepCopy() *GroupShareParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberParameters)
+	out := new(GroupShareParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberSAMLIdentity) DeepCopyInto(out *MemberSAMLIdentity) {
+func (in *GroupShareSpec) DeepCopyInto(out *GroupShareSpec) {
 	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSAMLIdentity.
-func (in *MemberSAMLIdentity) DeepCopy() *MemberSAMLIdentity {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupShareSpec.
+func (in *GroupShareSpec) DeepCopy() *GroupShareSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberSAMLIdentity)
+	out := new(GroupShareSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+func (in *GroupShareStatus) DeepCopyInto(out *GroupShareStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupShareStatus.
+func (in *GroupShareStatus) DeepCopy() *GroupShareStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupShareStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
-func (in *MemberSpec) DeepCopy() *MemberSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupSpec.
+func (in *GroupSpec) DeepCopy() *GroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberSpec)
+	out := new(GroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
-func (in *MemberStatus) DeepCopy() *MemberStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupStatus.
+func (in *GroupStatus) DeepCopy() *GroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberStatus)
+	out := new(GroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
+func (in *GroupTree) DeepCopyInto(out *GroupTree) {
 	*out = *in
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
-		*out = new(int)
-		**out = **in
-	}
-	if in.GroupIDRef != nil {
-		in, out := &in.GroupIDRef, &out.GroupIDRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GroupIDSelector != nil {
-		in, out := &in.GroupIDSelector, &out.GroupIDSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
-func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupTree.
+func (in *GroupTree) DeepCopy() *GroupTree {
 	if in == nil {
 		return nil
 	}
-	out := new(SharedWithGroups)
+	out := new(GroupTree)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupTree) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SharedWithGroupsObservation) DeepCopyInto(out *SharedWithGroupsObservation) {
+func (in *GroupTreeGroup) DeepCopyInto(out *GroupTreeGroup) {
 	*out = *in
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(int)
 		**out = **in
 	}
-	if in.GroupName != nil {
-		in, out := &in.GroupName, &out.GroupName
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupTreeGroup.
+func (in *GroupTreeGroup) DeepCopy() *GroupTreeGroup {
+	if in == nil {
+		return nil
 	}
-	if in.GroupFullPath != nil {
-		in, out := &in.GroupFullPath, &out.GroupFullPath
-		*out = new(string)
+	out := new(GroupTreeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupTreeList) DeepCopyInto(out *GroupTreeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupTree, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupTreeList.
+func (in *GroupTreeList) DeepCopy() *GroupTreeList {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupTreeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupTreeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupTreeNode) DeepCopyInto(out *GroupTreeNode) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(VisibilityValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupTreeNode.
+func (in *GroupTreeNode) DeepCopy() *GroupTreeNode {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupTreeNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupTreeSpec) DeepCopyInto(out *GroupTreeSpec) {
+	*out = *in
+	if in.ProviderConfigReference != nil {
+		in, out := &in.ProviderConfigReference, &out.ProviderConfigReference
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfirmDelete != nil {
+		in, out := &in.ConfirmDelete, &out.ConfirmDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RootGroupID != nil {
+		in, out := &in.RootGroupID, &out.RootGroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.RootGroupRef != nil {
+		in, out := &in.RootGroupRef, &out.RootGroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]GroupTreeNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupTreeSpec.
+func (in *GroupTreeSpec) DeepCopy() *GroupTreeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupTreeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupTreeStatus) DeepCopyInto(out *GroupTreeStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]GroupTreeGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupTreeStatus.
+func (in *GroupTreeStatus) DeepCopy() *GroupTreeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupTreeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPGroupLink) DeepCopyInto(out *LDAPGroupLink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPGroupLink.
+func (in *LDAPGroupLink) DeepCopy() *LDAPGroupLink {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPGroupLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Label) DeepCopyInto(out *Label) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Label.
+func (in *Label) DeepCopy() *Label {
+	if in == nil {
+		return nil
+	}
+	out := new(Label)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelObservation) DeepCopyInto(out *LabelObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelObservation.
+func (in *LabelObservation) DeepCopy() *LabelObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSet) DeepCopyInto(out *LabelSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSet.
+func (in *LabelSet) DeepCopy() *LabelSet {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LabelSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetList) DeepCopyInto(out *LabelSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LabelSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetList.
+func (in *LabelSetList) DeepCopy() *LabelSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LabelSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetObservation) DeepCopyInto(out *LabelSetObservation) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]LabelObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetObservation.
+func (in *LabelSetObservation) DeepCopy() *LabelSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetParameters) DeepCopyInto(out *LabelSetParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]Label, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PropagateToProjectIDs != nil {
+		in, out := &in.PropagateToProjectIDs, &out.PropagateToProjectIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetParameters.
+func (in *LabelSetParameters) DeepCopy() *LabelSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetSpec) DeepCopyInto(out *LabelSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetSpec.
+func (in *LabelSetSpec) DeepCopy() *LabelSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetStatus) DeepCopyInto(out *LabelSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetStatus.
+func (in *LabelSetStatus) DeepCopy() *LabelSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Member) DeepCopyInto(out *Member) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
+func (in *Member) DeepCopy() *Member {
+	if in == nil {
+		return nil
+	}
+	out := new(Member)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Member) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberList) DeepCopyInto(out *MemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
+func (in *MemberList) DeepCopy() *MemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+	*out = *in
+	if in.GroupSAMLIdentity != nil {
+		in, out := &in.GroupSAMLIdentity, &out.GroupSAMLIdentity
+		*out = new(MemberSAMLIdentity)
+		**out = **in
+	}
+	if in.ExtendedExpiresAt != nil {
+		in, out := &in.ExtendedExpiresAt, &out.ExtendedExpiresAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
+func (in *MemberObservation) DeepCopy() *MemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoExtendBy != nil {
+		in, out := &in.AutoExtendBy, &out.AutoExtendBy
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RequireSAMLProvider != nil {
+		in, out := &in.RequireSAMLProvider, &out.RequireSAMLProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.UnassignIssuablesOnRemove != nil {
+		in, out := &in.UnassignIssuablesOnRemove, &out.UnassignIssuablesOnRemove
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipSubresources != nil {
+		in, out := &in.SkipSubresources, &out.SkipSubresources
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
+func (in *MemberParameters) DeepCopy() *MemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberSAMLIdentity) DeepCopyInto(out *MemberSAMLIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSAMLIdentity.
+func (in *MemberSAMLIdentity) DeepCopy() *MemberSAMLIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberSAMLIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
+func (in *MemberSpec) DeepCopy() *MemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
+func (in *MemberStatus) DeepCopy() *MemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Milestone) DeepCopyInto(out *Milestone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Milestone.
+func (in *Milestone) DeepCopy() *Milestone {
+	if in == nil {
+		return nil
+	}
+	out := new(Milestone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Milestone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneAutoClosePolicy) DeepCopyInto(out *MilestoneAutoClosePolicy) {
+	*out = *in
+	if in.RollOverOpenIssues != nil {
+		in, out := &in.RollOverOpenIssues, &out.RollOverOpenIssues
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneAutoClosePolicy.
+func (in *MilestoneAutoClosePolicy) DeepCopy() *MilestoneAutoClosePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneAutoClosePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneList) DeepCopyInto(out *MilestoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Milestone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneList.
+func (in *MilestoneList) DeepCopy() *MilestoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MilestoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneObservation) DeepCopyInto(out *MilestoneObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneObservation.
+func (in *MilestoneObservation) DeepCopy() *MilestoneObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneParameters) DeepCopyInto(out *MilestoneParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartDate != nil {
+		in, out := &in.StartDate, &out.StartDate
+		*out = new(string)
+		**out = **in
+	}
+	if in.DueDate != nil {
+		in, out := &in.DueDate, &out.DueDate
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoClosePolicy != nil {
+		in, out := &in.AutoClosePolicy, &out.AutoClosePolicy
+		*out = new(MilestoneAutoClosePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneParameters.
+func (in *MilestoneParameters) DeepCopy() *MilestoneParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneSpec) DeepCopyInto(out *MilestoneSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneSpec.
+func (in *MilestoneSpec) DeepCopy() *MilestoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneStatus) DeepCopyInto(out *MilestoneStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneStatus.
+func (in *MilestoneStatus) DeepCopy() *MilestoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironment) DeepCopyInto(out *ProtectedEnvironment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironment.
+func (in *ProtectedEnvironment) DeepCopy() *ProtectedEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedEnvironment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentList) DeepCopyInto(out *ProtectedEnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProtectedEnvironment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentList.
+func (in *ProtectedEnvironmentList) DeepCopy() *ProtectedEnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedEnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedEnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentObservation) DeepCopyInto(out *ProtectedEnvironmentObservation) {
+	*out = *in
+	if in.DeployAccessLevels != nil {
+		in, out := &in.DeployAccessLevels, &out.DeployAccessLevels
+		*out = make([]EnvironmentAccessObservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApprovalRules != nil {
+		in, out := &in.ApprovalRules, &out.ApprovalRules
+		*out = make([]EnvironmentAccessObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentObservation.
+func (in *ProtectedEnvironmentObservation) DeepCopy() *ProtectedEnvironmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedEnvironmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentParameters) DeepCopyInto(out *ProtectedEnvironmentParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeployAccessLevels != nil {
+		in, out := &in.DeployAccessLevels, &out.DeployAccessLevels
+		*out = make([]EnvironmentAccess, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RequiredApprovalCount != nil {
+		in, out := &in.RequiredApprovalCount, &out.RequiredApprovalCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.ApprovalRules != nil {
+		in, out := &in.ApprovalRules, &out.ApprovalRules
+		*out = make([]EnvironmentApprovalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentParameters.
+func (in *ProtectedEnvironmentParameters) DeepCopy() *ProtectedEnvironmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedEnvironmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentSpec) DeepCopyInto(out *ProtectedEnvironmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentSpec.
+func (in *ProtectedEnvironmentSpec) DeepCopy() *ProtectedEnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedEnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedEnvironmentStatus) DeepCopyInto(out *ProtectedEnvironmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedEnvironmentStatus.
+func (in *ProtectedEnvironmentStatus) DeepCopy() *ProtectedEnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedEnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
+func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedWithGroups)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedWithGroupsObservation) DeepCopyInto(out *SharedWithGroupsObservation) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupFullPath != nil {
+		in, out := &in.GroupFullPath, &out.GroupFullPath
+		*out = new(string)
 		**out = **in
 	}
 	if in.GroupAccessLevel != nil {
@@ -1002,11 +2060,21 @@ func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
 	if in.Masked != nil {
 		in, out := &in.Masked, &out.Masked
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Hidden != nil {
+		in, out := &in.Hidden, &out.Hidden
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Protected != nil {
 		in, out := &in.Protected, &out.Protected
 		*out = new(bool)
@@ -1027,6 +2095,11 @@ func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableParameters.
@@ -24,6 +24,8 @@ import (
 
 // AccessTokenParameters define the desired state of a Gitlab access token
 // https://docs.gitlab.com/ee/api/access_tokens.html
+// An access token belongs to either a project or a group.
+// +kubebuilder:validation:XValidation:rule="!(has(self.groupId) || has(self.groupIdRef) || has(self.groupIdSelector)) || !(has(self.projectId) || has(self.projectIdRef) || has(self.projectIdSelector))",message="an access token belongs to either a project or a group"
 type AccessTokenParameters struct {
 	// ProjectID is the ID of the project to create the access token in.
 	// +optional
@@ -40,6 +42,24 @@ type AccessTokenParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// GroupID is the ID of the group to create the access token in. Creating
+	// group access tokens requires the Owner role.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=GroupIDRef
+	// +crossplane:generate:reference:selectorFieldName=GroupIDSelector
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
 	// Expiration date of the access token. The date cannot be set later than the maximum allowable lifetime of an access token.
 	// If not set, the maximum allowable lifetime of a personal access token is 365 days.
 	// Expected in ISO 8601 format (2019-03-15T08:00:00Z)
@@ -61,6 +81,24 @@ type AccessTokenParameters struct {
 	// Name of the project access token
 	// +required
 	Name string `json:"name"`
+
+	// RecreateOnDrift recreates the access token when its scopes, access
+	// level or expiry were changed outside of Crossplane, or when it was
+	// revoked. The new token is published to the connection secret before
+	// the replaced one is revoked.
+	// +optional
+	RecreateOnDrift *bool `json:"recreateOnDrift,omitempty"`
+
+	// SkipRevokeOnDelete keeps the access token valid when the managed resource
+	// is deleted, for credentials shared with systems outside of Crossplane.
+	// +optional
+	SkipRevokeOnDelete *bool `json:"skipRevokeOnDelete,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // AccessTokenObservation represents a access token.
@@ -69,6 +107,11 @@ type AccessTokenParameters struct {
 // https://docs.gitlab.com/ee/api/project_access_tokens.html
 type AccessTokenObservation struct {
 	TokenID *int `json:"id,omitempty"`
+
+	// RevokePendingID is the ID of the token replaced by the last
+	// recreation. It is revoked once its successor was published.
+	// +optional
+	RevokePendingID *int `json:"revokePendingId,omitempty"`
 }
 
 // A AccessTokenSpec defines the desired state of a Gitlab Project.
@@ -107,3 +150,8 @@ type AccessTokenList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []AccessToken `json:"items"`
 }
+
+// GetExpiresAt of this AccessToken.
+func (mg *AccessToken) GetExpiresAt() *metav1.Time {
+	return mg.Spec.ForProvider.ExpiresAt
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A RotationPhase is the phase of the rotation of an access token.
+type RotationPhase string
+
+// Phases of the rotation of an access token.
+const (
+	// RotationPending tokens are not requested to rotate yet.
+	RotationPending RotationPhase = "Pending"
+	// RotationRotating tokens are requested to rotate.
+	RotationRotating RotationPhase = "Rotating"
+	// RotationRotated tokens were revoked and recreated.
+	RotationRotated RotationPhase = "Rotated"
+	// RotationFailed tokens were deleted before they were rotated.
+	RotationFailed RotationPhase = "Failed"
+)
+
+// An AccessTokenRotationSpec defines the access tokens an
+// AccessTokenRotation rotates and how fast.
+type AccessTokenRotationSpec struct {
+	// TokenSelector selects the project and group AccessTokens to rotate by
+	// their labels. A rotation without a selector rotates all AccessTokens.
+	// +optional
+	TokenSelector *metav1.LabelSelector `json:"tokenSelector,omitempty"`
+
+	// MaxParallel is the number of access tokens rotating at the same time.
+	// Defaults to 5.
+	// +optional
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	MaxParallel *int `json:"maxParallel,omitempty"`
+
+	// Interval is the minimum time between the starts of two rotations, to
+	// spread the load on Gitlab and on the consumers of the tokens.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// An AccessTokenRotationTarget reports the rotation of an access token.
+type AccessTokenRotationTarget struct {
+	// Kind of the access token, AccessToken.projects.gitlab.crossplane.io or
+	// AccessToken.groups.gitlab.crossplane.io.
+	Kind string `json:"kind"`
+
+	// Name of the access token.
+	Name string `json:"name"`
+
+	// Phase of the rotation of the access token.
+	Phase RotationPhase `json:"phase"`
+
+	// StartedAt is the time the access token was requested to rotate.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// FinishedAt is the time the rotation of the access token was seen to
+	// complete or fail.
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+
+	// Message explains why the access token is not rotated yet or failed to
+	// rotate.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// An AccessTokenRotationStatus reports the progress of an
+// AccessTokenRotation.
+type AccessTokenRotationStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+
+	// Total is the number of access tokens the rotation applies to.
+	Total int `json:"total,omitempty"`
+
+	// Rotated is the number of access tokens that were rotated.
+	Rotated int `json:"rotated,omitempty"`
+
+	// Failed is the number of access tokens that failed to rotate.
+	Failed int `json:"failed,omitempty"`
+
+	// LastStartedAt is the time the last access token was requested to
+	// rotate.
+	// +optional
+	LastStartedAt *metav1.Time `json:"lastStartedAt,omitempty"`
+
+	// Tokens reports the rotation of every access token.
+	// +optional
+	Tokens []AccessTokenRotationTarget `json:"tokens,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessTokenRotation rotates the project and group AccessTokens it
+// selects, e.g. in response to a security incident. The selected tokens are
+// recorded when the rotation is created and requested to rotate one after
+// another, at most MaxParallel at a time. Each token is recreated by its own
+// controller, which publishes the new token to its connection secret and
+// then revokes the replaced one. The status reports the rotation of every
+// token. A rotation runs once; create another one to rotate the tokens
+// again.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TOTAL",type="integer",JSONPath=".status.total"
+// +kubebuilder:printcolumn:name="ROTATED",type="integer",JSONPath=".status.rotated"
+// +kubebuilder:printcolumn:name="FAILED",type="integer",JSONPath=".status.failed"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,gitlab}
+type AccessTokenRotation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessTokenRotationSpec   `json:"spec"`
+	Status AccessTokenRotationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessTokenRotationList contains a list of AccessTokenRotation items.
+type AccessTokenRotationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessTokenRotation `json:"items"`
+}
+
+// GetCondition of this AccessTokenRotation.
+func (r *AccessTokenRotation) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return r.Status.GetCondition(ct)
+}
+
+// SetConditions of this AccessTokenRotation.
+func (r *AccessTokenRotation) SetConditions(c ...xpv1.Condition) {
+	r.Status.SetConditions(c...)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApprovalParameters define when the user of the provider token approves a
+// Gitlab merge request. The merge request is approved while all conditions
+// hold, and the approval is revoked when one of them stops holding.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ApprovalParameters struct {
+	// The ID or URL-encoded path of the project of the merge request.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// MergeRequestIID is the internal ID of the merge request in its
+	// project.
+	// +immutable
+	// +kubebuilder:validation:Minimum=1
+	MergeRequestIID int `json:"mergeRequestIid"`
+
+	// RequiredLabels the merge request must have to be approved.
+	// +optional
+	RequiredLabels []string `json:"requiredLabels,omitempty"`
+
+	// AuthorUsername is the username the author of the merge request must
+	// have to be approved, for example the one of a dependency update bot.
+	// +optional
+	AuthorUsername *string `json:"authorUsername,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig. Its user approves the merge request.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ApprovalObservation represents the observed state of the approval of a
+// Gitlab merge request.
+type ApprovalObservation struct {
+	// Approved is whether the user of the provider token approved the
+	// merge request.
+	Approved bool `json:"approved,omitempty"`
+
+	// UnmetConditions lists the conditions that keep the merge request
+	// from being approved.
+	UnmetConditions []string `json:"unmetConditions,omitempty"`
+
+	// State of the merge request.
+	State string `json:"state,omitempty"`
+
+	// SHA of the head commit of the merge request.
+	SHA string `json:"sha,omitempty"`
+
+	// WebURL of the merge request.
+	WebURL string `json:"webUrl,omitempty"`
+}
+
+// An ApprovalSpec defines the desired state of the approval of a Gitlab
+// merge request.
+type ApprovalSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApprovalParameters `json:"forProvider"`
+}
+
+// An ApprovalStatus represents the observed state of the approval of a
+// Gitlab merge request.
+type ApprovalStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApprovalObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Approval is a managed resource that represents the approval of a
+// Gitlab merge request by the user of the provider token, given while the
+// merge request meets the conditions of the spec. Deleting it revokes the
+// approval.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPROVED",type="boolean",JSONPath=".status.atProvider.approved"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Approval struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApprovalSpec   `json:"spec"`
+	Status ApprovalStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApprovalList contains a list of Approval items.
+type ApprovalList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Approval `json:"items"`
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApprovalRule is a single merge request approval rule managed by an
+// ApprovalRuleSet.
+type ApprovalRule struct {
+	// Name of the approval rule.
+	Name string `json:"name"`
+
+	// ApprovalsRequired is the number of approvals required for this rule.
+	// +kubebuilder:validation:Minimum=0
+	ApprovalsRequired int `json:"approvalsRequired"`
+
+	// RuleType of the approval rule. A rule whose type changes is deleted
+	// and created again, because Gitlab cannot change the type of a rule.
+	// +optional
+	// +kubebuilder:validation:Enum=regular;any_approver
+	RuleType *string `json:"ruleType,omitempty"`
+
+	// UserIDs of the users eligible to approve.
+	// +optional
+	UserIDs []int `json:"userIds,omitempty"`
+
+	// GroupIDs of the groups whose members are eligible to approve.
+	// +optional
+	GroupIDs []int `json:"groupIds,omitempty"`
+
+	// ProtectedBranchIDs of the protected branches the rule applies to.
+	// +optional
+	ProtectedBranchIDs []int `json:"protectedBranchIds,omitempty"`
+
+	// AppliesToAllProtectedBranches applies the rule to all protected
+	// branches, ignoring ProtectedBranchIDs.
+	// +optional
+	AppliesToAllProtectedBranches *bool `json:"appliesToAllProtectedBranches,omitempty"`
+}
+
+// ApprovalRuleSetParameters define the desired state of all merge request
+// approval rules of a Gitlab Project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#project-level-mr-approvals
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ApprovalRuleSetParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Rules is the list of approval rules the project should have.
+	// +listType=map
+	// +listMapKey=name
+	Rules []ApprovalRule `json:"rules"`
+
+	// Prune deletes project approval rules that are not listed in Rules,
+	// such as rules added in the Gitlab UI. Defaults to true.
+	// +optional
+	// +kubebuilder:default=true
+	Prune *bool `json:"prune,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ApprovalRuleSetObservation represents the observed approval rules of a
+// Gitlab Project.
+type ApprovalRuleSetObservation struct {
+	// Rules is the list of project approval rules found at Gitlab.
+	Rules []ApprovalRuleObservation `json:"rules,omitempty"`
+}
+
+// ApprovalRuleObservation represents an observed Gitlab approval rule.
+type ApprovalRuleObservation struct {
+	ID                            int    `json:"id"`
+	Name                          string `json:"name"`
+	RuleType                      string `json:"ruleType,omitempty"`
+	ApprovalsRequired             int    `json:"approvalsRequired,omitempty"`
+	UserIDs                       []int  `json:"userIds,omitempty"`
+	GroupIDs                      []int  `json:"groupIds,omitempty"`
+	ProtectedBranchIDs            []int  `json:"protectedBranchIds,omitempty"`
+	AppliesToAllProtectedBranches bool   `json:"appliesToAllProtectedBranches,omitempty"`
+}
+
+// An ApprovalRuleSetSpec defines the desired state of a Gitlab Project
+// ApprovalRuleSet.
+type ApprovalRuleSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApprovalRuleSetParameters `json:"forProvider"`
+}
+
+// An ApprovalRuleSetStatus represents the observed state of a Gitlab Project
+// ApprovalRuleSet.
+type ApprovalRuleSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApprovalRuleSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApprovalRuleSet is a managed resource that authoritatively manages the
+// merge request approval rules of a Gitlab Project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ApprovalRuleSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApprovalRuleSetSpec   `json:"spec"`
+	Status ApprovalRuleSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApprovalRuleSetList contains a list of ApprovalRuleSet items.
+type ApprovalRuleSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApprovalRuleSet `json:"items"`
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApprovalSettingsParameters define the merge request approval settings of
+// a Gitlab Project. Settings that are not set are late initialized from
+// Gitlab. The number of approvals required is managed by the Project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#change-configuration
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ApprovalSettingsParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// ResetApprovalsOnPush removes all approvals of a merge request when new
+	// commits are pushed to it.
+	// +optional
+	ResetApprovalsOnPush *bool `json:"resetApprovalsOnPush,omitempty"`
+
+	// DisableOverridingApproversPerMergeRequest keeps merge requests from
+	// changing the approval rules of the project.
+	// +optional
+	DisableOverridingApproversPerMergeRequest *bool `json:"disableOverridingApproversPerMergeRequest,omitempty"`
+
+	// MergeRequestsAuthorApproval allows the author of a merge request to
+	// approve it.
+	// +optional
+	MergeRequestsAuthorApproval *bool `json:"mergeRequestsAuthorApproval,omitempty"`
+
+	// RequirePasswordToApprove requires approvers to authenticate again.
+	// +optional
+	RequirePasswordToApprove *bool `json:"requirePasswordToApprove,omitempty"`
+
+	// SelectiveCodeOwnerRemovals removes only the approvals of code owners
+	// whose files changed when new commits are pushed. Requires
+	// ResetApprovalsOnPush to be false.
+	// +optional
+	SelectiveCodeOwnerRemovals *bool `json:"selectiveCodeOwnerRemovals,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ApprovalSettingsObservation represents the observed merge request approval
+// settings of a Gitlab Project.
+type ApprovalSettingsObservation struct {
+	ResetApprovalsOnPush                      bool `json:"resetApprovalsOnPush,omitempty"`
+	DisableOverridingApproversPerMergeRequest bool `json:"disableOverridingApproversPerMergeRequest,omitempty"`
+	MergeRequestsAuthorApproval               bool `json:"mergeRequestsAuthorApproval,omitempty"`
+	RequirePasswordToApprove                  bool `json:"requirePasswordToApprove,omitempty"`
+	SelectiveCodeOwnerRemovals                bool `json:"selectiveCodeOwnerRemovals,omitempty"`
+}
+
+// An ApprovalSettingsSpec defines the desired state of the merge request
+// approval settings of a Gitlab Project.
+type ApprovalSettingsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApprovalSettingsParameters `json:"forProvider"`
+}
+
+// An ApprovalSettingsStatus represents the observed state of the merge
+// request approval settings of a Gitlab Project.
+type ApprovalSettingsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApprovalSettingsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApprovalSettings is a managed resource that represents the merge
+// request approval settings of a Gitlab Project. Every project has exactly
+// one set of approval settings, so at most one ApprovalSettings should
+// manage a project. Deleting it leaves the settings of the project as they
+// are.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ApprovalSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApprovalSettingsSpec   `json:"spec"`
+	Status ApprovalSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApprovalSettingsList contains a list of ApprovalSettings items.
+type ApprovalSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApprovalSettings `json:"items"`
+}
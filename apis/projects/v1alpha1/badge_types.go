@@ -0,0 +1,139 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BadgeParameters define the desired state of a Gitlab Project Badge.
+//
+// LinkURL and ImageURL may contain placeholders which Gitlab renders per
+// project, for example %{project_path} or %{default_branch}.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_badges.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required, or
+// for a group badge 1 of [GroupID, GroupIDRef, GroupIDSelector].
+// +kubebuilder:validation:XValidation:rule="!(has(self.groupId) || has(self.groupIdRef) || has(self.groupIdSelector)) || !(has(self.projectId) || has(self.projectIdRef) || has(self.projectIdSelector))",message="a badge belongs to either a project or a group"
+type BadgeParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// The ID or URL-encoded path of the group of a group badge.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=GroupIDRef
+	// +crossplane:generate:reference:selectorFieldName=GroupIDSelector
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// LinkURL is the URL the badge links to.
+	LinkURL string `json:"linkUrl"`
+
+	// ImageURL is the URL of the badge image.
+	ImageURL string `json:"imageUrl"`
+
+	// Name of the badge.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// BadgeObservation represents the observed state of a Gitlab Project Badge.
+type BadgeObservation struct {
+	// ID of the badge at gitlab
+	ID int `json:"id,omitempty"`
+
+	// RenderedLinkURL is LinkURL with its placeholders rendered by Gitlab.
+	RenderedLinkURL string `json:"renderedLinkUrl,omitempty"`
+
+	// RenderedImageURL is ImageURL with its placeholders rendered by Gitlab.
+	RenderedImageURL string `json:"renderedImageUrl,omitempty"`
+
+	// Kind is either project or group, depending on where the badge is
+	// defined.
+	Kind string `json:"kind,omitempty"`
+}
+
+// A BadgeSpec defines the desired state of a Gitlab Project Badge.
+type BadgeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BadgeParameters `json:"forProvider"`
+}
+
+// A BadgeStatus represents the observed state of a Gitlab Project Badge.
+type BadgeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BadgeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Badge is a managed resource that represents a Gitlab Project Badge.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Badge struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BadgeSpec   `json:"spec"`
+	Status BadgeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BadgeList contains a list of Badge items.
+type BadgeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Badge `json:"items"`
+}
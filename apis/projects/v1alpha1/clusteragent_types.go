@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterAgentParameters define the desired state of the registration of a
+// GitLab agent for Kubernetes with a Gitlab Project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#register-an-agent-with-a-project
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ClusterAgentParameters struct {
+	// The ID or URL-encoded path of the project the agent is registered
+	// with. The project holds the configuration of the agent.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name of the agent. It is unique within the project and matches the
+	// directory of the agent configuration in the project.
+	// +immutable
+	Name string `json:"name"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ClusterAgentObservation represents the observed state of a GitLab agent
+// for Kubernetes.
+type ClusterAgentObservation struct {
+	ID                             int          `json:"id,omitempty"`
+	CreatedAt                      *metav1.Time `json:"createdAt,omitempty"`
+	CreatedByUserID                int          `json:"createdByUserId,omitempty"`
+	ConfigProjectID                int          `json:"configProjectId,omitempty"`
+	ConfigProjectPathWithNamespace string       `json:"configProjectPathWithNamespace,omitempty"`
+}
+
+// A ClusterAgentSpec defines the desired state of a GitLab agent for
+// Kubernetes.
+type ClusterAgentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterAgentParameters `json:"forProvider"`
+}
+
+// A ClusterAgentStatus represents the observed state of a GitLab agent for
+// Kubernetes.
+type ClusterAgentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterAgentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterAgent is a managed resource that represents the registration of
+// a GitLab agent for Kubernetes with a Gitlab Project. An agent cannot be
+// changed once registered. Deleting a ClusterAgent also revokes the tokens
+// of the agent.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="integer",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ClusterAgent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterAgentSpec   `json:"spec"`
+	Status ClusterAgentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterAgentList contains a list of ClusterAgent items.
+type ClusterAgentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAgent `json:"items"`
+}
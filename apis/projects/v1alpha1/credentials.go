@@ -0,0 +1,179 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// GetCredentialsSecretRef of this AccessToken.
+func (mg *AccessToken) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this ApprovalRuleSet.
+func (mg *ApprovalRuleSet) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this ApprovalSettings.
+func (mg *ApprovalSettings) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Badge.
+func (mg *Badge) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this DeployKey.
+func (mg *DeployKey) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this DeployToken.
+func (mg *DeployToken) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this ExternalStatusCheck.
+func (mg *ExternalStatusCheck) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this FreezePeriod.
+func (mg *FreezePeriod) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Hook.
+func (mg *Hook) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this IssueLink.
+func (mg *IssueLink) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this LabelSet.
+func (mg *LabelSet) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Member.
+func (mg *Member) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this PipelineRetentionPolicy.
+func (mg *PipelineRetentionPolicy) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this PipelineSchedule.
+func (mg *PipelineSchedule) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Project.
+func (mg *Project) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this ProtectedBranchSet.
+func (mg *ProtectedBranchSet) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Release.
+func (mg *Release) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this RemoteMirror.
+func (mg *RemoteMirror) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Variable.
+func (mg *Variable) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this ProjectShare.
+func (mg *ProjectShare) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this ClusterAgent.
+func (mg *ClusterAgent) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this DefaultReviewers.
+func (mg *DefaultReviewers) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Snippet.
+func (mg *Snippet) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this WikiPage.
+func (mg *WikiPage) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this DeployKeyEnablement.
+func (mg *DeployKeyEnablement) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this JobTokenScope.
+func (mg *JobTokenScope) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this ForkRelationship.
+func (mg *ForkRelationship) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this JiraIntegration.
+func (mg *JiraIntegration) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this ProjectNotificationSettings.
+func (mg *ProjectNotificationSettings) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Note.
+func (mg *Note) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Approval.
+func (mg *Approval) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
+
+// GetCredentialsSecretRef of this Milestone.
+func (mg *Milestone) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return mg.Spec.ForProvider.CredentialsSecretRef
+}
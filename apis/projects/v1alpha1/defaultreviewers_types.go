@@ -0,0 +1,178 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A CodeOwnersEntry assigns owners to the paths matching a pattern.
+type CodeOwnersEntry struct {
+	// Pattern of the paths, e.g. *.go or /docs/.
+	Pattern string `json:"pattern"`
+
+	// Owners of the matching paths, as @username, @group/path or email
+	// address. The default owners of the section own the paths if empty.
+	// +optional
+	Owners []string `json:"owners,omitempty"`
+}
+
+// A CodeOwnersSection is a section of a CODEOWNERS file.
+//
+// GitLab docs: https://docs.gitlab.com/ee/user/project/codeowners/reference.html#sections
+type CodeOwnersSection struct {
+	// Name of the section.
+	Name string `json:"name"`
+
+	// Optional sections do not require the approval of a code owner.
+	// +optional
+	Optional *bool `json:"optional,omitempty"`
+
+	// ApprovalsRequired is the number of code owners of the section that
+	// must approve a merge request. Gitlab requires one if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ApprovalsRequired *int `json:"approvalsRequired,omitempty"`
+
+	// DefaultOwners own the entries of the section that have no owners.
+	// +optional
+	DefaultOwners []string `json:"defaultOwners,omitempty"`
+
+	// Entries of the section.
+	Entries []CodeOwnersEntry `json:"entries"`
+}
+
+// DefaultReviewersParameters define the CODEOWNERS file and the approval
+// rules that route the merge requests of a Gitlab Project to their
+// reviewers.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_files.html
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#project-level-mr-approvals
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type DefaultReviewersParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Branch the CODEOWNERS file is committed to. Defaults to main.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=main
+	Branch *string `json:"branch,omitempty"`
+
+	// FilePath of the CODEOWNERS file. Gitlab looks for it in the root,
+	// docs/ and .gitlab/ directories, in this order. Defaults to
+	// .gitlab/CODEOWNERS.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=.gitlab/CODEOWNERS
+	FilePath *string `json:"filePath,omitempty"`
+
+	// CommitMessage of the commits that change the CODEOWNERS file.
+	// +optional
+	CommitMessage *string `json:"commitMessage,omitempty"`
+
+	// Sections of the CODEOWNERS file. The whole file is managed, changes
+	// made to it in Gitlab are overwritten.
+	// +listType=map
+	// +listMapKey=name
+	Sections []CodeOwnersSection `json:"sections"`
+
+	// Rules are the approval rules that route merge requests to reviewers
+	// besides the code owners. Other approval rules of the project are left
+	// alone.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Rules []ApprovalRule `json:"rules,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// DefaultReviewersObservation represents the observed CODEOWNERS file and
+// approval rules of a Gitlab Project.
+type DefaultReviewersObservation struct {
+	// LastCommitID is the ID of the last commit that changed the CODEOWNERS
+	// file.
+	LastCommitID string `json:"lastCommitId,omitempty"`
+
+	// Rules are the approval rules of the project that are managed by the
+	// DefaultReviewers.
+	Rules []ApprovalRuleObservation `json:"rules,omitempty"`
+}
+
+// A DefaultReviewersSpec defines the desired state of the default reviewers
+// of a Gitlab Project.
+type DefaultReviewersSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DefaultReviewersParameters `json:"forProvider"`
+}
+
+// A DefaultReviewersStatus represents the observed state of the default
+// reviewers of a Gitlab Project.
+type DefaultReviewersStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DefaultReviewersObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DefaultReviewers is a managed resource that routes the merge requests of
+// a Gitlab Project to their reviewers, by managing its CODEOWNERS file and
+// a set of its approval rules as a unit. Deleting it deletes the file and
+// the rules.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type DefaultReviewers struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DefaultReviewersSpec   `json:"spec"`
+	Status DefaultReviewersStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DefaultReviewersList contains a list of DefaultReviewers items.
+type DefaultReviewersList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DefaultReviewers `json:"items"`
+}
@@ -59,6 +59,12 @@ type DeployKeyParameters struct {
 	// KeySecretRef field representing reference to the key.
 	// This property is required.
 	KeySecretRef xpv1.SecretKeySelector `json:"keySecretRef"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // DeployKeyObservation represents observed stated of Deploy Key.
@@ -104,3 +110,13 @@ type DeployKeyList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []DeployKey `json:"items"`
 }
+
+// GetExpiresAt of this DeployKey.
+func (mg *DeployKey) GetExpiresAt() *metav1.Time {
+	return mg.Spec.ForProvider.ExpiresAt
+}
+
+// GetSecretRefs of this DeployKey.
+func (mg *DeployKey) GetSecretRefs() []xpv1.SecretKeySelector {
+	return []xpv1.SecretKeySelector{mg.Spec.ForProvider.KeySecretRef}
+}
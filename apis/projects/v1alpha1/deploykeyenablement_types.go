@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeployKeyEnablementParameters define the deploy key to enable and the
+// projects to enable it for. The deploy key is enabled for the projects of
+// the group and the selected Projects.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deploy_keys.html#enable-a-deploy-key
+// At least 1 of [GroupID, GroupIDRef, GroupIDSelector, ProjectSelector] required.
+type DeployKeyEnablementParameters struct {
+	// KeyID is the ID of the deploy key to enable, usually a public deploy
+	// key created by an administrator.
+	// +immutable
+	KeyID int `json:"keyId"`
+
+	// GroupID is the ID of the group whose projects the deploy key is
+	// enabled for.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=GroupIDRef
+	// +crossplane:generate:reference:selectorFieldName=GroupIDSelector
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its GroupID.
+	// +optional
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its GroupID.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// IncludeSubgroups enables the deploy key for the projects of the
+	// subgroups of the group too.
+	// +optional
+	IncludeSubgroups *bool `json:"includeSubgroups,omitempty"`
+
+	// ProjectSelector selects Projects by their labels to enable the deploy
+	// key for. Projects that are not created yet are included once they
+	// are.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// DeployKeyEnablementObservation represents the projects a deploy key is
+// enabled for.
+type DeployKeyEnablementObservation struct {
+	// ProjectIDs are the IDs of the projects the deploy key is enabled for.
+	ProjectIDs []int `json:"projectIds,omitempty"`
+}
+
+// A DeployKeyEnablementSpec defines the desired state of a
+// DeployKeyEnablement.
+type DeployKeyEnablementSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DeployKeyEnablementParameters `json:"forProvider"`
+}
+
+// A DeployKeyEnablementStatus represents the observed state of a
+// DeployKeyEnablement.
+type DeployKeyEnablementStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DeployKeyEnablementObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DeployKeyEnablement is a managed resource that enables an existing
+// deploy key for a set of projects, e.g. the key of a deployment tool for
+// every project of a group. The set is selected again every poll, so the
+// key is enabled for projects that join it and removed from projects that
+// leave it. Deleting the DeployKeyEnablement removes the key from all
+// projects. Gitlab deletes a deploy key that is not public when it is
+// removed from the last project it is enabled for.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KEY",type="integer",JSONPath=".spec.forProvider.keyId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type DeployKeyEnablement struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeployKeyEnablementSpec   `json:"spec"`
+	Status DeployKeyEnablementStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeployKeyEnablementList contains a list of DeployKeyEnablement items.
+type DeployKeyEnablementList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeployKeyEnablement `json:"items"`
+}
@@ -24,6 +24,8 @@ import (
 
 // DeployTokenParameters define the desired state of a Gitlab deploy token
 // https://docs.gitlab.com/ee/api/deploy_tokens.html
+// A deploy token belongs to either a project or a group.
+// +kubebuilder:validation:XValidation:rule="!(has(self.groupId) || has(self.groupIdRef) || has(self.groupIdSelector)) || !(has(self.projectId) || has(self.projectIdRef) || has(self.projectIdSelector) || has(self.projectPath))",message="a deploy token belongs to either a project or a group"
 type DeployTokenParameters struct {
 	// ProjectID is the ID of the project to create the deploy token in.
 	// +optional
@@ -39,6 +41,28 @@ type DeployTokenParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the path of the project including its namespace, e.g.
+	// group/project. It identifies the project if projectId is not set and
+	// not resolved from a reference, so projects not managed in this
+	// cluster can be used.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
+	// GroupID is the ID of the group to create the deploy token in.
+	// +optional
+	// +immutable
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
 	// Expiration date for the deploy token. Does not expire if no value is provided.
 	// Expected in ISO 8601 format (2019-03-15T08:00:00Z)
 	// +optional
@@ -54,7 +78,19 @@ type DeployTokenParameters struct {
 	// Must be at least one of read_repository, read_registry, write_registry,
 	// read_package_registry, or write_package_registry.
 	// +immutable
+	// +kubebuilder:validation:MinItems=1
 	Scopes []string `json:"scopes"`
+
+	// SkipRevokeOnDelete keeps the deploy token valid when the managed resource
+	// is deleted, for credentials shared with systems outside of Crossplane.
+	// +optional
+	SkipRevokeOnDelete *bool `json:"skipRevokeOnDelete,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // DeployTokenObservation represents a deploy token.
@@ -99,3 +135,8 @@ type DeployTokenList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []DeployToken `json:"items"`
 }
+
+// GetExpiresAt of this DeployToken.
+func (mg *DeployToken) GetExpiresAt() *metav1.Time {
+	return mg.Spec.ForProvider.ExpiresAt
+}
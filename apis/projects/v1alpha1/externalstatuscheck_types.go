@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExternalStatusCheckParameters define the desired state of a Gitlab
+// external status check. External status checks require Gitlab Ultimate.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/status_checks.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ExternalStatusCheckParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name is the display name of the status check.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// ExternalURL is the URL merge request data is sent to.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	ExternalURL string `json:"externalUrl"`
+
+	// ProtectedBranches are the names of the protected branches the check
+	// applies to. The check applies to all branches if empty.
+	// +optional
+	ProtectedBranches []string `json:"protectedBranches,omitempty"`
+
+	// SharedSecretSecretRef selects the secret key holding the HMAC secret
+	// Gitlab signs the requests to ExternalURL with.
+	// +optional
+	// +nullable
+	SharedSecretSecretRef *xpv1.SecretKeySelector `json:"sharedSecretSecretRef,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ExternalStatusCheckObservation represents the observed state of a Gitlab
+// external status check.
+type ExternalStatusCheckObservation struct {
+	// ID of the status check at gitlab
+	ID int `json:"id,omitempty"`
+
+	// HMAC is true if Gitlab signs the requests of the check.
+	HMAC bool `json:"hmac,omitempty"`
+
+	// SharedSecretHash is the SHA-256 hash of the shared secret last sent to
+	// Gitlab. Gitlab does not return the secret, so a rotated secret is
+	// detected through it.
+	SharedSecretHash string `json:"sharedSecretHash,omitempty"`
+}
+
+// An ExternalStatusCheckSpec defines the desired state of a Gitlab external
+// status check.
+type ExternalStatusCheckSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ExternalStatusCheckParameters `json:"forProvider"`
+}
+
+// An ExternalStatusCheckStatus represents the observed state of a Gitlab
+// external status check.
+type ExternalStatusCheckStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ExternalStatusCheckObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ExternalStatusCheck is a managed resource that represents a Gitlab
+// external status check of a project, gating merge requests on an external
+// service.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ExternalStatusCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ExternalStatusCheckSpec   `json:"spec"`
+	Status ExternalStatusCheckStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalStatusCheckList contains a list of ExternalStatusCheck items.
+type ExternalStatusCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalStatusCheck `json:"items"`
+}
+
+// GetSecretRefs of this ExternalStatusCheck.
+func (mg *ExternalStatusCheck) GetSecretRefs() []xpv1.SecretKeySelector {
+	if ref := mg.Spec.ForProvider.SharedSecretSecretRef; ref != nil {
+		return []xpv1.SecretKeySelector{*ref}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ForkRelationshipParameters define the project an existing Gitlab project
+// is a fork of. Gitlab cannot change a fork relationship, so changing the
+// project it is forked from removes the relationship and establishes it
+// again.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#create-a-forked-fromto-relation-between-existing-projects
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+// At least 1 of [ForkedFromProjectID, ForkedFromProjectIDRef, ForkedFromProjectIDSelector] required.
+type ForkRelationshipParameters struct {
+	// The ID or URL-encoded path of the project that is marked as a fork.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// The ID of the project the project is forked from.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ForkedFromProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ForkedFromProjectIDSelector
+	ForkedFromProjectID *string `json:"forkedFromProjectId,omitempty"`
+
+	// ForkedFromProjectIDRef is a reference to a project to retrieve its
+	// ForkedFromProjectID.
+	// +optional
+	ForkedFromProjectIDRef *xpv1.Reference `json:"forkedFromProjectIdRef,omitempty"`
+
+	// ForkedFromProjectIDSelector selects reference to a project to retrieve
+	// its ForkedFromProjectID.
+	// +optional
+	ForkedFromProjectIDSelector *xpv1.Selector `json:"forkedFromProjectIdSelector,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ForkRelationshipObservation represents the observed fork relationship of
+// a Gitlab project.
+type ForkRelationshipObservation struct {
+	// ForkedFromProjectID is the ID of the project the project is forked
+	// from at gitlab.
+	ForkedFromProjectID int `json:"forkedFromProjectId,omitempty"`
+
+	// ForkedFromProjectPath is the path with namespace of the project the
+	// project is forked from.
+	ForkedFromProjectPath string `json:"forkedFromProjectPath,omitempty"`
+}
+
+// A ForkRelationshipSpec defines the desired state of a Gitlab fork
+// relationship.
+type ForkRelationshipSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ForkRelationshipParameters `json:"forProvider"`
+}
+
+// A ForkRelationshipStatus represents the observed state of a Gitlab fork
+// relationship.
+type ForkRelationshipStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ForkRelationshipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ForkRelationship is a managed resource that marks an existing Gitlab
+// project as a fork of another existing project, without creating a new
+// fork. A project is a fork of at most one project, so at most one
+// ForkRelationship should manage a project. Deleting it removes the
+// relationship and leaves both projects as they are.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FORKED FROM",type="string",JSONPath=".status.atProvider.forkedFromProjectPath"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ForkRelationship struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ForkRelationshipSpec   `json:"spec"`
+	Status ForkRelationshipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ForkRelationshipList contains a list of ForkRelationship items.
+type ForkRelationshipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ForkRelationship `json:"items"`
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FreezePeriodParameters define the desired state of a Gitlab deploy freeze
+// period.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/freeze_periods.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type FreezePeriodParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// FreezeStart is the cron schedule the freeze period starts at, for
+	// example: 0 23 * * 5.
+	// +kubebuilder:validation:MinLength=1
+	FreezeStart string `json:"freezeStart"`
+
+	// FreezeEnd is the cron schedule the freeze period ends at, for
+	// example: 0 7 * * 1.
+	// +kubebuilder:validation:MinLength=1
+	FreezeEnd string `json:"freezeEnd"`
+
+	// CronTimezone is the time zone of the cron schedules, for example:
+	// Europe/Berlin (default: UTC).
+	// +optional
+	CronTimezone *string `json:"cronTimezone,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// FreezePeriodObservation represents the observed state of a Gitlab deploy
+// freeze period.
+type FreezePeriodObservation struct {
+	// ID of the freeze period at gitlab
+	ID        int          `json:"id,omitempty"`
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A FreezePeriodSpec defines the desired state of a Gitlab deploy freeze
+// period.
+type FreezePeriodSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FreezePeriodParameters `json:"forProvider"`
+}
+
+// A FreezePeriodStatus represents the observed state of a Gitlab deploy
+// freeze period.
+type FreezePeriodStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FreezePeriodObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FreezePeriod is a managed resource that represents a Gitlab deploy
+// freeze period of a project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="START",type="string",JSONPath=".spec.forProvider.freezeStart"
+// +kubebuilder:printcolumn:name="END",type="string",JSONPath=".spec.forProvider.freezeEnd"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type FreezePeriod struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FreezePeriodSpec   `json:"spec"`
+	Status FreezePeriodStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FreezePeriodList contains a list of FreezePeriod items.
+type FreezePeriodList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FreezePeriod `json:"items"`
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelGitLabImport is the label identifying the GitLabImport a resource
+// was created by.
+const LabelGitLabImport = "projects.gitlab.crossplane.io/gitlab-import"
+
+// ImportedKind is a kind of managed resource a GitLabImport creates.
+// +kubebuilder:validation:Enum=Project;Member;Variable
+type ImportedKind string
+
+// List of kinds a GitLabImport creates.
+const (
+	ImportedKindProject  ImportedKind = "Project"
+	ImportedKindMember   ImportedKind = "Member"
+	ImportedKindVariable ImportedKind = "Variable"
+)
+
+// A GitLabImportSpec defines the group to import and how fast it is
+// imported.
+type GitLabImportSpec struct {
+	// ProviderConfigReference specifies how the group is listed and how the
+	// created resources connect to Gitlab.
+	// +kubebuilder:default={"name": "default"}
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+
+	// GroupID is the ID of the group whose projects are imported.
+	// +optional
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a Group to retrieve its ID.
+	// +optional
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// IncludeSubgroups imports the projects of subgroups too.
+	// +optional
+	IncludeSubgroups *bool `json:"includeSubgroups,omitempty"`
+
+	// Kinds of the resources to create for every project. Defaults to all.
+	// +optional
+	// +listType=set
+	// +kubebuilder:default={"Project","Member","Variable"}
+	Kinds []ImportedKind `json:"kinds,omitempty"`
+
+	// BatchSize is the number of projects whose resources are created per
+	// batch. Defaults to 10.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=10
+	BatchSize *int `json:"batchSize,omitempty"`
+
+	// BatchInterval is the time to wait between batches. Defaults to 30s.
+	// +optional
+	// +kubebuilder:default="30s"
+	BatchInterval *metav1.Duration `json:"batchInterval,omitempty"`
+}
+
+// A GitLabImportStatus represents the progress of a GitLabImport.
+type GitLabImportStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+
+	// GroupID is the ID of the imported group.
+	GroupID *int `json:"groupId,omitempty"`
+
+	// Projects is the number of projects of the group.
+	Projects int `json:"projects,omitempty"`
+
+	// ImportedProjects is the number of projects of the group that are
+	// imported.
+	ImportedProjects int `json:"importedProjects,omitempty"`
+
+	// LastProjectID is the ID of the last imported project. Projects are
+	// imported in the order of their IDs.
+	LastProjectID int `json:"lastProjectId,omitempty"`
+
+	// Resources is the number of resources created.
+	Resources int `json:"resources,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GitLabImport adopts the projects of an existing group, with their
+// members and variables, by creating observe-only managed resources for
+// them with the external names of the existing resources. Projects are
+// imported in batches in the order of their IDs, so projects created later
+// are imported too. Members and variables are imported with their project.
+// Resources that are already managed are skipped, and the created resources
+// are left in place when the GitLabImport is deleted. Requires management
+// policies to be enabled. Variable values are not imported.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="GROUP",type="integer",JSONPath=".status.groupId"
+// +kubebuilder:printcolumn:name="PROJECTS",type="integer",JSONPath=".status.projects"
+// +kubebuilder:printcolumn:name="IMPORTED",type="integer",JSONPath=".status.importedProjects"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,gitlab}
+type GitLabImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GitLabImportSpec   `json:"spec"`
+	Status GitLabImportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GitLabImportList contains a list of GitLabImport items.
+type GitLabImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitLabImport `json:"items"`
+}
+
+// GetCondition of this GitLabImport.
+func (gi *GitLabImport) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return gi.Status.GetCondition(ct)
+}
+
+// SetConditions of this GitLabImport.
+func (gi *GitLabImport) SetConditions(c ...xpv1.Condition) {
+	gi.Status.SetConditions(c...)
+}
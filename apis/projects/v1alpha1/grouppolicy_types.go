@@ -0,0 +1,159 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelGroupPolicy is the label identifying the GroupPolicy a resource was
+// rendered from.
+const LabelGroupPolicy = "projects.gitlab.crossplane.io/group-policy"
+
+// A GroupPolicySpec defines the protections applied to every project of a
+// group.
+type GroupPolicySpec struct {
+	// ProviderConfigReference specifies how the group is listed and how the
+	// rendered resources connect to Gitlab.
+	// +kubebuilder:default={"name": "default"}
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+
+	// GroupID is the ID of the group whose projects are protected.
+	// +optional
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a Group to retrieve its ID.
+	// +optional
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// IncludeSubgroups applies the policy to the projects of subgroups too.
+	// +optional
+	IncludeSubgroups *bool `json:"includeSubgroups,omitempty"`
+
+	// Protections are the branch protections of every project. A
+	// ProtectedBranchSet is rendered for each project if any are given.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Protections []BranchProtection `json:"protections,omitempty"`
+
+	// ApprovalRules are the approval rules of every project. An
+	// ApprovalRuleSet is rendered for each project if any are given.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ApprovalRules []ApprovalRule `json:"approvalRules,omitempty"`
+
+	// ApprovalSettings are the merge request approval settings of every
+	// project. An ApprovalSettings is rendered for each project if given.
+	// +optional
+	ApprovalSettings *PolicyApprovalSettings `json:"approvalSettings,omitempty"`
+
+	// Prune removes protections and approval rules of the projects that are
+	// not listed in the policy. Defaults to true.
+	// +optional
+	// +kubebuilder:default=true
+	Prune *bool `json:"prune,omitempty"`
+}
+
+// PolicyApprovalSettings are the merge request approval settings a
+// GroupPolicy applies to every project. Settings that are not set are left
+// as they are.
+type PolicyApprovalSettings struct {
+	// ResetApprovalsOnPush removes all approvals of a merge request when new
+	// commits are pushed to it.
+	// +optional
+	ResetApprovalsOnPush *bool `json:"resetApprovalsOnPush,omitempty"`
+
+	// DisableOverridingApproversPerMergeRequest keeps merge requests from
+	// changing the approval rules of the project.
+	// +optional
+	DisableOverridingApproversPerMergeRequest *bool `json:"disableOverridingApproversPerMergeRequest,omitempty"`
+
+	// MergeRequestsAuthorApproval allows the author of a merge request to
+	// approve it.
+	// +optional
+	MergeRequestsAuthorApproval *bool `json:"mergeRequestsAuthorApproval,omitempty"`
+
+	// RequirePasswordToApprove requires approvers to authenticate again.
+	// +optional
+	RequirePasswordToApprove *bool `json:"requirePasswordToApprove,omitempty"`
+
+	// SelectiveCodeOwnerRemovals removes only the approvals of code owners
+	// whose files changed when new commits are pushed. Requires
+	// ResetApprovalsOnPush to be false.
+	// +optional
+	SelectiveCodeOwnerRemovals *bool `json:"selectiveCodeOwnerRemovals,omitempty"`
+}
+
+// A GroupPolicyStatus represents the observed state of a GroupPolicy.
+type GroupPolicyStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+
+	// GroupID is the ID of the group the policy applies to.
+	GroupID *int `json:"groupId,omitempty"`
+
+	// Projects is the number of projects the policy applies to.
+	Projects int `json:"projects,omitempty"`
+
+	// Resources rendered from the policy.
+	Resources []BlueprintResource `json:"resources,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GroupPolicy applies branch protections, approval rules and approval
+// settings to every project of a group, including projects created later,
+// by rendering a ProtectedBranchSet, an ApprovalRuleSet and an
+// ApprovalSettings for each. Projects are rediscovered every poll interval.
+// Projects should not also be targeted by other ProtectedBranchSets,
+// ApprovalRuleSets or ApprovalSettings, as each set prunes what it does not
+// list and a project has only one set of approval settings.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="GROUP",type="integer",JSONPath=".status.groupId"
+// +kubebuilder:printcolumn:name="PROJECTS",type="integer",JSONPath=".status.projects"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,gitlab}
+type GroupPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupPolicySpec   `json:"spec"`
+	Status GroupPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupPolicyList contains a list of GroupPolicy items.
+type GroupPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupPolicy `json:"items"`
+}
+
+// GetCondition of this GroupPolicy.
+func (gp *GroupPolicy) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return gp.Status.GetCondition(ct)
+}
+
+// SetConditions of this GroupPolicy.
+func (gp *GroupPolicy) SetConditions(c ...xpv1.Condition) {
+	gp.Status.SetConditions(c...)
+}
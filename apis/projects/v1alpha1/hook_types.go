@@ -17,12 +17,15 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
-// HookParameters defines the desired state of a Gitlab Project Hook.
+// HookParameters defines the desired state of a Gitlab Project Hook. A hook
+// belongs to either a project or a group.
+// +kubebuilder:validation:XValidation:rule="!(has(self.groupId) || has(self.groupIdRef) || has(self.groupIdSelector)) || !(has(self.projectId) || has(self.projectIdRef) || has(self.projectIdSelector) || has(self.projectPath))",message="a hook belongs to either a project or a group"
 type HookParameters struct {
 	// URL is the hook URL.
 	URL *string `json:"url"`
@@ -45,6 +48,28 @@ type HookParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the path of the project including its namespace, e.g.
+	// group/project. It identifies the project if projectId is not set and
+	// not resolved from a reference, so projects not managed in this
+	// cluster can be used.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
+	// GroupID is the ID of the group of a group hook.
+	// +optional
+	// +immutable
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
 	// PushEvents triggers hook on push events.
 	// +optional
 	PushEvents *bool `json:"pushEvents,omitempty"`
@@ -89,9 +114,26 @@ type HookParameters struct {
 	// +optional
 	EnableSSLVerification *bool `json:"enableSslVerification,omitempty"`
 
-	// Token is the secret token to validate received payloads.
+	// Token is the secret token to validate received payloads. Mutually
+	// exclusive with TokenSecretRef.
 	// +optional
 	Token *string `json:"token,omitempty"`
+
+	// TokenSecretRef references a secret holding the token to validate
+	// received payloads. Mutually exclusive with Token.
+	// +optional
+	TokenSecretRef *xpv1.SecretKeySelector `json:"tokenSecretRef,omitempty"`
+
+	// AutoReEnable re-enables the hook by sending a test push event when
+	// GitLab has disabled it after repeated delivery failures.
+	// +optional
+	AutoReEnable *bool `json:"autoReEnable,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // HookObservation represents a project hook.
@@ -104,12 +146,64 @@ type HookObservation struct {
 
 	// CreatedAt specifies the time the project hook was created
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// AlertStatus is the delivery health of the hook as reported by GitLab,
+	// one of executable, temporarily_disabled or disabled.
+	AlertStatus string `json:"alertStatus,omitempty"`
+
+	// DisabledUntil is the time until which a temporarily disabled hook
+	// will not be triggered.
+	DisabledUntil *metav1.Time `json:"disabledUntil,omitempty"`
+}
+
+// Hook alert statuses reported by GitLab.
+const (
+	HookAlertStatusExecutable          = "executable"
+	HookAlertStatusTemporarilyDisabled = "temporarily_disabled"
+	HookAlertStatusDisabled            = "disabled"
+)
+
+// TypeDegraded resources are available but not fully functional.
+const TypeDegraded xpv1.ConditionType = "Degraded"
+
+// Reasons a hook is or is not degraded.
+const (
+	ReasonHookExecutable xpv1.ConditionReason = "HookExecutable"
+	ReasonHookDisabled   xpv1.ConditionReason = "HookDisabled"
+)
+
+// HookExecutable returns a condition that indicates GitLab is delivering
+// events to the hook.
+func HookExecutable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHookExecutable,
+	}
+}
+
+// HookDisabled returns a condition that indicates GitLab has disabled the
+// hook after repeated delivery failures.
+func HookDisabled(status string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHookDisabled,
+		Message:            "GitLab reports the hook as " + status,
+	}
 }
 
 // A HookSpec defines the desired state of a Gitlab Project Hook.
 type HookSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       HookParameters `json:"forProvider"`
+
+	// Requires lists what must be met before the Hook is created or changed
+	// in Gitlab. Until then it waits instead of failing.
+	// +optional
+	Requires []Requirement `json:"requires,omitempty"`
 }
 
 // A HookStatus represents the observed state of a Gitlab Project Hook.
@@ -123,6 +217,7 @@ type HookStatus struct {
 // A Hook is a managed resource that represents a Gitlab Project Hook
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DEGRADED",type="string",JSONPath=".status.conditions[?(@.type=='Degraded')].status",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
@@ -142,3 +237,11 @@ type HookList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Hook `json:"items"`
 }
+
+// GetSecretRefs of this Hook.
+func (mg *Hook) GetSecretRefs() []xpv1.SecretKeySelector {
+	if ref := mg.Spec.ForProvider.TokenSecretRef; ref != nil {
+		return []xpv1.SecretKeySelector{*ref}
+	}
+	return nil
+}
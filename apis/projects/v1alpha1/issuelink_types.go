@@ -0,0 +1,147 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IssueLinkParameters define the desired state of a Gitlab issue link.
+// Gitlab cannot change a link, so all fields are immutable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issue_links.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type IssueLinkParameters struct {
+	// The ID or URL-encoded path of the project of the source issue.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// IssueIID is the internal ID of the source issue in its project.
+	// +immutable
+	// +kubebuilder:validation:Minimum=1
+	IssueIID int `json:"issueIid"`
+
+	// The ID or URL-encoded path of the project of the target issue.
+	// Defaults to the project of the source issue.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=TargetProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=TargetProjectIDSelector
+	TargetProjectID *string `json:"targetProjectId,omitempty"`
+
+	// TargetProjectIDRef is a reference to a project to retrieve its
+	// TargetProjectID.
+	// +optional
+	// +immutable
+	TargetProjectIDRef *xpv1.Reference `json:"targetProjectIdRef,omitempty"`
+
+	// TargetProjectIDSelector selects reference to a project to retrieve its
+	// TargetProjectID.
+	// +optional
+	// +immutable
+	TargetProjectIDSelector *xpv1.Selector `json:"targetProjectIdSelector,omitempty"`
+
+	// TargetIssueIID is the internal ID of the target issue in its project.
+	// +immutable
+	// +kubebuilder:validation:Minimum=1
+	TargetIssueIID int `json:"targetIssueIid"`
+
+	// LinkType is the type of the relation, one of relates_to, blocks or
+	// is_blocked_by (default: relates_to). Blocking relations require Gitlab
+	// Premium.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=relates_to;blocks;is_blocked_by
+	LinkType *string `json:"linkType,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// IssueLinkObservation represents the observed state of a Gitlab issue link.
+type IssueLinkObservation struct {
+	// ID of the issue link at gitlab
+	ID int `json:"id,omitempty"`
+
+	// LinkType is the type of the relation as seen from the source issue.
+	LinkType string `json:"linkType,omitempty"`
+
+	// TargetIssueWebURL is the web URL of the target issue.
+	TargetIssueWebURL string `json:"targetIssueWebUrl,omitempty"`
+
+	// CreatedAt is when the link was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// An IssueLinkSpec defines the desired state of a Gitlab issue link.
+type IssueLinkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IssueLinkParameters `json:"forProvider"`
+}
+
+// An IssueLinkStatus represents the observed state of a Gitlab issue link.
+type IssueLinkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IssueLinkObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IssueLink is a managed resource that represents a typed link between
+// two Gitlab issues.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.linkType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type IssueLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IssueLinkSpec   `json:"spec"`
+	Status IssueLinkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IssueLinkList contains a list of IssueLink items.
+type IssueLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IssueLink `json:"items"`
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JiraIntegrationParameters define the desired state of the Jira
+// integration of a Gitlab Project. Settings that are not set are late
+// initialized from Gitlab.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/integrations.html#jira
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type JiraIntegrationParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// URL of the Jira instance the project is linked to.
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+
+	// APIURL is the URL of the API of the Jira instance, if it differs
+	// from URL.
+	// +optional
+	APIURL *string `json:"apiUrl,omitempty"`
+
+	// ProjectKey is the key of the Jira project issues are referenced in.
+	// +optional
+	ProjectKey *string `json:"projectKey,omitempty"`
+
+	// Username to authenticate to Jira with. Mutually exclusive with
+	// UsernameSecretRef.
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// UsernameSecretRef references a secret holding the username to
+	// authenticate to Jira with. Mutually exclusive with Username.
+	// +optional
+	UsernameSecretRef *xpv1.SecretKeySelector `json:"usernameSecretRef,omitempty"`
+
+	// PasswordSecretRef references a secret holding the password or API
+	// token to authenticate to Jira with. Gitlab does not return it, so it
+	// is sent again when the secret changes.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+
+	// JiraIssueTransitionID is the ID of the transition that closes Jira
+	// issues referenced by commits merged into the default branch. Multiple
+	// IDs are separated by commas or semicolons.
+	// +optional
+	JiraIssueTransitionID *string `json:"jiraIssueTransitionId,omitempty"`
+
+	// CommitEvents links commits that reference Jira issues to them.
+	// +optional
+	CommitEvents *bool `json:"commitEvents,omitempty"`
+
+	// MergeRequestsEvents links merge requests that reference Jira issues
+	// to them.
+	// +optional
+	MergeRequestsEvents *bool `json:"mergeRequestsEvents,omitempty"`
+
+	// CommentOnEventEnabled comments on referenced Jira issues when a
+	// commit or merge request references them.
+	// +optional
+	CommentOnEventEnabled *bool `json:"commentOnEventEnabled,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// JiraIntegrationObservation represents the observed state of the Jira
+// integration of a Gitlab Project.
+type JiraIntegrationObservation struct {
+	// Active is whether the integration is active.
+	Active bool `json:"active,omitempty"`
+
+	// CreatedAt is when the integration was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is when the integration was last changed.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A JiraIntegrationSpec defines the desired state of the Jira integration
+// of a Gitlab Project.
+type JiraIntegrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JiraIntegrationParameters `json:"forProvider"`
+}
+
+// A JiraIntegrationStatus represents the observed state of the Jira
+// integration of a Gitlab Project.
+type JiraIntegrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JiraIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A JiraIntegration is a managed resource that represents the Jira
+// integration of a Gitlab Project. Every project has at most one Jira
+// integration, so at most one JiraIntegration should manage a project.
+// Deleting it disables the integration.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type JiraIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JiraIntegrationSpec   `json:"spec"`
+	Status JiraIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JiraIntegrationList contains a list of JiraIntegration items.
+type JiraIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JiraIntegration `json:"items"`
+}
+
+// GetSecretRefs of this JiraIntegration.
+func (mg *JiraIntegration) GetSecretRefs() []xpv1.SecretKeySelector {
+	refs := []xpv1.SecretKeySelector{mg.Spec.ForProvider.PasswordSecretRef}
+	if ref := mg.Spec.ForProvider.UsernameSecretRef; ref != nil {
+		refs = append(refs, *ref)
+	}
+	return refs
+}
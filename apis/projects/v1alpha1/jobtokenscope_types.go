@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobTokenScopeParameters define which projects and groups may access a
+// Gitlab Project with their CI/CD job tokens.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type JobTokenScopeParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Enabled limits access to the project to the job tokens of the
+	// projects and groups of the allowlist. Late initialized from Gitlab if
+	// not set.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// AllowedProjectIDs are the IDs of the projects whose job tokens may
+	// access the project.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=AllowedProjectIDRefs
+	// +crossplane:generate:reference:selectorFieldName=AllowedProjectIDSelector
+	AllowedProjectIDs []string `json:"allowedProjectIds,omitempty"`
+
+	// AllowedProjectIDRefs are references to projects to retrieve their
+	// AllowedProjectIDs.
+	// +optional
+	AllowedProjectIDRefs []xpv1.Reference `json:"allowedProjectIdRefs,omitempty"`
+
+	// AllowedProjectIDSelector selects references to projects to retrieve
+	// their AllowedProjectIDs.
+	// +optional
+	AllowedProjectIDSelector *xpv1.Selector `json:"allowedProjectIdSelector,omitempty"`
+
+	// AllowedGroupIDs are the IDs of the groups whose projects' job tokens
+	// may access the project.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=AllowedGroupIDRefs
+	// +crossplane:generate:reference:selectorFieldName=AllowedGroupIDSelector
+	AllowedGroupIDs []string `json:"allowedGroupIds,omitempty"`
+
+	// AllowedGroupIDRefs are references to groups to retrieve their
+	// AllowedGroupIDs.
+	// +optional
+	AllowedGroupIDRefs []xpv1.Reference `json:"allowedGroupIdRefs,omitempty"`
+
+	// AllowedGroupIDSelector selects references to groups to retrieve their
+	// AllowedGroupIDs.
+	// +optional
+	AllowedGroupIDSelector *xpv1.Selector `json:"allowedGroupIdSelector,omitempty"`
+
+	// Prune removes projects and groups from the allowlist that are not
+	// listed, such as ones added in the Gitlab UI. The project itself is
+	// always allowed. Defaults to true.
+	// +optional
+	// +kubebuilder:default=true
+	Prune *bool `json:"prune,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// JobTokenScopeObservation represents the observed job token scope of a
+// Gitlab Project.
+type JobTokenScopeObservation struct {
+	// Enabled is true if access to the project is limited to the
+	// allowlist.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// AllowedProjectIDs are the IDs of the projects of the allowlist.
+	AllowedProjectIDs []int `json:"allowedProjectIds,omitempty"`
+
+	// AllowedGroupIDs are the IDs of the groups of the allowlist.
+	AllowedGroupIDs []int `json:"allowedGroupIds,omitempty"`
+}
+
+// A JobTokenScopeSpec defines the desired state of the job token scope of a
+// Gitlab Project.
+type JobTokenScopeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JobTokenScopeParameters `json:"forProvider"`
+}
+
+// A JobTokenScopeStatus represents the observed state of the job token scope
+// of a Gitlab Project.
+type JobTokenScopeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JobTokenScopeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A JobTokenScope is a managed resource that represents the CI/CD job token
+// scope of a Gitlab Project: whether access with job tokens is limited, and
+// the allowlist of projects and groups that may access it. Every project
+// has exactly one job token scope, so at most one JobTokenScope should
+// manage a project. Deleting it removes the listed projects and groups from
+// the allowlist and leaves the scope enabled as it is.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type JobTokenScope struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobTokenScopeSpec   `json:"spec"`
+	Status JobTokenScopeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobTokenScopeList contains a list of JobTokenScope items.
+type JobTokenScopeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JobTokenScope `json:"items"`
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Label is a single label managed by a LabelSet.
+type Label struct {
+	// Name of the label.
+	Name string `json:"name"`
+
+	// Color of the label given in 6-digit hex notation with leading '#'
+	// sign (for example, #FFAABB) or one of the CSS color names.
+	Color string `json:"color"`
+
+	// Description of the label.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Priority of the label. Must be greater or equal than zero.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Priority *int `json:"priority,omitempty"`
+}
+
+// LabelSetParameters define the desired state of all labels of a Gitlab
+// Project or Group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/labels.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required, or
+// for the labels of a group 1 of [GroupID, GroupIDRef, GroupIDSelector].
+// +kubebuilder:validation:XValidation:rule="!(has(self.groupId) || has(self.groupIdRef) || has(self.groupIdSelector)) || !(has(self.projectId) || has(self.projectIdRef) || has(self.projectIdSelector))",message="a label set belongs to either a project or a group"
+type LabelSetParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// The ID or URL-encoded path of the group whose labels are managed.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=GroupIDRef
+	// +crossplane:generate:reference:selectorFieldName=GroupIDSelector
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// Labels is the list of labels the project or group should have.
+	// +listType=map
+	// +listMapKey=name
+	Labels []Label `json:"labels"`
+
+	// Prune deletes project or group labels that are not listed in Labels.
+	// Labels inherited from ancestor groups are never pruned.
+	// +optional
+	Prune *bool `json:"prune,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// LabelSetObservation represents the observed labels of a Gitlab Project.
+type LabelSetObservation struct {
+	// Labels is the list of project labels found at Gitlab.
+	Labels []LabelObservation `json:"labels,omitempty"`
+}
+
+// LabelObservation represents an observed Gitlab label.
+type LabelObservation struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+	Priority    int    `json:"priority,omitempty"`
+}
+
+// A LabelSetSpec defines the desired state of a Gitlab Project LabelSet.
+type LabelSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LabelSetParameters `json:"forProvider"`
+}
+
+// A LabelSetStatus represents the observed state of a Gitlab Project LabelSet.
+type LabelSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LabelSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LabelSet is a managed resource that authoritatively manages the labels of
+// a Gitlab Project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type LabelSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LabelSetSpec   `json:"spec"`
+	Status LabelSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LabelSetList contains a list of LabelSet items.
+type LabelSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LabelSet `json:"items"`
+}
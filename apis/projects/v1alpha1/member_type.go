@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -39,6 +41,14 @@ type MemberParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the path of the project including its namespace, e.g.
+	// group/project. It identifies the project if projectId is not set and
+	// not resolved from a reference, so projects not managed in this
+	// cluster can be used.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
 	// The user ID of the member.
 	// +optional
 	UserID *int `json:"userID,omitempty"`
@@ -54,6 +64,27 @@ type MemberParameters struct {
 	// A date string in the format YEAR-MONTH-DAY.
 	// +optional
 	ExpiresAt *string `json:"expiresAt,omitempty"`
+
+	// AutoExtendBy extends the membership to this long after now when it is
+	// about to expire, keeping access time-bound but continuously renewed.
+	// Extensions happen within 14 days of expiry, or within half of
+	// AutoExtendBy if that is shorter. ExpiresAt is left as configured, the
+	// extended date is reported in ExtendedExpiresAt of the status.
+	// +optional
+	AutoExtendBy *metav1.Duration `json:"autoExtendBy,omitempty"`
+
+	// AllowInherited accepts a membership the user inherits from an ancestor
+	// group of the project instead of creating a direct one, as long as the
+	// inherited access level is at least AccessLevel. Inherited memberships
+	// are never edited or removed by this resource.
+	// +optional
+	AllowInherited *bool `json:"allowInherited,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // MemberObservation represents a project member.
@@ -68,6 +99,14 @@ type MemberObservation struct {
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
 	WebURL    string       `json:"webURL,omitempty"`
 	AvatarURL string       `json:"avatarURL,omitempty"`
+
+	// Inherited is true if the membership is inherited from an ancestor
+	// group rather than a direct membership of the project.
+	Inherited bool `json:"inherited,omitempty"`
+
+	// ExtendedExpiresAt is the date AutoExtendBy extended the membership to.
+	// It is used instead of ExpiresAt as long as it is later.
+	ExtendedExpiresAt *string `json:"extendedExpiresAt,omitempty"`
 }
 
 // A MemberSpec defines the desired state of a Gitlab Project Member.
@@ -109,3 +148,19 @@ type MemberList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Member `json:"items"`
 }
+
+// GetExpiresAt of this Member. Dates that cannot be parsed are ignored.
+func (mg *Member) GetExpiresAt() *metav1.Time {
+	at := mg.Spec.ForProvider.ExpiresAt
+	if mg.Status.AtProvider.ExtendedExpiresAt != nil {
+		at = mg.Status.AtProvider.ExtendedExpiresAt
+	}
+	if at == nil {
+		return nil
+	}
+	t, err := time.Parse(time.DateOnly, *at)
+	if err != nil {
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NoteParameters define the desired state of a comment on a Gitlab issue or
+// merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type NoteParameters struct {
+	// The ID or URL-encoded path of the project of the issue or merge
+	// request.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// NoteableType is the type of what is commented on, either Issue or
+	// MergeRequest.
+	// +immutable
+	// +kubebuilder:validation:Enum=Issue;MergeRequest
+	NoteableType string `json:"noteableType"`
+
+	// NoteableIID is the internal ID of the issue or merge request in its
+	// project.
+	// +immutable
+	// +kubebuilder:validation:Minimum=1
+	NoteableIID int `json:"noteableIid"`
+
+	// Body of the comment.
+	// +kubebuilder:validation:MinLength=1
+	Body string `json:"body"`
+
+	// Marker identifies the comment among the comments of the issue or
+	// merge request. It is appended to the body as an HTML comment, and an
+	// existing comment containing it is updated instead of posting another
+	// one.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9._:/-]+$`
+	Marker *string `json:"marker,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// NoteObservation represents the observed state of a comment on a Gitlab
+// issue or merge request.
+type NoteObservation struct {
+	// ID of the note at gitlab
+	ID int `json:"id,omitempty"`
+
+	// AuthorUsername is the username of the author of the comment.
+	AuthorUsername string `json:"authorUsername,omitempty"`
+
+	// CreatedAt is when the comment was posted.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is when the comment was last changed.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A NoteSpec defines the desired state of a comment on a Gitlab issue or
+// merge request.
+type NoteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NoteParameters `json:"forProvider"`
+}
+
+// A NoteStatus represents the observed state of a comment on a Gitlab issue
+// or merge request.
+type NoteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NoteObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Note is a managed resource that represents a comment on a Gitlab issue
+// or merge request, for example to publish the status of provisioning.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.noteableType"
+// +kubebuilder:printcolumn:name="IID",type="integer",JSONPath=".spec.forProvider.noteableIid"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Note struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NoteSpec   `json:"spec"`
+	Status NoteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NoteList contains a list of Note items.
+type NoteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Note `json:"items"`
+}
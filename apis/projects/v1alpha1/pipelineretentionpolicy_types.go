@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PipelineRetentionPolicyParameters define which pipelines of a Gitlab
+// Project are deleted. If OlderThanDays is set, only pipelines last updated
+// more than OlderThanDays days ago are deleted. Of these, a pipeline is deleted
+// if it is not among the KeepLast newest. Pipelines that have not finished are
+// never deleted. Nothing is deleted if neither KeepLast nor OlderThanDays is
+// set.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#delete-a-pipeline
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type PipelineRetentionPolicyParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// OlderThanDays deletes pipelines last updated more than this many days
+	// ago.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	OlderThanDays *int `json:"olderThanDays,omitempty"`
+
+	// KeepLast is the number of newest pipelines that are never deleted. If
+	// OlderThanDays is set, these are the newest of the pipelines older than
+	// OlderThanDays days.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepLast *int `json:"keepLast,omitempty"`
+
+	// Ref limits the policy to pipelines of a branch or tag. The policy
+	// applies to the pipelines of all refs if not set.
+	// +optional
+	Ref *string `json:"ref,omitempty"`
+
+	// MaxDeletionsPerRun is the maximum number of pipelines deleted per
+	// reconciliation (default: 100). The rest is deleted on the next ones.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxDeletionsPerRun *int `json:"maxDeletionsPerRun,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// PipelineRetentionPolicyObservation represents the observed state of a
+// pipeline retention policy.
+type PipelineRetentionPolicyObservation struct {
+	// Pipelines is the number of pipelines the policy examined, at most
+	// KeepLast plus MaxDeletionsPerRun.
+	Pipelines int `json:"pipelines,omitempty"`
+
+	// ExpiredPipelines is the number of pipelines the next run deletes, at
+	// most MaxDeletionsPerRun.
+	ExpiredPipelines int `json:"expiredPipelines,omitempty"`
+
+	// LastRunTime is when pipelines were last deleted.
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// LastRunDeletedPipelines is the number of pipelines deleted by the last
+	// run.
+	LastRunDeletedPipelines int `json:"lastRunDeletedPipelines,omitempty"`
+}
+
+// A PipelineRetentionPolicySpec defines the desired state of a pipeline
+// retention policy.
+type PipelineRetentionPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PipelineRetentionPolicyParameters `json:"forProvider"`
+}
+
+// A PipelineRetentionPolicyStatus represents the observed state of a pipeline
+// retention policy.
+type PipelineRetentionPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PipelineRetentionPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PipelineRetentionPolicy is a managed resource that periodically deletes
+// old pipelines of a Gitlab Project. The policy is enforced on every poll of
+// the provider. Deleting the policy does not restore deleted pipelines.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXPIRED",type="integer",JSONPath=".status.atProvider.expiredPipelines"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type PipelineRetentionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PipelineRetentionPolicySpec   `json:"spec"`
+	Status PipelineRetentionPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PipelineRetentionPolicyList contains a list of PipelineRetentionPolicy
+// items.
+type PipelineRetentionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PipelineRetentionPolicy `json:"items"`
+}
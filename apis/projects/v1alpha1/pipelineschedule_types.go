@@ -17,7 +17,10 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -69,6 +72,12 @@ type PipelineScheduleParameters struct {
 
 	// PipelineVariables is a type of environment variable.
 	Variables []PipelineVariable `json:"variables,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // PipelineScheduleObservation represents observed stated of Gitlab Pipeline Schedule.
@@ -91,12 +100,63 @@ type LastPipeline struct {
 	Status string `json:"status"`
 }
 
+// Pipeline statuses reported by GitLab that end a pipeline.
+const (
+	PipelineStatusSuccess = "success"
+	PipelineStatusFailed  = "failed"
+)
+
+// TypeLastRunSucceeded indicates whether the last pipeline ran by a
+// schedule succeeded.
+const TypeLastRunSucceeded xpv1.ConditionType = "LastRunSucceeded"
+
+// Reasons the last run of a schedule did or did not succeed.
+const (
+	ReasonPipelineSucceeded xpv1.ConditionReason = "PipelineSucceeded"
+	ReasonPipelineFailed    xpv1.ConditionReason = "PipelineFailed"
+)
+
+// LastRunSucceeded returns a condition that indicates the last pipeline ran
+// by the schedule succeeded.
+func LastRunSucceeded() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLastRunSucceeded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPipelineSucceeded,
+	}
+}
+
+// LastRunFailed returns a condition that indicates the last pipeline ran by
+// the schedule failed.
+func LastRunFailed(p *LastPipeline) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLastRunSucceeded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPipelineFailed,
+		Message:            fmt.Sprintf("pipeline %d on %s failed", p.ID, p.Ref),
+	}
+}
+
 // PipelineVariable represents a pipeline variable.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/pipelines.html
 type PipelineVariable struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key string `json:"key"`
+
+	// Value of the variable. Mutually exclusive with ValueSecretRef.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ValueSecretRef is used to obtain the value from a secret, so that it
+	// is not stored in the custom resource. Mutually exclusive with Value.
+	// +optional
+	ValueSecretRef *xpv1.SecretKeySelector `json:"valueSecretRef,omitempty"`
+
+	// VariableType is the type of the variable. Variables of type file are
+	// passed to the pipeline as the path of a file holding the value.
+	// +kubebuilder:validation:Enum=env_var;file
 	// +optional
 	VariableType *string `json:"variableType,omitempty"`
 }
@@ -116,8 +176,11 @@ type PipelineScheduleStatus struct {
 // +kubebuilder:object:root=true
 
 // A PipelineSchedule is a managed resource that represents a Gitlab Pipeline Schedule.
+// Its LastRunSucceeded condition reports whether the last pipeline ran by
+// the schedule succeeded.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LAST-RUN",type="string",JSONPath=".status.atProvider.lastPipeline.status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
@@ -138,3 +201,14 @@ type PipelineScheduleList struct {
 
 	Items []PipelineSchedule `json:"items"`
 }
+
+// GetSecretRefs of this PipelineSchedule.
+func (mg *PipelineSchedule) GetSecretRefs() []xpv1.SecretKeySelector {
+	var refs []xpv1.SecretKeySelector
+	for _, v := range mg.Spec.ForProvider.Variables {
+		if v.ValueSecretRef != nil {
+			refs = append(refs, *v.ValueSecretRef)
+		}
+	}
+	return refs
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 )
 
 // AccessControlValue represents an access control value within GitLab,
@@ -133,22 +135,65 @@ type ProjectLicense struct {
 }
 
 // ContainerExpirationPolicyAttributes represents the available container
-// expiration policy attributes.
+// expiration policy attributes. Attributes that are not set are left as they
+// are at Gitlab.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#create-project
 type ContainerExpirationPolicyAttributes struct {
-	Cadence         *string `json:"cadence,omitempty"`
-	KeepN           *int    `json:"keepN,omitempty"`
-	OlderThan       *string `json:"olderThan,omitempty"`
+	// Cadence at which the cleanup policy runs.
+	// +optional
+	// +kubebuilder:validation:Enum=1d;7d;14d;1month;3month
+	Cadence *string `json:"cadence,omitempty"`
+
+	// KeepN is the number of most recent tags to keep per image.
+	// +optional
+	// +kubebuilder:validation:Enum=1;5;10;25;50;100
+	KeepN *int `json:"keepN,omitempty"`
+
+	// OlderThan removes tags older than this.
+	// +optional
+	// +kubebuilder:validation:Enum=7d;14d;30d;90d
+	OlderThan *string `json:"olderThan,omitempty"`
+
+	// NameRegexDelete is the regular expression of the tags to remove.
+	// +optional
 	NameRegexDelete *string `json:"nameRegexDelete,omitempty"`
-	NameRegexKeep   *string `json:"nameRegexKeep,omitempty"`
-	Enabled         *bool   `json:"enabled,omitempty"`
+
+	// NameRegexKeep is the regular expression of the tags to keep, even if
+	// they match NameRegexDelete.
+	// +optional
+	NameRegexKeep *string `json:"nameRegexKeep,omitempty"`
+
+	// Enabled turns the cleanup policy on.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 
 	// Deprecated members
 	NameRegex *string `url:"name_regex,omitempty" json:"name_regex,omitempty"`
 }
 
+// SecurityScanning toggles security scanning jobs through the CI variables
+// that disable them. A disabled scanner gets its variable set to "true", an
+// enabled one gets it removed. Scanners left unset are not managed.
+type SecurityScanning struct {
+	// SAST enables static application security testing. Managed through
+	// the SAST_DISABLED variable.
+	// +optional
+	SAST *bool `json:"sast,omitempty"`
+
+	// DAST enables dynamic application security testing. Managed through
+	// the DAST_DISABLED variable.
+	// +optional
+	DAST *bool `json:"dast,omitempty"`
+
+	// DependencyScanning enables dependency scanning. Managed through the
+	// DEPENDENCY_SCANNING_DISABLED variable.
+	// +optional
+	DependencyScanning *bool `json:"dependencyScanning,omitempty"`
+}
+
 // ProjectParameters define the desired state of a Gitlab Project
+// +kubebuilder:validation:XValidation:rule="!(has(self.ciAllowForkPipelines) && self.ciAllowForkPipelines && has(self.forkingAccessLevel) && self.forkingAccessLevel == 'disabled')",message="ciAllowForkPipelines requires forking to be enabled"
 type ProjectParameters struct {
 	// Set whether or not merge requests can be merged with skipped jobs.
 	// +optional
@@ -160,10 +205,12 @@ type ProjectParameters struct {
 	ApprovalsBeforeMerge *int `json:"approvalsBeforeMerge,omitempty"`
 
 	// Auto-cancel pending pipelines. This isn’t a boolean, but enabled/disabled.
+	// +kubebuilder:validation:Enum=enabled;disabled
 	// +optional
 	AutoCancelPendingPipelines *string `json:"autoCancelPendingPipelines,omitempty"`
 
-	// Auto Deploy strategy (continuous, manual or timedIncremental).
+	// Auto Deploy strategy (continuous, manual or timed_incremental).
+	// +kubebuilder:validation:Enum=continuous;manual;timed_incremental
 	// +optional
 	AutoDevopsDeployStrategy *string `json:"autoDevopsDeployStrategy,omitempty"`
 
@@ -203,15 +250,36 @@ type ProjectParameters struct {
 	// +optional
 	CIForwardDeploymentEnabled *bool `json:"ciForwardDeploymentEnabled,omitempty"`
 
+	// Allow pipelines of merge requests from forks to run in this project.
+	// Requires forking to be enabled. Ignored by Gitlab editions that do not
+	// support it.
+	// +optional
+	CIAllowForkPipelines *bool `json:"ciAllowForkPipelines,omitempty"`
+
 	// Update the image cleanup policy for this project. Accepts: cadence (string), keepN (integer), olderThan (string),
 	// nameRegex (string), nameRegexDelete (string), nameRegexKeep (string), enabled (boolean).
 	// +optional
 	ContainerExpirationPolicyAttributes *ContainerExpirationPolicyAttributes `json:"containerExpirationPolicyAttributes,omitempty"`
 
 	// Enable container registry for this project.
+	// Deprecated: Use ContainerRegistryAccessLevel instead, which takes
+	// precedence.
 	// +optional
 	ContainerRegistryEnabled *bool `json:"containerRegistryEnabled,omitempty"`
 
+	// One of disabled, private, or enabled.
+	// +kubebuilder:validation:Enum=disabled;private;enabled
+	// +optional
+	ContainerRegistryAccessLevel *AccessControlValue `json:"containerRegistryAccessLevel,omitempty"`
+
+	// CustomAttributes are the custom attributes the project should have.
+	// Attributes not listed here are left alone unless
+	// PruneCustomAttributes is set. Requires an administrator token.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	CustomAttributes []CustomAttribute `json:"customAttributes,omitempty"`
+
 	// The default branch name. Requires initializeWithReadme to be true.
 	// +optional
 	DefaultBranch *string `json:"defaultBranch,omitempty"`
@@ -227,9 +295,14 @@ type ProjectParameters struct {
 	Name *string `json:"name,omitempty"`
 
 	// Disable email notifications.
+	// Deprecated: Use EmailsEnabled instead, which takes precedence.
 	// +optional
 	EmailsDisabled *bool `json:"emailsDisabled,omitempty"`
 
+	// Enable email notifications.
+	// +optional
+	EmailsEnabled *bool `json:"emailsEnabled,omitempty"`
+
 	// The classification label for the project.
 	// +optional
 	ExternalAuthorizationClassificationLabel *string `json:"externalAuthorizationClassificationLabel,omitempty"`
@@ -248,6 +321,17 @@ type ProjectParameters struct {
 	// +optional
 	ImportURL *string `json:"importUrl,omitempty"`
 
+	// ImportURLUsername is the username to authenticate to ImportURL with.
+	// Defaults to oauth2 if ImportURLPasswordSecretRef is set.
+	// +optional
+	ImportURLUsername *string `json:"importUrlUsername,omitempty"`
+
+	// ImportURLPasswordSecretRef references a secret holding the password or
+	// token to authenticate to ImportURL with, e.g. to pull mirror a private
+	// repository.
+	// +optional
+	ImportURLPasswordSecretRef *xpv1.SecretKeySelector `json:"importUrlPasswordSecretRef,omitempty"`
+
 	// false by default.
 	// +optional
 	// +immutable
@@ -266,6 +350,10 @@ type ProjectParameters struct {
 	// +optional
 	LFSEnabled *bool `json:"lfsEnabled,omitempty"`
 
+	// Template used to create merge commit messages in merge requests.
+	// +optional
+	MergeCommitTemplate *string `json:"mergeCommitTemplate,omitempty"`
+
 	// Set the merge method used.
 	// +optional
 	MergeMethod *MergeMethodValue `json:"mergeMethod,omitempty"`
@@ -279,6 +367,11 @@ type ProjectParameters struct {
 	// +optional
 	MergeRequestsTemplate *string `json:"mergeRequestsTemplate,omitempty"`
 
+	// Target merge requests of a fork at the fork itself instead of the
+	// upstream project. Only applies to forked projects.
+	// +optional
+	MRDefaultTargetSelf *bool `json:"mrDefaultTargetSelf,omitempty"`
+
 	// Enables pull mirroring in a project.
 	// +optional
 	Mirror *bool `json:"mirror,omitempty"`
@@ -343,9 +436,19 @@ type ProjectParameters struct {
 	PrintingMergeRequestLinkEnabled *bool `json:"printingMergeRequestLinkEnabled,omitempty"`
 
 	// If true, jobs can be viewed by non-project members.
+	// Deprecated: Use PublicJobs instead, which takes precedence.
 	// +optional
 	PublicBuilds *bool `json:"publicBuilds,omitempty"`
 
+	// If true, jobs can be viewed by non-project members.
+	// +optional
+	PublicJobs *bool `json:"publicJobs,omitempty"`
+
+	// PruneCustomAttributes deletes custom attributes of the project that are
+	// not listed in CustomAttributes.
+	// +optional
+	PruneCustomAttributes *bool `json:"pruneCustomAttributes,omitempty"`
+
 	// Enable Delete source branch option by default for all new merge requests.
 	// +optional
 	RemoveSourceBranchAfterMerge *bool `json:"removeSourceBranchAfterMerge,omitempty"`
@@ -354,6 +457,12 @@ type ProjectParameters struct {
 	// +optional
 	RepositoryAccessLevel *AccessControlValue `json:"repositoryAccessLevel,omitempty"`
 
+	// Which storage shard the repository is on. Changing it moves the
+	// repository. Requires an administrator token; it is not late
+	// initialized.
+	// +optional
+	RepositoryStorage *string `json:"repositoryStorage,omitempty"`
+
 	// Allow users to request member access.
 	// +optional
 	RequestAccessEnabled *bool `json:"requestAccessEnabled,omitempty"`
@@ -362,6 +471,11 @@ type ProjectParameters struct {
 	// +optional
 	ResolveOutdatedDiffDiscussions *bool `json:"resolveOutdatedDiffDiscussions,omitempty"`
 
+	// SecurityScanning toggles the standard security scanning jobs of Auto
+	// DevOps and the Gitlab security scanning CI templates.
+	// +optional
+	SecurityScanning *SecurityScanning `json:"securityScanning,omitempty"`
+
 	// Enable or disable Service Desk feature.
 	// +optional
 	ServiceDeskEnabled *bool `json:"serviceDeskEnabled,omitempty"`
@@ -374,6 +488,10 @@ type ProjectParameters struct {
 	// +optional
 	SnippetsAccessLevel *AccessControlValue `json:"snippetsAccessLevel,omitempty"`
 
+	// Template used to create squash commit messages in merge requests.
+	// +optional
+	SquashCommitTemplate *string `json:"squashCommitTemplate,omitempty"`
+
 	// The commit message used to apply merge request suggestions.
 	// +optional
 	SuggestionCommitMessage *string `json:"suggestionCommitMessage,omitempty"`
@@ -407,6 +525,24 @@ type ProjectParameters struct {
 	// One of disabled, private, or enabled.
 	// +optional
 	WikiAccessLevel *AccessControlValue `json:"wikiAccessLevel,omitempty"`
+
+	// Timeouts of the calls made to Gitlab for this project, for example
+	// to allow exporting a large project before deleting it.
+	// +optional
+	Timeouts *commonv1alpha1.Timeouts `json:"timeouts,omitempty"`
+
+	// ConfirmDelete must be true for the project to be deleted in Gitlab
+	// when this managed resource is deleted. The deletion is blocked
+	// otherwise, unless confirmed by the gitlab.crossplane.io/confirm-delete
+	// annotation.
+	// +optional
+	ConfirmDelete *bool `json:"confirmDelete,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // ProjectNamespace represents a project namespace.
@@ -498,6 +634,44 @@ type SharedWithGroups struct {
 	GroupAccessLevel int    `json:"groupAccessLevel,omitempty"`
 }
 
+// AnnotationKeyMirrorPull requests an immediate pull of a pull-mirrored
+// project. The pull is started once for every new value of the annotation,
+// e.g. a timestamp.
+const AnnotationKeyMirrorPull = "gitlab.crossplane.io/mirror-pull"
+
+// PullMirrorObservation is the observed state of the pull mirror of a Project.
+type PullMirrorObservation struct {
+	LastError              string       `json:"lastError,omitempty"`
+	LastSuccessfulUpdateAt *metav1.Time `json:"lastSuccessfulUpdateAt,omitempty"`
+	LastUpdateAt           *metav1.Time `json:"lastUpdateAt,omitempty"`
+	UpdateStatus           string       `json:"updateStatus,omitempty"`
+
+	// LastPullRequest is the value of the mirror-pull annotation the last
+	// pull was started for.
+	LastPullRequest string `json:"lastPullRequest,omitempty"`
+}
+
+// AnnotationKeyExportBeforeDelete exports a project before it is deleted
+// while set to "true". The export is uploaded to the URL annotated with
+// AnnotationKeyExportUploadURL, and the deletion waits until it finished.
+const AnnotationKeyExportBeforeDelete = "gitlab.crossplane.io/export-before-delete"
+
+// AnnotationKeyExportUploadURL is a URL Gitlab uploads the export of a
+// project to with a PUT request, e.g. a presigned object storage URL. Gitlab
+// removes exports together with their project, so a project annotated with
+// AnnotationKeyExportBeforeDelete is not deleted until this URL is set.
+const AnnotationKeyExportUploadURL = "gitlab.crossplane.io/export-upload-url"
+
+// ProjectExportObservation is the observed state of the export of a Project
+// before its deletion.
+type ProjectExportObservation struct {
+	// RequestedAt is the time the export was scheduled.
+	RequestedAt *metav1.Time `json:"requestedAt,omitempty"`
+
+	// Status of the export, e.g. queued, started or finished.
+	Status string `json:"status,omitempty"`
+}
+
 // ProjectObservation is the observed state of a Project.
 type ProjectObservation struct {
 	ID                        int                        `json:"id,omitempty"`
@@ -509,6 +683,7 @@ type ProjectObservation struct {
 	CreatorID                 int                        `json:"creatorId,omitempty"`
 	CustomAttributes          []CustomAttribute          `json:"customAttributes,omitempty"`
 	EmptyRepo                 bool                       `json:"emptyRepo,omitempty"`
+	Export                    *ProjectExportObservation  `json:"export,omitempty"`
 	ForkedFromProject         *ForkParent                `json:"forkedFromProject,omitempty"`
 	ForksCount                int                        `json:"forksCount,omitempty"`
 	HTTPURLToRepo             string                     `json:"httpUrlToRepo,omitempty"`
@@ -529,6 +704,7 @@ type ProjectObservation struct {
 	PathWithNamespace         string                     `json:"pathWithNamespace,omitempty"`
 	Permissions               *Permissions               `json:"permissions,omitempty"`
 	Public                    bool                       `json:"public,omitempty"`
+	PullMirror                *PullMirrorObservation     `json:"pullMirror,omitempty"`
 	ReadmeURL                 string                     `json:"readmeUrl,omitempty"`
 	SSHURLToRepo              string                     `json:"sshUrlToRepo,omitempty"`
 	ServiceDeskAddress        string                     `json:"serviceDeskAddress,omitempty"`
@@ -536,20 +712,49 @@ type ProjectObservation struct {
 	SnippetsEnabled           bool                       `json:"snippetsEnabled,omitempty"`
 	StarCount                 int                        `json:"starCount,omitempty"`
 	Statistics                *ProjectStatistics         `json:"statistics,omitempty"`
+	Topics                    []string                   `json:"topics,omitempty"`
 	WebURL                    string                     `json:"webUrl,omitempty"`
 	WikiEnabled               bool                       `json:"wikiEnabled,omitempty"`
 }
 
+// ProjectObservationOptions select the heavyweight fields Gitlab only
+// returns for a project when asked to. They are left out of the observation
+// by default to keep API payloads small.
+type ProjectObservationOptions struct {
+	// Statistics includes the project statistics in the observation.
+	// +optional
+	Statistics *bool `json:"statistics,omitempty"`
+
+	// License includes the project license in the observation.
+	// +optional
+	License *bool `json:"license,omitempty"`
+
+	// CustomAttributes includes the custom attributes of the project in the
+	// observation. Requires an administrator token.
+	// +optional
+	CustomAttributes *bool `json:"customAttributes,omitempty"`
+}
+
 // A ProjectSpec defines the desired state of a Gitlab Project.
 type ProjectSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ProjectParameters `json:"forProvider"`
+
+	// Observation selects optional fields to request from Gitlab when the
+	// project is observed.
+	// +optional
+	Observation *ProjectObservationOptions `json:"observation,omitempty"`
 }
 
 // A ProjectStatus represents the observed state of a Gitlab Project.
 type ProjectStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          ProjectObservation `json:"atProvider,omitempty"`
+
+	// Phase of the creation of the project, which continues after it was
+	// created while Gitlab imports its content.
+	// +optional
+	Phase commonv1alpha1.OperationPhase `json:"phase,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -558,6 +763,7 @@ type ProjectStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.phase"
 // +kubebuilder:printcolumn:name="PATH WITH NAMESPACE",type="string",JSONPath=".status.atProvider.pathWithNamespace"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
@@ -569,6 +775,49 @@ type Project struct {
 	Status ProjectStatus `json:"status,omitempty"`
 }
 
+// GetTimeouts of this Project.
+func (mg *Project) GetTimeouts() *commonv1alpha1.Timeouts {
+	return mg.Spec.ForProvider.Timeouts
+}
+
+// GetConfirmDelete of this Project.
+func (mg *Project) GetConfirmDelete() *bool {
+	return mg.Spec.ForProvider.ConfirmDelete
+}
+
+// GetAdminOnlyFields returns the paths of the fields of this Project that
+// are set and require an administrator token.
+func (mg *Project) GetAdminOnlyFields() []string {
+	var fields []string
+	p := &mg.Spec.ForProvider
+	if len(p.CustomAttributes) > 0 {
+		fields = append(fields, "spec.forProvider.customAttributes")
+	}
+	if p.PruneCustomAttributes != nil && *p.PruneCustomAttributes {
+		fields = append(fields, "spec.forProvider.pruneCustomAttributes")
+	}
+	if p.MirrorUserID != nil {
+		fields = append(fields, "spec.forProvider.mirrorUserId")
+	}
+	if p.RepositoryStorage != nil {
+		fields = append(fields, "spec.forProvider.repositoryStorage")
+	}
+	if o := mg.Spec.Observation; o != nil && o.CustomAttributes != nil && *o.CustomAttributes {
+		fields = append(fields, "spec.observation.customAttributes")
+	}
+	return fields
+}
+
+// GetPhase of this Project.
+func (mg *Project) GetPhase() commonv1alpha1.OperationPhase {
+	return mg.Status.Phase
+}
+
+// SetPhase of this Project.
+func (mg *Project) SetPhase(p commonv1alpha1.OperationPhase) {
+	mg.Status.Phase = p
+}
+
 // +kubebuilder:object:root=true
 
 // ProjectList contains a list of Project items
@@ -577,3 +826,11 @@ type ProjectList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Project `json:"items"`
 }
+
+// GetSecretRefs of this Project.
+func (mg *Project) GetSecretRefs() []xpv1.SecretKeySelector {
+	if ref := mg.Spec.ForProvider.ImportURLPasswordSecretRef; ref != nil {
+		return []xpv1.SecretKeySelector{*ref}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelBlueprint is the label identifying the ProjectBlueprint a resource
+// was rendered from.
+const LabelBlueprint = "projects.gitlab.crossplane.io/blueprint"
+
+// A BlueprintHook is a Hook of the Project of a ProjectBlueprint.
+type BlueprintHook struct {
+	// Name of the Hook, unique within the blueprint. The Hook resource is
+	// named after the blueprint and this name.
+	// +kubebuilder:validation:Pattern:=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+
+	// ForProvider are the parameters of the Hook. The project is set by the
+	// blueprint.
+	ForProvider HookParameters `json:"forProvider"`
+}
+
+// A BlueprintVariable is a Variable of the Project of a ProjectBlueprint.
+type BlueprintVariable struct {
+	// Name of the Variable, unique within the blueprint. The Variable
+	// resource is named after the blueprint and this name.
+	// +kubebuilder:validation:Pattern:=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+
+	// ForProvider are the parameters of the Variable. The project is set by
+	// the blueprint.
+	ForProvider VariableParameters `json:"forProvider"`
+}
+
+// A BlueprintBadge is a Badge of the Project of a ProjectBlueprint.
+type BlueprintBadge struct {
+	// Name of the Badge, unique within the blueprint. The Badge resource is
+	// named after the blueprint and this name.
+	// +kubebuilder:validation:Pattern:=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+
+	// ForProvider are the parameters of the Badge. The project is set by
+	// the blueprint.
+	ForProvider BadgeParameters `json:"forProvider"`
+}
+
+// A ProjectBlueprintSpec defines a Project and the resources belonging to
+// it.
+type ProjectBlueprintSpec struct {
+	// ProviderConfigReference specifies how the rendered resources connect
+	// to Gitlab.
+	// +kubebuilder:default={"name": "default"}
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+
+	// Parameters replace the placeholders $(name) in the string values of
+	// the Project and its resources.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// Project are the parameters of the Project.
+	Project ProjectParameters `json:"project"`
+
+	// Hooks of the Project.
+	// +optional
+	Hooks []BlueprintHook `json:"hooks,omitempty"`
+
+	// Variables of the Project.
+	// +optional
+	Variables []BlueprintVariable `json:"variables,omitempty"`
+
+	// Badges of the Project.
+	// +optional
+	Badges []BlueprintBadge `json:"badges,omitempty"`
+}
+
+// A BlueprintResource is a resource rendered from a ProjectBlueprint.
+type BlueprintResource struct {
+	Kind  string `json:"kind"`
+	Name  string `json:"name"`
+	Ready bool   `json:"ready"`
+}
+
+// A ProjectBlueprintStatus represents the observed state of a
+// ProjectBlueprint.
+type ProjectBlueprintStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+
+	// ProjectID is the ID of the Project once it was created.
+	ProjectID *int `json:"projectId,omitempty"`
+
+	// Resources rendered from the blueprint.
+	Resources []BlueprintResource `json:"resources,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectBlueprint renders a Project and its Hooks, Variables and Badges
+// from parameters, and manages them as a unit. Resources removed from the
+// blueprint are deleted, and all of them are deleted with it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT",type="integer",JSONPath=".status.projectId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,gitlab}
+type ProjectBlueprint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectBlueprintSpec   `json:"spec"`
+	Status ProjectBlueprintStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectBlueprintList contains a list of ProjectBlueprint items.
+type ProjectBlueprintList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectBlueprint `json:"items"`
+}
+
+// GetCondition of this ProjectBlueprint.
+func (bp *ProjectBlueprint) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return bp.Status.GetCondition(ct)
+}
+
+// SetConditions of this ProjectBlueprint.
+func (bp *ProjectBlueprint) SetConditions(c ...xpv1.Condition) {
+	bp.Status.SetConditions(c...)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NotificationEvents selects the events that notify about a project if its
+// notification level is custom. Events that are not set are left as they
+// are.
+type NotificationEvents struct {
+	// +optional
+	CloseIssue *bool `json:"closeIssue,omitempty"`
+
+	// +optional
+	CloseMergeRequest *bool `json:"closeMergeRequest,omitempty"`
+
+	// +optional
+	FailedPipeline *bool `json:"failedPipeline,omitempty"`
+
+	// +optional
+	MergeMergeRequest *bool `json:"mergeMergeRequest,omitempty"`
+
+	// +optional
+	NewIssue *bool `json:"newIssue,omitempty"`
+
+	// +optional
+	NewMergeRequest *bool `json:"newMergeRequest,omitempty"`
+
+	// +optional
+	NewNote *bool `json:"newNote,omitempty"`
+
+	// +optional
+	ReassignIssue *bool `json:"reassignIssue,omitempty"`
+
+	// +optional
+	ReassignMergeRequest *bool `json:"reassignMergeRequest,omitempty"`
+
+	// +optional
+	ReopenIssue *bool `json:"reopenIssue,omitempty"`
+
+	// +optional
+	ReopenMergeRequest *bool `json:"reopenMergeRequest,omitempty"`
+
+	// +optional
+	SuccessPipeline *bool `json:"successPipeline,omitempty"`
+}
+
+// ProjectNotificationSettingsParameters define the notification settings
+// of the user of the provider token for a Gitlab Project. The level is late
+// initialized from Gitlab if it is not set.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notification_settings.html#group--project-level-notification-settings
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ProjectNotificationSettingsParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Level of notifications about the project. Use disabled to keep a bot
+	// user from receiving mails about the projects it owns.
+	// +optional
+	// +kubebuilder:validation:Enum=disabled;participating;watch;global;mention;custom
+	Level *string `json:"level,omitempty"`
+
+	// Events that notify about the project. Only used if Level is custom.
+	// +optional
+	Events *NotificationEvents `json:"events,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig. The notification settings of its user are managed.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ProjectNotificationSettingsObservation represents the observed
+// notification settings of the user of the provider token for a Gitlab
+// Project.
+type ProjectNotificationSettingsObservation struct {
+	Level string `json:"level,omitempty"`
+}
+
+// A ProjectNotificationSettingsSpec defines the desired state of the
+// notification settings for a Gitlab Project.
+type ProjectNotificationSettingsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectNotificationSettingsParameters `json:"forProvider"`
+}
+
+// A ProjectNotificationSettingsStatus represents the observed state of the
+// notification settings for a Gitlab Project.
+type ProjectNotificationSettingsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectNotificationSettingsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectNotificationSettings is a managed resource that represents the
+// notification settings of the user of the provider token for a Gitlab
+// Project. Every user has one set of notification settings per project, so
+// at most one ProjectNotificationSettings per ProviderConfig should manage
+// a project. Deleting it resets the level to global.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LEVEL",type="string",JSONPath=".status.atProvider.level"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ProjectNotificationSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectNotificationSettingsSpec   `json:"spec"`
+	Status ProjectNotificationSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectNotificationSettingsList contains a list of
+// ProjectNotificationSettings items.
+type ProjectNotificationSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectNotificationSettings `json:"items"`
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectDefaults are the values of Project parameters that are used when
+// a Project does not set them.
+type ProjectDefaults struct {
+	// Visibility of the Project.
+	// +optional
+	Visibility *VisibilityValue `json:"visibility,omitempty"`
+
+	// MergeMethod of the Project.
+	// +optional
+	MergeMethod *MergeMethodValue `json:"mergeMethod,omitempty"`
+
+	// DefaultBranch of the Project.
+	// +optional
+	DefaultBranch *string `json:"defaultBranch,omitempty"`
+
+	// OnlyAllowMergeIfPipelineSucceeds of the Project.
+	// +optional
+	OnlyAllowMergeIfPipelineSucceeds *bool `json:"onlyAllowMergeIfPipelineSucceeds,omitempty"`
+
+	// OnlyAllowMergeIfAllDiscussionsAreResolved of the Project.
+	// +optional
+	OnlyAllowMergeIfAllDiscussionsAreResolved *bool `json:"onlyAllowMergeIfAllDiscussionsAreResolved,omitempty"`
+
+	// RemoveSourceBranchAfterMerge of the Project.
+	// +optional
+	RemoveSourceBranchAfterMerge *bool `json:"removeSourceBranchAfterMerge,omitempty"`
+}
+
+// A ProjectPolicySpec defines the Projects a ProjectPolicy applies to and
+// the defaults it sets on them.
+type ProjectPolicySpec struct {
+	// ProjectSelector selects the Projects the policy applies to by their
+	// labels, e.g. a team label or the crossplane.io/claim-namespace label
+	// of Projects created by claims. A policy without a selector applies to
+	// all Projects.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+
+	// Defaults are set on the selected Projects when they are created,
+	// unless the Project sets the parameter itself. When several policies
+	// select a Project, the policy first in order of name wins.
+	Defaults ProjectDefaults `json:"defaults"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectPolicy defaults the parameters of the Projects it selects, so
+// organisation wide settings need not be repeated by every Project.
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,gitlab}
+type ProjectPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectPolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectPolicyList contains a list of ProjectPolicy items.
+type ProjectPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectPolicy `json:"items"`
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectShareParameters define the desired state of the share of a Gitlab
+// Project with a group, which grants the members of the group access to the
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#share-project-with-group
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+// At least 1 of [GroupID, GroupIDRef, GroupIDSelector] required.
+type ProjectShareParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// The ID of the group the project is shared with.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	// +crossplane:generate:reference:refFieldName=GroupIDRef
+	// +crossplane:generate:reference:selectorFieldName=GroupIDSelector
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its GroupID.
+	// +optional
+	// +immutable
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// GroupAccess is the access level granted to the members of the group.
+	// Valid values are 10 (Guest), 20 (Reporter), 30 (Developer) and 40
+	// (Maintainer).
+	// +kubebuilder:validation:Enum=10;20;30;40
+	GroupAccess AccessLevelValue `json:"groupAccess"`
+
+	// ExpiresAt is the date the share expires, in the format
+	// YEAR-MONTH-DAY.
+	// +optional
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ProjectShareObservation represents the observed state of the share of a
+// Gitlab Project with a group.
+type ProjectShareObservation struct {
+	GroupName        string `json:"groupName,omitempty"`
+	GroupFullPath    string `json:"groupFullPath,omitempty"`
+	GroupAccessLevel int    `json:"groupAccessLevel,omitempty"`
+	ExpiresAt        string `json:"expiresAt,omitempty"`
+}
+
+// A ProjectShareSpec defines the desired state of the share of a Gitlab
+// Project with a group.
+type ProjectShareSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectShareParameters `json:"forProvider"`
+}
+
+// A ProjectShareStatus represents the observed state of the share of a
+// Gitlab Project with a group.
+type ProjectShareStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectShareObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectShare is a managed resource that represents the share of a Gitlab
+// Project with a group. Gitlab cannot change a share, so a changed access
+// level or expiry shares the project again.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".status.atProvider.groupFullPath"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ProjectShare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectShareSpec   `json:"spec"`
+	Status ProjectShareStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectShareList contains a list of ProjectShare items.
+type ProjectShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectShare `json:"items"`
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BranchProtection is the protection of a branch, or of all branches
+// matching a wildcard, managed by a ProtectedBranchSet.
+type BranchProtection struct {
+	// Name of the branch or wildcard, for example main or release/*.
+	Name string `json:"name"`
+
+	// PushAccessLevel is the access level allowed to push. Defaults to 40
+	// (Maintainer). Valid values are 0 (No access), 30 (Developer), 40
+	// (Maintainer) and 60 (Admin).
+	// +optional
+	PushAccessLevel *AccessLevelValue `json:"pushAccessLevel,omitempty"`
+
+	// MergeAccessLevel is the access level allowed to merge. Defaults to 40
+	// (Maintainer).
+	// +optional
+	MergeAccessLevel *AccessLevelValue `json:"mergeAccessLevel,omitempty"`
+
+	// UnprotectAccessLevel is the access level allowed to unprotect.
+	// Defaults to 40 (Maintainer).
+	// +optional
+	UnprotectAccessLevel *AccessLevelValue `json:"unprotectAccessLevel,omitempty"`
+
+	// AllowForcePush allows force pushes to the branch. Defaults to false.
+	// +optional
+	AllowForcePush *bool `json:"allowForcePush,omitempty"`
+
+	// CodeOwnerApprovalRequired prevents pushes to the branch if it matches
+	// an item in the CODEOWNERS file. Defaults to false.
+	// +optional
+	CodeOwnerApprovalRequired *bool `json:"codeOwnerApprovalRequired,omitempty"`
+}
+
+// ProtectedBranchSetParameters define the desired state of all protected
+// branches of a Gitlab Project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_branches.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ProtectedBranchSetParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Protections is the list of branch protections the project should have.
+	// Users and groups allowed to push, merge or unprotect in Gitlab are
+	// removed, so only the access levels listed here apply.
+	// +listType=map
+	// +listMapKey=name
+	Protections []BranchProtection `json:"protections"`
+
+	// Prune unprotects branches that are not listed in Protections, such as
+	// protections added in the Gitlab UI. Defaults to true.
+	// +optional
+	// +kubebuilder:default=true
+	Prune *bool `json:"prune,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ProtectedBranchSetObservation represents the observed protected branches
+// of a Gitlab Project.
+type ProtectedBranchSetObservation struct {
+	// Protections is the list of protected branches found at Gitlab.
+	Protections []BranchProtectionObservation `json:"protections,omitempty"`
+}
+
+// BranchProtectionObservation represents an observed Gitlab protected
+// branch.
+type BranchProtectionObservation struct {
+	ID                        int                `json:"id"`
+	Name                      string             `json:"name"`
+	PushAccessLevels          []AccessLevelValue `json:"pushAccessLevels,omitempty"`
+	MergeAccessLevels         []AccessLevelValue `json:"mergeAccessLevels,omitempty"`
+	UnprotectAccessLevels     []AccessLevelValue `json:"unprotectAccessLevels,omitempty"`
+	AllowForcePush            bool               `json:"allowForcePush,omitempty"`
+	CodeOwnerApprovalRequired bool               `json:"codeOwnerApprovalRequired,omitempty"`
+}
+
+// A ProtectedBranchSetSpec defines the desired state of a Gitlab Project
+// ProtectedBranchSet.
+type ProtectedBranchSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProtectedBranchSetParameters `json:"forProvider"`
+}
+
+// A ProtectedBranchSetStatus represents the observed state of a Gitlab
+// Project ProtectedBranchSet.
+type ProtectedBranchSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProtectedBranchSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProtectedBranchSet is a managed resource that authoritatively manages the
+// protected branches of a Gitlab Project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ProtectedBranchSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProtectedBranchSetSpec   `json:"spec"`
+	Status ProtectedBranchSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProtectedBranchSetList contains a list of ProtectedBranchSet items.
+type ProtectedBranchSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProtectedBranchSet `json:"items"`
+}
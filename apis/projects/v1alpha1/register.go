@@ -101,6 +101,238 @@ var (
 	PipelineScheduleGroupVersionKind = SchemeGroupVersion.WithKind(PipelineScheduleKind)
 )
 
+// LabelSet type metadata
+var (
+	LabelSetKind             = reflect.TypeOf(LabelSet{}).Name()
+	LabelSetGroupKind        = schema.GroupKind{Group: Group, Kind: LabelSetKind}.String()
+	LabelSetKindAPIVersion   = LabelSetKind + "." + SchemeGroupVersion.String()
+	LabelSetGroupVersionKind = SchemeGroupVersion.WithKind(LabelSetKind)
+)
+
+// Badge type metadata
+var (
+	BadgeKind             = reflect.TypeOf(Badge{}).Name()
+	BadgeGroupKind        = schema.GroupKind{Group: Group, Kind: BadgeKind}.String()
+	BadgeKindAPIVersion   = BadgeKind + "." + SchemeGroupVersion.String()
+	BadgeGroupVersionKind = SchemeGroupVersion.WithKind(BadgeKind)
+)
+
+// ProjectPolicy type metadata
+var (
+	ProjectPolicyKind             = reflect.TypeOf(ProjectPolicy{}).Name()
+	ProjectPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectPolicyKind}.String()
+	ProjectPolicyKindAPIVersion   = ProjectPolicyKind + "." + SchemeGroupVersion.String()
+	ProjectPolicyGroupVersionKind = SchemeGroupVersion.WithKind(ProjectPolicyKind)
+)
+
+// ProjectBlueprint type metadata
+var (
+	ProjectBlueprintKind             = reflect.TypeOf(ProjectBlueprint{}).Name()
+	ProjectBlueprintGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectBlueprintKind}.String()
+	ProjectBlueprintKindAPIVersion   = ProjectBlueprintKind + "." + SchemeGroupVersion.String()
+	ProjectBlueprintGroupVersionKind = SchemeGroupVersion.WithKind(ProjectBlueprintKind)
+)
+
+// ApprovalRuleSet type metadata
+var (
+	ApprovalRuleSetKind             = reflect.TypeOf(ApprovalRuleSet{}).Name()
+	ApprovalRuleSetGroupKind        = schema.GroupKind{Group: Group, Kind: ApprovalRuleSetKind}.String()
+	ApprovalRuleSetKindAPIVersion   = ApprovalRuleSetKind + "." + SchemeGroupVersion.String()
+	ApprovalRuleSetGroupVersionKind = SchemeGroupVersion.WithKind(ApprovalRuleSetKind)
+)
+
+// ProtectedBranchSet type metadata
+var (
+	ProtectedBranchSetKind             = reflect.TypeOf(ProtectedBranchSet{}).Name()
+	ProtectedBranchSetGroupKind        = schema.GroupKind{Group: Group, Kind: ProtectedBranchSetKind}.String()
+	ProtectedBranchSetKindAPIVersion   = ProtectedBranchSetKind + "." + SchemeGroupVersion.String()
+	ProtectedBranchSetGroupVersionKind = SchemeGroupVersion.WithKind(ProtectedBranchSetKind)
+)
+
+// GroupPolicy type metadata
+var (
+	GroupPolicyKind             = reflect.TypeOf(GroupPolicy{}).Name()
+	GroupPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: GroupPolicyKind}.String()
+	GroupPolicyKindAPIVersion   = GroupPolicyKind + "." + SchemeGroupVersion.String()
+	GroupPolicyGroupVersionKind = SchemeGroupVersion.WithKind(GroupPolicyKind)
+)
+
+// Release type metadata
+var (
+	ReleaseKind             = reflect.TypeOf(Release{}).Name()
+	ReleaseGroupKind        = schema.GroupKind{Group: Group, Kind: ReleaseKind}.String()
+	ReleaseKindAPIVersion   = ReleaseKind + "." + SchemeGroupVersion.String()
+	ReleaseGroupVersionKind = SchemeGroupVersion.WithKind(ReleaseKind)
+)
+
+// FreezePeriod type metadata
+var (
+	FreezePeriodKind             = reflect.TypeOf(FreezePeriod{}).Name()
+	FreezePeriodGroupKind        = schema.GroupKind{Group: Group, Kind: FreezePeriodKind}.String()
+	FreezePeriodKindAPIVersion   = FreezePeriodKind + "." + SchemeGroupVersion.String()
+	FreezePeriodGroupVersionKind = SchemeGroupVersion.WithKind(FreezePeriodKind)
+)
+
+// ExternalStatusCheck type metadata
+var (
+	ExternalStatusCheckKind             = reflect.TypeOf(ExternalStatusCheck{}).Name()
+	ExternalStatusCheckGroupKind        = schema.GroupKind{Group: Group, Kind: ExternalStatusCheckKind}.String()
+	ExternalStatusCheckKindAPIVersion   = ExternalStatusCheckKind + "." + SchemeGroupVersion.String()
+	ExternalStatusCheckGroupVersionKind = SchemeGroupVersion.WithKind(ExternalStatusCheckKind)
+)
+
+// IssueLink type metadata
+var (
+	IssueLinkKind             = reflect.TypeOf(IssueLink{}).Name()
+	IssueLinkGroupKind        = schema.GroupKind{Group: Group, Kind: IssueLinkKind}.String()
+	IssueLinkKindAPIVersion   = IssueLinkKind + "." + SchemeGroupVersion.String()
+	IssueLinkGroupVersionKind = SchemeGroupVersion.WithKind(IssueLinkKind)
+)
+
+// PipelineRetentionPolicy type metadata
+var (
+	PipelineRetentionPolicyKind             = reflect.TypeOf(PipelineRetentionPolicy{}).Name()
+	PipelineRetentionPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: PipelineRetentionPolicyKind}.String()
+	PipelineRetentionPolicyKindAPIVersion   = PipelineRetentionPolicyKind + "." + SchemeGroupVersion.String()
+	PipelineRetentionPolicyGroupVersionKind = SchemeGroupVersion.WithKind(PipelineRetentionPolicyKind)
+)
+
+// ApprovalSettings type metadata
+var (
+	ApprovalSettingsKind             = reflect.TypeOf(ApprovalSettings{}).Name()
+	ApprovalSettingsGroupKind        = schema.GroupKind{Group: Group, Kind: ApprovalSettingsKind}.String()
+	ApprovalSettingsKindAPIVersion   = ApprovalSettingsKind + "." + SchemeGroupVersion.String()
+	ApprovalSettingsGroupVersionKind = SchemeGroupVersion.WithKind(ApprovalSettingsKind)
+)
+
+// RemoteMirror type metadata
+var (
+	RemoteMirrorKind             = reflect.TypeOf(RemoteMirror{}).Name()
+	RemoteMirrorGroupKind        = schema.GroupKind{Group: Group, Kind: RemoteMirrorKind}.String()
+	RemoteMirrorKindAPIVersion   = RemoteMirrorKind + "." + SchemeGroupVersion.String()
+	RemoteMirrorGroupVersionKind = SchemeGroupVersion.WithKind(RemoteMirrorKind)
+)
+
+// AccessTokenRotation type metadata
+var (
+	AccessTokenRotationKind             = reflect.TypeOf(AccessTokenRotation{}).Name()
+	AccessTokenRotationGroupKind        = schema.GroupKind{Group: Group, Kind: AccessTokenRotationKind}.String()
+	AccessTokenRotationKindAPIVersion   = AccessTokenRotationKind + "." + SchemeGroupVersion.String()
+	AccessTokenRotationGroupVersionKind = SchemeGroupVersion.WithKind(AccessTokenRotationKind)
+)
+
+// ProjectShare type metadata
+var (
+	ProjectShareKind             = reflect.TypeOf(ProjectShare{}).Name()
+	ProjectShareGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectShareKind}.String()
+	ProjectShareKindAPIVersion   = ProjectShareKind + "." + SchemeGroupVersion.String()
+	ProjectShareGroupVersionKind = SchemeGroupVersion.WithKind(ProjectShareKind)
+)
+
+// ClusterAgent type metadata
+var (
+	ClusterAgentKind             = reflect.TypeOf(ClusterAgent{}).Name()
+	ClusterAgentGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterAgentKind}.String()
+	ClusterAgentKindAPIVersion   = ClusterAgentKind + "." + SchemeGroupVersion.String()
+	ClusterAgentGroupVersionKind = SchemeGroupVersion.WithKind(ClusterAgentKind)
+)
+
+// DefaultReviewers type metadata
+var (
+	DefaultReviewersKind             = reflect.TypeOf(DefaultReviewers{}).Name()
+	DefaultReviewersGroupKind        = schema.GroupKind{Group: Group, Kind: DefaultReviewersKind}.String()
+	DefaultReviewersKindAPIVersion   = DefaultReviewersKind + "." + SchemeGroupVersion.String()
+	DefaultReviewersGroupVersionKind = SchemeGroupVersion.WithKind(DefaultReviewersKind)
+)
+
+// Snippet type metadata
+var (
+	SnippetKind             = reflect.TypeOf(Snippet{}).Name()
+	SnippetGroupKind        = schema.GroupKind{Group: Group, Kind: SnippetKind}.String()
+	SnippetKindAPIVersion   = SnippetKind + "." + SchemeGroupVersion.String()
+	SnippetGroupVersionKind = SchemeGroupVersion.WithKind(SnippetKind)
+)
+
+// WikiPage type metadata
+var (
+	WikiPageKind             = reflect.TypeOf(WikiPage{}).Name()
+	WikiPageGroupKind        = schema.GroupKind{Group: Group, Kind: WikiPageKind}.String()
+	WikiPageKindAPIVersion   = WikiPageKind + "." + SchemeGroupVersion.String()
+	WikiPageGroupVersionKind = SchemeGroupVersion.WithKind(WikiPageKind)
+)
+
+// DeployKeyEnablement type metadata
+var (
+	DeployKeyEnablementKind             = reflect.TypeOf(DeployKeyEnablement{}).Name()
+	DeployKeyEnablementGroupKind        = schema.GroupKind{Group: Group, Kind: DeployKeyEnablementKind}.String()
+	DeployKeyEnablementKindAPIVersion   = DeployKeyEnablementKind + "." + SchemeGroupVersion.String()
+	DeployKeyEnablementGroupVersionKind = SchemeGroupVersion.WithKind(DeployKeyEnablementKind)
+)
+
+// GitLabImport type metadata
+var (
+	GitLabImportKind             = reflect.TypeOf(GitLabImport{}).Name()
+	GitLabImportGroupKind        = schema.GroupKind{Group: Group, Kind: GitLabImportKind}.String()
+	GitLabImportKindAPIVersion   = GitLabImportKind + "." + SchemeGroupVersion.String()
+	GitLabImportGroupVersionKind = SchemeGroupVersion.WithKind(GitLabImportKind)
+)
+
+// JobTokenScope type metadata
+var (
+	JobTokenScopeKind             = reflect.TypeOf(JobTokenScope{}).Name()
+	JobTokenScopeGroupKind        = schema.GroupKind{Group: Group, Kind: JobTokenScopeKind}.String()
+	JobTokenScopeKindAPIVersion   = JobTokenScopeKind + "." + SchemeGroupVersion.String()
+	JobTokenScopeGroupVersionKind = SchemeGroupVersion.WithKind(JobTokenScopeKind)
+)
+
+// ForkRelationship type metadata
+var (
+	ForkRelationshipKind             = reflect.TypeOf(ForkRelationship{}).Name()
+	ForkRelationshipGroupKind        = schema.GroupKind{Group: Group, Kind: ForkRelationshipKind}.String()
+	ForkRelationshipKindAPIVersion   = ForkRelationshipKind + "." + SchemeGroupVersion.String()
+	ForkRelationshipGroupVersionKind = SchemeGroupVersion.WithKind(ForkRelationshipKind)
+)
+
+// JiraIntegration type metadata
+var (
+	JiraIntegrationKind             = reflect.TypeOf(JiraIntegration{}).Name()
+	JiraIntegrationGroupKind        = schema.GroupKind{Group: Group, Kind: JiraIntegrationKind}.String()
+	JiraIntegrationKindAPIVersion   = JiraIntegrationKind + "." + SchemeGroupVersion.String()
+	JiraIntegrationGroupVersionKind = SchemeGroupVersion.WithKind(JiraIntegrationKind)
+)
+
+// ProjectNotificationSettings type metadata
+var (
+	ProjectNotificationSettingsKind             = reflect.TypeOf(ProjectNotificationSettings{}).Name()
+	ProjectNotificationSettingsGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectNotificationSettingsKind}.String()
+	ProjectNotificationSettingsKindAPIVersion   = ProjectNotificationSettingsKind + "." + SchemeGroupVersion.String()
+	ProjectNotificationSettingsGroupVersionKind = SchemeGroupVersion.WithKind(ProjectNotificationSettingsKind)
+)
+
+// Note type metadata
+var (
+	NoteKind             = reflect.TypeOf(Note{}).Name()
+	NoteGroupKind        = schema.GroupKind{Group: Group, Kind: NoteKind}.String()
+	NoteKindAPIVersion   = NoteKind + "." + SchemeGroupVersion.String()
+	NoteGroupVersionKind = SchemeGroupVersion.WithKind(NoteKind)
+)
+
+// Approval type metadata
+var (
+	ApprovalKind             = reflect.TypeOf(Approval{}).Name()
+	ApprovalGroupKind        = schema.GroupKind{Group: Group, Kind: ApprovalKind}.String()
+	ApprovalKindAPIVersion   = ApprovalKind + "." + SchemeGroupVersion.String()
+	ApprovalGroupVersionKind = SchemeGroupVersion.WithKind(ApprovalKind)
+)
+
+// Milestone type metadata
+var (
+	MilestoneKind             = reflect.TypeOf(Milestone{}).Name()
+	MilestoneGroupKind        = schema.GroupKind{Group: Group, Kind: MilestoneKind}.String()
+	MilestoneKindAPIVersion   = MilestoneKind + "." + SchemeGroupVersion.String()
+	MilestoneGroupVersionKind = SchemeGroupVersion.WithKind(MilestoneKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Project{}, &ProjectList{})
 	SchemeBuilder.Register(&Hook{}, &HookList{})
@@ -110,4 +342,33 @@ func init() {
 	SchemeBuilder.Register(&DeployKey{}, &DeployKeyList{})
 	SchemeBuilder.Register(&AccessToken{}, &AccessTokenList{})
 	SchemeBuilder.Register(&PipelineSchedule{}, &PipelineScheduleList{})
+	SchemeBuilder.Register(&LabelSet{}, &LabelSetList{})
+	SchemeBuilder.Register(&Badge{}, &BadgeList{})
+	SchemeBuilder.Register(&ProjectPolicy{}, &ProjectPolicyList{})
+	SchemeBuilder.Register(&ProjectBlueprint{}, &ProjectBlueprintList{})
+	SchemeBuilder.Register(&ApprovalRuleSet{}, &ApprovalRuleSetList{})
+	SchemeBuilder.Register(&ProtectedBranchSet{}, &ProtectedBranchSetList{})
+	SchemeBuilder.Register(&GroupPolicy{}, &GroupPolicyList{})
+	SchemeBuilder.Register(&Release{}, &ReleaseList{})
+	SchemeBuilder.Register(&FreezePeriod{}, &FreezePeriodList{})
+	SchemeBuilder.Register(&ExternalStatusCheck{}, &ExternalStatusCheckList{})
+	SchemeBuilder.Register(&IssueLink{}, &IssueLinkList{})
+	SchemeBuilder.Register(&PipelineRetentionPolicy{}, &PipelineRetentionPolicyList{})
+	SchemeBuilder.Register(&ApprovalSettings{}, &ApprovalSettingsList{})
+	SchemeBuilder.Register(&RemoteMirror{}, &RemoteMirrorList{})
+	SchemeBuilder.Register(&AccessTokenRotation{}, &AccessTokenRotationList{})
+	SchemeBuilder.Register(&ProjectShare{}, &ProjectShareList{})
+	SchemeBuilder.Register(&ClusterAgent{}, &ClusterAgentList{})
+	SchemeBuilder.Register(&DefaultReviewers{}, &DefaultReviewersList{})
+	SchemeBuilder.Register(&Snippet{}, &SnippetList{})
+	SchemeBuilder.Register(&WikiPage{}, &WikiPageList{})
+	SchemeBuilder.Register(&DeployKeyEnablement{}, &DeployKeyEnablementList{})
+	SchemeBuilder.Register(&GitLabImport{}, &GitLabImportList{})
+	SchemeBuilder.Register(&JobTokenScope{}, &JobTokenScopeList{})
+	SchemeBuilder.Register(&ForkRelationship{}, &ForkRelationshipList{})
+	SchemeBuilder.Register(&JiraIntegration{}, &JiraIntegrationList{})
+	SchemeBuilder.Register(&ProjectNotificationSettings{}, &ProjectNotificationSettingsList{})
+	SchemeBuilder.Register(&Note{}, &NoteList{})
+	SchemeBuilder.Register(&Approval{}, &ApprovalList{})
+	SchemeBuilder.Register(&Milestone{}, &MilestoneList{})
 }
@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReleaseAssetLink is a link to an asset of a release. Links are identified
+// by their name.
+type ReleaseAssetLink struct {
+	// Name of the link.
+	Name string `json:"name"`
+
+	// URL the link points to.
+	URL string `json:"url"`
+
+	// FilePath is the path of a direct asset link, for example /binaries/linux-amd64.
+	// +optional
+	FilePath *string `json:"filePath,omitempty"`
+
+	// LinkType is the type of the link.
+	// +kubebuilder:validation:Enum=other;runbook;image;package
+	// +optional
+	LinkType *string `json:"linkType,omitempty"`
+}
+
+// ReleaseParameters define the desired state of a Gitlab project release.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ReleaseParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// TagName is the tag the release is created from.
+	// +immutable
+	TagName string `json:"tagName"`
+
+	// TagMessage is the message of the annotated tag created if TagName
+	// does not exist yet.
+	// +optional
+	// +immutable
+	TagMessage *string `json:"tagMessage,omitempty"`
+
+	// Ref is the commit SHA, branch or tag the tag is created from if
+	// TagName does not exist yet.
+	// +optional
+	// +immutable
+	Ref *string `json:"ref,omitempty"`
+
+	// Name of the release. Defaults to TagName.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Description of the release. Markdown is supported.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// ReleasedAt is the date the release is or will be ready. Defaults to
+	// the creation time.
+	// +optional
+	ReleasedAt *metav1.Time `json:"releasedAt,omitempty"`
+
+	// Milestones are the titles of the milestones associated with the
+	// release. Milestones not listed are removed from the release. The
+	// milestones are left as they are if unset.
+	// +optional
+	Milestones []string `json:"milestones,omitempty"`
+
+	// AssetLinks of the release. Links not listed are removed.
+	// +optional
+	AssetLinks []ReleaseAssetLink `json:"assetLinks,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ReleaseAssetLinkObservation is an asset link of a release as reported by
+// Gitlab.
+type ReleaseAssetLinkObservation struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	URL            string `json:"url"`
+	DirectAssetURL string `json:"directAssetUrl,omitempty"`
+	External       bool   `json:"external,omitempty"`
+	LinkType       string `json:"linkType,omitempty"`
+}
+
+// ReleaseObservation represents the observed state of a Gitlab project
+// release.
+type ReleaseObservation struct {
+	CreatedAt           *metav1.Time                  `json:"createdAt,omitempty"`
+	CommitID            string                        `json:"commitId,omitempty"`
+	UpcomingRelease     bool                          `json:"upcomingRelease,omitempty"`
+	Milestones          []string                      `json:"milestones,omitempty"`
+	EvidenceSHA         string                        `json:"evidenceSha,omitempty"`
+	EvidenceCollectedAt *metav1.Time                  `json:"evidenceCollectedAt,omitempty"`
+	AssetLinks          []ReleaseAssetLinkObservation `json:"assetLinks,omitempty"`
+}
+
+// A ReleaseSpec defines the desired state of a Gitlab project release.
+type ReleaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReleaseParameters `json:"forProvider"`
+}
+
+// A ReleaseStatus represents the observed state of a Gitlab project release.
+type ReleaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReleaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Release is a managed resource that represents a Gitlab project release.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TAG",type="string",JSONPath=".spec.forProvider.tagName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Release struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReleaseSpec   `json:"spec"`
+	Status ReleaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReleaseList contains a list of Release items.
+type ReleaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Release `json:"items"`
+}
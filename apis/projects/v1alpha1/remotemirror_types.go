@@ -0,0 +1,154 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RemoteMirrorParameters define the desired state of a push mirror of a
+// Gitlab Project, which pushes the repository of the project to another
+// repository.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/remote_mirrors.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type RemoteMirrorParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// URL of the repository the project is pushed to, without credentials.
+	// Gitlab does not allow to change the URL of a mirror.
+	// +immutable
+	URL string `json:"url"`
+
+	// Username to authenticate to the mirrored repository with. Defaults to
+	// oauth2 if PasswordSecretRef is set.
+	// +optional
+	// +immutable
+	Username *string `json:"username,omitempty"`
+
+	// PasswordSecretRef references a secret holding the password or token
+	// to authenticate to the mirrored repository with. Gitlab does not
+	// return it, so it is only sent when the mirror is created.
+	// +optional
+	// +immutable
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// Enabled starts pushing to the mirror.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// OnlyProtectedBranches limits the mirror to protected branches.
+	// +optional
+	OnlyProtectedBranches *bool `json:"onlyProtectedBranches,omitempty"`
+
+	// KeepDivergentRefs skips refs of the mirror that diverged from the
+	// project rather than overwriting them.
+	// +optional
+	KeepDivergentRefs *bool `json:"keepDivergentRefs,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// RemoteMirrorObservation represents the observed state of a push mirror of
+// a Gitlab Project.
+type RemoteMirrorObservation struct {
+	ID int `json:"id,omitempty"`
+
+	// URL of the mirror with masked credentials.
+	URL string `json:"url,omitempty"`
+
+	// UpdateStatus is the status of the last push, e.g. finished or failed.
+	UpdateStatus string `json:"updateStatus,omitempty"`
+
+	// LastError is the error of the last push, if it failed.
+	LastError string `json:"lastError,omitempty"`
+
+	LastUpdateAt           *metav1.Time `json:"lastUpdateAt,omitempty"`
+	LastUpdateStartedAt    *metav1.Time `json:"lastUpdateStartedAt,omitempty"`
+	LastSuccessfulUpdateAt *metav1.Time `json:"lastSuccessfulUpdateAt,omitempty"`
+}
+
+// A RemoteMirrorSpec defines the desired state of a push mirror of a Gitlab
+// Project.
+type RemoteMirrorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RemoteMirrorParameters `json:"forProvider"`
+}
+
+// A RemoteMirrorStatus represents the observed state of a push mirror of a
+// Gitlab Project.
+type RemoteMirrorStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RemoteMirrorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RemoteMirror is a managed resource that represents a push mirror of a
+// Gitlab Project. It is unavailable while pushing to the mirror fails.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.updateStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type RemoteMirror struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RemoteMirrorSpec   `json:"spec"`
+	Status RemoteMirrorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RemoteMirrorList contains a list of RemoteMirror items.
+type RemoteMirrorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RemoteMirror `json:"items"`
+}
+
+// GetSecretRefs of this RemoteMirror.
+func (mg *RemoteMirror) GetSecretRefs() []xpv1.SecretKeySelector {
+	if ref := mg.Spec.ForProvider.PasswordSecretRef; ref != nil {
+		return []xpv1.SecretKeySelector{*ref}
+	}
+	return nil
+}
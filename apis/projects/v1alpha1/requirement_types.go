@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A Requirement is met before a resource is created or changed in Gitlab.
+// +kubebuilder:validation:Enum:=projectReady
+type Requirement string
+
+// Requirements of a resource.
+const (
+	// RequireProjectReady waits until the referenced Project is ready and
+	// has finished importing its repository.
+	RequireProjectReady Requirement = "projectReady"
+)
+
+// ReasonWaitingForProject is the reason a resource is not ready while it
+// waits for its Project.
+const ReasonWaitingForProject xpv1.ConditionReason = "WaitingForProject"
+
+// WaitingForProject returns a condition that indicates the resource waits
+// for its Project before it is created or changed in Gitlab.
+func WaitingForProject(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWaitingForProject,
+		Message:            msg,
+	}
+}
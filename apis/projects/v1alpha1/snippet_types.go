@@ -0,0 +1,139 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SnippetFile is a file of a snippet. Files are identified by their path.
+type SnippetFile struct {
+	// FilePath is the path of the file within the snippet, for example
+	// scripts/setup.sh.
+	// +kubebuilder:validation:MinLength=1
+	FilePath string `json:"filePath"`
+
+	// Content of the file.
+	Content string `json:"content"`
+}
+
+// SnippetParameters define the desired state of a Gitlab project snippet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_snippets.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type SnippetParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Title of the snippet.
+	// +kubebuilder:validation:MinLength=1
+	Title string `json:"title"`
+
+	// Description of the snippet.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Visibility of the snippet.
+	// +kubebuilder:validation:Enum=private;internal;public
+	// +optional
+	Visibility *VisibilityValue `json:"visibility,omitempty"`
+
+	// Files of the snippet. Files not listed are removed from the snippet.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=filePath
+	Files []SnippetFile `json:"files"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// SnippetFileObservation is a file of a snippet as reported by Gitlab.
+type SnippetFileObservation struct {
+	Path   string `json:"path"`
+	RawURL string `json:"rawUrl,omitempty"`
+}
+
+// SnippetObservation represents the observed state of a Gitlab project
+// snippet.
+type SnippetObservation struct {
+	// ID of the snippet at gitlab
+	ID        int                      `json:"id,omitempty"`
+	WebURL    string                   `json:"webUrl,omitempty"`
+	CreatedAt *metav1.Time             `json:"createdAt,omitempty"`
+	UpdatedAt *metav1.Time             `json:"updatedAt,omitempty"`
+	Files     []SnippetFileObservation `json:"files,omitempty"`
+}
+
+// A SnippetSpec defines the desired state of a Gitlab project snippet.
+type SnippetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnippetParameters `json:"forProvider"`
+}
+
+// A SnippetStatus represents the observed state of a Gitlab project snippet.
+type SnippetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnippetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Snippet is a managed resource that represents a Gitlab project snippet,
+// for example to distribute a shared script to many projects.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TITLE",type="string",JSONPath=".spec.forProvider.title"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Snippet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnippetSpec   `json:"spec"`
+	Status SnippetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnippetList contains a list of Snippet items.
+type SnippetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snippet `json:"items"`
+}
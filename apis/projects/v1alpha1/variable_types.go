@@ -33,8 +33,26 @@ const (
 	VariableTypeFile   VariableType = "file"
 )
 
+// VariableInheritancePolicy defines how a project variable treats a group
+// variable with the same key it inherits.
+type VariableInheritancePolicy string
+
+// List of variable inheritance policies.
+const (
+	// VariableInheritancePolicyOverride manages the project variable even if
+	// it shadows an inherited group variable.
+	VariableInheritancePolicyOverride VariableInheritancePolicy = "Override"
+
+	// VariableInheritancePolicyInherit defers to an inherited group variable:
+	// the project variable is neither created nor updated while a group
+	// variable with the same key applies to its environment scope.
+	VariableInheritancePolicyInherit VariableInheritancePolicy = "Inherit"
+)
+
 // VariableParameters define the desired state of a Gitlab CI Variable
 // https://docs.gitlab.com/ee/api/project_level_variables.html
+// A variable belongs to either a project or a group.
+// +kubebuilder:validation:XValidation:rule="!(has(self.groupId) || has(self.groupIdRef) || has(self.groupIdSelector)) || !(has(self.projectId) || has(self.projectIdRef) || has(self.projectIdSelector) || has(self.projectPath))",message="a variable belongs to either a project or a group"
 type VariableParameters struct {
 	// ProjectID is the ID of the project to create the variable on.
 	// +optional
@@ -50,6 +68,28 @@ type VariableParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the path of the project including its namespace, e.g.
+	// group/project. It identifies the project if projectId is not set and
+	// not resolved from a reference, so projects not managed in this
+	// cluster can be used.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
+	// GroupID is the ID of the group of a group variable.
+	// +optional
+	// +immutable
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
 	// Key for the variable.
 	// +kubebuilder:validation:Pattern:=^[a-zA-Z0-9\_]+$
 	// +kubebuilder:validation:MaxLength:=255
@@ -87,6 +127,37 @@ type VariableParameters struct {
 	// that this variable is applied to.
 	// +optional
 	EnvironmentScope *string `json:"environmentScope,omitempty"`
+
+	// Scopes fans the variable out into one Gitlab variable per environment
+	// scope, all managed as a unit. Mutually exclusive with EnvironmentScope.
+	// +optional
+	// +listType=set
+	Scopes []string `json:"scopes,omitempty"`
+
+	// InheritancePolicy defines how the variable treats a variable with the
+	// same key inherited from an ancestor group. Defaults to Override. Not
+	// supported together with Scopes.
+	// +kubebuilder:validation:Enum:=Override;Inherit
+	// +optional
+	InheritancePolicy *VariableInheritancePolicy `json:"inheritancePolicy,omitempty"`
+
+	// CredentialsSecretRef references a secret holding the token to
+	// authenticate to Gitlab with instead of the token of the
+	// ProviderConfig.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// VariableObservation represents the observed state of a Gitlab Project CI
+// Variable.
+type VariableObservation struct {
+	// Scopes are the environment scopes the variable was last applied to
+	// when using Scopes.
+	Scopes []string `json:"scopes,omitempty"`
+
+	// InheritedFrom is the full path of the group the variable is inherited
+	// from when InheritancePolicy is Inherit.
+	InheritedFrom string `json:"inheritedFrom,omitempty"`
 }
 
 // A VariableSpec defines the desired state of a Gitlab Project CI
@@ -94,12 +165,18 @@ type VariableParameters struct {
 type VariableSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       VariableParameters `json:"forProvider"`
+
+	// Requires lists what must be met before the Variable is created or changed
+	// in Gitlab. Until then it waits instead of failing.
+	// +optional
+	Requires []Requirement `json:"requires,omitempty"`
 }
 
 // A VariableStatus represents the observed state of a Gitlab Project CI
 // Variable.
 type VariableStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VariableObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -126,3 +203,11 @@ type VariableList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Variable `json:"items"`
 }
+
+// GetSecretRefs of this Variable.
+func (mg *Variable) GetSecretRefs() []xpv1.SecretKeySelector {
+	if ref := mg.Spec.ForProvider.ValueSecretRef; ref != nil {
+		return []xpv1.SecretKeySelector{*ref}
+	}
+	return nil
+}
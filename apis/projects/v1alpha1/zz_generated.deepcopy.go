@@ -21,7 +21,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apisv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -92,6 +94,11 @@ func (in *AccessTokenObservation) DeepCopyInto(out *AccessTokenObservation) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.RevokePendingID != nil {
+		in, out := &in.RevokePendingID, &out.RevokePendingID
+		*out = new(int)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenObservation.
@@ -122,6 +129,21 @@ func (in *AccessTokenParameters) DeepCopyInto(out *AccessTokenParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExpiresAt != nil {
 		in, out := &in.ExpiresAt, &out.ExpiresAt
 		*out = (*in).DeepCopy()
@@ -136,6 +158,21 @@ func (in *AccessTokenParameters) DeepCopyInto(out *AccessTokenParameters) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RecreateOnDrift != nil {
+		in, out := &in.RecreateOnDrift, &out.RecreateOnDrift
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipRevokeOnDelete != nil {
+		in, out := &in.SkipRevokeOnDelete, &out.SkipRevokeOnDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenParameters.
@@ -149,125 +186,180 @@ func (in *AccessTokenParameters) DeepCopy() *AccessTokenParameters {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AccessTokenSpec) DeepCopyInto(out *AccessTokenSpec) {
+func (in *AccessTokenRotation) DeepCopyInto(out *AccessTokenRotation) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenSpec.
-func (in *AccessTokenSpec) DeepCopy() *AccessTokenSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenRotation.
+func (in *AccessTokenRotation) DeepCopy() *AccessTokenRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(AccessTokenSpec)
+	out := new(AccessTokenRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessTokenRotation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AccessTokenStatus) DeepCopyInto(out *AccessTokenStatus) {
+func (in *AccessTokenRotationList) DeepCopyInto(out *AccessTokenRotationList) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessTokenRotation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenStatus.
-func (in *AccessTokenStatus) DeepCopy() *AccessTokenStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenRotationList.
+func (in *AccessTokenRotationList) DeepCopy() *AccessTokenRotationList {
 	if in == nil {
 		return nil
 	}
-	out := new(AccessTokenStatus)
+	out := new(AccessTokenRotationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessTokenRotationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerExpirationPolicy) DeepCopyInto(out *ContainerExpirationPolicy) {
+func (in *AccessTokenRotationSpec) DeepCopyInto(out *AccessTokenRotationSpec) {
 	*out = *in
-	if in.NextRunAt != nil {
-		in, out := &in.NextRunAt, &out.NextRunAt
-		*out = (*in).DeepCopy()
+	if in.TokenSelector != nil {
+		in, out := &in.TokenSelector, &out.TokenSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxParallel != nil {
+		in, out := &in.MaxParallel, &out.MaxParallel
+		*out = new(int)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicy.
-func (in *ContainerExpirationPolicy) DeepCopy() *ContainerExpirationPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenRotationSpec.
+func (in *AccessTokenRotationSpec) DeepCopy() *AccessTokenRotationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerExpirationPolicy)
+	out := new(AccessTokenRotationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerExpirationPolicyAttributes) DeepCopyInto(out *ContainerExpirationPolicyAttributes) {
+func (in *AccessTokenRotationStatus) DeepCopyInto(out *AccessTokenRotationStatus) {
 	*out = *in
-	if in.Cadence != nil {
-		in, out := &in.Cadence, &out.Cadence
-		*out = new(string)
-		**out = **in
-	}
-	if in.KeepN != nil {
-		in, out := &in.KeepN, &out.KeepN
-		*out = new(int)
-		**out = **in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.LastStartedAt != nil {
+		in, out := &in.LastStartedAt, &out.LastStartedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.OlderThan != nil {
-		in, out := &in.OlderThan, &out.OlderThan
-		*out = new(string)
-		**out = **in
+	if in.Tokens != nil {
+		in, out := &in.Tokens, &out.Tokens
+		*out = make([]AccessTokenRotationTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.NameRegexDelete != nil {
-		in, out := &in.NameRegexDelete, &out.NameRegexDelete
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenRotationStatus.
+func (in *AccessTokenRotationStatus) DeepCopy() *AccessTokenRotationStatus {
+	if in == nil {
+		return nil
 	}
-	if in.NameRegexKeep != nil {
-		in, out := &in.NameRegexKeep, &out.NameRegexKeep
-		*out = new(string)
-		**out = **in
+	out := new(AccessTokenRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessTokenRotationTarget) DeepCopyInto(out *AccessTokenRotationTarget) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.NameRegex != nil {
-		in, out := &in.NameRegex, &out.NameRegex
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenRotationTarget.
+func (in *AccessTokenRotationTarget) DeepCopy() *AccessTokenRotationTarget {
+	if in == nil {
+		return nil
 	}
+	out := new(AccessTokenRotationTarget)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicyAttributes.
-func (in *ContainerExpirationPolicyAttributes) DeepCopy() *ContainerExpirationPolicyAttributes {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessTokenSpec) DeepCopyInto(out *AccessTokenSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenSpec.
+func (in *AccessTokenSpec) DeepCopy() *AccessTokenSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerExpirationPolicyAttributes)
+	out := new(AccessTokenSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
+func (in *AccessTokenStatus) DeepCopyInto(out *AccessTokenStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttribute.
-func (in *CustomAttribute) DeepCopy() *CustomAttribute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenStatus.
+func (in *AccessTokenStatus) DeepCopy() *AccessTokenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomAttribute)
+	out := new(AccessTokenStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKey) DeepCopyInto(out *DeployKey) {
+func (in *Approval) DeepCopyInto(out *Approval) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -275,18 +367,18 @@ func (in *DeployKey) DeepCopyInto(out *DeployKey) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKey.
-func (in *DeployKey) DeepCopy() *DeployKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKey)
+	out := new(Approval)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployKey) DeepCopyObject() runtime.Object {
+func (in *Approval) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -294,31 +386,31 @@ func (in *DeployKey) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyList) DeepCopyInto(out *DeployKeyList) {
+func (in *ApprovalList) DeepCopyInto(out *ApprovalList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DeployKey, len(*in))
+		*out = make([]Approval, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyList.
-func (in *DeployKeyList) DeepCopy() *DeployKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalList.
+func (in *ApprovalList) DeepCopy() *ApprovalList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyList)
+	out := new(ApprovalList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployKeyList) DeepCopyObject() runtime.Object {
+func (in *ApprovalList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -326,31 +418,27 @@ func (in *DeployKeyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyObservation) DeepCopyInto(out *DeployKeyObservation) {
+func (in *ApprovalObservation) DeepCopyInto(out *ApprovalObservation) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(int)
-		**out = **in
-	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
+	if in.UnmetConditions != nil {
+		in, out := &in.UnmetConditions, &out.UnmetConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyObservation.
-func (in *DeployKeyObservation) DeepCopy() *DeployKeyObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalObservation.
+func (in *ApprovalObservation) DeepCopy() *ApprovalObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyObservation)
+	out := new(ApprovalObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
+func (in *ApprovalParameters) DeepCopyInto(out *ApprovalParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
@@ -367,64 +455,105 @@ func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CanPush != nil {
-		in, out := &in.CanPush, &out.CanPush
-		*out = new(bool)
+	if in.RequiredLabels != nil {
+		in, out := &in.RequiredLabels, &out.RequiredLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthorUsername != nil {
+		in, out := &in.AuthorUsername, &out.AuthorUsername
+		*out = new(string)
 		**out = **in
 	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
 	}
-	out.KeySecretRef = in.KeySecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyParameters.
-func (in *DeployKeyParameters) DeepCopy() *DeployKeyParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalParameters.
+func (in *ApprovalParameters) DeepCopy() *ApprovalParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyParameters)
+	out := new(ApprovalParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeySpec) DeepCopyInto(out *DeployKeySpec) {
+func (in *ApprovalRule) DeepCopyInto(out *ApprovalRule) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.RuleType != nil {
+		in, out := &in.RuleType, &out.RuleType
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupIDs != nil {
+		in, out := &in.GroupIDs, &out.GroupIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProtectedBranchIDs != nil {
+		in, out := &in.ProtectedBranchIDs, &out.ProtectedBranchIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.AppliesToAllProtectedBranches != nil {
+		in, out := &in.AppliesToAllProtectedBranches, &out.AppliesToAllProtectedBranches
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeySpec.
-func (in *DeployKeySpec) DeepCopy() *DeployKeySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRule.
+func (in *ApprovalRule) DeepCopy() *ApprovalRule {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeySpec)
+	out := new(ApprovalRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyStatus) DeepCopyInto(out *DeployKeyStatus) {
+func (in *ApprovalRuleObservation) DeepCopyInto(out *ApprovalRuleObservation) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupIDs != nil {
+		in, out := &in.GroupIDs, &out.GroupIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProtectedBranchIDs != nil {
+		in, out := &in.ProtectedBranchIDs, &out.ProtectedBranchIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyStatus.
-func (in *DeployKeyStatus) DeepCopy() *DeployKeyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleObservation.
+func (in *ApprovalRuleObservation) DeepCopy() *ApprovalRuleObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyStatus)
+	out := new(ApprovalRuleObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployToken) DeepCopyInto(out *DeployToken) {
+func (in *ApprovalRuleSet) DeepCopyInto(out *ApprovalRuleSet) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -432,18 +561,18 @@ func (in *DeployToken) DeepCopyInto(out *DeployToken) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployToken.
-func (in *DeployToken) DeepCopy() *DeployToken {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleSet.
+func (in *ApprovalRuleSet) DeepCopy() *ApprovalRuleSet {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployToken)
+	out := new(ApprovalRuleSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployToken) DeepCopyObject() runtime.Object {
+func (in *ApprovalRuleSet) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -451,31 +580,31 @@ func (in *DeployToken) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenList) DeepCopyInto(out *DeployTokenList) {
+func (in *ApprovalRuleSetList) DeepCopyInto(out *ApprovalRuleSetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DeployToken, len(*in))
+		*out = make([]ApprovalRuleSet, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenList.
-func (in *DeployTokenList) DeepCopy() *DeployTokenList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleSetList.
+func (in *ApprovalRuleSetList) DeepCopy() *ApprovalRuleSetList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenList)
+	out := new(ApprovalRuleSetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployTokenList) DeepCopyObject() runtime.Object {
+func (in *ApprovalRuleSetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -483,26 +612,33 @@ func (in *DeployTokenList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenObservation) DeepCopyInto(out *DeployTokenObservation) {
+func (in *ApprovalRuleSetObservation) DeepCopyInto(out *ApprovalRuleSetObservation) {
 	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ApprovalRuleObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenObservation.
-func (in *DeployTokenObservation) DeepCopy() *DeployTokenObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleSetObservation.
+func (in *ApprovalRuleSetObservation) DeepCopy() *ApprovalRuleSetObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenObservation)
+	out := new(ApprovalRuleSetObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
+func (in *ApprovalRuleSetParameters) DeepCopyInto(out *ApprovalRuleSetParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(int)
+		*out = new(string)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -515,98 +651,71 @@ func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ApprovalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Username != nil {
-		in, out := &in.Username, &out.Username
-		*out = new(string)
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
-	if in.Scopes != nil {
-		in, out := &in.Scopes, &out.Scopes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenParameters.
-func (in *DeployTokenParameters) DeepCopy() *DeployTokenParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleSetParameters.
+func (in *ApprovalRuleSetParameters) DeepCopy() *ApprovalRuleSetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenParameters)
+	out := new(ApprovalRuleSetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenSpec) DeepCopyInto(out *DeployTokenSpec) {
+func (in *ApprovalRuleSetSpec) DeepCopyInto(out *ApprovalRuleSetSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenSpec.
-func (in *DeployTokenSpec) DeepCopy() *DeployTokenSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleSetSpec.
+func (in *ApprovalRuleSetSpec) DeepCopy() *ApprovalRuleSetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenSpec)
+	out := new(ApprovalRuleSetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenStatus) DeepCopyInto(out *DeployTokenStatus) {
+func (in *ApprovalRuleSetStatus) DeepCopyInto(out *ApprovalRuleSetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenStatus.
-func (in *DeployTokenStatus) DeepCopy() *DeployTokenStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(DeployTokenStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ForkParent) DeepCopyInto(out *ForkParent) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkParent.
-func (in *ForkParent) DeepCopy() *ForkParent {
-	if in == nil {
-		return nil
-	}
-	out := new(ForkParent)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupAccess) DeepCopyInto(out *GroupAccess) {
-	*out = *in
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAccess.
-func (in *GroupAccess) DeepCopy() *GroupAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleSetStatus.
+func (in *ApprovalRuleSetStatus) DeepCopy() *ApprovalRuleSetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupAccess)
+	out := new(ApprovalRuleSetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Hook) DeepCopyInto(out *Hook) {
+func (in *ApprovalSettings) DeepCopyInto(out *ApprovalSettings) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -614,18 +723,18 @@ func (in *Hook) DeepCopyInto(out *Hook) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
-func (in *Hook) DeepCopy() *Hook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettings.
+func (in *ApprovalSettings) DeepCopy() *ApprovalSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(Hook)
+	out := new(ApprovalSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Hook) DeepCopyObject() runtime.Object {
+func (in *ApprovalSettings) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -633,31 +742,31 @@ func (in *Hook) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookList) DeepCopyInto(out *HookList) {
+func (in *ApprovalSettingsList) DeepCopyInto(out *ApprovalSettingsList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Hook, len(*in))
+		*out = make([]ApprovalSettings, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookList.
-func (in *HookList) DeepCopy() *HookList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsList.
+func (in *ApprovalSettingsList) DeepCopy() *ApprovalSettingsList {
 	if in == nil {
 		return nil
 	}
-	out := new(HookList)
+	out := new(ApprovalSettingsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HookList) DeepCopyObject() runtime.Object {
+func (in *ApprovalSettingsList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -665,40 +774,26 @@ func (in *HookList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookObservation) DeepCopyInto(out *HookObservation) {
+func (in *ApprovalSettingsObservation) DeepCopyInto(out *ApprovalSettingsObservation) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookObservation.
-func (in *HookObservation) DeepCopy() *HookObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsObservation.
+func (in *ApprovalSettingsObservation) DeepCopy() *ApprovalSettingsObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(HookObservation)
+	out := new(ApprovalSettingsObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookParameters) DeepCopyInto(out *HookParameters) {
+func (in *ApprovalSettingsParameters) DeepCopyInto(out *ApprovalSettingsParameters) {
 	*out = *in
-	if in.URL != nil {
-		in, out := &in.URL, &out.URL
-		*out = new(string)
-		**out = **in
-	}
-	if in.ConfidentialNoteEvents != nil {
-		in, out := &in.ConfidentialNoteEvents, &out.ConfidentialNoteEvents
-		*out = new(bool)
-		**out = **in
-	}
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(int)
+		*out = new(string)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -711,144 +806,118 @@ func (in *HookParameters) DeepCopyInto(out *HookParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PushEvents != nil {
-		in, out := &in.PushEvents, &out.PushEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PushEventsBranchFilter != nil {
-		in, out := &in.PushEventsBranchFilter, &out.PushEventsBranchFilter
-		*out = new(string)
-		**out = **in
-	}
-	if in.IssuesEvents != nil {
-		in, out := &in.IssuesEvents, &out.IssuesEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ConfidentialIssuesEvents != nil {
-		in, out := &in.ConfidentialIssuesEvents, &out.ConfidentialIssuesEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MergeRequestsEvents != nil {
-		in, out := &in.MergeRequestsEvents, &out.MergeRequestsEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TagPushEvents != nil {
-		in, out := &in.TagPushEvents, &out.TagPushEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NoteEvents != nil {
-		in, out := &in.NoteEvents, &out.NoteEvents
+	if in.ResetApprovalsOnPush != nil {
+		in, out := &in.ResetApprovalsOnPush, &out.ResetApprovalsOnPush
 		*out = new(bool)
 		**out = **in
 	}
-	if in.JobEvents != nil {
-		in, out := &in.JobEvents, &out.JobEvents
+	if in.DisableOverridingApproversPerMergeRequest != nil {
+		in, out := &in.DisableOverridingApproversPerMergeRequest, &out.DisableOverridingApproversPerMergeRequest
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PipelineEvents != nil {
-		in, out := &in.PipelineEvents, &out.PipelineEvents
+	if in.MergeRequestsAuthorApproval != nil {
+		in, out := &in.MergeRequestsAuthorApproval, &out.MergeRequestsAuthorApproval
 		*out = new(bool)
 		**out = **in
 	}
-	if in.WikiPageEvents != nil {
-		in, out := &in.WikiPageEvents, &out.WikiPageEvents
+	if in.RequirePasswordToApprove != nil {
+		in, out := &in.RequirePasswordToApprove, &out.RequirePasswordToApprove
 		*out = new(bool)
 		**out = **in
 	}
-	if in.EnableSSLVerification != nil {
-		in, out := &in.EnableSSLVerification, &out.EnableSSLVerification
+	if in.SelectiveCodeOwnerRemovals != nil {
+		in, out := &in.SelectiveCodeOwnerRemovals, &out.SelectiveCodeOwnerRemovals
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Token != nil {
-		in, out := &in.Token, &out.Token
-		*out = new(string)
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookParameters.
-func (in *HookParameters) DeepCopy() *HookParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsParameters.
+func (in *ApprovalSettingsParameters) DeepCopy() *ApprovalSettingsParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(HookParameters)
+	out := new(ApprovalSettingsParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookSpec) DeepCopyInto(out *HookSpec) {
+func (in *ApprovalSettingsSpec) DeepCopyInto(out *ApprovalSettingsSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookSpec.
-func (in *HookSpec) DeepCopy() *HookSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsSpec.
+func (in *ApprovalSettingsSpec) DeepCopy() *ApprovalSettingsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HookSpec)
+	out := new(ApprovalSettingsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+func (in *ApprovalSettingsStatus) DeepCopyInto(out *ApprovalSettingsStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
-func (in *HookStatus) DeepCopy() *HookStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsStatus.
+func (in *ApprovalSettingsStatus) DeepCopy() *ApprovalSettingsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HookStatus)
+	out := new(ApprovalSettingsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LastPipeline) DeepCopyInto(out *LastPipeline) {
+func (in *ApprovalSpec) DeepCopyInto(out *ApprovalSpec) {
 	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastPipeline.
-func (in *LastPipeline) DeepCopy() *LastPipeline {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSpec.
+func (in *ApprovalSpec) DeepCopy() *ApprovalSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LastPipeline)
+	out := new(ApprovalSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Links) DeepCopyInto(out *Links) {
+func (in *ApprovalStatus) DeepCopyInto(out *ApprovalStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
-func (in *Links) DeepCopy() *Links {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalStatus.
+func (in *ApprovalStatus) DeepCopy() *ApprovalStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Links)
+	out := new(ApprovalStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Member) DeepCopyInto(out *Member) {
+func (in *Badge) DeepCopyInto(out *Badge) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -856,18 +925,18 @@ func (in *Member) DeepCopyInto(out *Member) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
-func (in *Member) DeepCopy() *Member {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Badge.
+func (in *Badge) DeepCopy() *Badge {
 	if in == nil {
 		return nil
 	}
-	out := new(Member)
+	out := new(Badge)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Member) DeepCopyObject() runtime.Object {
+func (in *Badge) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -875,31 +944,31 @@ func (in *Member) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberList) DeepCopyInto(out *MemberList) {
+func (in *BadgeList) DeepCopyInto(out *BadgeList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Member, len(*in))
+		*out = make([]Badge, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
-func (in *MemberList) DeepCopy() *MemberList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BadgeList.
+func (in *BadgeList) DeepCopy() *BadgeList {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberList)
+	out := new(BadgeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MemberList) DeepCopyObject() runtime.Object {
+func (in *BadgeList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -907,30 +976,26 @@ func (in *MemberList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+func (in *BadgeObservation) DeepCopyInto(out *BadgeObservation) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
-func (in *MemberObservation) DeepCopy() *MemberObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BadgeObservation.
+func (in *BadgeObservation) DeepCopy() *BadgeObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberObservation)
+	out := new(BadgeObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+func (in *BadgeParameters) DeepCopyInto(out *BadgeParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(int)
+		*out = new(string)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -943,565 +1008,5676 @@ func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int)
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
 		**out = **in
 	}
-	if in.UserName != nil {
-		in, out := &in.UserName, &out.UserName
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = new(string)
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
-func (in *MemberParameters) DeepCopy() *MemberParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BadgeParameters.
+func (in *BadgeParameters) DeepCopy() *BadgeParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberParameters)
+	out := new(BadgeParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+func (in *BadgeSpec) DeepCopyInto(out *BadgeSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
-func (in *MemberSpec) DeepCopy() *MemberSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BadgeSpec.
+func (in *BadgeSpec) DeepCopy() *BadgeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberSpec)
+	out := new(BadgeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+func (in *BadgeStatus) DeepCopyInto(out *BadgeStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
-func (in *MemberStatus) DeepCopy() *MemberStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BadgeStatus.
+func (in *BadgeStatus) DeepCopy() *BadgeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberStatus)
+	out := new(BadgeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Permissions) DeepCopyInto(out *Permissions) {
+func (in *BlueprintBadge) DeepCopyInto(out *BlueprintBadge) {
 	*out = *in
-	if in.ProjectAccess != nil {
-		in, out := &in.ProjectAccess, &out.ProjectAccess
-		*out = new(ProjectAccess)
-		**out = **in
-	}
-	if in.GroupAccess != nil {
-		in, out := &in.GroupAccess, &out.GroupAccess
-		*out = new(GroupAccess)
-		**out = **in
-	}
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permissions.
-func (in *Permissions) DeepCopy() *Permissions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueprintBadge.
+func (in *BlueprintBadge) DeepCopy() *BlueprintBadge {
 	if in == nil {
 		return nil
 	}
-	out := new(Permissions)
+	out := new(BlueprintBadge)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
+func (in *BlueprintHook) DeepCopyInto(out *BlueprintHook) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSchedule.
-func (in *PipelineSchedule) DeepCopy() *PipelineSchedule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueprintHook.
+func (in *BlueprintHook) DeepCopy() *BlueprintHook {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineSchedule)
+	out := new(BlueprintHook)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueprintResource) DeepCopyInto(out *BlueprintResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueprintResource.
+func (in *BlueprintResource) DeepCopy() *BlueprintResource {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(BlueprintResource)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleList) DeepCopyInto(out *PipelineScheduleList) {
+func (in *BlueprintVariable) DeepCopyInto(out *BlueprintVariable) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PipelineSchedule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleList.
-func (in *PipelineScheduleList) DeepCopy() *PipelineScheduleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueprintVariable.
+func (in *BlueprintVariable) DeepCopy() *BlueprintVariable {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleList)
+	out := new(BlueprintVariable)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleObservation) DeepCopyInto(out *PipelineScheduleObservation) {
+func (in *BranchProtection) DeepCopyInto(out *BranchProtection) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(int)
+	if in.PushAccessLevel != nil {
+		in, out := &in.PushAccessLevel, &out.PushAccessLevel
+		*out = new(AccessLevelValue)
 		**out = **in
 	}
-	if in.NextRunAt != nil {
-		in, out := &in.NextRunAt, &out.NextRunAt
-		*out = (*in).DeepCopy()
-	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
+	if in.MergeAccessLevel != nil {
+		in, out := &in.MergeAccessLevel, &out.MergeAccessLevel
+		*out = new(AccessLevelValue)
+		**out = **in
 	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
-		*out = (*in).DeepCopy()
+	if in.UnprotectAccessLevel != nil {
+		in, out := &in.UnprotectAccessLevel, &out.UnprotectAccessLevel
+		*out = new(AccessLevelValue)
+		**out = **in
 	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
-		*out = new(User)
-		(*in).DeepCopyInto(*out)
+	if in.AllowForcePush != nil {
+		in, out := &in.AllowForcePush, &out.AllowForcePush
+		*out = new(bool)
+		**out = **in
 	}
-	if in.LastPipeline != nil {
-		in, out := &in.LastPipeline, &out.LastPipeline
-		*out = new(LastPipeline)
+	if in.CodeOwnerApprovalRequired != nil {
+		in, out := &in.CodeOwnerApprovalRequired, &out.CodeOwnerApprovalRequired
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleObservation.
-func (in *PipelineScheduleObservation) DeepCopy() *PipelineScheduleObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtection.
+func (in *BranchProtection) DeepCopy() *BranchProtection {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleObservation)
+	out := new(BranchProtection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParameters) {
+func (in *BranchProtectionObservation) DeepCopyInto(out *BranchProtectionObservation) {
 	*out = *in
-	if in.ProjectID != nil {
-		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ProjectIDRef != nil {
-		in, out := &in.ProjectIDRef, &out.ProjectIDRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ProjectIDSelector != nil {
-		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CronTimezone != nil {
-		in, out := &in.CronTimezone, &out.CronTimezone
-		*out = new(string)
-		**out = **in
+	if in.PushAccessLevels != nil {
+		in, out := &in.PushAccessLevels, &out.PushAccessLevels
+		*out = make([]AccessLevelValue, len(*in))
+		copy(*out, *in)
 	}
-	if in.Active != nil {
-		in, out := &in.Active, &out.Active
-		*out = new(bool)
-		**out = **in
+	if in.MergeAccessLevels != nil {
+		in, out := &in.MergeAccessLevels, &out.MergeAccessLevels
+		*out = make([]AccessLevelValue, len(*in))
+		copy(*out, *in)
 	}
-	if in.Variables != nil {
-		in, out := &in.Variables, &out.Variables
-		*out = make([]PipelineVariable, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.UnprotectAccessLevels != nil {
+		in, out := &in.UnprotectAccessLevels, &out.UnprotectAccessLevels
+		*out = make([]AccessLevelValue, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleParameters.
-func (in *PipelineScheduleParameters) DeepCopy() *PipelineScheduleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionObservation.
+func (in *BranchProtectionObservation) DeepCopy() *BranchProtectionObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleParameters)
+	out := new(BranchProtectionObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleSpec) DeepCopyInto(out *PipelineScheduleSpec) {
+func (in *ClusterAgent) DeepCopyInto(out *ClusterAgent) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleSpec.
-func (in *PipelineScheduleSpec) DeepCopy() *PipelineScheduleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgent.
+func (in *ClusterAgent) DeepCopy() *ClusterAgent {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleSpec)
+	out := new(ClusterAgent)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAgent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleStatus) DeepCopyInto(out *PipelineScheduleStatus) {
+func (in *ClusterAgentList) DeepCopyInto(out *ClusterAgentList) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAgent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleStatus.
-func (in *PipelineScheduleStatus) DeepCopy() *PipelineScheduleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentList.
+func (in *ClusterAgentList) DeepCopy() *ClusterAgentList {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleStatus)
+	out := new(ClusterAgentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAgentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineVariable) DeepCopyInto(out *PipelineVariable) {
+func (in *ClusterAgentObservation) DeepCopyInto(out *ClusterAgentObservation) {
 	*out = *in
-	if in.VariableType != nil {
-		in, out := &in.VariableType, &out.VariableType
-		*out = new(string)
-		**out = **in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineVariable.
-func (in *PipelineVariable) DeepCopy() *PipelineVariable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentObservation.
+func (in *ClusterAgentObservation) DeepCopy() *ClusterAgentObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineVariable)
+	out := new(ClusterAgentObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *ClusterAgentParameters) DeepCopyInto(out *ClusterAgentParameters) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentParameters.
+func (in *ClusterAgentParameters) DeepCopy() *ClusterAgentParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(ClusterAgentParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAgentSpec) DeepCopyInto(out *ClusterAgentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentSpec.
+func (in *ClusterAgentSpec) DeepCopy() *ClusterAgentSpec {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ClusterAgentSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectAccess) DeepCopyInto(out *ProjectAccess) {
+func (in *ClusterAgentStatus) DeepCopyInto(out *ClusterAgentStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccess.
-func (in *ProjectAccess) DeepCopy() *ProjectAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentStatus.
+func (in *ClusterAgentStatus) DeepCopy() *ClusterAgentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectAccess)
+	out := new(ClusterAgentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectLicense) DeepCopyInto(out *ProjectLicense) {
+func (in *CodeOwnersEntry) DeepCopyInto(out *CodeOwnersEntry) {
 	*out = *in
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLicense.
-func (in *ProjectLicense) DeepCopy() *ProjectLicense {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CodeOwnersEntry.
+func (in *CodeOwnersEntry) DeepCopy() *CodeOwnersEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectLicense)
+	out := new(CodeOwnersEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *CodeOwnersSection) DeepCopyInto(out *CodeOwnersSection) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
+	if in.Optional != nil {
+		in, out := &in.Optional, &out.Optional
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ApprovalsRequired != nil {
+		in, out := &in.ApprovalsRequired, &out.ApprovalsRequired
+		*out = new(int)
+		**out = **in
+	}
+	if in.DefaultOwners != nil {
+		in, out := &in.DefaultOwners, &out.DefaultOwners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]CodeOwnersEntry, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CodeOwnersSection.
+func (in *CodeOwnersSection) DeepCopy() *CodeOwnersSection {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(CodeOwnersSection)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectNamespace) DeepCopyInto(out *ProjectNamespace) {
+func (in *ContainerExpirationPolicy) DeepCopyInto(out *ContainerExpirationPolicy) {
 	*out = *in
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespace.
-func (in *ProjectNamespace) DeepCopy() *ProjectNamespace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicy.
+func (in *ContainerExpirationPolicy) DeepCopy() *ContainerExpirationPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectNamespace)
+	out := new(ContainerExpirationPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectObservation) DeepCopyInto(out *ProjectObservation) {
+func (in *ContainerExpirationPolicyAttributes) DeepCopyInto(out *ContainerExpirationPolicyAttributes) {
 	*out = *in
-	if in.ComplianceFrameworks != nil {
-		in, out := &in.ComplianceFrameworks, &out.ComplianceFrameworks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ContainerExpirationPolicy != nil {
-		in, out := &in.ContainerExpirationPolicy, &out.ContainerExpirationPolicy
-		*out = new(ContainerExpirationPolicy)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.CustomAttributes != nil {
-		in, out := &in.CustomAttributes, &out.CustomAttributes
-		*out = make([]CustomAttribute, len(*in))
-		copy(*out, *in)
-	}
-	if in.ForkedFromProject != nil {
-		in, out := &in.ForkedFromProject, &out.ForkedFromProject
-		*out = new(ForkParent)
+	if in.Cadence != nil {
+		in, out := &in.Cadence, &out.Cadence
+		*out = new(string)
 		**out = **in
 	}
-	if in.LastActivityAt != nil {
-		in, out := &in.LastActivityAt, &out.LastActivityAt
-		*out = (*in).DeepCopy()
-	}
-	if in.License != nil {
-		in, out := &in.License, &out.License
-		*out = new(ProjectLicense)
+	if in.KeepN != nil {
+		in, out := &in.KeepN, &out.KeepN
+		*out = new(int)
 		**out = **in
 	}
-	if in.Links != nil {
-		in, out := &in.Links, &out.Links
-		*out = new(Links)
+	if in.OlderThan != nil {
+		in, out := &in.OlderThan, &out.OlderThan
+		*out = new(string)
 		**out = **in
 	}
-	if in.MarkedForDeletionAt != nil {
+	if in.NameRegexDelete != nil {
+		in, out := &in.NameRegexDelete, &out.NameRegexDelete
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameRegexKeep != nil {
+		in, out := &in.NameRegexKeep, &out.NameRegexKeep
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NameRegex != nil {
+		in, out := &in.NameRegex, &out.NameRegex
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicyAttributes.
+func (in *ContainerExpirationPolicyAttributes) DeepCopy() *ContainerExpirationPolicyAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerExpirationPolicyAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttribute.
+func (in *CustomAttribute) DeepCopy() *CustomAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultReviewers) DeepCopyInto(out *DefaultReviewers) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultReviewers.
+func (in *DefaultReviewers) DeepCopy() *DefaultReviewers {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultReviewers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultReviewers) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultReviewersList) DeepCopyInto(out *DefaultReviewersList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DefaultReviewers, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultReviewersList.
+func (in *DefaultReviewersList) DeepCopy() *DefaultReviewersList {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultReviewersList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultReviewersList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultReviewersObservation) DeepCopyInto(out *DefaultReviewersObservation) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ApprovalRuleObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultReviewersObservation.
+func (in *DefaultReviewersObservation) DeepCopy() *DefaultReviewersObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultReviewersObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultReviewersParameters) DeepCopyInto(out *DefaultReviewersParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Branch != nil {
+		in, out := &in.Branch, &out.Branch
+		*out = new(string)
+		**out = **in
+	}
+	if in.FilePath != nil {
+		in, out := &in.FilePath, &out.FilePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommitMessage != nil {
+		in, out := &in.CommitMessage, &out.CommitMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sections != nil {
+		in, out := &in.Sections, &out.Sections
+		*out = make([]CodeOwnersSection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ApprovalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultReviewersParameters.
+func (in *DefaultReviewersParameters) DeepCopy() *DefaultReviewersParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultReviewersParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultReviewersSpec) DeepCopyInto(out *DefaultReviewersSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultReviewersSpec.
+func (in *DefaultReviewersSpec) DeepCopy() *DefaultReviewersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultReviewersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultReviewersStatus) DeepCopyInto(out *DefaultReviewersStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultReviewersStatus.
+func (in *DefaultReviewersStatus) DeepCopy() *DefaultReviewersStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultReviewersStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKey) DeepCopyInto(out *DeployKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKey.
+func (in *DeployKey) DeepCopy() *DeployKey {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyEnablement) DeepCopyInto(out *DeployKeyEnablement) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyEnablement.
+func (in *DeployKeyEnablement) DeepCopy() *DeployKeyEnablement {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyEnablement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployKeyEnablement) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyEnablementList) DeepCopyInto(out *DeployKeyEnablementList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeployKeyEnablement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyEnablementList.
+func (in *DeployKeyEnablementList) DeepCopy() *DeployKeyEnablementList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyEnablementList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployKeyEnablementList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyEnablementObservation) DeepCopyInto(out *DeployKeyEnablementObservation) {
+	*out = *in
+	if in.ProjectIDs != nil {
+		in, out := &in.ProjectIDs, &out.ProjectIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyEnablementObservation.
+func (in *DeployKeyEnablementObservation) DeepCopy() *DeployKeyEnablementObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyEnablementObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyEnablementParameters) DeepCopyInto(out *DeployKeyEnablementParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IncludeSubgroups != nil {
+		in, out := &in.IncludeSubgroups, &out.IncludeSubgroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyEnablementParameters.
+func (in *DeployKeyEnablementParameters) DeepCopy() *DeployKeyEnablementParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyEnablementParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyEnablementSpec) DeepCopyInto(out *DeployKeyEnablementSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyEnablementSpec.
+func (in *DeployKeyEnablementSpec) DeepCopy() *DeployKeyEnablementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyEnablementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyEnablementStatus) DeepCopyInto(out *DeployKeyEnablementStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyEnablementStatus.
+func (in *DeployKeyEnablementStatus) DeepCopy() *DeployKeyEnablementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyEnablementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyList) DeepCopyInto(out *DeployKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeployKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyList.
+func (in *DeployKeyList) DeepCopy() *DeployKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyObservation) DeepCopyInto(out *DeployKeyObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyObservation.
+func (in *DeployKeyObservation) DeepCopy() *DeployKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CanPush != nil {
+		in, out := &in.CanPush, &out.CanPush
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	out.KeySecretRef = in.KeySecretRef
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyParameters.
+func (in *DeployKeyParameters) DeepCopy() *DeployKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeySpec) DeepCopyInto(out *DeployKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeySpec.
+func (in *DeployKeySpec) DeepCopy() *DeployKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKeyStatus) DeepCopyInto(out *DeployKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyStatus.
+func (in *DeployKeyStatus) DeepCopy() *DeployKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployToken) DeepCopyInto(out *DeployToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployToken.
+func (in *DeployToken) DeepCopy() *DeployToken {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployTokenList) DeepCopyInto(out *DeployTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeployToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenList.
+func (in *DeployTokenList) DeepCopy() *DeployTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployTokenObservation) DeepCopyInto(out *DeployTokenObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenObservation.
+func (in *DeployTokenObservation) DeepCopy() *DeployTokenObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployTokenObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectPath != nil {
+		in, out := &in.ProjectPath, &out.ProjectPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SkipRevokeOnDelete != nil {
+		in, out := &in.SkipRevokeOnDelete, &out.SkipRevokeOnDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenParameters.
+func (in *DeployTokenParameters) DeepCopy() *DeployTokenParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployTokenParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployTokenSpec) DeepCopyInto(out *DeployTokenSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenSpec.
+func (in *DeployTokenSpec) DeepCopy() *DeployTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployTokenStatus) DeepCopyInto(out *DeployTokenStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenStatus.
+func (in *DeployTokenStatus) DeepCopy() *DeployTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalStatusCheck) DeepCopyInto(out *ExternalStatusCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStatusCheck.
+func (in *ExternalStatusCheck) DeepCopy() *ExternalStatusCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalStatusCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalStatusCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalStatusCheckList) DeepCopyInto(out *ExternalStatusCheckList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalStatusCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStatusCheckList.
+func (in *ExternalStatusCheckList) DeepCopy() *ExternalStatusCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalStatusCheckList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalStatusCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalStatusCheckObservation) DeepCopyInto(out *ExternalStatusCheckObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStatusCheckObservation.
+func (in *ExternalStatusCheckObservation) DeepCopy() *ExternalStatusCheckObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalStatusCheckObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalStatusCheckParameters) DeepCopyInto(out *ExternalStatusCheckParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtectedBranches != nil {
+		in, out := &in.ProtectedBranches, &out.ProtectedBranches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SharedSecretSecretRef != nil {
+		in, out := &in.SharedSecretSecretRef, &out.SharedSecretSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStatusCheckParameters.
+func (in *ExternalStatusCheckParameters) DeepCopy() *ExternalStatusCheckParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalStatusCheckParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalStatusCheckSpec) DeepCopyInto(out *ExternalStatusCheckSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStatusCheckSpec.
+func (in *ExternalStatusCheckSpec) DeepCopy() *ExternalStatusCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalStatusCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalStatusCheckStatus) DeepCopyInto(out *ExternalStatusCheckStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStatusCheckStatus.
+func (in *ExternalStatusCheckStatus) DeepCopy() *ExternalStatusCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalStatusCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForkParent) DeepCopyInto(out *ForkParent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkParent.
+func (in *ForkParent) DeepCopy() *ForkParent {
+	if in == nil {
+		return nil
+	}
+	out := new(ForkParent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForkRelationship) DeepCopyInto(out *ForkRelationship) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkRelationship.
+func (in *ForkRelationship) DeepCopy() *ForkRelationship {
+	if in == nil {
+		return nil
+	}
+	out := new(ForkRelationship)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ForkRelationship) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForkRelationshipList) DeepCopyInto(out *ForkRelationshipList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ForkRelationship, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkRelationshipList.
+func (in *ForkRelationshipList) DeepCopy() *ForkRelationshipList {
+	if in == nil {
+		return nil
+	}
+	out := new(ForkRelationshipList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ForkRelationshipList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForkRelationshipObservation) DeepCopyInto(out *ForkRelationshipObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkRelationshipObservation.
+func (in *ForkRelationshipObservation) DeepCopy() *ForkRelationshipObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ForkRelationshipObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForkRelationshipParameters) DeepCopyInto(out *ForkRelationshipParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForkedFromProjectID != nil {
+		in, out := &in.ForkedFromProjectID, &out.ForkedFromProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForkedFromProjectIDRef != nil {
+		in, out := &in.ForkedFromProjectIDRef, &out.ForkedFromProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForkedFromProjectIDSelector != nil {
+		in, out := &in.ForkedFromProjectIDSelector, &out.ForkedFromProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkRelationshipParameters.
+func (in *ForkRelationshipParameters) DeepCopy() *ForkRelationshipParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ForkRelationshipParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForkRelationshipSpec) DeepCopyInto(out *ForkRelationshipSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkRelationshipSpec.
+func (in *ForkRelationshipSpec) DeepCopy() *ForkRelationshipSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ForkRelationshipSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForkRelationshipStatus) DeepCopyInto(out *ForkRelationshipStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkRelationshipStatus.
+func (in *ForkRelationshipStatus) DeepCopy() *ForkRelationshipStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ForkRelationshipStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezePeriod) DeepCopyInto(out *FreezePeriod) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezePeriod.
+func (in *FreezePeriod) DeepCopy() *FreezePeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezePeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FreezePeriod) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezePeriodList) DeepCopyInto(out *FreezePeriodList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FreezePeriod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezePeriodList.
+func (in *FreezePeriodList) DeepCopy() *FreezePeriodList {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezePeriodList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FreezePeriodList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezePeriodObservation) DeepCopyInto(out *FreezePeriodObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezePeriodObservation.
+func (in *FreezePeriodObservation) DeepCopy() *FreezePeriodObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezePeriodObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezePeriodParameters) DeepCopyInto(out *FreezePeriodParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CronTimezone != nil {
+		in, out := &in.CronTimezone, &out.CronTimezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezePeriodParameters.
+func (in *FreezePeriodParameters) DeepCopy() *FreezePeriodParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezePeriodParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezePeriodSpec) DeepCopyInto(out *FreezePeriodSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezePeriodSpec.
+func (in *FreezePeriodSpec) DeepCopy() *FreezePeriodSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezePeriodSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezePeriodStatus) DeepCopyInto(out *FreezePeriodStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezePeriodStatus.
+func (in *FreezePeriodStatus) DeepCopy() *FreezePeriodStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezePeriodStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabImport) DeepCopyInto(out *GitLabImport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabImport.
+func (in *GitLabImport) DeepCopy() *GitLabImport {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitLabImport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabImportList) DeepCopyInto(out *GitLabImportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GitLabImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabImportList.
+func (in *GitLabImportList) DeepCopy() *GitLabImportList {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabImportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitLabImportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabImportSpec) DeepCopyInto(out *GitLabImportSpec) {
+	*out = *in
+	if in.ProviderConfigReference != nil {
+		in, out := &in.ProviderConfigReference, &out.ProviderConfigReference
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IncludeSubgroups != nil {
+		in, out := &in.IncludeSubgroups, &out.IncludeSubgroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]ImportedKind, len(*in))
+		copy(*out, *in)
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.BatchInterval != nil {
+		in, out := &in.BatchInterval, &out.BatchInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabImportSpec.
+func (in *GitLabImportSpec) DeepCopy() *GitLabImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabImportStatus) DeepCopyInto(out *GitLabImportStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabImportStatus.
+func (in *GitLabImportStatus) DeepCopy() *GitLabImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupAccess) DeepCopyInto(out *GroupAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAccess.
+func (in *GroupAccess) DeepCopy() *GroupAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPolicy) DeepCopyInto(out *GroupPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicy.
+func (in *GroupPolicy) DeepCopy() *GroupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPolicyList) DeepCopyInto(out *GroupPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyList.
+func (in *GroupPolicyList) DeepCopy() *GroupPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPolicySpec) DeepCopyInto(out *GroupPolicySpec) {
+	*out = *in
+	if in.ProviderConfigReference != nil {
+		in, out := &in.ProviderConfigReference, &out.ProviderConfigReference
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IncludeSubgroups != nil {
+		in, out := &in.IncludeSubgroups, &out.IncludeSubgroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Protections != nil {
+		in, out := &in.Protections, &out.Protections
+		*out = make([]BranchProtection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApprovalRules != nil {
+		in, out := &in.ApprovalRules, &out.ApprovalRules
+		*out = make([]ApprovalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApprovalSettings != nil {
+		in, out := &in.ApprovalSettings, &out.ApprovalSettings
+		*out = new(PolicyApprovalSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicySpec.
+func (in *GroupPolicySpec) DeepCopy() *GroupPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupPolicyStatus) DeepCopyInto(out *GroupPolicyStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]BlueprintResource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyStatus.
+func (in *GroupPolicyStatus) DeepCopy() *GroupPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hook) DeepCopyInto(out *Hook) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
+func (in *Hook) DeepCopy() *Hook {
+	if in == nil {
+		return nil
+	}
+	out := new(Hook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Hook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookList) DeepCopyInto(out *HookList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Hook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookList.
+func (in *HookList) DeepCopy() *HookList {
+	if in == nil {
+		return nil
+	}
+	out := new(HookList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookObservation) DeepCopyInto(out *HookObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DisabledUntil != nil {
+		in, out := &in.DisabledUntil, &out.DisabledUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookObservation.
+func (in *HookObservation) DeepCopy() *HookObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HookObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookParameters) DeepCopyInto(out *HookParameters) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfidentialNoteEvents != nil {
+		in, out := &in.ConfidentialNoteEvents, &out.ConfidentialNoteEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectPath != nil {
+		in, out := &in.ProjectPath, &out.ProjectPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PushEvents != nil {
+		in, out := &in.PushEvents, &out.PushEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PushEventsBranchFilter != nil {
+		in, out := &in.PushEventsBranchFilter, &out.PushEventsBranchFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.IssuesEvents != nil {
+		in, out := &in.IssuesEvents, &out.IssuesEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfidentialIssuesEvents != nil {
+		in, out := &in.ConfidentialIssuesEvents, &out.ConfidentialIssuesEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeRequestsEvents != nil {
+		in, out := &in.MergeRequestsEvents, &out.MergeRequestsEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TagPushEvents != nil {
+		in, out := &in.TagPushEvents, &out.TagPushEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NoteEvents != nil {
+		in, out := &in.NoteEvents, &out.NoteEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JobEvents != nil {
+		in, out := &in.JobEvents, &out.JobEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PipelineEvents != nil {
+		in, out := &in.PipelineEvents, &out.PipelineEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WikiPageEvents != nil {
+		in, out := &in.WikiPageEvents, &out.WikiPageEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableSSLVerification != nil {
+		in, out := &in.EnableSSLVerification, &out.EnableSSLVerification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.AutoReEnable != nil {
+		in, out := &in.AutoReEnable, &out.AutoReEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookParameters.
+func (in *HookParameters) DeepCopy() *HookParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HookParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookSpec) DeepCopyInto(out *HookSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.Requires != nil {
+		in, out := &in.Requires, &out.Requires
+		*out = make([]Requirement, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookSpec.
+func (in *HookSpec) DeepCopy() *HookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
+func (in *HookStatus) DeepCopy() *HookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueLink) DeepCopyInto(out *IssueLink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueLink.
+func (in *IssueLink) DeepCopy() *IssueLink {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IssueLink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueLinkList) DeepCopyInto(out *IssueLinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IssueLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueLinkList.
+func (in *IssueLinkList) DeepCopy() *IssueLinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueLinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IssueLinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueLinkObservation) DeepCopyInto(out *IssueLinkObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueLinkObservation.
+func (in *IssueLinkObservation) DeepCopy() *IssueLinkObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueLinkObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueLinkParameters) DeepCopyInto(out *IssueLinkParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetProjectID != nil {
+		in, out := &in.TargetProjectID, &out.TargetProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetProjectIDRef != nil {
+		in, out := &in.TargetProjectIDRef, &out.TargetProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetProjectIDSelector != nil {
+		in, out := &in.TargetProjectIDSelector, &out.TargetProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinkType != nil {
+		in, out := &in.LinkType, &out.LinkType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueLinkParameters.
+func (in *IssueLinkParameters) DeepCopy() *IssueLinkParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueLinkParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueLinkSpec) DeepCopyInto(out *IssueLinkSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueLinkSpec.
+func (in *IssueLinkSpec) DeepCopy() *IssueLinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueLinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueLinkStatus) DeepCopyInto(out *IssueLinkStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueLinkStatus.
+func (in *IssueLinkStatus) DeepCopy() *IssueLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraIntegration) DeepCopyInto(out *JiraIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraIntegration.
+func (in *JiraIntegration) DeepCopy() *JiraIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JiraIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraIntegrationList) DeepCopyInto(out *JiraIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JiraIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraIntegrationList.
+func (in *JiraIntegrationList) DeepCopy() *JiraIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JiraIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraIntegrationObservation) DeepCopyInto(out *JiraIntegrationObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraIntegrationObservation.
+func (in *JiraIntegrationObservation) DeepCopy() *JiraIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraIntegrationParameters) DeepCopyInto(out *JiraIntegrationParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectKey != nil {
+		in, out := &in.ProjectKey, &out.ProjectKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.UsernameSecretRef != nil {
+		in, out := &in.UsernameSecretRef, &out.UsernameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	out.PasswordSecretRef = in.PasswordSecretRef
+	if in.JiraIssueTransitionID != nil {
+		in, out := &in.JiraIssueTransitionID, &out.JiraIssueTransitionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommitEvents != nil {
+		in, out := &in.CommitEvents, &out.CommitEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeRequestsEvents != nil {
+		in, out := &in.MergeRequestsEvents, &out.MergeRequestsEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CommentOnEventEnabled != nil {
+		in, out := &in.CommentOnEventEnabled, &out.CommentOnEventEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraIntegrationParameters.
+func (in *JiraIntegrationParameters) DeepCopy() *JiraIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraIntegrationSpec) DeepCopyInto(out *JiraIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraIntegrationSpec.
+func (in *JiraIntegrationSpec) DeepCopy() *JiraIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraIntegrationStatus) DeepCopyInto(out *JiraIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraIntegrationStatus.
+func (in *JiraIntegrationStatus) DeepCopy() *JiraIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTokenScope) DeepCopyInto(out *JobTokenScope) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTokenScope.
+func (in *JobTokenScope) DeepCopy() *JobTokenScope {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTokenScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JobTokenScope) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTokenScopeList) DeepCopyInto(out *JobTokenScopeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JobTokenScope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTokenScopeList.
+func (in *JobTokenScopeList) DeepCopy() *JobTokenScopeList {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTokenScopeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JobTokenScopeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTokenScopeObservation) DeepCopyInto(out *JobTokenScopeObservation) {
+	*out = *in
+	if in.AllowedProjectIDs != nil {
+		in, out := &in.AllowedProjectIDs, &out.AllowedProjectIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedGroupIDs != nil {
+		in, out := &in.AllowedGroupIDs, &out.AllowedGroupIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTokenScopeObservation.
+func (in *JobTokenScopeObservation) DeepCopy() *JobTokenScopeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTokenScopeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTokenScopeParameters) DeepCopyInto(out *JobTokenScopeParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowedProjectIDs != nil {
+		in, out := &in.AllowedProjectIDs, &out.AllowedProjectIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedProjectIDRefs != nil {
+		in, out := &in.AllowedProjectIDRefs, &out.AllowedProjectIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedProjectIDSelector != nil {
+		in, out := &in.AllowedProjectIDSelector, &out.AllowedProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedGroupIDs != nil {
+		in, out := &in.AllowedGroupIDs, &out.AllowedGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedGroupIDRefs != nil {
+		in, out := &in.AllowedGroupIDRefs, &out.AllowedGroupIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedGroupIDSelector != nil {
+		in, out := &in.AllowedGroupIDSelector, &out.AllowedGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTokenScopeParameters.
+func (in *JobTokenScopeParameters) DeepCopy() *JobTokenScopeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTokenScopeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTokenScopeSpec) DeepCopyInto(out *JobTokenScopeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTokenScopeSpec.
+func (in *JobTokenScopeSpec) DeepCopy() *JobTokenScopeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTokenScopeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTokenScopeStatus) DeepCopyInto(out *JobTokenScopeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTokenScopeStatus.
+func (in *JobTokenScopeStatus) DeepCopy() *JobTokenScopeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTokenScopeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Label) DeepCopyInto(out *Label) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Label.
+func (in *Label) DeepCopy() *Label {
+	if in == nil {
+		return nil
+	}
+	out := new(Label)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelObservation) DeepCopyInto(out *LabelObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelObservation.
+func (in *LabelObservation) DeepCopy() *LabelObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSet) DeepCopyInto(out *LabelSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSet.
+func (in *LabelSet) DeepCopy() *LabelSet {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LabelSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetList) DeepCopyInto(out *LabelSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LabelSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetList.
+func (in *LabelSetList) DeepCopy() *LabelSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LabelSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetObservation) DeepCopyInto(out *LabelSetObservation) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]LabelObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetObservation.
+func (in *LabelSetObservation) DeepCopy() *LabelSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetParameters) DeepCopyInto(out *LabelSetParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]Label, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetParameters.
+func (in *LabelSetParameters) DeepCopy() *LabelSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetSpec) DeepCopyInto(out *LabelSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetSpec.
+func (in *LabelSetSpec) DeepCopy() *LabelSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSetStatus) DeepCopyInto(out *LabelSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSetStatus.
+func (in *LabelSetStatus) DeepCopy() *LabelSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastPipeline) DeepCopyInto(out *LastPipeline) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastPipeline.
+func (in *LastPipeline) DeepCopy() *LastPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(LastPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Links) DeepCopyInto(out *Links) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
+func (in *Links) DeepCopy() *Links {
+	if in == nil {
+		return nil
+	}
+	out := new(Links)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Member) DeepCopyInto(out *Member) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
+func (in *Member) DeepCopy() *Member {
+	if in == nil {
+		return nil
+	}
+	out := new(Member)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Member) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberList) DeepCopyInto(out *MemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
+func (in *MemberList) DeepCopy() *MemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExtendedExpiresAt != nil {
+		in, out := &in.ExtendedExpiresAt, &out.ExtendedExpiresAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
+func (in *MemberObservation) DeepCopy() *MemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectPath != nil {
+		in, out := &in.ProjectPath, &out.ProjectPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoExtendBy != nil {
+		in, out := &in.AutoExtendBy, &out.AutoExtendBy
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AllowInherited != nil {
+		in, out := &in.AllowInherited, &out.AllowInherited
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
+func (in *MemberParameters) DeepCopy() *MemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
+func (in *MemberSpec) DeepCopy() *MemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
+func (in *MemberStatus) DeepCopy() *MemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Milestone) DeepCopyInto(out *Milestone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Milestone.
+func (in *Milestone) DeepCopy() *Milestone {
+	if in == nil {
+		return nil
+	}
+	out := new(Milestone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Milestone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneAutoClosePolicy) DeepCopyInto(out *MilestoneAutoClosePolicy) {
+	*out = *in
+	if in.RollOverOpenIssues != nil {
+		in, out := &in.RollOverOpenIssues, &out.RollOverOpenIssues
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneAutoClosePolicy.
+func (in *MilestoneAutoClosePolicy) DeepCopy() *MilestoneAutoClosePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneAutoClosePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneList) DeepCopyInto(out *MilestoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Milestone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneList.
+func (in *MilestoneList) DeepCopy() *MilestoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MilestoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneObservation) DeepCopyInto(out *MilestoneObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneObservation.
+func (in *MilestoneObservation) DeepCopy() *MilestoneObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneParameters) DeepCopyInto(out *MilestoneParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartDate != nil {
+		in, out := &in.StartDate, &out.StartDate
+		*out = new(string)
+		**out = **in
+	}
+	if in.DueDate != nil {
+		in, out := &in.DueDate, &out.DueDate
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoClosePolicy != nil {
+		in, out := &in.AutoClosePolicy, &out.AutoClosePolicy
+		*out = new(MilestoneAutoClosePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneParameters.
+func (in *MilestoneParameters) DeepCopy() *MilestoneParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneSpec) DeepCopyInto(out *MilestoneSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneSpec.
+func (in *MilestoneSpec) DeepCopy() *MilestoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MilestoneStatus) DeepCopyInto(out *MilestoneStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MilestoneStatus.
+func (in *MilestoneStatus) DeepCopy() *MilestoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MilestoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Note) DeepCopyInto(out *Note) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Note.
+func (in *Note) DeepCopy() *Note {
+	if in == nil {
+		return nil
+	}
+	out := new(Note)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Note) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoteList) DeepCopyInto(out *NoteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Note, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoteList.
+func (in *NoteList) DeepCopy() *NoteList {
+	if in == nil {
+		return nil
+	}
+	out := new(NoteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NoteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoteObservation) DeepCopyInto(out *NoteObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoteObservation.
+func (in *NoteObservation) DeepCopy() *NoteObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NoteObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoteParameters) DeepCopyInto(out *NoteParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Marker != nil {
+		in, out := &in.Marker, &out.Marker
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoteParameters.
+func (in *NoteParameters) DeepCopy() *NoteParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NoteParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoteSpec) DeepCopyInto(out *NoteSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoteSpec.
+func (in *NoteSpec) DeepCopy() *NoteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NoteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoteStatus) DeepCopyInto(out *NoteStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoteStatus.
+func (in *NoteStatus) DeepCopy() *NoteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NoteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationEvents) DeepCopyInto(out *NotificationEvents) {
+	*out = *in
+	if in.CloseIssue != nil {
+		in, out := &in.CloseIssue, &out.CloseIssue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CloseMergeRequest != nil {
+		in, out := &in.CloseMergeRequest, &out.CloseMergeRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FailedPipeline != nil {
+		in, out := &in.FailedPipeline, &out.FailedPipeline
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeMergeRequest != nil {
+		in, out := &in.MergeMergeRequest, &out.MergeMergeRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NewIssue != nil {
+		in, out := &in.NewIssue, &out.NewIssue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NewMergeRequest != nil {
+		in, out := &in.NewMergeRequest, &out.NewMergeRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NewNote != nil {
+		in, out := &in.NewNote, &out.NewNote
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReassignIssue != nil {
+		in, out := &in.ReassignIssue, &out.ReassignIssue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReassignMergeRequest != nil {
+		in, out := &in.ReassignMergeRequest, &out.ReassignMergeRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReopenIssue != nil {
+		in, out := &in.ReopenIssue, &out.ReopenIssue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReopenMergeRequest != nil {
+		in, out := &in.ReopenMergeRequest, &out.ReopenMergeRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SuccessPipeline != nil {
+		in, out := &in.SuccessPipeline, &out.SuccessPipeline
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationEvents.
+func (in *NotificationEvents) DeepCopy() *NotificationEvents {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationEvents)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Permissions) DeepCopyInto(out *Permissions) {
+	*out = *in
+	if in.ProjectAccess != nil {
+		in, out := &in.ProjectAccess, &out.ProjectAccess
+		*out = new(ProjectAccess)
+		**out = **in
+	}
+	if in.GroupAccess != nil {
+		in, out := &in.GroupAccess, &out.GroupAccess
+		*out = new(GroupAccess)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permissions.
+func (in *Permissions) DeepCopy() *Permissions {
+	if in == nil {
+		return nil
+	}
+	out := new(Permissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRetentionPolicy) DeepCopyInto(out *PipelineRetentionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRetentionPolicy.
+func (in *PipelineRetentionPolicy) DeepCopy() *PipelineRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineRetentionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRetentionPolicyList) DeepCopyInto(out *PipelineRetentionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PipelineRetentionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRetentionPolicyList.
+func (in *PipelineRetentionPolicyList) DeepCopy() *PipelineRetentionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRetentionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineRetentionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRetentionPolicyObservation) DeepCopyInto(out *PipelineRetentionPolicyObservation) {
+	*out = *in
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRetentionPolicyObservation.
+func (in *PipelineRetentionPolicyObservation) DeepCopy() *PipelineRetentionPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRetentionPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRetentionPolicyParameters) DeepCopyInto(out *PipelineRetentionPolicyParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OlderThanDays != nil {
+		in, out := &in.OlderThanDays, &out.OlderThanDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int)
+		**out = **in
+	}
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxDeletionsPerRun != nil {
+		in, out := &in.MaxDeletionsPerRun, &out.MaxDeletionsPerRun
+		*out = new(int)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRetentionPolicyParameters.
+func (in *PipelineRetentionPolicyParameters) DeepCopy() *PipelineRetentionPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRetentionPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRetentionPolicySpec) DeepCopyInto(out *PipelineRetentionPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRetentionPolicySpec.
+func (in *PipelineRetentionPolicySpec) DeepCopy() *PipelineRetentionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRetentionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRetentionPolicyStatus) DeepCopyInto(out *PipelineRetentionPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRetentionPolicyStatus.
+func (in *PipelineRetentionPolicyStatus) DeepCopy() *PipelineRetentionPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRetentionPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSchedule.
+func (in *PipelineSchedule) DeepCopy() *PipelineSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleList) DeepCopyInto(out *PipelineScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PipelineSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleList.
+func (in *PipelineScheduleList) DeepCopy() *PipelineScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleObservation) DeepCopyInto(out *PipelineScheduleObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(User)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastPipeline != nil {
+		in, out := &in.LastPipeline, &out.LastPipeline
+		*out = new(LastPipeline)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleObservation.
+func (in *PipelineScheduleObservation) DeepCopy() *PipelineScheduleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CronTimezone != nil {
+		in, out := &in.CronTimezone, &out.CronTimezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]PipelineVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleParameters.
+func (in *PipelineScheduleParameters) DeepCopy() *PipelineScheduleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleSpec) DeepCopyInto(out *PipelineScheduleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleSpec.
+func (in *PipelineScheduleSpec) DeepCopy() *PipelineScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleStatus) DeepCopyInto(out *PipelineScheduleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleStatus.
+func (in *PipelineScheduleStatus) DeepCopy() *PipelineScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineVariable) DeepCopyInto(out *PipelineVariable) {
+	*out = *in
+	if in.ValueSecretRef != nil {
+		in, out := &in.ValueSecretRef, &out.ValueSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.VariableType != nil {
+		in, out := &in.VariableType, &out.VariableType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineVariable.
+func (in *PipelineVariable) DeepCopy() *PipelineVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyApprovalSettings) DeepCopyInto(out *PolicyApprovalSettings) {
+	*out = *in
+	if in.ResetApprovalsOnPush != nil {
+		in, out := &in.ResetApprovalsOnPush, &out.ResetApprovalsOnPush
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableOverridingApproversPerMergeRequest != nil {
+		in, out := &in.DisableOverridingApproversPerMergeRequest, &out.DisableOverridingApproversPerMergeRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeRequestsAuthorApproval != nil {
+		in, out := &in.MergeRequestsAuthorApproval, &out.MergeRequestsAuthorApproval
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequirePasswordToApprove != nil {
+		in, out := &in.RequirePasswordToApprove, &out.RequirePasswordToApprove
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SelectiveCodeOwnerRemovals != nil {
+		in, out := &in.SelectiveCodeOwnerRemovals, &out.SelectiveCodeOwnerRemovals
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyApprovalSettings.
+func (in *PolicyApprovalSettings) DeepCopy() *PolicyApprovalSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyApprovalSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAccess) DeepCopyInto(out *ProjectAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccess.
+func (in *ProjectAccess) DeepCopy() *ProjectAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectBlueprint) DeepCopyInto(out *ProjectBlueprint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectBlueprint.
+func (in *ProjectBlueprint) DeepCopy() *ProjectBlueprint {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectBlueprint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectBlueprint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectBlueprintList) DeepCopyInto(out *ProjectBlueprintList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectBlueprint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectBlueprintList.
+func (in *ProjectBlueprintList) DeepCopy() *ProjectBlueprintList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectBlueprintList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectBlueprintList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectBlueprintSpec) DeepCopyInto(out *ProjectBlueprintSpec) {
+	*out = *in
+	if in.ProviderConfigReference != nil {
+		in, out := &in.ProviderConfigReference, &out.ProviderConfigReference
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Project.DeepCopyInto(&out.Project)
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]BlueprintHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]BlueprintVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Badges != nil {
+		in, out := &in.Badges, &out.Badges
+		*out = make([]BlueprintBadge, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectBlueprintSpec.
+func (in *ProjectBlueprintSpec) DeepCopy() *ProjectBlueprintSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectBlueprintSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectBlueprintStatus) DeepCopyInto(out *ProjectBlueprintStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]BlueprintResource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectBlueprintStatus.
+func (in *ProjectBlueprintStatus) DeepCopy() *ProjectBlueprintStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectBlueprintStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectDefaults) DeepCopyInto(out *ProjectDefaults) {
+	*out = *in
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(VisibilityValue)
+		**out = **in
+	}
+	if in.MergeMethod != nil {
+		in, out := &in.MergeMethod, &out.MergeMethod
+		*out = new(MergeMethodValue)
+		**out = **in
+	}
+	if in.DefaultBranch != nil {
+		in, out := &in.DefaultBranch, &out.DefaultBranch
+		*out = new(string)
+		**out = **in
+	}
+	if in.OnlyAllowMergeIfPipelineSucceeds != nil {
+		in, out := &in.OnlyAllowMergeIfPipelineSucceeds, &out.OnlyAllowMergeIfPipelineSucceeds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnlyAllowMergeIfAllDiscussionsAreResolved != nil {
+		in, out := &in.OnlyAllowMergeIfAllDiscussionsAreResolved, &out.OnlyAllowMergeIfAllDiscussionsAreResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoveSourceBranchAfterMerge != nil {
+		in, out := &in.RemoveSourceBranchAfterMerge, &out.RemoveSourceBranchAfterMerge
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectDefaults.
+func (in *ProjectDefaults) DeepCopy() *ProjectDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExportObservation) DeepCopyInto(out *ProjectExportObservation) {
+	*out = *in
+	if in.RequestedAt != nil {
+		in, out := &in.RequestedAt, &out.RequestedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExportObservation.
+func (in *ProjectExportObservation) DeepCopy() *ProjectExportObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExportObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectLicense) DeepCopyInto(out *ProjectLicense) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLicense.
+func (in *ProjectLicense) DeepCopy() *ProjectLicense {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectLicense)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNamespace) DeepCopyInto(out *ProjectNamespace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespace.
+func (in *ProjectNamespace) DeepCopy() *ProjectNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNotificationSettings) DeepCopyInto(out *ProjectNotificationSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNotificationSettings.
+func (in *ProjectNotificationSettings) DeepCopy() *ProjectNotificationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNotificationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectNotificationSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNotificationSettingsList) DeepCopyInto(out *ProjectNotificationSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectNotificationSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNotificationSettingsList.
+func (in *ProjectNotificationSettingsList) DeepCopy() *ProjectNotificationSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNotificationSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectNotificationSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNotificationSettingsObservation) DeepCopyInto(out *ProjectNotificationSettingsObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNotificationSettingsObservation.
+func (in *ProjectNotificationSettingsObservation) DeepCopy() *ProjectNotificationSettingsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNotificationSettingsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNotificationSettingsParameters) DeepCopyInto(out *ProjectNotificationSettingsParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(NotificationEvents)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNotificationSettingsParameters.
+func (in *ProjectNotificationSettingsParameters) DeepCopy() *ProjectNotificationSettingsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNotificationSettingsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNotificationSettingsSpec) DeepCopyInto(out *ProjectNotificationSettingsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNotificationSettingsSpec.
+func (in *ProjectNotificationSettingsSpec) DeepCopy() *ProjectNotificationSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNotificationSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNotificationSettingsStatus) DeepCopyInto(out *ProjectNotificationSettingsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNotificationSettingsStatus.
+func (in *ProjectNotificationSettingsStatus) DeepCopy() *ProjectNotificationSettingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNotificationSettingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectObservation) DeepCopyInto(out *ProjectObservation) {
+	*out = *in
+	if in.ComplianceFrameworks != nil {
+		in, out := &in.ComplianceFrameworks, &out.ComplianceFrameworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ContainerExpirationPolicy != nil {
+		in, out := &in.ContainerExpirationPolicy, &out.ContainerExpirationPolicy
+		*out = new(ContainerExpirationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CustomAttributes != nil {
+		in, out := &in.CustomAttributes, &out.CustomAttributes
+		*out = make([]CustomAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(ProjectExportObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForkedFromProject != nil {
+		in, out := &in.ForkedFromProject, &out.ForkedFromProject
+		*out = new(ForkParent)
+		**out = **in
+	}
+	if in.LastActivityAt != nil {
+		in, out := &in.LastActivityAt, &out.LastActivityAt
+		*out = (*in).DeepCopy()
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(ProjectLicense)
+		**out = **in
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = new(Links)
+		**out = **in
+	}
+	if in.MarkedForDeletionAt != nil {
 		in, out := &in.MarkedForDeletionAt, &out.MarkedForDeletionAt
 		*out = (*in).DeepCopy()
 	}
-	if in.Namespace != nil {
-		in, out := &in.Namespace, &out.Namespace
-		*out = new(ProjectNamespace)
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(ProjectNamespace)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(User)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(Permissions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PullMirror != nil {
+		in, out := &in.PullMirror, &out.PullMirror
+		*out = new(PullMirrorObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedWithGroups != nil {
+		in, out := &in.SharedWithGroups, &out.SharedWithGroups
+		*out = make([]SharedWithGroups, len(*in))
+		copy(*out, *in)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(ProjectStatistics)
+		**out = **in
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectObservation.
+func (in *ProjectObservation) DeepCopy() *ProjectObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectObservationOptions) DeepCopyInto(out *ProjectObservationOptions) {
+	*out = *in
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(bool)
+		**out = **in
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CustomAttributes != nil {
+		in, out := &in.CustomAttributes, &out.CustomAttributes
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectObservationOptions.
+func (in *ProjectObservationOptions) DeepCopy() *ProjectObservationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectObservationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectParameters) DeepCopyInto(out *ProjectParameters) {
+	*out = *in
+	if in.AllowMergeOnSkippedPipeline != nil {
+		in, out := &in.AllowMergeOnSkippedPipeline, &out.AllowMergeOnSkippedPipeline
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ApprovalsBeforeMerge != nil {
+		in, out := &in.ApprovalsBeforeMerge, &out.ApprovalsBeforeMerge
+		*out = new(int)
+		**out = **in
+	}
+	if in.AutoCancelPendingPipelines != nil {
+		in, out := &in.AutoCancelPendingPipelines, &out.AutoCancelPendingPipelines
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoDevopsDeployStrategy != nil {
+		in, out := &in.AutoDevopsDeployStrategy, &out.AutoDevopsDeployStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoDevopsEnabled != nil {
+		in, out := &in.AutoDevopsEnabled, &out.AutoDevopsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutocloseReferencedIssues != nil {
+		in, out := &in.AutocloseReferencedIssues, &out.AutocloseReferencedIssues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BuildCoverageRegex != nil {
+		in, out := &in.BuildCoverageRegex, &out.BuildCoverageRegex
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildGitStrategy != nil {
+		in, out := &in.BuildGitStrategy, &out.BuildGitStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildTimeout != nil {
+		in, out := &in.BuildTimeout, &out.BuildTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.BuildsAccessLevel != nil {
+		in, out := &in.BuildsAccessLevel, &out.BuildsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.CIConfigPath != nil {
+		in, out := &in.CIConfigPath, &out.CIConfigPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDefaultGitDepth != nil {
+		in, out := &in.CIDefaultGitDepth, &out.CIDefaultGitDepth
+		*out = new(int)
+		**out = **in
+	}
+	if in.CIForwardDeploymentEnabled != nil {
+		in, out := &in.CIForwardDeploymentEnabled, &out.CIForwardDeploymentEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CIAllowForkPipelines != nil {
+		in, out := &in.CIAllowForkPipelines, &out.CIAllowForkPipelines
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContainerExpirationPolicyAttributes != nil {
+		in, out := &in.ContainerExpirationPolicyAttributes, &out.ContainerExpirationPolicyAttributes
+		*out = new(ContainerExpirationPolicyAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerRegistryEnabled != nil {
+		in, out := &in.ContainerRegistryEnabled, &out.ContainerRegistryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContainerRegistryAccessLevel != nil {
+		in, out := &in.ContainerRegistryAccessLevel, &out.ContainerRegistryAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.CustomAttributes != nil {
+		in, out := &in.CustomAttributes, &out.CustomAttributes
+		*out = make([]CustomAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultBranch != nil {
+		in, out := &in.DefaultBranch, &out.DefaultBranch
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.EmailsDisabled != nil {
+		in, out := &in.EmailsDisabled, &out.EmailsDisabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EmailsEnabled != nil {
+		in, out := &in.EmailsEnabled, &out.EmailsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExternalAuthorizationClassificationLabel != nil {
+		in, out := &in.ExternalAuthorizationClassificationLabel, &out.ExternalAuthorizationClassificationLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForkingAccessLevel != nil {
+		in, out := &in.ForkingAccessLevel, &out.ForkingAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.GroupWithProjectTemplatesID != nil {
+		in, out := &in.GroupWithProjectTemplatesID, &out.GroupWithProjectTemplatesID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ImportURL != nil {
+		in, out := &in.ImportURL, &out.ImportURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportURLUsername != nil {
+		in, out := &in.ImportURLUsername, &out.ImportURLUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportURLPasswordSecretRef != nil {
+		in, out := &in.ImportURLPasswordSecretRef, &out.ImportURLPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.InitializeWithReadme != nil {
+		in, out := &in.InitializeWithReadme, &out.InitializeWithReadme
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IssuesAccessLevel != nil {
+		in, out := &in.IssuesAccessLevel, &out.IssuesAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.IssuesTemplate != nil {
+		in, out := &in.IssuesTemplate, &out.IssuesTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.LFSEnabled != nil {
+		in, out := &in.LFSEnabled, &out.LFSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeCommitTemplate != nil {
+		in, out := &in.MergeCommitTemplate, &out.MergeCommitTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.MergeMethod != nil {
+		in, out := &in.MergeMethod, &out.MergeMethod
+		*out = new(MergeMethodValue)
+		**out = **in
+	}
+	if in.MergeRequestsAccessLevel != nil {
+		in, out := &in.MergeRequestsAccessLevel, &out.MergeRequestsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.MergeRequestsTemplate != nil {
+		in, out := &in.MergeRequestsTemplate, &out.MergeRequestsTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.MRDefaultTargetSelf != nil {
+		in, out := &in.MRDefaultTargetSelf, &out.MRDefaultTargetSelf
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorOverwritesDivergedBranches != nil {
+		in, out := &in.MirrorOverwritesDivergedBranches, &out.MirrorOverwritesDivergedBranches
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorTriggerBuilds != nil {
+		in, out := &in.MirrorTriggerBuilds, &out.MirrorTriggerBuilds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorUserID != nil {
+		in, out := &in.MirrorUserID, &out.MirrorUserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NamespaceID != nil {
+		in, out := &in.NamespaceID, &out.NamespaceID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NamespaceIDRef != nil {
+		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceIDSelector != nil {
+		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnlyAllowMergeIfAllDiscussionsAreResolved != nil {
+		in, out := &in.OnlyAllowMergeIfAllDiscussionsAreResolved, &out.OnlyAllowMergeIfAllDiscussionsAreResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnlyAllowMergeIfPipelineSucceeds != nil {
+		in, out := &in.OnlyAllowMergeIfPipelineSucceeds, &out.OnlyAllowMergeIfPipelineSucceeds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnlyMirrorProtectedBranches != nil {
+		in, out := &in.OnlyMirrorProtectedBranches, &out.OnlyMirrorProtectedBranches
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OperationsAccessLevel != nil {
+		in, out := &in.OperationsAccessLevel, &out.OperationsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.PackagesEnabled != nil {
+		in, out := &in.PackagesEnabled, &out.PackagesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PagesAccessLevel != nil {
+		in, out := &in.PagesAccessLevel, &out.PagesAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrintingMergeRequestLinkEnabled != nil {
+		in, out := &in.PrintingMergeRequestLinkEnabled, &out.PrintingMergeRequestLinkEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicBuilds != nil {
+		in, out := &in.PublicBuilds, &out.PublicBuilds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicJobs != nil {
+		in, out := &in.PublicJobs, &out.PublicJobs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PruneCustomAttributes != nil {
+		in, out := &in.PruneCustomAttributes, &out.PruneCustomAttributes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoveSourceBranchAfterMerge != nil {
+		in, out := &in.RemoveSourceBranchAfterMerge, &out.RemoveSourceBranchAfterMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RepositoryAccessLevel != nil {
+		in, out := &in.RepositoryAccessLevel, &out.RepositoryAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.RepositoryStorage != nil {
+		in, out := &in.RepositoryStorage, &out.RepositoryStorage
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestAccessEnabled != nil {
+		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResolveOutdatedDiffDiscussions != nil {
+		in, out := &in.ResolveOutdatedDiffDiscussions, &out.ResolveOutdatedDiffDiscussions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityScanning != nil {
+		in, out := &in.SecurityScanning, &out.SecurityScanning
+		*out = new(SecurityScanning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceDeskEnabled != nil {
+		in, out := &in.ServiceDeskEnabled, &out.ServiceDeskEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SharedRunnersEnabled != nil {
+		in, out := &in.SharedRunnersEnabled, &out.SharedRunnersEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SnippetsAccessLevel != nil {
+		in, out := &in.SnippetsAccessLevel, &out.SnippetsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.SquashCommitTemplate != nil {
+		in, out := &in.SquashCommitTemplate, &out.SquashCommitTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuggestionCommitMessage != nil {
+		in, out := &in.SuggestionCommitMessage, &out.SuggestionCommitMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagList != nil {
+		in, out := &in.TagList, &out.TagList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplateName != nil {
+		in, out := &in.TemplateName, &out.TemplateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemplateProjectID != nil {
+		in, out := &in.TemplateProjectID, &out.TemplateProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.UseCustomTemplate != nil {
+		in, out := &in.UseCustomTemplate, &out.UseCustomTemplate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(VisibilityValue)
+		**out = **in
+	}
+	if in.WikiAccessLevel != nil {
+		in, out := &in.WikiAccessLevel, &out.WikiAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(apisv1alpha1.Timeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfirmDelete != nil {
+		in, out := &in.ConfirmDelete, &out.ConfirmDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectParameters.
+func (in *ProjectParameters) DeepCopy() *ProjectParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicy) DeepCopyInto(out *ProjectPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicy.
+func (in *ProjectPolicy) DeepCopy() *ProjectPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyList) DeepCopyInto(out *ProjectPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyList.
+func (in *ProjectPolicyList) DeepCopy() *ProjectPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicySpec) DeepCopyInto(out *ProjectPolicySpec) {
+	*out = *in
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Defaults.DeepCopyInto(&out.Defaults)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicySpec.
+func (in *ProjectPolicySpec) DeepCopy() *ProjectPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShare) DeepCopyInto(out *ProjectShare) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShare.
+func (in *ProjectShare) DeepCopy() *ProjectShare {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShare)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectShare) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareList) DeepCopyInto(out *ProjectShareList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectShare, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareList.
+func (in *ProjectShareList) DeepCopy() *ProjectShareList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectShareList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareObservation) DeepCopyInto(out *ProjectShareObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareObservation.
+func (in *ProjectShareObservation) DeepCopy() *ProjectShareObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareParameters) DeepCopyInto(out *ProjectShareParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
 		**out = **in
 	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
-		*out = new(User)
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Permissions != nil {
-		in, out := &in.Permissions, &out.Permissions
-		*out = new(Permissions)
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SharedWithGroups != nil {
-		in, out := &in.SharedWithGroups, &out.SharedWithGroups
-		*out = make([]SharedWithGroups, len(*in))
-		copy(*out, *in)
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareParameters.
+func (in *ProjectShareParameters) DeepCopy() *ProjectShareParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareSpec) DeepCopyInto(out *ProjectShareSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareSpec.
+func (in *ProjectShareSpec) DeepCopy() *ProjectShareSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShareStatus) DeepCopyInto(out *ProjectShareStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShareStatus.
+func (in *ProjectShareStatus) DeepCopy() *ProjectShareStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShareStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.Observation != nil {
+		in, out := &in.Observation, &out.Observation
+		*out = new(ProjectObservationOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatistics) DeepCopyInto(out *ProjectStatistics) {
+	*out = *in
+	out.StorageStatistics = in.StorageStatistics
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatistics.
+func (in *ProjectStatistics) DeepCopy() *ProjectStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchSet) DeepCopyInto(out *ProtectedBranchSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchSet.
+func (in *ProtectedBranchSet) DeepCopy() *ProtectedBranchSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedBranchSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchSetList) DeepCopyInto(out *ProtectedBranchSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProtectedBranchSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchSetList.
+func (in *ProtectedBranchSetList) DeepCopy() *ProtectedBranchSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedBranchSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchSetObservation) DeepCopyInto(out *ProtectedBranchSetObservation) {
+	*out = *in
+	if in.Protections != nil {
+		in, out := &in.Protections, &out.Protections
+		*out = make([]BranchProtectionObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchSetObservation.
+func (in *ProtectedBranchSetObservation) DeepCopy() *ProtectedBranchSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchSetParameters) DeepCopyInto(out *ProtectedBranchSetParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Protections != nil {
+		in, out := &in.Protections, &out.Protections
+		*out = make([]BranchProtection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchSetParameters.
+func (in *ProtectedBranchSetParameters) DeepCopy() *ProtectedBranchSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchSetSpec) DeepCopyInto(out *ProtectedBranchSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchSetSpec.
+func (in *ProtectedBranchSetSpec) DeepCopy() *ProtectedBranchSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchSetStatus) DeepCopyInto(out *ProtectedBranchSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchSetStatus.
+func (in *ProtectedBranchSetStatus) DeepCopy() *ProtectedBranchSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullMirrorObservation) DeepCopyInto(out *PullMirrorObservation) {
+	*out = *in
+	if in.LastSuccessfulUpdateAt != nil {
+		in, out := &in.LastSuccessfulUpdateAt, &out.LastSuccessfulUpdateAt
+		*out = (*in).DeepCopy()
 	}
-	if in.Statistics != nil {
-		in, out := &in.Statistics, &out.Statistics
-		*out = new(ProjectStatistics)
-		**out = **in
+	if in.LastUpdateAt != nil {
+		in, out := &in.LastUpdateAt, &out.LastUpdateAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectObservation.
-func (in *ProjectObservation) DeepCopy() *ProjectObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullMirrorObservation.
+func (in *PullMirrorObservation) DeepCopy() *PullMirrorObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectObservation)
+	out := new(PullMirrorObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectParameters) DeepCopyInto(out *ProjectParameters) {
+func (in *Release) DeepCopyInto(out *Release) {
 	*out = *in
-	if in.AllowMergeOnSkippedPipeline != nil {
-		in, out := &in.AllowMergeOnSkippedPipeline, &out.AllowMergeOnSkippedPipeline
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Release.
+func (in *Release) DeepCopy() *Release {
+	if in == nil {
+		return nil
 	}
-	if in.ApprovalsBeforeMerge != nil {
-		in, out := &in.ApprovalsBeforeMerge, &out.ApprovalsBeforeMerge
-		*out = new(int)
-		**out = **in
+	out := new(Release)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Release) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.AutoCancelPendingPipelines != nil {
-		in, out := &in.AutoCancelPendingPipelines, &out.AutoCancelPendingPipelines
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseAssetLink) DeepCopyInto(out *ReleaseAssetLink) {
+	*out = *in
+	if in.FilePath != nil {
+		in, out := &in.FilePath, &out.FilePath
 		*out = new(string)
 		**out = **in
 	}
-	if in.AutoDevopsDeployStrategy != nil {
-		in, out := &in.AutoDevopsDeployStrategy, &out.AutoDevopsDeployStrategy
+	if in.LinkType != nil {
+		in, out := &in.LinkType, &out.LinkType
 		*out = new(string)
 		**out = **in
 	}
-	if in.AutoDevopsEnabled != nil {
-		in, out := &in.AutoDevopsEnabled, &out.AutoDevopsEnabled
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseAssetLink.
+func (in *ReleaseAssetLink) DeepCopy() *ReleaseAssetLink {
+	if in == nil {
+		return nil
 	}
-	if in.AutocloseReferencedIssues != nil {
-		in, out := &in.AutocloseReferencedIssues, &out.AutocloseReferencedIssues
-		*out = new(bool)
-		**out = **in
+	out := new(ReleaseAssetLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseAssetLinkObservation) DeepCopyInto(out *ReleaseAssetLinkObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseAssetLinkObservation.
+func (in *ReleaseAssetLinkObservation) DeepCopy() *ReleaseAssetLinkObservation {
+	if in == nil {
+		return nil
 	}
-	if in.BuildCoverageRegex != nil {
-		in, out := &in.BuildCoverageRegex, &out.BuildCoverageRegex
-		*out = new(string)
-		**out = **in
+	out := new(ReleaseAssetLinkObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseList) DeepCopyInto(out *ReleaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Release, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.BuildGitStrategy != nil {
-		in, out := &in.BuildGitStrategy, &out.BuildGitStrategy
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseList.
+func (in *ReleaseList) DeepCopy() *ReleaseList {
+	if in == nil {
+		return nil
 	}
-	if in.BuildTimeout != nil {
-		in, out := &in.BuildTimeout, &out.BuildTimeout
-		*out = new(int)
-		**out = **in
+	out := new(ReleaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.BuildsAccessLevel != nil {
-		in, out := &in.BuildsAccessLevel, &out.BuildsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseObservation) DeepCopyInto(out *ReleaseObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.CIConfigPath != nil {
-		in, out := &in.CIConfigPath, &out.CIConfigPath
-		*out = new(string)
-		**out = **in
+	if in.Milestones != nil {
+		in, out := &in.Milestones, &out.Milestones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.CIDefaultGitDepth != nil {
-		in, out := &in.CIDefaultGitDepth, &out.CIDefaultGitDepth
-		*out = new(int)
-		**out = **in
+	if in.EvidenceCollectedAt != nil {
+		in, out := &in.EvidenceCollectedAt, &out.EvidenceCollectedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.CIForwardDeploymentEnabled != nil {
-		in, out := &in.CIForwardDeploymentEnabled, &out.CIForwardDeploymentEnabled
-		*out = new(bool)
+	if in.AssetLinks != nil {
+		in, out := &in.AssetLinks, &out.AssetLinks
+		*out = make([]ReleaseAssetLinkObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseObservation.
+func (in *ReleaseObservation) DeepCopy() *ReleaseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseParameters) DeepCopyInto(out *ReleaseParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
 		**out = **in
 	}
-	if in.ContainerExpirationPolicyAttributes != nil {
-		in, out := &in.ContainerExpirationPolicyAttributes, &out.ContainerExpirationPolicyAttributes
-		*out = new(ContainerExpirationPolicyAttributes)
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ContainerRegistryEnabled != nil {
-		in, out := &in.ContainerRegistryEnabled, &out.ContainerRegistryEnabled
-		*out = new(bool)
-		**out = **in
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.DefaultBranch != nil {
-		in, out := &in.DefaultBranch, &out.DefaultBranch
+	if in.TagMessage != nil {
+		in, out := &in.TagMessage, &out.TagMessage
 		*out = new(string)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
 		*out = new(string)
 		**out = **in
 	}
@@ -1510,289 +6686,500 @@ func (in *ProjectParameters) DeepCopyInto(out *ProjectParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.EmailsDisabled != nil {
-		in, out := &in.EmailsDisabled, &out.EmailsDisabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ExternalAuthorizationClassificationLabel != nil {
-		in, out := &in.ExternalAuthorizationClassificationLabel, &out.ExternalAuthorizationClassificationLabel
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.ForkingAccessLevel != nil {
-		in, out := &in.ForkingAccessLevel, &out.ForkingAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	if in.ReleasedAt != nil {
+		in, out := &in.ReleasedAt, &out.ReleasedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.GroupWithProjectTemplatesID != nil {
-		in, out := &in.GroupWithProjectTemplatesID, &out.GroupWithProjectTemplatesID
-		*out = new(int)
-		**out = **in
+	if in.Milestones != nil {
+		in, out := &in.Milestones, &out.Milestones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.ImportURL != nil {
-		in, out := &in.ImportURL, &out.ImportURL
-		*out = new(string)
-		**out = **in
+	if in.AssetLinks != nil {
+		in, out := &in.AssetLinks, &out.AssetLinks
+		*out = make([]ReleaseAssetLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.InitializeWithReadme != nil {
-		in, out := &in.InitializeWithReadme, &out.InitializeWithReadme
-		*out = new(bool)
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
-	if in.IssuesAccessLevel != nil {
-		in, out := &in.IssuesAccessLevel, &out.IssuesAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseParameters.
+func (in *ReleaseParameters) DeepCopy() *ReleaseParameters {
+	if in == nil {
+		return nil
 	}
-	if in.IssuesTemplate != nil {
-		in, out := &in.IssuesTemplate, &out.IssuesTemplate
-		*out = new(string)
-		**out = **in
+	out := new(ReleaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseSpec) DeepCopyInto(out *ReleaseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSpec.
+func (in *ReleaseSpec) DeepCopy() *ReleaseSpec {
+	if in == nil {
+		return nil
 	}
-	if in.LFSEnabled != nil {
-		in, out := &in.LFSEnabled, &out.LFSEnabled
-		*out = new(bool)
-		**out = **in
+	out := new(ReleaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseStatus.
+func (in *ReleaseStatus) DeepCopy() *ReleaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteMirror) DeepCopyInto(out *RemoteMirror) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteMirror.
+func (in *RemoteMirror) DeepCopy() *RemoteMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteMirror) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MergeMethod != nil {
-		in, out := &in.MergeMethod, &out.MergeMethod
-		*out = new(MergeMethodValue)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteMirrorList) DeepCopyInto(out *RemoteMirrorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RemoteMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MergeRequestsAccessLevel != nil {
-		in, out := &in.MergeRequestsAccessLevel, &out.MergeRequestsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteMirrorList.
+func (in *RemoteMirrorList) DeepCopy() *RemoteMirrorList {
+	if in == nil {
+		return nil
 	}
-	if in.MergeRequestsTemplate != nil {
-		in, out := &in.MergeRequestsTemplate, &out.MergeRequestsTemplate
-		*out = new(string)
-		**out = **in
+	out := new(RemoteMirrorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteMirrorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Mirror != nil {
-		in, out := &in.Mirror, &out.Mirror
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteMirrorObservation) DeepCopyInto(out *RemoteMirrorObservation) {
+	*out = *in
+	if in.LastUpdateAt != nil {
+		in, out := &in.LastUpdateAt, &out.LastUpdateAt
+		*out = (*in).DeepCopy()
 	}
-	if in.MirrorOverwritesDivergedBranches != nil {
-		in, out := &in.MirrorOverwritesDivergedBranches, &out.MirrorOverwritesDivergedBranches
-		*out = new(bool)
-		**out = **in
+	if in.LastUpdateStartedAt != nil {
+		in, out := &in.LastUpdateStartedAt, &out.LastUpdateStartedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.MirrorTriggerBuilds != nil {
-		in, out := &in.MirrorTriggerBuilds, &out.MirrorTriggerBuilds
-		*out = new(bool)
-		**out = **in
+	if in.LastSuccessfulUpdateAt != nil {
+		in, out := &in.LastSuccessfulUpdateAt, &out.LastSuccessfulUpdateAt
+		*out = (*in).DeepCopy()
 	}
-	if in.MirrorUserID != nil {
-		in, out := &in.MirrorUserID, &out.MirrorUserID
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteMirrorObservation.
+func (in *RemoteMirrorObservation) DeepCopy() *RemoteMirrorObservation {
+	if in == nil {
+		return nil
 	}
-	if in.NamespaceID != nil {
-		in, out := &in.NamespaceID, &out.NamespaceID
-		*out = new(int)
+	out := new(RemoteMirrorObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteMirrorParameters) DeepCopyInto(out *RemoteMirrorParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
 		**out = **in
 	}
-	if in.NamespaceIDRef != nil {
-		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NamespaceIDSelector != nil {
-		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.OnlyAllowMergeIfAllDiscussionsAreResolved != nil {
-		in, out := &in.OnlyAllowMergeIfAllDiscussionsAreResolved, &out.OnlyAllowMergeIfAllDiscussionsAreResolved
-		*out = new(bool)
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
 		**out = **in
 	}
-	if in.OnlyAllowMergeIfPipelineSucceeds != nil {
-		in, out := &in.OnlyAllowMergeIfPipelineSucceeds, &out.OnlyAllowMergeIfPipelineSucceeds
-		*out = new(bool)
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
-	if in.OnlyMirrorProtectedBranches != nil {
-		in, out := &in.OnlyMirrorProtectedBranches, &out.OnlyMirrorProtectedBranches
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.OperationsAccessLevel != nil {
-		in, out := &in.OperationsAccessLevel, &out.OperationsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.PackagesEnabled != nil {
-		in, out := &in.PackagesEnabled, &out.PackagesEnabled
+	if in.OnlyProtectedBranches != nil {
+		in, out := &in.OnlyProtectedBranches, &out.OnlyProtectedBranches
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PagesAccessLevel != nil {
-		in, out := &in.PagesAccessLevel, &out.PagesAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(string)
-		**out = **in
-	}
-	if in.PrintingMergeRequestLinkEnabled != nil {
-		in, out := &in.PrintingMergeRequestLinkEnabled, &out.PrintingMergeRequestLinkEnabled
+	if in.KeepDivergentRefs != nil {
+		in, out := &in.KeepDivergentRefs, &out.KeepDivergentRefs
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PublicBuilds != nil {
-		in, out := &in.PublicBuilds, &out.PublicBuilds
-		*out = new(bool)
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
-	if in.RemoveSourceBranchAfterMerge != nil {
-		in, out := &in.RemoveSourceBranchAfterMerge, &out.RemoveSourceBranchAfterMerge
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteMirrorParameters.
+func (in *RemoteMirrorParameters) DeepCopy() *RemoteMirrorParameters {
+	if in == nil {
+		return nil
 	}
-	if in.RepositoryAccessLevel != nil {
-		in, out := &in.RepositoryAccessLevel, &out.RepositoryAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	out := new(RemoteMirrorParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteMirrorSpec) DeepCopyInto(out *RemoteMirrorSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteMirrorSpec.
+func (in *RemoteMirrorSpec) DeepCopy() *RemoteMirrorSpec {
+	if in == nil {
+		return nil
 	}
-	if in.RequestAccessEnabled != nil {
-		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
-		*out = new(bool)
-		**out = **in
+	out := new(RemoteMirrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteMirrorStatus) DeepCopyInto(out *RemoteMirrorStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteMirrorStatus.
+func (in *RemoteMirrorStatus) DeepCopy() *RemoteMirrorStatus {
+	if in == nil {
+		return nil
 	}
-	if in.ResolveOutdatedDiffDiscussions != nil {
-		in, out := &in.ResolveOutdatedDiffDiscussions, &out.ResolveOutdatedDiffDiscussions
+	out := new(RemoteMirrorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityScanning) DeepCopyInto(out *SecurityScanning) {
+	*out = *in
+	if in.SAST != nil {
+		in, out := &in.SAST, &out.SAST
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ServiceDeskEnabled != nil {
-		in, out := &in.ServiceDeskEnabled, &out.ServiceDeskEnabled
+	if in.DAST != nil {
+		in, out := &in.DAST, &out.DAST
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SharedRunnersEnabled != nil {
-		in, out := &in.SharedRunnersEnabled, &out.SharedRunnersEnabled
+	if in.DependencyScanning != nil {
+		in, out := &in.DependencyScanning, &out.DependencyScanning
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SnippetsAccessLevel != nil {
-		in, out := &in.SnippetsAccessLevel, &out.SnippetsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.SuggestionCommitMessage != nil {
-		in, out := &in.SuggestionCommitMessage, &out.SuggestionCommitMessage
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityScanning.
+func (in *SecurityScanning) DeepCopy() *SecurityScanning {
+	if in == nil {
+		return nil
 	}
-	if in.TagList != nil {
-		in, out := &in.TagList, &out.TagList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(SecurityScanning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
+func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
+	if in == nil {
+		return nil
 	}
-	if in.TemplateName != nil {
-		in, out := &in.TemplateName, &out.TemplateName
-		*out = new(string)
-		**out = **in
+	out := new(SharedWithGroups)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snippet) DeepCopyInto(out *Snippet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snippet.
+func (in *Snippet) DeepCopy() *Snippet {
+	if in == nil {
+		return nil
 	}
-	if in.TemplateProjectID != nil {
-		in, out := &in.TemplateProjectID, &out.TemplateProjectID
-		*out = new(int)
-		**out = **in
+	out := new(Snippet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Snippet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.UseCustomTemplate != nil {
-		in, out := &in.UseCustomTemplate, &out.UseCustomTemplate
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnippetFile) DeepCopyInto(out *SnippetFile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetFile.
+func (in *SnippetFile) DeepCopy() *SnippetFile {
+	if in == nil {
+		return nil
 	}
-	if in.Visibility != nil {
-		in, out := &in.Visibility, &out.Visibility
-		*out = new(VisibilityValue)
-		**out = **in
+	out := new(SnippetFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnippetFileObservation) DeepCopyInto(out *SnippetFileObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetFileObservation.
+func (in *SnippetFileObservation) DeepCopy() *SnippetFileObservation {
+	if in == nil {
+		return nil
 	}
-	if in.WikiAccessLevel != nil {
-		in, out := &in.WikiAccessLevel, &out.WikiAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	out := new(SnippetFileObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnippetList) DeepCopyInto(out *SnippetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Snippet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectParameters.
-func (in *ProjectParameters) DeepCopy() *ProjectParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetList.
+func (in *SnippetList) DeepCopy() *SnippetList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectParameters)
+	out := new(SnippetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnippetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *SnippetObservation) DeepCopyInto(out *SnippetObservation) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]SnippetFileObservation, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetObservation.
+func (in *SnippetObservation) DeepCopy() *SnippetObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(SnippetObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatistics) DeepCopyInto(out *ProjectStatistics) {
+func (in *SnippetParameters) DeepCopyInto(out *SnippetParameters) {
 	*out = *in
-	out.StorageStatistics = in.StorageStatistics
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(VisibilityValue)
+		**out = **in
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]SnippetFile, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatistics.
-func (in *ProjectStatistics) DeepCopy() *ProjectStatistics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetParameters.
+func (in *SnippetParameters) DeepCopy() *SnippetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatistics)
+	out := new(SnippetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *SnippetSpec) DeepCopyInto(out *SnippetSpec) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetSpec.
+func (in *SnippetSpec) DeepCopy() *SnippetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(SnippetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
+func (in *SnippetStatus) DeepCopyInto(out *SnippetStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
-func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetStatus.
+func (in *SnippetStatus) DeepCopy() *SnippetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SharedWithGroups)
+	out := new(SnippetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1943,6 +7330,26 @@ func (in *VariableList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableObservation) DeepCopyInto(out *VariableObservation) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableObservation.
+func (in *VariableObservation) DeepCopy() *VariableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 	*out = *in
@@ -1961,6 +7368,26 @@ func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProjectPath != nil {
+		in, out := &in.ProjectPath, &out.ProjectPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Value != nil {
 		in, out := &in.Value, &out.Value
 		*out = new(string)
@@ -1996,6 +7423,21 @@ func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InheritancePolicy != nil {
+		in, out := &in.InheritancePolicy, &out.InheritancePolicy
+		*out = new(VariableInheritancePolicy)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableParameters.
@@ -2013,6 +7455,11 @@ func (in *VariableSpec) DeepCopyInto(out *VariableSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.Requires != nil {
+		in, out := &in.Requires, &out.Requires
+		*out = make([]Requirement, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSpec.
@@ -2029,6 +7476,7 @@ func (in *VariableSpec) DeepCopy() *VariableSpec {
 func (in *VariableStatus) DeepCopyInto(out *VariableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableStatus.
@@ -2040,3 +7488,151 @@ func (in *VariableStatus) DeepCopy() *VariableStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPage) DeepCopyInto(out *WikiPage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPage.
+func (in *WikiPage) DeepCopy() *WikiPage {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WikiPage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageList) DeepCopyInto(out *WikiPageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WikiPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageList.
+func (in *WikiPageList) DeepCopy() *WikiPageList {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WikiPageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageObservation) DeepCopyInto(out *WikiPageObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageObservation.
+func (in *WikiPageObservation) DeepCopy() *WikiPageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageParameters) DeepCopyInto(out *WikiPageParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(WikiFormatValue)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageParameters.
+func (in *WikiPageParameters) DeepCopy() *WikiPageParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageSpec) DeepCopyInto(out *WikiPageSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageSpec.
+func (in *WikiPageSpec) DeepCopy() *WikiPageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageStatus) DeepCopyInto(out *WikiPageStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageStatus.
+func (in *WikiPageStatus) DeepCopy() *WikiPageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
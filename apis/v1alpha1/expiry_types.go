@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TypeExpiring indicates whether a resource, such as a token, expires soon.
+const TypeExpiring xpv1.ConditionType = "Expiring"
+
+// Reasons of the Expiring condition.
+const (
+	ReasonExpiresSoon xpv1.ConditionReason = "ExpiresSoon"
+	ReasonExpired     xpv1.ConditionReason = "Expired"
+	ReasonNotExpiring xpv1.ConditionReason = "NotExpiring"
+)
+
+// Expiring returns a condition that indicates the resource expires at at,
+// which is before now or soon after it.
+func Expiring(at, now time.Time) xpv1.Condition {
+	c := xpv1.Condition{
+		Type:               TypeExpiring,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonExpiresSoon,
+		Message:            "expires at " + at.UTC().Format(time.DateOnly),
+	}
+	if !at.After(now) {
+		c.Reason = ReasonExpired
+		c.Message = "expired at " + at.UTC().Format(time.DateOnly)
+	}
+	return c
+}
+
+// NotExpiring returns a condition that indicates the resource does not
+// expire soon.
+func NotExpiring() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeExpiring,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotExpiring,
+	}
+}
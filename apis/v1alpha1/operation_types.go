@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// An OperationPhase is the phase of the operation Gitlab keeps running in
+// the background after a resource was created, such as an import.
+// +kubebuilder:validation:Enum=Creating;Importing;Ready;Failed
+type OperationPhase string
+
+// Phases of an operation.
+const (
+	// PhaseCreating means the resource was requested but not observed yet.
+	PhaseCreating OperationPhase = "Creating"
+
+	// PhaseImporting means Gitlab is importing the content of the resource.
+	PhaseImporting OperationPhase = "Importing"
+
+	// PhaseReady means the operation finished and the resource can be used.
+	PhaseReady OperationPhase = "Ready"
+
+	// PhaseFailed means the operation failed.
+	PhaseFailed OperationPhase = "Failed"
+)
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TypeProviderToken indicates whether the token the provider authenticates
+// with may create a resource, such as an access token.
+const TypeProviderToken xpv1.ConditionType = "ProviderToken"
+
+// Reasons of the ProviderToken condition.
+const (
+	ReasonProviderTokenInsufficient xpv1.ConditionReason = "ProviderTokenInsufficient"
+	ReasonProviderTokenSufficient   xpv1.ConditionReason = "ProviderTokenSufficient"
+)
+
+// ProviderTokenInsufficient returns a condition that indicates the provider
+// token lacks a scope or role the resource requires, and why.
+func ProviderTokenInsufficient(message string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeProviderToken,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonProviderTokenInsufficient,
+		Message:            message,
+	}
+}
+
+// ProviderTokenSufficient returns a condition that indicates the provider
+// token has the scopes and role the resource requires.
+func ProviderTokenSufficient() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeProviderToken,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonProviderTokenSufficient,
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Timeouts bound how long the calls to Gitlab made to create, read, update
+// or delete a resource may take. Unset timeouts default to one minute, and
+// no timeout may exceed 30 minutes. Only deleting a resource may take longer
+// than the one minute a reconcile takes at most.
+type Timeouts struct {
+	// Create bounds the calls made to create the resource, at most to one
+	// minute.
+	// +optional
+	Create *metav1.Duration `json:"create,omitempty"`
+
+	// Read bounds the calls made to observe the resource, at most to one
+	// minute.
+	// +optional
+	Read *metav1.Duration `json:"read,omitempty"`
+
+	// Update bounds the calls made to update the resource, at most to one
+	// minute.
+	// +optional
+	Update *metav1.Duration `json:"update,omitempty"`
+
+	// Delete bounds the calls made to delete the resource, including
+	// exporting a project before it is deleted.
+	// +optional
+	Delete *metav1.Duration `json:"delete,omitempty"`
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -33,6 +34,139 @@ type ProviderConfigSpec struct {
 	// InsecureSkipVerify ignores self signed TLS certificates when connecting
 	// to Gitlab.
 	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+
+	// APIPath is the path of the Gitlab REST API relative to the BaseURL.
+	// Defaults to api/v4.
+	// +optional
+	APIPath *string `json:"apiPath,omitempty"`
+
+	// Version of Gitlab, e.g. 15.11. Request fields introduced by later
+	// versions of Gitlab are not sent, so older self-managed instances do
+	// not reject requests that set them. Leave unset for the latest version.
+	// +kubebuilder:validation:Pattern=`^[0-9]+\.[0-9]+`
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// ConditionalRequests sends the ETag of the last response for a URL with
+	// the next GET request for it. Gitlab answers with 304 Not Modified if
+	// the resource did not change, which is observed as no drift without
+	// transferring the resource again.
+	// +optional
+	ConditionalRequests *bool `json:"conditionalRequests,omitempty"`
+
+	// Retry configures how requests failing with a rate limit or server
+	// error are retried. Defaults to the retries of the Gitlab client.
+	// +optional
+	Retry *RetryPolicy `json:"retry,omitempty"`
+
+	// CheckConnectivity periodically requests the version of Gitlab with
+	// the credentials of this ProviderConfig and reports the result in its
+	// Connected condition, so misconfigured credentials are noticed before
+	// managed resources fail to sync.
+	// +optional
+	CheckConnectivity *bool `json:"checkConnectivity,omitempty"`
+
+	// MaintenanceWindows limits the changes made to Gitlab by managed
+	// resources using this ProviderConfig to these windows. Managed resources
+	// are observed at any time. Changes are made at any time if not set. The
+	// gitlab.crossplane.io/maintenance-window annotation of a managed
+	// resource overrides the windows for it.
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// Sudo is the username or ID of the user requests are made as, so that
+	// changes are attributed to a service user rather than the owner of
+	// the token. Requires an administrator token with the sudo scope. The
+	// gitlab.crossplane.io/sudo annotation of a managed resource overrides
+	// the user for it.
+	// +optional
+	Sudo *string `json:"sudo,omitempty"`
+}
+
+// TypeConnected is the type of the condition reporting whether Gitlab is
+// reachable with a ProviderConfig.
+const TypeConnected xpv1.ConditionType = "Connected"
+
+// Reasons of the Connected condition.
+const (
+	ReasonConnected    xpv1.ConditionReason = "Connected"
+	ReasonDisconnected xpv1.ConditionReason = "Disconnected"
+)
+
+// Connected returns a condition that indicates Gitlab is reachable with a
+// ProviderConfig.
+func Connected() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConnected,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonConnected,
+	}
+}
+
+// Disconnected returns a condition that indicates Gitlab is not reachable
+// with a ProviderConfig.
+func Disconnected(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConnected,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDisconnected,
+		Message:            err.Error(),
+	}
+}
+
+// A RetryPolicy configures how requests to Gitlab are retried. The delay
+// before a retry starts at BaseDelay and doubles with every retry up to
+// MaxDelay.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is retried. Zero disables
+	// retries.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// BaseDelay is the delay before the first retry.
+	// +optional
+	BaseDelay *metav1.Duration `json:"baseDelay,omitempty"`
+
+	// MaxDelay is the longest delay before a retry, including a delay
+	// requested by Gitlab with a Retry-After header.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+
+	// JitterPercent randomly varies each delay by up to this percentage, so
+	// retries of many requests do not hit Gitlab at the same time.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	JitterPercent *int `json:"jitterPercent,omitempty"`
+}
+
+// A Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type Weekday string
+
+// A MaintenanceWindow is a recurring period of time in which changes are
+// made to Gitlab.
+type MaintenanceWindow struct {
+	// Days of the week the window starts on. The window starts every day if
+	// not set.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of day the window starts, e.g. 22:00.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day the window ends, e.g. 06:00. A window that
+	// ends before it starts ends on the next day.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+
+	// TimeZone of Start and End, e.g. Europe/Berlin. Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -47,6 +181,31 @@ type ProviderCredentials struct {
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// Token describes the access token of this ProviderConfig, if Gitlab
+	// reports it.
+	// +optional
+	Token *TokenStatus `json:"token,omitempty"`
+}
+
+// TokenStatus describes the access token a ProviderConfig authenticates
+// with.
+type TokenStatus struct {
+	// ID of the token.
+	ID int `json:"id"`
+
+	// Name of the token.
+	Name string `json:"name"`
+
+	// Scopes of the token.
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Active is false once the token is revoked or expired.
+	Active bool `json:"active"`
+
+	// ExpiresAt is the time the token expires, if any.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -54,6 +213,8 @@ type ProviderConfigStatus struct {
 // A ProviderConfig configures how gitlab controller should connect to Gitlab API.
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="CONNECTED",type="string",JSONPath=".status.conditions[?(@.type=='Connected')].status"
+// +kubebuilder:printcolumn:name="TOKEN-EXPIRES",type="date",JSONPath=".status.token.expiresAt",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,gitlab}
 // +kubebuilder:subresource:status
 type ProviderConfig struct {
@@ -36,11 +36,14 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis"
 	"github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/webhooks"
 )
 
 func main() {
@@ -55,6 +58,14 @@ func main() {
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+		enableDryRun               = app.Flag("enable-dry-run", "Report the changes controllers would make to Gitlab without making them.").Default("false").Envar("ENABLE_DRY_RUN").Bool()
+		enableVariableCache        = app.Flag("enable-variable-cache", "Observe the project Variables of a project from a short lived cache of all its variables.").Default("false").Envar("ENABLE_VARIABLE_CACHE").Bool()
+		enableProjectCache         = app.Flag("enable-project-cache", "Observe the Projects of a group from a list of its projects refreshed once per poll interval.").Default("false").Envar("ENABLE_PROJECT_CACHE").Bool()
+		enableAuditLog             = app.Flag("enable-audit-log", "Log a structured audit record for every change made to Gitlab.").Default("false").Envar("ENABLE_AUDIT_LOG").Bool()
+		enablePollStaggering       = app.Flag("enable-poll-staggering", "Spread the polls of managed resources evenly over the poll interval.").Default("false").Envar("ENABLE_POLL_STAGGERING").Bool()
+		enableSecretMigration      = app.Flag("enable-secret-migration", "Move plaintext sensitive fields of managed resources into Secrets and reference them instead.").Default("false").Envar("ENABLE_SECRET_MIGRATION").Bool()
+		webhookTLSCertDir          = app.Flag("webhook-tls-cert-dir", "Directory of the TLS certificate (tls.crt and tls.key) of the admission webhooks. Webhooks are disabled if unset.").Envar("WEBHOOK_TLS_CERT_DIR").String()
+		enableTracing              = app.Flag("enable-tracing", "Export OpenTelemetry traces via OTLP, configured by the OTEL_EXPORTER_OTLP_* environment variables.").Default("false").Envar("ENABLE_TRACING").Bool()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -69,10 +80,23 @@ func main() {
 
 	log.Debug("Starting", "sync-period", syncInterval.String())
 
+	if *enableTracing {
+		shutdown, err := tracing.Setup(context.Background())
+		kingpin.FatalIfError(err, "Cannot setup tracing")
+		defer shutdown(context.Background()) //nolint:errcheck
+		log.Info("Tracing enabled")
+	}
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
+	var webhookServer webhook.Server
+	if *webhookTLSCertDir != "" {
+		webhookServer = webhook.NewServer(webhook.Options{CertDir: *webhookTLSCertDir})
+	}
+
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		WebhookServer: webhookServer,
 		Cache: cache.Options{
 			SyncPeriod: syncInterval,
 		},
@@ -126,7 +150,40 @@ func main() {
 		log.Info("Alpha feature enabled", "flag", features.EnableAlphaManagementPolicies)
 	}
 
+	if *enableDryRun {
+		o.Features.Enable(features.EnableDryRun)
+		log.Info("Dry run enabled, no changes will be made to Gitlab", "flag", features.EnableDryRun)
+	}
+
+	if *enableVariableCache {
+		o.Features.Enable(features.EnableVariableCache)
+		log.Info("Variable cache enabled", "flag", features.EnableVariableCache)
+	}
+
+	if *enableProjectCache {
+		o.Features.Enable(features.EnableProjectCache)
+		log.Info("Project cache enabled", "flag", features.EnableProjectCache)
+	}
+
+	if *enableAuditLog {
+		o.Features.Enable(features.EnableAuditLog)
+		log.Info("Audit log enabled", "flag", features.EnableAuditLog)
+	}
+
+	if *enablePollStaggering {
+		o.Features.Enable(features.EnablePollStaggering)
+		log.Info("Poll staggering enabled", "flag", features.EnablePollStaggering)
+	}
+
+	if *enableSecretMigration {
+		o.Features.Enable(features.EnableSecretMigration)
+		log.Info("Secret migration enabled", "flag", features.EnableSecretMigration)
+	}
+
 	kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup Gitlab controllers")
+	if *webhookTLSCertDir != "" {
+		kingpin.FatalIfError(webhooks.Setup(mgr), "Cannot setup webhooks")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
 
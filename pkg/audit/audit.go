@@ -0,0 +1,147 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records the changes controllers make to Gitlab.
+package audit
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A Request is a mutating request made to Gitlab.
+type Request struct {
+	Method     string   `json:"method"`
+	Path       string   `json:"path"`
+	StatusCode int      `json:"statusCode,omitempty"`
+	Fields     []string `json:"fields,omitempty"`
+}
+
+// A Log collects the mutating requests made for one operation.
+type Log struct {
+	mu       sync.Mutex
+	requests []Request
+}
+
+// Add records a request.
+func (l *Log) Add(r Request) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.requests = append(l.requests, r)
+}
+
+// Requests returns the recorded requests.
+func (l *Log) Requests() []Request {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Request{}, l.requests...)
+}
+
+type logKey struct{}
+
+// WithLog returns a context collecting the mutating requests made with it
+// in l.
+func WithLog(ctx context.Context, l *Log) context.Context {
+	return context.WithValue(ctx, logKey{}, l)
+}
+
+// FromContext returns the Log of ctx, or nil if requests made with ctx are
+// not audited.
+func FromContext(ctx context.Context) *Log {
+	l, _ := ctx.Value(logKey{}).(*Log)
+	return l
+}
+
+// NewConnecter wraps c so that the Create, Update and Delete operations of
+// the clients it returns are logged as audit records with the requests they
+// made to Gitlab. It returns c if enabled is false.
+func NewConnecter(enabled bool, log logging.Logger, gvk schema.GroupVersionKind, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if !enabled {
+		return c
+	}
+	return &connecter{log: log.WithValues("audit", true), kind: gvk.GroupKind().String(), connecter: c}
+}
+
+type connecter struct {
+	log       logging.Logger
+	kind      string
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{log: c.log, kind: c.kind, client: ec}, nil
+}
+
+type external struct {
+	log    logging.Logger
+	kind   string
+	client managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	return e.client.Observe(ctx, mg)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	l := &Log{}
+	c, err := e.client.Create(WithLog(ctx, l), mg)
+	e.record("create", mg, l, err)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	l := &Log{}
+	u, err := e.client.Update(WithLog(ctx, l), mg)
+	e.record("update", mg, l, err)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	l := &Log{}
+	err := e.client.Delete(WithLog(ctx, l), mg)
+	e.record("delete", mg, l, err)
+	return err
+}
+
+// record logs an operation that made requests to Gitlab or failed.
+func (e *external) record(operation string, mg resource.Managed, l *Log, err error) {
+	requests := l.Requests()
+	if len(requests) == 0 && err == nil {
+		return
+	}
+	kv := []any{
+		"kind", e.kind,
+		"name", mg.GetName(),
+		"externalName", meta.GetExternalName(mg),
+		"operation", operation,
+		"requests", requests,
+	}
+	if err != nil {
+		kv = append(kv, "error", err.Error())
+	}
+	e.log.Info("Audit", kv...)
+}
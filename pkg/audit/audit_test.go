@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+// recordingLogger records the key value pairs of Info messages.
+type recordingLogger struct {
+	logging.Logger
+	records []map[string]any
+}
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...any) {
+	r := map[string]any{"msg": msg}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		r[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+	l.records = append(l.records, r)
+}
+
+func (l *recordingLogger) WithValues(keysAndValues ...any) logging.Logger {
+	return l
+}
+
+func TestConnecter(t *testing.T) {
+	errBoom := errors.New("boom")
+	gvk := schema.GroupVersionKind{Group: "projects.gitlab.crossplane.io", Version: "v1alpha1", Kind: "Hook"}
+
+	log := &recordingLogger{Logger: logging.NewNopLogger()}
+	c := NewConnecter(true, log, gvk, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			CreateFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+				FromContext(ctx).Add(Request{Method: "POST", Path: "/api/v4/projects/1/hooks", StatusCode: 201, Fields: []string{"url"}})
+				return managed.ExternalCreation{}, nil
+			},
+			UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+				return managed.ExternalUpdate{}, nil
+			},
+			DeleteFn: func(_ context.Context, _ resource.Managed) error {
+				return errBoom
+			},
+		}, nil
+	}))
+
+	mg := &fake.Managed{}
+	mg.SetName("hook")
+	meta.SetExternalName(mg, "42")
+	ec, err := c.Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	if _, err := ec.Create(context.Background(), mg); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := ec.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if err := ec.Delete(context.Background(), mg); !errors.Is(err, errBoom) {
+		t.Fatalf("Delete: want %v, got %v", errBoom, err)
+	}
+
+	want := []map[string]any{
+		{
+			"msg":          "Audit",
+			"kind":         "Hook.projects.gitlab.crossplane.io",
+			"name":         "hook",
+			"externalName": "42",
+			"operation":    "create",
+			"requests":     []Request{{Method: "POST", Path: "/api/v4/projects/1/hooks", StatusCode: 201, Fields: []string{"url"}}},
+		},
+		{
+			"msg":          "Audit",
+			"kind":         "Hook.projects.gitlab.crossplane.io",
+			"name":         "hook",
+			"externalName": "42",
+			"operation":    "delete",
+			"requests":     []Request{},
+			"error":        "boom",
+		},
+	}
+	if diff := cmp.Diff(want, log.records); diff != "" {
+		t.Errorf("records: -want, +got:\n%s", diff)
+	}
+}
+
+func TestNewConnecterDisabled(t *testing.T) {
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return nil, nil
+	})
+	if _, ok := NewConnecter(false, logging.NewNopLogger(), schema.GroupVersionKind{}, c).(managed.ExternalConnectorFn); !ok {
+		t.Errorf("NewConnecter: want the wrapped connecter when disabled")
+	}
+}
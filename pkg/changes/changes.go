@@ -0,0 +1,227 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package changes reports which fields controllers change in Gitlab.
+package changes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// maxValueLength bounds the length of the values shown in an event.
+	maxValueLength = 64
+
+	reasonChanged event.Reason = "ChangedExternalResource"
+)
+
+// A Change is a field of a Gitlab resource sent with a different value than
+// the one last observed.
+type Change struct {
+	Field string
+	// Old and New are empty if the values are redacted or not scalar.
+	Old string
+	New string
+}
+
+func (c Change) String() string {
+	if c.Old == "" && c.New == "" {
+		return c.Field
+	}
+	return fmt.Sprintf("%s: %s -> %s", c.Field, c.Old, c.New)
+}
+
+// A Recorder collects the Gitlab resources observed and the changes made to
+// them during one reconcile.
+type Recorder struct {
+	mu       sync.Mutex
+	observed map[string]map[string]json.RawMessage
+	changes  []Change
+}
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{observed: map[string]map[string]json.RawMessage{}}
+}
+
+// Observed records the body of a resource read from path.
+func (r *Recorder) Observed(path string, body []byte) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.observed[path] = fields
+}
+
+// Sent records the body of a request changing the resource at path. Fields
+// sent with the value last observed at path are not changes. If path was
+// not observed, every field sent counts as a change.
+func (r *Recorder) Sent(path string, body []byte) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	observed, known := r.observed[path]
+	for f, v := range fields {
+		old, ok := observed[f]
+		if known && ok && jsonEqual(old, v) {
+			continue
+		}
+		c := Change{Field: f}
+		if known && !sensitive(f) {
+			c.Old, c.New = scalar(old), scalar(v)
+		}
+		r.changes = append(r.changes, c)
+	}
+}
+
+// Changes returns the recorded changes sorted by field.
+func (r *Recorder) Changes() []Change {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := append([]Change{}, r.changes...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out
+}
+
+// sensitive returns true for fields whose values must not be shown.
+func sensitive(field string) bool {
+	f := strings.ToLower(field)
+	for _, s := range []string{"token", "password", "secret", "value", "key"} {
+		if strings.Contains(f, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// scalar returns the value of a JSON string, number, boolean or null, and
+// "" for anything else.
+func scalar(raw json.RawMessage) string {
+	if raw == nil {
+		return "null"
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return ""
+	}
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case float64, bool:
+		s = fmt.Sprint(v)
+	case nil:
+		s = "null"
+	default:
+		return ""
+	}
+	if len(s) > maxValueLength {
+		s = s[:maxValueLength] + "..."
+	}
+	return s
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+type recorderKey struct{}
+
+// WithRecorder returns a context recording the resources observed and
+// changed with it in r.
+func WithRecorder(ctx context.Context, r *Recorder) context.Context {
+	return context.WithValue(ctx, recorderKey{}, r)
+}
+
+// FromContext returns the Recorder of ctx, or nil if changes made with ctx
+// are not recorded.
+func FromContext(ctx context.Context) *Recorder {
+	r, _ := ctx.Value(recorderKey{}).(*Recorder)
+	return r
+}
+
+// NewConnecter wraps c so that an event listing the changed fields is
+// recorded for every update of the clients it returns.
+func NewConnecter(record event.Recorder, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{record: record, connecter: c}
+}
+
+type connecter struct {
+	record    event.Recorder
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	// A client is connected for every reconcile, so the recorder holds the
+	// resources observed right before they are updated.
+	return &external{record: c.record, recorder: NewRecorder(), client: ec}, nil
+}
+
+type external struct {
+	record   event.Recorder
+	recorder *Recorder
+	client   managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	return e.client.Observe(WithRecorder(ctx, e.recorder), mg)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return e.client.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.client.Update(WithRecorder(ctx, e.recorder), mg)
+	if err != nil {
+		return u, err
+	}
+	if changes := e.recorder.Changes(); len(changes) > 0 {
+		s := make([]string, len(changes))
+		for i, c := range changes {
+			s[i] = c.String()
+		}
+		e.record.Event(mg, event.Normal(reasonChanged, "Changed fields in Gitlab: "+strings.Join(s, ", ")))
+	}
+	return u, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return e.client.Delete(ctx, mg)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestRecorder(t *testing.T) {
+	cases := map[string]struct {
+		observed map[string]string
+		path     string
+		sent     string
+		want     []Change
+	}{
+		"Unchanged": {
+			observed: map[string]string{"/projects/1": `{"name": "p", "topics": ["a"]}`},
+			path:     "/projects/1",
+			sent:     `{"name": "p", "topics": ["a"]}`,
+			want:     []Change{},
+		},
+		"Changed": {
+			observed: map[string]string{"/projects/1": `{"name": "p", "topics": ["a"], "archived": false}`},
+			path:     "/projects/1",
+			sent:     `{"name": "q", "topics": ["b"], "archived": true, "description": "d"}`,
+			want: []Change{
+				{Field: "archived", Old: "false", New: "true"},
+				{Field: "description", Old: "null", New: "d"},
+				{Field: "name", Old: "p", New: "q"},
+				{Field: "topics"},
+			},
+		},
+		"Redacted": {
+			observed: map[string]string{"/projects/1/variables/K": `{"key": "K", "value": "old", "masked": false}`},
+			path:     "/projects/1/variables/K",
+			sent:     `{"value": "new", "masked": true}`,
+			want: []Change{
+				{Field: "masked", Old: "false", New: "true"},
+				{Field: "value"},
+			},
+		},
+		"NotObserved": {
+			observed: map[string]string{"/projects/2": `{"name": "p"}`},
+			path:     "/projects/1",
+			sent:     `{"name": "p"}`,
+			want:     []Change{{Field: "name"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewRecorder()
+			for p, b := range tc.observed {
+				r.Observed(p, []byte(b))
+			}
+			r.Sent(tc.path, []byte(tc.sent))
+			if diff := cmp.Diff(tc.want, r.Changes()); diff != "" {
+				t.Errorf("r.Changes(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// recordingRecorder records the events it is asked to record.
+type recordingRecorder struct {
+	events []event.Event
+}
+
+func (r *recordingRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recordingRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestConnecter(t *testing.T) {
+	rec := &recordingRecorder{}
+	c := NewConnecter(rec, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				FromContext(ctx).Observed("/projects/1", []byte(`{"name": "p", "token": "a"}`))
+				return managed.ExternalObservation{ResourceExists: true}, nil
+			},
+			UpdateFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+				FromContext(ctx).Sent("/projects/1", []byte(`{"name": "q", "token": "b"}`))
+				return managed.ExternalUpdate{}, nil
+			},
+		}, nil
+	}))
+
+	mg := &fake.Managed{}
+	ec, err := c.Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	if _, err := ec.Observe(context.Background(), mg); err != nil {
+		t.Fatalf("Observe: %v", err)
+	}
+	if _, err := ec.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	want := []event.Event{event.Normal(reasonChanged, "Changed fields in Gitlab: name: p -> q, token")}
+	if diff := cmp.Diff(want, rec.events); diff != "" {
+		t.Errorf("events: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+)
+
+// auditTransport records the mutating requests made with a context that
+// carries an audit log. Only the names of the fields sent are recorded, so
+// no secret values end up in audit records.
+type auditTransport struct {
+	base http.RoundTripper
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	l := audit.FromContext(req.Context())
+	if l == nil || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return t.base.RoundTrip(req)
+	}
+
+	r := audit.Request{Method: req.Method, Path: req.URL.EscapedPath()}
+	if req.Body != nil && req.Header.Get("Content-Type") == "application/json" {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		r.Fields = fieldNames(body)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if resp != nil {
+		r.StatusCode = resp.StatusCode
+	}
+	l.Add(r)
+	return resp, err
+}
+
+// fieldNames returns the sorted names of the top level fields of a JSON
+// object.
+func fieldNames(body []byte) []string {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(fields))
+	for f := range fields {
+		names = append(names, f)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+)
+
+func TestAuditTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer srv.Close()
+
+	l := &audit.Log{}
+	ctx := audit.WithLog(context.Background(), l)
+	git := NewClient(Config{BaseURL: srv.URL})
+	if _, _, err := git.Projects.GetProject(1, nil, gitlab.WithContext(ctx)); err != nil {
+		t.Fatalf("GetProject: %v", err)
+	}
+	if _, _, err := git.Projects.EditProject(1, &gitlab.EditProjectOptions{Name: ptr.To("p"), Description: ptr.To("secret")}, gitlab.WithContext(ctx)); err != nil {
+		t.Fatalf("EditProject: %v", err)
+	}
+
+	want := []audit.Request{{Method: http.MethodPut, Path: "/api/v4/projects/1", StatusCode: http.StatusOK, Fields: []string{"description", "name"}}}
+	if diff := cmp.Diff(want, l.Requests()); diff != "" {
+		t.Errorf("requests: -want, +got:\n%s", diff)
+	}
+}
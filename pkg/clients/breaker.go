@@ -0,0 +1,197 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const (
+	// breakerThreshold is the number of consecutive failed requests after
+	// which the circuit to a Gitlab instance opens.
+	breakerThreshold = 5
+	// breakerMinBackoff is how long the circuit stays open after it trips.
+	breakerMinBackoff = 10 * time.Second
+	// breakerMaxBackoff caps the exponentially growing open period.
+	breakerMaxBackoff = 5 * time.Minute
+
+	defaultBaseURL = "https://gitlab.com/"
+)
+
+// CircuitOpenError is returned instead of sending a request to a Gitlab
+// instance that failed repeatedly.
+type CircuitOpenError struct {
+	BaseURL    string
+	RetryAfter time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("gitlab instance %s is unavailable, not retrying before %s", e.BaseURL, e.RetryAfter.UTC().Format(time.RFC3339))
+}
+
+// IsCircuitOpen returns true if err was caused by an open circuit to a Gitlab
+// instance.
+func IsCircuitOpen(err error) bool {
+	var e *CircuitOpenError
+	return errors.As(err, &e)
+}
+
+// TypeUnavailable is the type of the condition reporting whether the Gitlab
+// instance of a managed resource is unavailable.
+const TypeUnavailable xpv1.ConditionType = "Unavailable"
+
+// Reasons of the Unavailable condition.
+const (
+	ReasonCircuitOpen   xpv1.ConditionReason = "CircuitOpen"
+	ReasonCircuitClosed xpv1.ConditionReason = "CircuitClosed"
+)
+
+// CircuitOpen returns a condition that indicates requests to the Gitlab
+// instance of a managed resource are rejected because of the open circuit
+// reported by err.
+func CircuitOpen(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUnavailable,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCircuitOpen,
+		Message:            err.Error(),
+	}
+}
+
+// CircuitClosed returns a condition that indicates requests are sent to the
+// Gitlab instance of a managed resource again.
+func CircuitClosed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUnavailable,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCircuitClosed,
+	}
+}
+
+// circuitBreaker tracks the consecutive failures of a Gitlab instance. Once
+// they reach breakerThreshold, requests are rejected until an exponentially
+// growing backoff has passed. The first successful request closes it again.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	failures  int
+	openUntil time.Time
+}
+
+func (b *circuitBreaker) allow() (time.Time, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.openUntil, !b.now().Before(b.openUntil)
+}
+
+func (b *circuitBreaker) record(ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if ok {
+		b.failures = 0
+		b.openUntil = time.Time{}
+		return
+	}
+	b.failures++
+	if b.failures < breakerThreshold {
+		return
+	}
+	backoff := breakerMinBackoff << (b.failures - breakerThreshold)
+	if backoff > breakerMaxBackoff || backoff <= 0 {
+		backoff = breakerMaxBackoff
+	}
+	b.openUntil = b.now().Add(backoff)
+}
+
+var (
+	breakersMu sync.Mutex
+	breakers   = map[string]*circuitBreaker{}
+)
+
+// breakerKey returns the key of the Gitlab instance at baseURL, which is the
+// same for every spelling of its URL that Gitlab clients accept.
+func breakerKey(baseURL string) string {
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return strings.TrimRight(baseURL, "/")
+	}
+	p := strings.TrimSuffix(strings.TrimRight(u.Path, "/"), "/api/v4")
+	return strings.ToLower(u.Scheme+"://"+u.Host) + strings.TrimRight(p, "/")
+}
+
+// breakerFor returns the circuit breaker shared by all clients of the Gitlab
+// instance with key.
+func breakerFor(key string) *circuitBreaker {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	b, ok := breakers[key]
+	if !ok {
+		b = &circuitBreaker{now: time.Now}
+		breakers[key] = b
+	}
+	return b
+}
+
+// breakerTransport short-circuits requests to a Gitlab instance while its
+// circuit breaker is open.
+type breakerTransport struct {
+	base    http.RoundTripper
+	baseURL string
+	breaker *circuitBreaker
+}
+
+func newBreakerTransport(base http.RoundTripper, baseURL string) *breakerTransport {
+	key := breakerKey(baseURL)
+	return &breakerTransport{base: base, baseURL: key, breaker: breakerFor(key)}
+}
+
+func (t *breakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if until, ok := t.breaker.allow(); !ok {
+		return nil, &CircuitOpenError{BaseURL: t.baseURL, RetryAfter: until}
+	}
+	resp, err := t.base.RoundTrip(req)
+	switch {
+	case err != nil:
+		// A request canceled by the caller says nothing about the instance.
+		if req.Context().Err() != nil || errors.Is(err, context.Canceled) {
+			return resp, err
+		}
+		t.breaker.record(false)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		t.breaker.record(false)
+	default:
+		t.breaker.record(true)
+	}
+	return resp, err
+}
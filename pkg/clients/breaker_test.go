@@ -0,0 +1,123 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestBreakerTransport(t *testing.T) {
+	now := time.Unix(0, 0)
+	status := http.StatusServiceUnavailable
+	var err error
+	calls := 0
+
+	tr := &breakerTransport{
+		baseURL: "https://gitlab.example.com/",
+		breaker: &circuitBreaker{now: func() time.Time { return now }},
+		base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			calls++
+			if err != nil {
+				return nil, err
+			}
+			return &http.Response{StatusCode: status}, nil
+		}),
+	}
+	do := func() error {
+		req, _ := http.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects/1", nil)
+		_, err := tr.RoundTrip(req)
+		return err
+	}
+
+	for i := 0; i < breakerThreshold; i++ {
+		if e := do(); e != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, e)
+		}
+	}
+
+	// The circuit is open: requests fail without reaching the instance.
+	if e := do(); !IsCircuitOpen(e) {
+		t.Fatalf("want open circuit, got %v", e)
+	}
+	if diff := cmp.Diff(breakerThreshold, calls); diff != "" {
+		t.Errorf("calls: -want, +got:\n%s", diff)
+	}
+
+	// Once the backoff passed, another failure reopens it for twice as long.
+	now = now.Add(breakerMinBackoff)
+	err = errors.New("connection refused")
+	if e := do(); e == nil || IsCircuitOpen(e) {
+		t.Fatalf("want transport error, got %v", e)
+	}
+	if diff := cmp.Diff(now.Add(2*breakerMinBackoff), tr.breaker.openUntil); diff != "" {
+		t.Errorf("openUntil: -want, +got:\n%s", diff)
+	}
+
+	// A successful request closes the circuit again.
+	now = now.Add(2 * breakerMinBackoff)
+	err = nil
+	status = http.StatusOK
+	if e := do(); e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+	if diff := cmp.Diff(0, tr.breaker.failures); diff != "" {
+		t.Errorf("failures: -want, +got:\n%s", diff)
+	}
+	if e := do(); e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+}
+
+func TestBreakerFor(t *testing.T) {
+	a := newBreakerTransport(http.DefaultTransport, "")
+	c := newBreakerTransport(http.DefaultTransport, "https://gitlab.example.com/")
+	for _, u := range []string{defaultBaseURL, "https://gitlab.com", "https://GitLab.com/api/v4/"} {
+		if b := newBreakerTransport(http.DefaultTransport, u); a.breaker != b.breaker {
+			t.Errorf("%q: clients of the same instance must share a circuit breaker", u)
+		}
+	}
+	if a.breaker == c.breaker {
+		t.Errorf("clients of different instances must not share a circuit breaker")
+	}
+}
+
+func TestBreakerKey(t *testing.T) {
+	cases := map[string]string{
+		"":                                   "https://gitlab.com",
+		"https://gitlab.com":                 "https://gitlab.com",
+		"https://gitlab.com/":                "https://gitlab.com",
+		"https://gitlab.com/api/v4":          "https://gitlab.com",
+		"HTTPS://GitLab.example.com:8443/":   "https://gitlab.example.com:8443",
+		"https://example.com/gitlab/api/v4/": "https://example.com/gitlab",
+	}
+	for in, want := range cases {
+		if got := breakerKey(in); got != want {
+			t.Errorf("breakerKey(%q): want %q, got %q", in, want, got)
+		}
+	}
+}
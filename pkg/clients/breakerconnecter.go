@@ -0,0 +1,90 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// NewBreakerConnecter wraps c so that connections and operations rejected by
+// the open circuit breaker of a Gitlab instance are reported in the
+// Unavailable condition of the managed resource, until an operation is no
+// longer rejected.
+func NewBreakerConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &breakerConnecter{connecter: c}
+}
+
+type breakerConnecter struct {
+	connecter managed.ExternalConnecter
+}
+
+func (c *breakerConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		// Connecting may not send a request, so only an open circuit is
+		// reported.
+		if IsCircuitOpen(err) {
+			mg.SetConditions(CircuitOpen(err))
+		}
+		return nil, err
+	}
+	return &breakerExternal{ExternalClient: ec}, nil
+}
+
+type breakerExternal struct {
+	managed.ExternalClient
+}
+
+func (e *breakerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	reportCircuit(mg, err)
+	return o, err
+}
+
+func (e *breakerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	reportCircuit(mg, err)
+	return c, err
+}
+
+func (e *breakerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	reportCircuit(mg, err)
+	return u, err
+}
+
+func (e *breakerExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.ExternalClient.Delete(ctx, mg)
+	reportCircuit(mg, err)
+	return err
+}
+
+// reportCircuit reports in the Unavailable condition of mg whether the
+// operation that returned err was rejected by an open circuit breaker.
+func reportCircuit(mg resource.Managed, err error) {
+	switch {
+	case IsCircuitOpen(err):
+		mg.SetConditions(CircuitOpen(err))
+	case mg.GetCondition(TypeUnavailable).Status == corev1.ConditionTrue:
+		mg.SetConditions(CircuitClosed())
+	}
+}
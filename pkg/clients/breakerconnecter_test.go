@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestBreakerConnecter(t *testing.T) {
+	var connectErr, observeErr error
+	c := NewBreakerConnecter(managed.ExternalConnectorFn(func(context.Context, resource.Managed) (managed.ExternalClient, error) {
+		if connectErr != nil {
+			return nil, connectErr
+		}
+		return &managed.ExternalClientFns{
+			ObserveFn: func(context.Context, resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{}, observeErr
+			},
+		}, nil
+	}))
+	mg := &fake.Managed{}
+	open := &CircuitOpenError{BaseURL: "https://gitlab.com", RetryAfter: time.Unix(0, 0)}
+
+	connectErr = errors.New("boom")
+	if _, err := c.Connect(context.Background(), mg); err != connectErr {
+		t.Errorf("Connect: want error %v, got %v", connectErr, err)
+	}
+	if got := mg.GetCondition(TypeUnavailable); got.Status != corev1.ConditionUnknown {
+		t.Errorf("connect failed: unexpected condition %+v", got)
+	}
+
+	connectErr = errors.Wrap(open, "cannot connect")
+	if _, err := c.Connect(context.Background(), mg); !IsCircuitOpen(err) {
+		t.Errorf("Connect: want open circuit error, got %v", err)
+	}
+	if got := mg.GetCondition(TypeUnavailable); got.Status != corev1.ConditionTrue || got.Reason != ReasonCircuitOpen {
+		t.Errorf("connect rejected: unexpected condition %+v", got)
+	}
+
+	connectErr = nil
+	e, err := c.Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect: unexpected error %v", err)
+	}
+	observeErr = open
+	if _, err := e.Observe(context.Background(), mg); err != observeErr {
+		t.Errorf("Observe: want error %v, got %v", observeErr, err)
+	}
+	if got := mg.GetCondition(TypeUnavailable); got.Status != corev1.ConditionTrue || got.Reason != ReasonCircuitOpen {
+		t.Errorf("circuit open: unexpected condition %+v", got)
+	}
+
+	observeErr = nil
+	if _, err := e.Observe(context.Background(), mg); err != nil {
+		t.Errorf("Observe: unexpected error %v", err)
+	}
+	if got := mg.GetCondition(TypeUnavailable); got.Status != corev1.ConditionFalse || got.Reason != ReasonCircuitClosed {
+		t.Errorf("circuit closed: unexpected condition %+v", got)
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+)
+
+// maxChangesBodySize bounds the size of the resources kept to find the
+// fields an update changes.
+const maxChangesBodySize = 1 << 20
+
+// changesTransport records the resources read and the updates sent with a
+// context that carries a changes recorder.
+type changesTransport struct {
+	base http.RoundTripper
+}
+
+func (t *changesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := changes.FromContext(req.Context())
+	if r == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	path := req.URL.EscapedPath()
+	if (req.Method == http.MethodPut || req.Method == http.MethodPatch) && req.Body != nil && req.Header.Get("Content-Type") == "application/json" {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		resp, err := t.base.RoundTrip(req)
+		if err == nil && resp.StatusCode < http.StatusBadRequest {
+			r.Sent(path, body)
+		}
+		return resp, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || req.Method != http.MethodGet || resp.StatusCode != http.StatusOK || resp.ContentLength > maxChangesBodySize {
+		return resp, err
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxChangesBodySize+1))
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(body) <= maxChangesBodySize {
+		r.Observed(path, body)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
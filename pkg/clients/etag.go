@@ -0,0 +1,153 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+const (
+	// maxETagEntries and maxETagBodySize bound the memory held by the
+	// responses kept for conditional requests.
+	maxETagEntries  = 500
+	maxETagBodySize = 128 << 10
+)
+
+// etags holds the responses for conditional requests of all clients, so
+// they survive the short lived clients of the controllers.
+var etags = newETagCache(maxETagEntries)
+
+type etagEntry struct {
+	key    string
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+// etagCache is a least recently used cache of responses with an ETag.
+type etagCache struct {
+	mu      sync.Mutex
+	max     int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newETagCache(max int) *etagCache {
+	return &etagCache{max: max, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (c *etagCache) get(key string) *etagEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*etagEntry)
+}
+
+func (c *etagCache) set(e *etagEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[e.key]; ok {
+		el.Value = e
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[e.key] = c.order.PushFront(e)
+	for c.order.Len() > c.max {
+		el := c.order.Back()
+		c.order.Remove(el)
+		delete(c.entries, el.Value.(*etagEntry).key)
+	}
+}
+
+// etagTransport makes conditional GET requests. It sends the ETag of the
+// last response for a URL as If-None-Match and answers a 304 Not Modified
+// with that last response, so an unchanged resource is observed as before
+// without transferring it again.
+type etagTransport struct {
+	base  http.RoundTripper
+	cache *etagCache
+
+	// instance separates the responses of different Gitlab instances and
+	// tokens.
+	instance string
+}
+
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("If-None-Match") != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	key := t.instance + " " + req.URL.String()
+	cached := t.cache.get(key)
+	if cached != nil {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return notModifiedResponse(req, resp, cached), nil
+	case resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "" && resp.ContentLength <= maxETagBodySize:
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxETagBodySize+1))
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(body) <= maxETagBodySize {
+			t.cache.set(&etagEntry{key: key, etag: resp.Header.Get("ETag"), header: resp.Header.Clone(), body: body})
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return resp, nil
+}
+
+// notModifiedResponse returns the cached response, updated with the headers
+// of the 304 Not Modified response, e.g. its rate limit and request ID.
+func notModifiedResponse(req *http.Request, resp *http.Response, cached *etagEntry) *http.Response {
+	header := cached.header.Clone()
+	for k, v := range resp.Header {
+		header[k] = v
+	}
+	header.Set("Content-Length", strconv.Itoa(len(cached.body)))
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         resp.Proto,
+		ProtoMajor:    resp.ProtoMajor,
+		ProtoMinor:    resp.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(cached.body)),
+		ContentLength: int64(len(cached.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestETagTransport(t *testing.T) {
+	ifNoneMatch := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == `W/"1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `W/"1"`)
+		_, _ = w.Write([]byte(`{"id": 1, "name": "p"}`))
+	}))
+	defer srv.Close()
+
+	git := NewClient(Config{BaseURL: srv.URL, Token: "t", ConditionalRequests: true})
+	for i := 0; i < 2; i++ {
+		prj, res, err := git.Projects.GetProject(1, nil)
+		if err != nil {
+			t.Fatalf("GetProject %d: %v", i, err)
+		}
+		if diff := cmp.Diff(http.StatusOK, res.StatusCode); diff != "" {
+			t.Errorf("GetProject %d: status: -want, +got:\n%s", i, diff)
+		}
+		if diff := cmp.Diff("p", prj.Name); diff != "" {
+			t.Errorf("GetProject %d: name: -want, +got:\n%s", i, diff)
+		}
+	}
+	if diff := cmp.Diff([]string{"", `W/"1"`}, ifNoneMatch); diff != "" {
+		t.Errorf("If-None-Match: -want, +got:\n%s", diff)
+	}
+}
+
+func TestETagCache(t *testing.T) {
+	c := newETagCache(2)
+	c.set(&etagEntry{key: "a", etag: "1"})
+	c.set(&etagEntry{key: "b", etag: "1"})
+	c.get("a")
+	c.set(&etagEntry{key: "c", etag: "1"})
+
+	got := map[string]bool{}
+	for _, k := range []string{"a", "b", "c"} {
+		got[k] = c.get(k) != nil
+	}
+	if diff := cmp.Diff(map[string]bool{"a": true, "b": false, "c": true}, got); diff != "" {
+		t.Errorf("cached: -want, +got:\n%s", diff)
+	}
+}
@@ -18,8 +18,14 @@ package clients
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -37,6 +43,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 // Config provides gitlab configurations for the Gitlab client
@@ -44,26 +51,87 @@ type Config struct {
 	Token              string
 	BaseURL            string
 	InsecureSkipVerify bool
+
+	// APIPath is the path of the API relative to BaseURL, DefaultAPIPath
+	// if empty.
+	APIPath string
+
+	// Version of Gitlab, nil if unknown.
+	Version *Version
+
+	// ConditionalRequests makes GET requests conditional on the ETag of
+	// the last response for the same URL.
+	ConditionalRequests bool
+
+	// Retry configures the retries of requests, nil for the retries of the
+	// Gitlab client.
+	Retry *RetryPolicy
+
+	// Sudo is the user requests are made as, the owner of Token if empty.
+	Sudo string
+}
+
+// CacheKey identifies the Gitlab instance and the token of c, so cached
+// responses are not shared between instances or differently privileged
+// tokens.
+func (c Config) CacheKey() string {
+	sum := sha256.Sum256([]byte(c.Token))
+	key := c.BaseURL + "#" + hex.EncodeToString(sum[:8])
+	if c.Sudo != "" {
+		key += "@" + c.Sudo
+	}
+	return key
+}
+
+// IsSaaS returns true if baseURL is the URL of gitlab.com, where tokens
+// cannot have administrator privileges. An empty baseURL defaults to
+// gitlab.com.
+func IsSaaS(baseURL string) bool {
+	if baseURL == "" {
+		return true
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return false
+	}
+	h := strings.ToLower(u.Hostname())
+	return h == "gitlab.com" || h == "www.gitlab.com"
 }
 
 // NewClient creates new Gitlab Client with provided Gitlab Configurations/Credentials.
+// Requests pass through a circuit breaker shared by all clients of the same
+// Gitlab instance, so an unavailable instance is not polled by every
+// controller.
 func NewClient(c Config) *gitlab.Client {
 	options := []gitlab.ClientOptionFunc{}
 	if c.BaseURL != "" {
 		options = append(options, gitlab.WithBaseURL(c.BaseURL))
 	}
+	transport := cleanhttp.DefaultPooledTransport()
 	if c.InsecureSkipVerify {
-		transport := cleanhttp.DefaultPooledTransport()
 		if transport.TLSClientConfig == nil {
 			transport.TLSClientConfig = &tls.Config{
 				MinVersion: tls.VersionTLS12,
 			}
 		}
 		transport.TLSClientConfig.InsecureSkipVerify = true
-		httpclient := &http.Client{
-			Transport: transport,
-		}
-		options = append(options, gitlab.WithHTTPClient(httpclient))
+	}
+	api, err := newAPITransport(&auditTransport{base: newBreakerTransport(transport, c.BaseURL)}, c.BaseURL, c.APIPath, c.Version)
+	if err != nil {
+		panic(err)
+	}
+	if c.Sudo != "" {
+		api = &sudoTransport{base: api, user: c.Sudo}
+	}
+	if c.ConditionalRequests {
+		api = &etagTransport{base: api, cache: etags, instance: c.CacheKey()}
+	}
+	httpclient := &http.Client{
+		Transport: tracing.NewTransport(&requestIDTransport{base: &changesTransport{base: api}}),
+	}
+	options = append(options, gitlab.WithHTTPClient(httpclient))
+	if c.Retry != nil {
+		options = append(options, c.Retry.clientOptions()...)
 	}
 	cl, err := gitlab.NewClient(c.Token, options...)
 	if err != nil {
@@ -95,6 +163,45 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	cfg, err := ConfigFromProviderConfig(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	if cr, ok := mg.(CredentialsReferencer); ok && cr.GetCredentialsSecretRef() != nil {
+		token, err := getSecretKey(ctx, c, *cr.GetCredentialsSecretRef())
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot get credentials secret of managed resource")
+		}
+		cfg.Token = token
+	}
+	if v, ok := mg.GetAnnotations()[AnnotationKeySudo]; ok {
+		cfg.Sudo = v
+	}
+	return cfg, nil
+}
+
+// A CredentialsReferencer may reference a secret holding the token to
+// authenticate to Gitlab with instead of the token of its ProviderConfig.
+type CredentialsReferencer interface {
+	GetCredentialsSecretRef() *xpv1.SecretKeySelector
+}
+
+// getSecretKey returns the value of the key of a secret.
+func getSecretKey(ctx context.Context, c client.Reader, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", err
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf("secret %s/%s has no key %s", ref.Namespace, ref.Name, ref.Key)
+	}
+	return string(v), nil
+}
+
+// ConfigFromProviderConfig produces a config that can be used to
+// authenticate to Gitlab with the credentials of pc.
+func ConfigFromProviderConfig(ctx context.Context, c client.Reader, pc *v1beta1.ProviderConfig) (*Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceSecret:
 		csr := pc.Spec.Credentials.SecretRef
@@ -105,16 +212,62 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		if err := c.Get(ctx, types.NamespacedName{Namespace: csr.Namespace, Name: csr.Name}, s); err != nil {
 			return nil, errors.Wrap(err, "cannot get credentials secret")
 		}
-		return &Config{
-			BaseURL:            pc.Spec.BaseURL,
-			Token:              string(s.Data[csr.Key]),
-			InsecureSkipVerify: ptr.Deref(pc.Spec.InsecureSkipVerify, false),
-		}, nil
+		cfg := &Config{
+			BaseURL:             pc.Spec.BaseURL,
+			Token:               string(s.Data[csr.Key]),
+			InsecureSkipVerify:  ptr.Deref(pc.Spec.InsecureSkipVerify, false),
+			APIPath:             ptr.Deref(pc.Spec.APIPath, ""),
+			ConditionalRequests: ptr.Deref(pc.Spec.ConditionalRequests, false),
+			Retry:               GenerateRetryPolicy(pc.Spec.Retry),
+			Sudo:                ptr.Deref(pc.Spec.Sudo, ""),
+		}
+		if pc.Spec.Version != nil {
+			v, err := ParseVersion(*pc.Spec.Version)
+			if err != nil {
+				return nil, err
+			}
+			cfg.Version = &v
+		}
+		return cfg, nil
 	default:
 		return nil, errors.Errorf("credentials source %s is not currently supported", s)
 	}
 }
 
+// CheckConnectivity requests the version of Gitlab, which requires a valid
+// token, and returns an error if the request fails.
+func CheckConnectivity(ctx context.Context, git *gitlab.Client) error {
+	req, err := git.NewRequest(http.MethodHead, "version", nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = git.Do(req, nil)
+	return err
+}
+
+// GetToken returns the metadata of the token git authenticates with.
+func GetToken(ctx context.Context, git *gitlab.Client) (*gitlab.PersonalAccessToken, error) {
+	t, _, err := git.PersonalAccessTokens.GetSinglePersonalAccessToken(gitlab.WithContext(ctx))
+	return t, err
+}
+
+// GenerateTokenStatus produces a TokenStatus from the metadata of a token.
+func GenerateTokenStatus(t *gitlab.PersonalAccessToken) *v1beta1.TokenStatus {
+	if t == nil {
+		return nil
+	}
+	ts := &v1beta1.TokenStatus{
+		ID:     t.ID,
+		Name:   t.Name,
+		Scopes: t.Scopes,
+		Active: t.Active,
+	}
+	if t.ExpiresAt != nil {
+		ts.ExpiresAt = &metav1.Time{Time: time.Time(*t.ExpiresAt)}
+	}
+	return ts
+}
+
 // LateInitializeStringPtr returns `from` if `in` is nil and `from` is non-empty,
 // in other cases it returns `in`.
 func LateInitializeStringPtr(in *string, from string) *string {
@@ -224,14 +377,38 @@ func IsStringEqualToStringPtr(sp *string, s string) bool {
 	return true
 }
 
+// DescribeToken returns a description of a token for events, so its
+// metadata is kept after the managed resource is gone.
+func DescribeToken(kind, id, name string, scopes []string, expiresAt *metav1.Time) string {
+	d := fmt.Sprintf("%s %s (name: %s, scopes: %s", kind, id, name, strings.Join(scopes, ","))
+	if expiresAt != nil {
+		d += ", expires at: " + expiresAt.UTC().Format(time.DateOnly)
+	}
+	return d + ")"
+}
+
 // IsResponseNotFound returns true of Gitlab Response indicates CR was not found
 func IsResponseNotFound(res *gitlab.Response) bool {
-	if res != nil && res.StatusCode == 404 {
+	if res != nil && res.Response != nil && res.StatusCode == 404 {
 		return true
 	}
 	return false
 }
 
+// ParseID converts a GitLab project or group identifier, which is either
+// an int ID or a string path, into an escaped path segment for API requests
+// the go-gitlab client does not cover.
+func ParseID(id interface{}) (string, error) {
+	switch v := id.(type) {
+	case int:
+		return strconv.Itoa(v), nil
+	case string:
+		return gitlab.PathEscape(v), nil
+	default:
+		return "", errors.Errorf("invalid ID type %#v, the ID must be an int or a string", id)
+	}
+}
+
 // TimeToMetaTime returns nil if parameter is nil, otherwise metav1.Time value
 func TimeToMetaTime(t *time.Time) *metav1.Time {
 	if t == nil {
@@ -239,3 +416,22 @@ func TimeToMetaTime(t *time.Time) *metav1.Time {
 	}
 	return &metav1.Time{Time: *t}
 }
+
+// ParseDate converts a date in the format YEAR-MONTH-DAY into a
+// gitlab.ISOTime. It returns nil if d is nil.
+func ParseDate(d *string) (*gitlab.ISOTime, error) {
+	if d == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(time.DateOnly, *d)
+	if err != nil {
+		return nil, err
+	}
+	date := gitlab.ISOTime(t)
+	return &date, nil
+}
+
+// IsDateBefore returns true if d is a day before the day of now in UTC.
+func IsDateBefore(d *gitlab.ISOTime, now time.Time) bool {
+	return d != nil && d.String() < now.UTC().Format(time.DateOnly)
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+func TestDescribeToken(t *testing.T) {
+	type args struct {
+		id        string
+		name      string
+		scopes    []string
+		expiresAt *metav1.Time
+	}
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"NoExpiry": {
+			args: args{id: "1", name: "ci", scopes: []string{"api", "read_api"}},
+			want: "access token 1 (name: ci, scopes: api,read_api)",
+		},
+		"Expiry": {
+			args: args{id: "1", name: "ci", scopes: []string{"api"}, expiresAt: &metav1.Time{Time: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}},
+			want: "access token 1 (name: ci, scopes: api, expires at: 2024-06-01)",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DescribeToken("access token", tc.args.id, tc.args.name, tc.args.scopes, tc.args.expiresAt)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSaaS(t *testing.T) {
+	cases := map[string]struct {
+		baseURL string
+		want    bool
+	}{
+		"Default": {
+			baseURL: "",
+			want:    true,
+		},
+		"GitlabCom": {
+			baseURL: "https://gitlab.com/",
+			want:    true,
+		},
+		"GitlabComAPI": {
+			baseURL: "https://GitLab.com/api/v4",
+			want:    true,
+		},
+		"SelfManaged": {
+			baseURL: "https://gitlab.example.com/",
+			want:    false,
+		},
+		"SubdomainOfGitlabCom": {
+			baseURL: "https://dedicated.gitlab.com/",
+			want:    false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsSaaS(tc.baseURL)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDateBefore(t *testing.T) {
+	now := time.Date(2024, 6, 1, 23, 0, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) *gitlab.ISOTime {
+		t := gitlab.ISOTime(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+		return &t
+	}
+	cases := map[string]struct {
+		date *gitlab.ISOTime
+		want bool
+	}{
+		"NoDate": {
+			want: false,
+		},
+		"DayBefore": {
+			date: date(2024, 5, 31),
+			want: true,
+		},
+		"SameDay": {
+			date: date(2024, 6, 1),
+			want: false,
+		},
+		"DayAfter": {
+			date: date(2024, 6, 2),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsDateBefore(tc.date, now)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUseProviderConfig(t *testing.T) {
+	secrets := map[string]map[string][]byte{
+		"provider": {"token": []byte("provider-token")},
+		"bot":      {"token": []byte("bot-token")},
+	}
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.ProviderConfig:
+				o.Spec.BaseURL = "https://gitlab.example.com/"
+				o.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+				o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "provider", Namespace: "ns"}, Key: "token"}
+			case *corev1.Secret:
+				o.Data = secrets[key.Name]
+			}
+			return nil
+		},
+		MockCreate: test.NewMockCreateFn(nil),
+		MockUpdate: test.NewMockUpdateFn(nil),
+	}
+	hook := func(ref *xpv1.SecretKeySelector, annotations map[string]string) *v1alpha1.Hook {
+		h := &v1alpha1.Hook{}
+		h.SetAnnotations(annotations)
+		h.SetProviderConfigReference(&xpv1.Reference{Name: "pc"})
+		h.Spec.ForProvider.CredentialsSecretRef = ref
+		return h
+	}
+
+	cases := map[string]struct {
+		ref         *xpv1.SecretKeySelector
+		annotations map[string]string
+		token       string
+		sudo        string
+		err         error
+	}{
+		"ProviderConfigToken": {
+			token: "provider-token",
+		},
+		"ManagedResourceToken": {
+			ref:   &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "bot", Namespace: "ns"}, Key: "token"},
+			token: "bot-token",
+		},
+		"ManagedResourceSudo": {
+			annotations: map[string]string{AnnotationKeySudo: "service-user"},
+			token:       "provider-token",
+			sudo:        "service-user",
+		},
+		"MissingKey": {
+			ref: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "bot", Namespace: "ns"}, Key: "other"},
+			err: errors.Wrap(errors.New("secret ns/bot has no key other"), "cannot get credentials secret of managed resource"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := UseProviderConfig(context.Background(), kube, hook(tc.ref, tc.annotations))
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.token, cfg.Token); diff != "" {
+				t.Errorf("token: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.sudo, cfg.Sudo); diff != "" {
+				t.Errorf("sudo: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff("https://gitlab.example.com/", cfg.BaseURL); diff != "" {
+				t.Errorf("base URL: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
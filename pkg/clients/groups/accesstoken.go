@@ -17,9 +17,14 @@ limitations under the License.
 package groups
 
 import (
+	"fmt"
+	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
@@ -30,6 +35,15 @@ type AccessTokenClient interface {
 	GetGroupAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
 	CreateGroupAccessToken(pid interface{}, opt *gitlab.CreateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
 	RevokeGroupAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetInheritedGroupMember(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
+	clients.ProviderTokenClient
+}
+
+type accessTokenClient struct {
+	*gitlab.GroupAccessTokensService
+	*gitlab.PersonalAccessTokensService
+	*gitlab.UsersService
+	git *gitlab.Client
 }
 
 // IsErrorGroupAccessTokenNotFound helper function to test for errGroupAccessTokenNotFound error.
@@ -43,7 +57,59 @@ func IsErrorGroupAccessTokenNotFound(err error) bool {
 // NewAccessTokenClient returns a new Gitlab GroupAccessToken service
 func NewAccessTokenClient(cfg clients.Config) AccessTokenClient {
 	git := clients.NewClient(cfg)
-	return git.GroupAccessTokens
+	return &accessTokenClient{
+		GroupAccessTokensService:    git.GroupAccessTokens,
+		PersonalAccessTokensService: git.PersonalAccessTokens,
+		UsersService:                git.Users,
+		git:                         git,
+	}
+}
+
+// GetInheritedGroupMember gets a member of a group, including members that
+// inherit their membership from an ancestor group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#get-a-member-of-a-group-or-project-including-inherited-and-invited-members
+func (c *accessTokenClient) GetInheritedGroupMember(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
+	group, err := clients.ParseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.git.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/members/all/%d", group, user), nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(gitlab.GroupMember)
+	resp, err := c.git.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+	return m, resp, nil
+}
+
+// InsufficientProviderToken returns why the provider token cannot create
+// the group access token p, or "" if it can. Creating group access tokens
+// requires the Owner role.
+func InsufficientProviderToken(c AccessTokenClient, p *v1alpha1.AccessTokenParameters, options ...gitlab.RequestOptionFunc) (string, error) {
+	member := func(user int) (gitlab.AccessLevelValue, *gitlab.Response, error) {
+		m, res, err := c.GetInheritedGroupMember(*p.GroupID, user, options...)
+		if err != nil {
+			return gitlab.NoPermissions, res, err
+		}
+		return m.AccessLevel, res, nil
+	}
+	return clients.InsufficientProviderToken(c, member, gitlab.OwnerPermissions, requestedAccessLevel(p.AccessLevel), options...)
+}
+
+// requestedAccessLevel returns the access level of an access token, which
+// Gitlab defaults to Maintainer.
+func requestedAccessLevel(l *v1alpha1.AccessLevelValue) gitlab.AccessLevelValue {
+	if l == nil {
+		return gitlab.MaintainerPermissions
+	}
+	return gitlab.AccessLevelValue(*l)
 }
 
 // GenerateCreateGroupAccessTokenOptions generates project creation options
@@ -63,3 +129,46 @@ func GenerateCreateGroupAccessTokenOptions(name string, p *v1alpha1.AccessTokenP
 
 	return accesstoken
 }
+
+// IsAccessTokenUpToDate checks whether the scopes, access level or expiry of
+// the access token drifted from the spec, or whether it was revoked.
+func IsAccessTokenUpToDate(p *v1alpha1.AccessTokenParameters, at *gitlab.GroupAccessToken) bool {
+	if at.Revoked {
+		return false
+	}
+	if !isScopesEqual(p.Scopes, at.Scopes) {
+		return false
+	}
+	if p.AccessLevel != nil && int(*p.AccessLevel) != int(at.AccessLevel) {
+		return false
+	}
+	return isExpiryEqual(p.ExpiresAt, at.ExpiresAt)
+}
+
+func isScopesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string(nil), a...)
+	y := append([]string(nil), b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isExpiryEqual compares expiry dates with day precision, as Gitlab stores
+// them.
+func isExpiryEqual(want *metav1.Time, got *gitlab.ISOTime) bool {
+	if want == nil {
+		return true
+	}
+	if got == nil {
+		return false
+	}
+	return want.UTC().Format(time.DateOnly) == time.Time(*got).UTC().Format(time.DateOnly)
+}
@@ -63,3 +63,9 @@ func GenerateCreateGroupDeployTokenOptions(name string, p *v1alpha1.DeployTokenP
 
 	return deploytoken
 }
+
+// IsDeployTokenUpToDate checks whether the scopes of the deploy token match
+// the spec, regardless of their order.
+func IsDeployTokenUpToDate(p *v1alpha1.DeployTokenParameters, dt *gitlab.DeployToken) bool {
+	return isScopesEqual(p.Scopes, dt.Scopes)
+}
@@ -78,3 +78,35 @@ func TestGenerateCreateGroupDeployTokenOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDeployTokenUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		scopes []string
+		token  *gitlab.DeployToken
+		want   bool
+	}{
+		"SameOrder": {
+			scopes: []string{"read_repository", "read_registry"},
+			token:  &gitlab.DeployToken{Scopes: []string{"read_repository", "read_registry"}},
+			want:   true,
+		},
+		"Reordered": {
+			scopes: []string{"read_registry", "read_repository"},
+			token:  &gitlab.DeployToken{Scopes: []string{"read_repository", "read_registry"}},
+			want:   true,
+		},
+		"Changed": {
+			scopes: []string{"read_repository", "write_registry"},
+			token:  &gitlab.DeployToken{Scopes: []string{"read_repository", "read_registry"}},
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDeployTokenUpToDate(&v1alpha1.DeployTokenParameters{Scopes: tc.scopes}, tc.token)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
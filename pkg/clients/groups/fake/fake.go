@@ -39,22 +39,49 @@ type MockClient struct {
 	MockAddMember    func(gid interface{}, opt *gitlab.AddGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
 	MockEditMember   func(gid interface{}, user int, opt *gitlab.EditGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
 	MockRemoveMember func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockListMembers  func(gid interface{}, opt *gitlab.ListGroupMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error)
 
 	MockGetGroupDeployToken    func(gid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error)
 	MockCreateGroupDeployToken func(gid interface{}, opt *gitlab.CreateGroupDeployTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error)
 	MockDeleteGroupDeployToken func(gid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
-	MockGetGroupAccessToken    func(gid interface{}, accessToken int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
-	MockCreateGroupAccessToken func(gid interface{}, opt *gitlab.CreateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
-	MockRevokeGroupAccessToken func(gid interface{}, accessToken int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetGroupAccessToken     func(gid interface{}, accessToken int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
+	MockCreateGroupAccessToken  func(gid interface{}, opt *gitlab.CreateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
+	MockRevokeGroupAccessToken  func(gid interface{}, accessToken int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetInheritedGroupMember func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
+
+	MockGetSinglePersonalAccessToken func(options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	MockCurrentUser                  func(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
 
 	MockListGroupVariables  func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error)
-	MockGetGroupVariable    func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
-	MockCreateGroupVariable func(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
-	MockUpdateGroupVariable func(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
-	MockRemoveGroupVariable func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetGroupVariable    func(gid interface{}, key string, opt *groups.GetVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error)
+	MockCreateGroupVariable func(gid interface{}, opt *groups.CreateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error)
+	MockUpdateGroupVariable func(gid interface{}, key string, opt *groups.UpdateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error)
+	MockRemoveGroupVariable func(gid interface{}, key string, opt *groups.RemoveVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockListGroupLabels  func(gid interface{}, opt *gitlab.ListGroupLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupLabel, *gitlab.Response, error)
+	MockCreateGroupLabel func(gid interface{}, opt *gitlab.CreateGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupLabel, *gitlab.Response, error)
+	MockUpdateGroupLabel func(gid interface{}, opt *gitlab.UpdateGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupLabel, *gitlab.Response, error)
+	MockDeleteGroupLabel func(gid interface{}, opt *gitlab.DeleteGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockListLabels       func(pid interface{}, opt *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error)
+	MockCreateLabel      func(pid interface{}, opt *gitlab.CreateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error)
+	MockUpdateLabel      func(pid interface{}, opt *gitlab.UpdateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error)
+
+	MockGetGroupProtectedEnvironment    func(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error)
+	MockProtectGroupEnvironment         func(gid interface{}, opt *gitlab.ProtectRepositoryEnvironmentsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error)
+	MockUpdateGroupProtectedEnvironment func(gid interface{}, environment string, opt *groups.UpdateProtectedEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error)
+	MockUnprotectGroupEnvironment       func(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockListGroupMilestones     func(gid interface{}, opt *gitlab.ListGroupMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMilestone, *gitlab.Response, error)
+	MockGetGroupMilestone       func(gid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error)
+	MockCreateGroupMilestone    func(gid interface{}, opt *gitlab.CreateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error)
+	MockUpdateGroupMilestone    func(gid interface{}, milestone int, opt *gitlab.UpdateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error)
+	MockDeleteGroupMilestone    func(gid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetGroupMilestoneIssues func(gid interface{}, milestone int, opt *gitlab.GetGroupMilestoneIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error)
+	MockUpdateIssue             func(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
 
 	MockListUsers func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	MockGetUser   func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
 }
 
 // GetGroup calls the underlying MockGetGroup method.
@@ -107,6 +134,11 @@ func (c *MockClient) RemoveGroupMember(gid interface{}, user int, opt *gitlab.Re
 	return c.MockRemoveMember(gid, user)
 }
 
+// ListGroupMembers calls the underlying MockListMembers method.
+func (c *MockClient) ListGroupMembers(gid interface{}, opt *gitlab.ListGroupMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error) {
+	return c.MockListMembers(gid, opt)
+}
+
 // GetGroupDeployToken calls the underlying MockGetGroupDeployToken method.
 func (c *MockClient) GetGroupDeployToken(gid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error) {
 	return c.MockGetGroupDeployToken(gid, deployToken)
@@ -137,32 +169,142 @@ func (c *MockClient) RevokeGroupAccessToken(gid interface{}, deployToken int, op
 	return c.MockRevokeGroupAccessToken(gid, deployToken)
 }
 
+// GetInheritedGroupMember calls the underlying MockGetInheritedGroupMember method.
+func (c *MockClient) GetInheritedGroupMember(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
+	return c.MockGetInheritedGroupMember(gid, user)
+}
+
+// GetSinglePersonalAccessToken calls the underlying MockGetSinglePersonalAccessToken method.
+func (c *MockClient) GetSinglePersonalAccessToken(options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return c.MockGetSinglePersonalAccessToken()
+}
+
+// CurrentUser calls the underlying MockCurrentUser method.
+func (c *MockClient) CurrentUser(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+	return c.MockCurrentUser()
+}
+
 // ListVariables calls the underlying MockListGroupVariables method.
 func (c *MockClient) ListVariables(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
 	return c.MockListGroupVariables(gid, opt)
 }
 
 // GetVariable calls the underlying MockGetGrouptVariable method.
-func (c *MockClient) GetVariable(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-	return c.MockGetGroupVariable(gid, key)
+func (c *MockClient) GetVariable(gid interface{}, key string, opt *groups.GetVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+	return c.MockGetGroupVariable(gid, key, opt)
 }
 
 // CreateVariable calls the underlying MockCreateGroupVariable method.
-func (c *MockClient) CreateVariable(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+func (c *MockClient) CreateVariable(gid interface{}, opt *groups.CreateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
 	return c.MockCreateGroupVariable(gid, opt)
 }
 
 // UpdateVariable calls the underlying MockUpdateGroupVariable method.
-func (c *MockClient) UpdateVariable(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+func (c *MockClient) UpdateVariable(gid interface{}, key string, opt *groups.UpdateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
 	return c.MockUpdateGroupVariable(gid, key, opt)
 }
 
 // RemoveVariable calls the underlying MockRemoveGroupVariable method.
-func (c *MockClient) RemoveVariable(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
-	return c.MockRemoveGroupVariable(gid, key)
+func (c *MockClient) RemoveVariable(gid interface{}, key string, opt *groups.RemoveVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockRemoveGroupVariable(gid, key, opt)
 }
 
 // ListUsers calls the underlying MockListUsers method.
 func (c *MockClient) ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error) {
 	return c.MockListUsers(opt)
 }
+
+// GetUser calls the underlying MockGetUser method.
+func (c *MockClient) GetUser(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+	return c.MockGetUser(user, opt)
+}
+
+// ListGroupLabels calls the underlying MockListGroupLabels method.
+func (c *MockClient) ListGroupLabels(gid interface{}, opt *gitlab.ListGroupLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupLabel, *gitlab.Response, error) {
+	return c.MockListGroupLabels(gid, opt)
+}
+
+// CreateGroupLabel calls the underlying MockCreateGroupLabel method.
+func (c *MockClient) CreateGroupLabel(gid interface{}, opt *gitlab.CreateGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupLabel, *gitlab.Response, error) {
+	return c.MockCreateGroupLabel(gid, opt)
+}
+
+// UpdateGroupLabel calls the underlying MockUpdateGroupLabel method.
+func (c *MockClient) UpdateGroupLabel(gid interface{}, opt *gitlab.UpdateGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupLabel, *gitlab.Response, error) {
+	return c.MockUpdateGroupLabel(gid, opt)
+}
+
+// DeleteGroupLabel calls the underlying MockDeleteGroupLabel method.
+func (c *MockClient) DeleteGroupLabel(gid interface{}, opt *gitlab.DeleteGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteGroupLabel(gid, opt)
+}
+
+// ListLabels calls the underlying MockListLabels method.
+func (c *MockClient) ListLabels(pid interface{}, opt *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+	return c.MockListLabels(pid, opt)
+}
+
+// CreateLabel calls the underlying MockCreateLabel method.
+func (c *MockClient) CreateLabel(pid interface{}, opt *gitlab.CreateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error) {
+	return c.MockCreateLabel(pid, opt)
+}
+
+// UpdateLabel calls the underlying MockUpdateLabel method.
+func (c *MockClient) UpdateLabel(pid interface{}, opt *gitlab.UpdateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error) {
+	return c.MockUpdateLabel(pid, opt)
+}
+
+// GetGroupProtectedEnvironment calls the underlying MockGetGroupProtectedEnvironment method.
+func (c *MockClient) GetGroupProtectedEnvironment(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+	return c.MockGetGroupProtectedEnvironment(gid, environment)
+}
+
+// ProtectGroupEnvironment calls the underlying MockProtectGroupEnvironment method.
+func (c *MockClient) ProtectGroupEnvironment(gid interface{}, opt *gitlab.ProtectRepositoryEnvironmentsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+	return c.MockProtectGroupEnvironment(gid, opt)
+}
+
+// UpdateGroupProtectedEnvironment calls the underlying MockUpdateGroupProtectedEnvironment method.
+func (c *MockClient) UpdateGroupProtectedEnvironment(gid interface{}, environment string, opt *groups.UpdateProtectedEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+	return c.MockUpdateGroupProtectedEnvironment(gid, environment, opt)
+}
+
+// UnprotectGroupEnvironment calls the underlying MockUnprotectGroupEnvironment method.
+func (c *MockClient) UnprotectGroupEnvironment(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockUnprotectGroupEnvironment(gid, environment)
+}
+
+// ListGroupMilestones calls the underlying MockListGroupMilestones method.
+func (c *MockClient) ListGroupMilestones(gid interface{}, opt *gitlab.ListGroupMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMilestone, *gitlab.Response, error) {
+	return c.MockListGroupMilestones(gid, opt)
+}
+
+// GetGroupMilestone calls the underlying MockGetGroupMilestone method.
+func (c *MockClient) GetGroupMilestone(gid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+	return c.MockGetGroupMilestone(gid, milestone)
+}
+
+// CreateGroupMilestone calls the underlying MockCreateGroupMilestone method.
+func (c *MockClient) CreateGroupMilestone(gid interface{}, opt *gitlab.CreateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+	return c.MockCreateGroupMilestone(gid, opt)
+}
+
+// UpdateGroupMilestone calls the underlying MockUpdateGroupMilestone method.
+func (c *MockClient) UpdateGroupMilestone(gid interface{}, milestone int, opt *gitlab.UpdateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+	return c.MockUpdateGroupMilestone(gid, milestone, opt)
+}
+
+// DeleteGroupMilestone calls the underlying MockDeleteGroupMilestone method.
+func (c *MockClient) DeleteGroupMilestone(gid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteGroupMilestone(gid, milestone)
+}
+
+// GetGroupMilestoneIssues calls the underlying MockGetGroupMilestoneIssues method.
+func (c *MockClient) GetGroupMilestoneIssues(gid interface{}, milestone int, opt *gitlab.GetGroupMilestoneIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockGetGroupMilestoneIssues(gid, milestone, opt)
+}
+
+// UpdateIssue calls the underlying MockUpdateIssue method.
+func (c *MockClient) UpdateIssue(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockUpdateIssue(pid, issue, opt)
+}
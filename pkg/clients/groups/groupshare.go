@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ShareClient defines Gitlab group share service operations
+type ShareClient interface {
+	GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	ShareGroupWithGroup(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	UnshareGroupFromGroup(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewShareClient returns a new Gitlab group share service
+func NewShareClient(cfg clients.Config) ShareClient {
+	git := clients.NewClient(cfg)
+	return git.Groups
+}
+
+// GenerateGroupShareObservation is used to produce
+// v1alpha1.GroupShareObservation from the share of grp with the group
+// groupID. It returns nil if grp is not shared with the group.
+func GenerateGroupShareObservation(grp *gitlab.Group, groupID int) *v1alpha1.GroupShareObservation {
+	if grp == nil {
+		return nil
+	}
+	for _, g := range grp.SharedWithGroups {
+		if g.GroupID != groupID {
+			continue
+		}
+		o := &v1alpha1.GroupShareObservation{
+			GroupName:        g.GroupName,
+			GroupFullPath:    g.GroupFullPath,
+			GroupAccessLevel: g.GroupAccessLevel,
+		}
+		if g.ExpiresAt != nil {
+			o.ExpiresAt = g.ExpiresAt.String()
+		}
+		return o
+	}
+	return nil
+}
+
+// IsGroupShareUpToDate checks whether the access level or the expiry of the
+// share differ from the spec.
+func IsGroupShareUpToDate(p *v1alpha1.GroupShareParameters, o *v1alpha1.GroupShareObservation) bool {
+	return int(p.GroupAccess) == o.GroupAccessLevel &&
+		ptr.Deref(p.ExpiresAt, "") == o.ExpiresAt
+}
+
+// GenerateShareGroupWithGroupOptions generates group share options. It
+// fails if ExpiresAt is not a date in the format YEAR-MONTH-DAY.
+func GenerateShareGroupWithGroupOptions(groupID int, p *v1alpha1.GroupShareParameters) (*gitlab.ShareGroupWithGroupOptions, error) {
+	opt := &gitlab.ShareGroupWithGroupOptions{
+		GroupID:     &groupID,
+		GroupAccess: ptr.To(gitlab.AccessLevelValue(p.GroupAccess)),
+	}
+	if p.ExpiresAt != nil {
+		t, err := time.Parse(time.DateOnly, *p.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		opt.ExpiresAt = ptr.To(gitlab.ISOTime(t))
+	}
+	return opt, nil
+}
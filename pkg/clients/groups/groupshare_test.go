@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+func sharedGroup(groupID int, expiresAt *gitlab.ISOTime) *gitlab.Group {
+	grp := &gitlab.Group{}
+	grp.SharedWithGroups = append(grp.SharedWithGroups, struct {
+		GroupID          int             `json:"group_id"`
+		GroupName        string          `json:"group_name"`
+		GroupFullPath    string          `json:"group_full_path"`
+		GroupAccessLevel int             `json:"group_access_level"`
+		ExpiresAt        *gitlab.ISOTime `json:"expires_at"`
+	}{GroupID: groupID, GroupName: "devs", GroupFullPath: "org/devs", GroupAccessLevel: 30, ExpiresAt: expiresAt})
+	return grp
+}
+
+func TestGenerateGroupShareObservation(t *testing.T) {
+	expiresAt := gitlab.ISOTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	cases := map[string]struct {
+		grp     *gitlab.Group
+		groupID int
+		want    *v1alpha1.GroupShareObservation
+	}{
+		"NilGroup": {
+			groupID: 5,
+		},
+		"NotShared": {
+			grp:     sharedGroup(6, nil),
+			groupID: 5,
+		},
+		"Shared": {
+			grp:     sharedGroup(5, &expiresAt),
+			groupID: 5,
+			want: &v1alpha1.GroupShareObservation{
+				GroupName:        "devs",
+				GroupFullPath:    "org/devs",
+				GroupAccessLevel: 30,
+				ExpiresAt:        "2030-01-01",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateGroupShareObservation(tc.grp, tc.groupID)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsGroupShareUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.GroupShareParameters
+		o    *v1alpha1.GroupShareObservation
+		want bool
+	}{
+		"UpToDate": {
+			p:    &v1alpha1.GroupShareParameters{GroupAccess: 30, ExpiresAt: ptr.To("2030-01-01")},
+			o:    &v1alpha1.GroupShareObservation{GroupAccessLevel: 30, ExpiresAt: "2030-01-01"},
+			want: true,
+		},
+		"AccessLevelChanged": {
+			p:    &v1alpha1.GroupShareParameters{GroupAccess: 50},
+			o:    &v1alpha1.GroupShareObservation{GroupAccessLevel: 30},
+			want: false,
+		},
+		"ExpiryChanged": {
+			p:    &v1alpha1.GroupShareParameters{GroupAccess: 30, ExpiresAt: ptr.To("2031-01-01")},
+			o:    &v1alpha1.GroupShareObservation{GroupAccessLevel: 30, ExpiresAt: "2030-01-01"},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsGroupShareUpToDate(tc.p, tc.o)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateShareGroupWithGroupOptions(t *testing.T) {
+	type want struct {
+		opt *gitlab.ShareGroupWithGroupOptions
+		err bool
+	}
+
+	cases := map[string]struct {
+		p *v1alpha1.GroupShareParameters
+		want
+	}{
+		"NoExpiry": {
+			p: &v1alpha1.GroupShareParameters{GroupAccess: 30},
+			want: want{
+				opt: &gitlab.ShareGroupWithGroupOptions{GroupID: ptr.To(5), GroupAccess: ptr.To(gitlab.DeveloperPermissions)},
+			},
+		},
+		"Expiry": {
+			p: &v1alpha1.GroupShareParameters{GroupAccess: 30, ExpiresAt: ptr.To("2030-01-01")},
+			want: want{
+				opt: &gitlab.ShareGroupWithGroupOptions{
+					GroupID:     ptr.To(5),
+					GroupAccess: ptr.To(gitlab.DeveloperPermissions),
+					ExpiresAt:   ptr.To(gitlab.ISOTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))),
+				},
+			},
+		},
+		"InvalidExpiry": {
+			p: &v1alpha1.GroupShareParameters{GroupAccess: 30, ExpiresAt: ptr.To("next year")},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			opt, err := GenerateShareGroupWithGroupOptions(5, tc.p)
+			if (err != nil) != tc.want.err {
+				t.Errorf("GenerateShareGroupWithGroupOptions(...): want error %t, got %v", tc.want.err, err)
+			}
+			if diff := cmp.Diff(tc.want.opt, opt, cmp.Comparer(func(a, b gitlab.ISOTime) bool { return a.String() == b.String() })); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
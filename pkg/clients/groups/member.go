@@ -19,6 +19,7 @@ package groups
 import (
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
@@ -35,12 +36,81 @@ type MemberClient interface {
 	AddGroupMember(gid interface{}, opt *gitlab.AddGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
 	EditGroupMember(gid interface{}, user int, opt *gitlab.EditGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
 	RemoveGroupMember(gid interface{}, user int, opt *gitlab.RemoveGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	ListGroupMembers(gid interface{}, opt *gitlab.ListGroupMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error)
+}
+
+type memberClient struct {
+	*gitlab.GroupMembersService
+	groups *gitlab.GroupsService
 }
 
 // NewMemberClient returns a new Gitlab Group Member service
 func NewMemberClient(cfg clients.Config) MemberClient {
 	git := clients.NewClient(cfg)
-	return git.GroupMembers
+	return &memberClient{GroupMembersService: git.GroupMembers, groups: git.Groups}
+}
+
+// GetGroup gets the group a member belongs to.
+func (c *memberClient) GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	return c.groups.GetGroup(gid, opt, options...)
+}
+
+// ListGroupMembers lists the direct members of a group.
+func (c *memberClient) ListGroupMembers(gid interface{}, opt *gitlab.ListGroupMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error) {
+	return c.groups.ListGroupMembers(gid, opt, options...)
+}
+
+// IsLastOwner returns true if the user is the only direct member of the
+// group with the owner access level. Gitlab refuses to remove the last
+// owner of a group.
+func IsLastOwner(c MemberClient, gid, user int, options ...gitlab.RequestOptionFunc) (bool, error) {
+	owners := 0
+	isOwner := false
+	opt := &gitlab.ListGroupMembersOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		members, res, err := c.ListGroupMembers(gid, opt, options...)
+		if err != nil {
+			return false, err
+		}
+		for _, m := range members {
+			if m.AccessLevel != gitlab.OwnerPermissions {
+				continue
+			}
+			owners++
+			isOwner = isOwner || m.ID == user
+		}
+		if res == nil || res.NextPage == 0 {
+			return isOwner && owners == 1, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// GenerateRemoveMemberOptions generates group member remove options
+func GenerateRemoveMemberOptions(p *v1alpha1.MemberParameters) *gitlab.RemoveGroupMemberOptions {
+	return &gitlab.RemoveGroupMemberOptions{
+		SkipSubresources:  p.SkipSubresources,
+		UnassignIssuables: p.UnassignIssuablesOnRemove,
+	}
+}
+
+// minimalAccessSince is the Gitlab version that introduced the minimal
+// access level.
+var minimalAccessSince = clients.Version{Major: 13, Minor: 5}
+
+// ValidateMinimalAccess returns an error if members of group g cannot have
+// the minimal access level on a Gitlab instance of version v, which may be
+// unknown. Gitlab EE only offers it in top-level groups. Whether an instance
+// is EE is not known before Gitlab rejects the level.
+func ValidateMinimalAccess(g *gitlab.Group, v *clients.Version) error {
+	if v != nil && v.Before(minimalAccessSince) {
+		return errors.Errorf("the minimal access level requires Gitlab %d.%d or later", minimalAccessSince.Major, minimalAccessSince.Minor)
+	}
+	if g != nil && g.ParentID != 0 {
+		return errors.Errorf("the minimal access level is only available in top-level groups, %s is a subgroup", g.FullPath)
+	}
+	return nil
 }
 
 // IsErrorMemberNotFound helper function to test for errMemberNotFound error.
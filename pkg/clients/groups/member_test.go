@@ -17,9 +17,13 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
 
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
 var (
@@ -182,3 +186,68 @@ func TestGenerateEditMemberOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMinimalAccess(t *testing.T) {
+	cases := map[string]struct {
+		group   *gitlab.Group
+		version *clients.Version
+		want    error
+	}{
+		"TopLevelGroup": {
+			group: &gitlab.Group{FullPath: "team"},
+		},
+		"KnownVersion": {
+			group:   &gitlab.Group{FullPath: "team"},
+			version: &clients.Version{Major: 15, Minor: 0},
+		},
+		"Subgroup": {
+			group: &gitlab.Group{FullPath: "team/sub", ParentID: 1},
+			want:  errors.New("the minimal access level is only available in top-level groups, team/sub is a subgroup"),
+		},
+		"OldVersion": {
+			group:   &gitlab.Group{FullPath: "team"},
+			version: &clients.Version{Major: 13, Minor: 4},
+			want:    errors.New("the minimal access level requires Gitlab 13.5 or later"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateMinimalAccess(tc.group, tc.version)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateMinimalAccess(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRemoveMemberOptions(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.MemberParameters
+		want       *gitlab.RemoveGroupMemberOptions
+	}{
+		"NoOptions": {
+			parameters: &v1alpha1.MemberParameters{},
+			want:       &gitlab.RemoveGroupMemberOptions{},
+		},
+		"AllOptions": {
+			parameters: &v1alpha1.MemberParameters{
+				SkipSubresources:          gitlab.Bool(true),
+				UnassignIssuablesOnRemove: gitlab.Bool(true),
+			},
+			want: &gitlab.RemoveGroupMemberOptions{
+				SkipSubresources:  gitlab.Bool(true),
+				UnassignIssuables: gitlab.Bool(true),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRemoveMemberOptions(tc.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
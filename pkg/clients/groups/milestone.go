@@ -0,0 +1,258 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	// MilestoneStateActive is the state of open milestones.
+	MilestoneStateActive = "active"
+	// MilestoneStateClosed is the state of closed milestones.
+	MilestoneStateClosed = "closed"
+
+	// MilestoneStateEventActivate reopens a closed milestone.
+	MilestoneStateEventActivate = "activate"
+	// MilestoneStateEventClose closes an active milestone.
+	MilestoneStateEventClose = "close"
+
+	// IssueStateOpened is the state of issues that are not closed.
+	IssueStateOpened = "opened"
+)
+
+// MilestoneClient defines Gitlab group milestone service operations, and
+// the issue service operation moving issues to another milestone.
+type MilestoneClient interface {
+	ListGroupMilestones(gid interface{}, opt *gitlab.ListGroupMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMilestone, *gitlab.Response, error)
+	GetGroupMilestone(gid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error)
+	CreateGroupMilestone(gid interface{}, opt *gitlab.CreateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error)
+	UpdateGroupMilestone(gid interface{}, milestone int, opt *gitlab.UpdateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error)
+	DeleteGroupMilestone(gid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetGroupMilestoneIssues(gid interface{}, milestone int, opt *gitlab.GetGroupMilestoneIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error)
+	UpdateIssue(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+}
+
+type milestoneClient struct {
+	*gitlab.GroupMilestonesService
+	*gitlab.IssuesService
+	git *gitlab.Client
+}
+
+// NewMilestoneClient returns a new Gitlab group milestone and issue service
+func NewMilestoneClient(cfg clients.Config) MilestoneClient {
+	git := clients.NewClient(cfg)
+	return &milestoneClient{GroupMilestonesService: git.GroupMilestones, IssuesService: git.Issues, git: git}
+}
+
+// DeleteGroupMilestone deletes a group milestone, which the go-gitlab client
+// does not cover.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_milestones.html#delete-group-milestone
+func (c *milestoneClient) DeleteGroupMilestone(gid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	group, err := clients.ParseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.git.NewRequest(http.MethodDelete, fmt.Sprintf("groups/%s/milestones/%d", group, milestone), nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return c.git.Do(req, nil)
+}
+
+// isDateEqual returns true if want is not set or is the date d.
+func isDateEqual(want *string, d *gitlab.ISOTime) bool {
+	if want == nil {
+		return true
+	}
+	return d != nil && *want == d.String()
+}
+
+// IsMilestoneUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsMilestoneUpToDate(p *v1alpha1.MilestoneParameters, m *gitlab.GroupMilestone) bool {
+	if p.Title != m.Title {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Description, m.Description) {
+		return false
+	}
+	if !isDateEqual(p.StartDate, m.StartDate) || !isDateEqual(p.DueDate, m.DueDate) {
+		return false
+	}
+	return clients.IsStringEqualToStringPtr(p.State, m.State)
+}
+
+// IsMilestoneDue returns true if the auto close policy of p applies to m,
+// which is the case if m is still active a day after its due date.
+func IsMilestoneDue(p *v1alpha1.MilestoneParameters, m *gitlab.GroupMilestone, now time.Time) bool {
+	return p.AutoClosePolicy != nil && p.State == nil &&
+		m.State == MilestoneStateActive && clients.IsDateBefore(m.DueDate, now)
+}
+
+// LateInitializeMilestone fills the empty fields in the milestone spec with
+// the values seen in gitlab.GroupMilestone.
+func LateInitializeMilestone(in *v1alpha1.MilestoneParameters, m *gitlab.GroupMilestone) {
+	if m == nil {
+		return
+	}
+	in.Description = clients.LateInitializeStringPtr(in.Description, m.Description)
+	if in.StartDate == nil && m.StartDate != nil {
+		in.StartDate = ptr.To(m.StartDate.String())
+	}
+	if in.DueDate == nil && m.DueDate != nil {
+		in.DueDate = ptr.To(m.DueDate.String())
+	}
+}
+
+// GenerateMilestoneObservation is used to produce
+// v1alpha1.MilestoneObservation from gitlab.GroupMilestone.
+func GenerateMilestoneObservation(m *gitlab.GroupMilestone) v1alpha1.MilestoneObservation {
+	if m == nil {
+		return v1alpha1.MilestoneObservation{}
+	}
+	return v1alpha1.MilestoneObservation{
+		ID:        m.ID,
+		IID:       m.IID,
+		State:     m.State,
+		CreatedAt: clients.TimeToMetaTime(m.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(m.UpdatedAt),
+	}
+}
+
+// GenerateCreateMilestoneOptions generates milestone creation options. It
+// fails if StartDate or DueDate is not a date in the format YEAR-MONTH-DAY.
+func GenerateCreateMilestoneOptions(p *v1alpha1.MilestoneParameters) (*gitlab.CreateGroupMilestoneOptions, error) {
+	start, err := clients.ParseDate(p.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	due, err := clients.ParseDate(p.DueDate)
+	if err != nil {
+		return nil, err
+	}
+	return &gitlab.CreateGroupMilestoneOptions{
+		Title:       &p.Title,
+		Description: p.Description,
+		StartDate:   start,
+		DueDate:     due,
+	}, nil
+}
+
+// GenerateUpdateMilestoneOptions generates milestone update options. It
+// fails if StartDate or DueDate is not a date in the format YEAR-MONTH-DAY.
+func GenerateUpdateMilestoneOptions(p *v1alpha1.MilestoneParameters) (*gitlab.UpdateGroupMilestoneOptions, error) {
+	start, err := clients.ParseDate(p.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	due, err := clients.ParseDate(p.DueDate)
+	if err != nil {
+		return nil, err
+	}
+	opt := &gitlab.UpdateGroupMilestoneOptions{
+		Title:       &p.Title,
+		Description: p.Description,
+		StartDate:   start,
+		DueDate:     due,
+	}
+	switch ptr.Deref(p.State, "") {
+	case MilestoneStateActive:
+		opt.StateEvent = ptr.To(MilestoneStateEventActivate)
+	case MilestoneStateClosed:
+		opt.StateEvent = ptr.To(MilestoneStateEventClose)
+	}
+	return opt, nil
+}
+
+// NextMilestone returns the active milestone of ms with the earliest due
+// date after the one of m, or nil if there is none.
+func NextMilestone(ms []*gitlab.GroupMilestone, m *gitlab.GroupMilestone) *gitlab.GroupMilestone {
+	if m.DueDate == nil {
+		return nil
+	}
+	var next *gitlab.GroupMilestone
+	for _, c := range ms {
+		if c.ID == m.ID || c.State != MilestoneStateActive || c.DueDate == nil || c.DueDate.String() <= m.DueDate.String() {
+			continue
+		}
+		if next == nil || c.DueDate.String() < next.DueDate.String() {
+			next = c
+		}
+	}
+	return next
+}
+
+// RollOverOpenIssues moves the open issues of the milestone m of the group
+// gid to the next milestone of the group. The issues stay if
+// there is no next milestone. It returns the next milestone.
+func RollOverOpenIssues(c MilestoneClient, gid interface{}, m *gitlab.GroupMilestone, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, error) {
+	var active []*gitlab.GroupMilestone
+	lopt := &gitlab.ListGroupMilestonesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		State:       ptr.To(MilestoneStateActive),
+	}
+	for {
+		ms, res, err := c.ListGroupMilestones(gid, lopt, options...)
+		if err != nil {
+			return nil, err
+		}
+		active = append(active, ms...)
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		lopt.Page = res.NextPage
+	}
+	next := NextMilestone(active, m)
+	if next == nil {
+		return nil, nil
+	}
+
+	var open []*gitlab.Issue
+	iopt := &gitlab.GetGroupMilestoneIssuesOptions{PerPage: 100}
+	for {
+		issues, res, err := c.GetGroupMilestoneIssues(gid, m.ID, iopt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range issues {
+			if i.State == IssueStateOpened {
+				open = append(open, i)
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		iopt.Page = res.NextPage
+	}
+	for _, i := range open {
+		if _, _, err := c.UpdateIssue(i.ProjectID, i.IID, &gitlab.UpdateIssueOptions{MilestoneID: &next.ID}, options...); err != nil {
+			return nil, err
+		}
+	}
+	return next, nil
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ProtectedEnvironmentClient defines Gitlab group protected environment
+// service operations. The go-gitlab client only covers the protected
+// environments of projects, whose representations the group endpoints share.
+type ProtectedEnvironmentClient interface {
+	GetGroupProtectedEnvironment(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error)
+	ProtectGroupEnvironment(gid interface{}, opt *gitlab.ProtectRepositoryEnvironmentsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error)
+	UpdateGroupProtectedEnvironment(gid interface{}, environment string, opt *UpdateProtectedEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error)
+	UnprotectGroupEnvironment(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// UpdateProtectedEnvironmentOptions represents the available
+// UpdateGroupProtectedEnvironment() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_environments.html#update-a-protected-environment
+type UpdateProtectedEnvironmentOptions struct {
+	DeployAccessLevels    *[]*UpdateEnvironmentAccessOptions `url:"deploy_access_levels,omitempty" json:"deploy_access_levels,omitempty"`
+	RequiredApprovalCount *int                               `url:"required_approval_count,omitempty" json:"required_approval_count,omitempty"`
+	ApprovalRules         *[]*UpdateEnvironmentAccessOptions `url:"approval_rules,omitempty" json:"approval_rules,omitempty"`
+}
+
+// UpdateEnvironmentAccessOptions adds an access or approval rule to a
+// protected environment, or removes the one with ID if Destroy is set.
+type UpdateEnvironmentAccessOptions struct {
+	ID                    *int                     `url:"id,omitempty" json:"id,omitempty"`
+	AccessLevel           *gitlab.AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
+	UserID                *int                     `url:"user_id,omitempty" json:"user_id,omitempty"`
+	GroupID               *int                     `url:"group_id,omitempty" json:"group_id,omitempty"`
+	RequiredApprovalCount *int                     `url:"required_approvals,omitempty" json:"required_approvals,omitempty"`
+	GroupInheritanceType  *int                     `url:"group_inheritance_type,omitempty" json:"group_inheritance_type,omitempty"`
+	Destroy               *bool                    `url:"_destroy,omitempty" json:"_destroy,omitempty"`
+}
+
+type protectedEnvironmentClient struct {
+	git *gitlab.Client
+}
+
+// NewProtectedEnvironmentClient returns a new Gitlab group protected
+// environment service.
+func NewProtectedEnvironmentClient(cfg clients.Config) ProtectedEnvironmentClient {
+	return &protectedEnvironmentClient{git: clients.NewClient(cfg)}
+}
+
+func (c *protectedEnvironmentClient) do(method string, gid interface{}, path string, opt, v interface{}, options []gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	group, err := clients.ParseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.git.NewRequest(method, fmt.Sprintf("groups/%s/protected_environments%s", group, path), opt, options)
+	if err != nil {
+		return nil, err
+	}
+	return c.git.Do(req, v)
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_environments.html#get-a-single-protected-environment
+func (c *protectedEnvironmentClient) GetGroupProtectedEnvironment(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+	pe := new(gitlab.ProtectedEnvironment)
+	resp, err := c.do(http.MethodGet, gid, "/"+url.PathEscape(environment), nil, pe, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pe, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_environments.html#protect-a-single-environment
+func (c *protectedEnvironmentClient) ProtectGroupEnvironment(gid interface{}, opt *gitlab.ProtectRepositoryEnvironmentsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+	pe := new(gitlab.ProtectedEnvironment)
+	resp, err := c.do(http.MethodPost, gid, "", opt, pe, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pe, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_environments.html#update-a-protected-environment
+func (c *protectedEnvironmentClient) UpdateGroupProtectedEnvironment(gid interface{}, environment string, opt *UpdateProtectedEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+	pe := new(gitlab.ProtectedEnvironment)
+	resp, err := c.do(http.MethodPut, gid, "/"+url.PathEscape(environment), opt, pe, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pe, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_environments.html#unprotect-a-single-environment
+func (c *protectedEnvironmentClient) UnprotectGroupEnvironment(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.do(http.MethodDelete, gid, "/"+url.PathEscape(environment), nil, nil, options)
+}
+
+// GenerateProtectEnvironmentOptions generates the options protecting the
+// environments of a deployment tier.
+func GenerateProtectEnvironmentOptions(p *v1alpha1.ProtectedEnvironmentParameters) *gitlab.ProtectRepositoryEnvironmentsOptions {
+	levels := make([]*gitlab.EnvironmentAccessOptions, len(p.DeployAccessLevels))
+	for i, a := range p.DeployAccessLevels {
+		levels[i] = &gitlab.EnvironmentAccessOptions{
+			AccessLevel: (*gitlab.AccessLevelValue)(a.AccessLevel),
+			UserID:      a.UserID,
+			GroupID:     a.GroupID,
+		}
+	}
+	opt := &gitlab.ProtectRepositoryEnvironmentsOptions{
+		Name:                  &p.DeploymentTier,
+		DeployAccessLevels:    &levels,
+		RequiredApprovalCount: p.RequiredApprovalCount,
+	}
+	if len(p.ApprovalRules) > 0 {
+		rules := make([]*gitlab.EnvironmentApprovalRuleOptions, len(p.ApprovalRules))
+		for i, r := range p.ApprovalRules {
+			rules[i] = &gitlab.EnvironmentApprovalRuleOptions{
+				AccessLevel:           (*gitlab.AccessLevelValue)(r.AccessLevel),
+				UserID:                r.UserID,
+				GroupID:               r.GroupID,
+				RequiredApprovalCount: r.RequiredApprovals,
+				GroupInheritanceType:  r.GroupInheritanceType,
+			}
+		}
+		opt.ApprovalRules = &rules
+	}
+	return opt
+}
+
+// GenerateUpdateProtectedEnvironmentOptions generates the options changing
+// the accesses and approval rules of pe to the ones of p. Gitlab updates
+// them by ID, so the ones not wanted anymore are removed and the missing
+// ones are added.
+func GenerateUpdateProtectedEnvironmentOptions(p *v1alpha1.ProtectedEnvironmentParameters, pe *gitlab.ProtectedEnvironment) *UpdateProtectedEnvironmentOptions {
+	opt := &UpdateProtectedEnvironmentOptions{RequiredApprovalCount: p.RequiredApprovalCount}
+
+	levels := []*UpdateEnvironmentAccessOptions{}
+	want := map[string]bool{}
+	for _, a := range p.DeployAccessLevels {
+		want[accessKey(a)] = true
+	}
+	have := map[string]bool{}
+	for _, a := range pe.DeployAccessLevels {
+		k := observedAccessKey(a.AccessLevel, a.UserID, a.GroupID)
+		have[k] = true
+		if !want[k] {
+			levels = append(levels, &UpdateEnvironmentAccessOptions{ID: ptr.To(a.ID), Destroy: ptr.To(true)})
+		}
+	}
+	for _, a := range p.DeployAccessLevels {
+		if !have[accessKey(a)] {
+			levels = append(levels, &UpdateEnvironmentAccessOptions{
+				AccessLevel: (*gitlab.AccessLevelValue)(a.AccessLevel),
+				UserID:      a.UserID,
+				GroupID:     a.GroupID,
+			})
+		}
+	}
+	if len(levels) > 0 {
+		opt.DeployAccessLevels = &levels
+	}
+
+	rules := []*UpdateEnvironmentAccessOptions{}
+	want = map[string]bool{}
+	for _, r := range p.ApprovalRules {
+		want[approvalRuleKey(r)] = true
+	}
+	have = map[string]bool{}
+	for _, r := range pe.ApprovalRules {
+		k := observedApprovalRuleKey(r)
+		have[k] = true
+		if !want[k] {
+			rules = append(rules, &UpdateEnvironmentAccessOptions{ID: ptr.To(r.ID), Destroy: ptr.To(true)})
+		}
+	}
+	for _, r := range p.ApprovalRules {
+		if !have[approvalRuleKey(r)] {
+			rules = append(rules, &UpdateEnvironmentAccessOptions{
+				AccessLevel:           (*gitlab.AccessLevelValue)(r.AccessLevel),
+				UserID:                r.UserID,
+				GroupID:               r.GroupID,
+				RequiredApprovalCount: r.RequiredApprovals,
+				GroupInheritanceType:  r.GroupInheritanceType,
+			})
+		}
+	}
+	if len(rules) > 0 {
+		opt.ApprovalRules = &rules
+	}
+	return opt
+}
+
+// GenerateProtectedEnvironmentObservation is used to produce
+// v1alpha1.ProtectedEnvironmentObservation from gitlab.ProtectedEnvironment.
+func GenerateProtectedEnvironmentObservation(pe *gitlab.ProtectedEnvironment) v1alpha1.ProtectedEnvironmentObservation {
+	o := v1alpha1.ProtectedEnvironmentObservation{}
+	if pe == nil {
+		return o
+	}
+	for _, a := range pe.DeployAccessLevels {
+		o.DeployAccessLevels = append(o.DeployAccessLevels, v1alpha1.EnvironmentAccessObservation{
+			ID:                     a.ID,
+			AccessLevel:            int(a.AccessLevel),
+			AccessLevelDescription: a.AccessLevelDescription,
+			UserID:                 a.UserID,
+			GroupID:                a.GroupID,
+		})
+	}
+	for _, r := range pe.ApprovalRules {
+		o.ApprovalRules = append(o.ApprovalRules, v1alpha1.EnvironmentAccessObservation{
+			ID:                     r.ID,
+			AccessLevel:            int(r.AccessLevel),
+			AccessLevelDescription: r.AccessLevelDescription,
+			UserID:                 r.UserID,
+			GroupID:                r.GroupID,
+			RequiredApprovals:      r.RequiredApprovalCount,
+		})
+	}
+	return o
+}
+
+// IsProtectedEnvironmentUpToDate checks whether the accesses and approval
+// rules of the protected environment match the spec, regardless of their
+// order.
+func IsProtectedEnvironmentUpToDate(p *v1alpha1.ProtectedEnvironmentParameters, pe *gitlab.ProtectedEnvironment) bool {
+	if p.RequiredApprovalCount != nil && *p.RequiredApprovalCount != pe.RequiredApprovalCount {
+		return false
+	}
+	opt := GenerateUpdateProtectedEnvironmentOptions(p, pe)
+	return opt.DeployAccessLevels == nil && opt.ApprovalRules == nil
+}
+
+// accessKey identifies an access by the user, group or role it grants access
+// to, as Gitlab reports a role for accesses of users and groups too.
+func accessKey(a v1alpha1.EnvironmentAccess) string {
+	var level int
+	if a.AccessLevel != nil {
+		level = int(*a.AccessLevel)
+	}
+	return observedAccessKey(gitlab.AccessLevelValue(level), ptr.Deref(a.UserID, 0), ptr.Deref(a.GroupID, 0))
+}
+
+func observedAccessKey(level gitlab.AccessLevelValue, user, group int) string {
+	switch {
+	case user != 0:
+		return fmt.Sprintf("user/%d", user)
+	case group != 0:
+		return fmt.Sprintf("group/%d", group)
+	default:
+		return fmt.Sprintf("level/%d", level)
+	}
+}
+
+func approvalRuleKey(r v1alpha1.EnvironmentApprovalRule) string {
+	return fmt.Sprintf("%s/%d/%d", accessKey(r.EnvironmentAccess), ptr.Deref(r.RequiredApprovals, 1), ptr.Deref(r.GroupInheritanceType, 0))
+}
+
+func observedApprovalRuleKey(r *gitlab.EnvironmentApprovalRule) string {
+	return fmt.Sprintf("%s/%d/%d", observedAccessKey(r.AccessLevel, r.UserID, r.GroupID), r.RequiredApprovalCount, r.GroupInheritanceType)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+func TestGenerateUpdateProtectedEnvironmentOptions(t *testing.T) {
+	maintainer := v1alpha1.AccessLevelValue(40)
+	current := &gitlab.ProtectedEnvironment{
+		Name:                  "production",
+		RequiredApprovalCount: 0,
+		DeployAccessLevels: []*gitlab.EnvironmentAccessDescription{
+			{ID: 1, AccessLevel: 40},
+			{ID: 2, AccessLevel: 40, UserID: 7},
+		},
+		ApprovalRules: []*gitlab.EnvironmentApprovalRule{
+			{ID: 3, AccessLevel: 30, GroupID: 9, RequiredApprovalCount: 1},
+		},
+	}
+
+	cases := map[string]struct {
+		parameters *v1alpha1.ProtectedEnvironmentParameters
+		want       *UpdateProtectedEnvironmentOptions
+		upToDate   bool
+	}{
+		"Unchanged": {
+			parameters: &v1alpha1.ProtectedEnvironmentParameters{
+				DeployAccessLevels: []v1alpha1.EnvironmentAccess{{UserID: ptr.To(7)}, {AccessLevel: &maintainer}},
+				ApprovalRules:      []v1alpha1.EnvironmentApprovalRule{{EnvironmentAccess: v1alpha1.EnvironmentAccess{GroupID: ptr.To(9)}}},
+			},
+			want:     &UpdateProtectedEnvironmentOptions{},
+			upToDate: true,
+		},
+		"Changed": {
+			parameters: &v1alpha1.ProtectedEnvironmentParameters{
+				DeployAccessLevels: []v1alpha1.EnvironmentAccess{{AccessLevel: &maintainer}},
+				ApprovalRules:      []v1alpha1.EnvironmentApprovalRule{{EnvironmentAccess: v1alpha1.EnvironmentAccess{GroupID: ptr.To(9)}, RequiredApprovals: ptr.To(2)}},
+			},
+			want: &UpdateProtectedEnvironmentOptions{
+				DeployAccessLevels: &[]*UpdateEnvironmentAccessOptions{
+					{ID: ptr.To(2), Destroy: ptr.To(true)},
+				},
+				ApprovalRules: &[]*UpdateEnvironmentAccessOptions{
+					{ID: ptr.To(3), Destroy: ptr.To(true)},
+					{GroupID: ptr.To(9), RequiredApprovalCount: ptr.To(2)},
+				},
+			},
+		},
+		"RequiredApprovalCount": {
+			parameters: &v1alpha1.ProtectedEnvironmentParameters{
+				DeployAccessLevels:    []v1alpha1.EnvironmentAccess{{UserID: ptr.To(7)}, {AccessLevel: &maintainer}},
+				ApprovalRules:         []v1alpha1.EnvironmentApprovalRule{{EnvironmentAccess: v1alpha1.EnvironmentAccess{GroupID: ptr.To(9)}}},
+				RequiredApprovalCount: ptr.To(1),
+			},
+			want: &UpdateProtectedEnvironmentOptions{RequiredApprovalCount: ptr.To(1)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateProtectedEnvironmentOptions(tc.parameters, current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.upToDate, IsProtectedEnvironmentUpToDate(tc.parameters, current)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package groups
 
 import (
+	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -35,16 +38,108 @@ const (
 // VariableClient defines Gitlab Variable service operations
 type VariableClient interface {
 	ListVariables(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error)
-	GetVariable(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
-	CreateVariable(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
-	UpdateVariable(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
-	RemoveVariable(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetVariable(gid interface{}, key string, opt *GetVariableOptions, options ...gitlab.RequestOptionFunc) (*Variable, *gitlab.Response, error)
+	CreateVariable(gid interface{}, opt *CreateVariableOptions, options ...gitlab.RequestOptionFunc) (*Variable, *gitlab.Response, error)
+	UpdateVariable(gid interface{}, key string, opt *UpdateVariableOptions, options ...gitlab.RequestOptionFunc) (*Variable, *gitlab.Response, error)
+	RemoveVariable(gid interface{}, key string, opt *RemoveVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// Variable is a group variable along with the fields of newer Gitlab
+// versions, which gitlab.GroupVariable does not carry.
+type Variable struct {
+	gitlab.GroupVariable
+	Description string `json:"description"`
+	Hidden      bool   `json:"hidden"`
+}
+
+// GetVariableOptions selects a variable defined in several environment
+// scopes.
+type GetVariableOptions struct {
+	Filter *gitlab.VariableFilter `url:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// CreateVariableOptions adds the options of newer Gitlab versions to
+// gitlab.CreateGroupVariableOptions.
+type CreateVariableOptions struct {
+	gitlab.CreateGroupVariableOptions
+	Description     *string `url:"description,omitempty" json:"description,omitempty"`
+	MaskedAndHidden *bool   `url:"masked_and_hidden,omitempty" json:"masked_and_hidden,omitempty"`
+}
+
+// UpdateVariableOptions adds the options of newer Gitlab versions to
+// gitlab.UpdateGroupVariableOptions.
+type UpdateVariableOptions struct {
+	gitlab.UpdateGroupVariableOptions
+	Description *string                `url:"description,omitempty" json:"description,omitempty"`
+	Filter      *gitlab.VariableFilter `url:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RemoveVariableOptions selects a variable defined in several environment
+// scopes.
+type RemoveVariableOptions struct {
+	Filter *gitlab.VariableFilter `url:"filter,omitempty" json:"filter,omitempty"`
+}
+
+type variableClient struct {
+	*gitlab.GroupVariablesService
+	git *gitlab.Client
 }
 
 // NewVariableClient returns a new Gitlab Group service
 func NewVariableClient(cfg clients.Config) VariableClient {
 	git := clients.NewClient(cfg)
-	return git.GroupVariables
+	return &variableClient{GroupVariablesService: git.GroupVariables, git: git}
+}
+
+func (c *variableClient) do(method string, gid interface{}, path string, opt, v interface{}, options []gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	group, err := clients.ParseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.git.NewRequest(method, fmt.Sprintf("groups/%s/variables%s", group, path), opt, options)
+	if err != nil {
+		return nil, err
+	}
+	return c.git.Do(req, v)
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#show-variable-details
+func (c *variableClient) GetVariable(gid interface{}, key string, opt *GetVariableOptions, options ...gitlab.RequestOptionFunc) (*Variable, *gitlab.Response, error) {
+	v := new(Variable)
+	resp, err := c.do(http.MethodGet, gid, "/"+url.PathEscape(key), opt, v, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#create-variable
+func (c *variableClient) CreateVariable(gid interface{}, opt *CreateVariableOptions, options ...gitlab.RequestOptionFunc) (*Variable, *gitlab.Response, error) {
+	v := new(Variable)
+	resp, err := c.do(http.MethodPost, gid, "", opt, v, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#update-variable
+func (c *variableClient) UpdateVariable(gid interface{}, key string, opt *UpdateVariableOptions, options ...gitlab.RequestOptionFunc) (*Variable, *gitlab.Response, error) {
+	v := new(Variable)
+	resp, err := c.do(http.MethodPut, gid, "/"+url.PathEscape(key), opt, v, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#remove-variable
+func (c *variableClient) RemoveVariable(gid interface{}, key string, opt *RemoveVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.do(http.MethodDelete, gid, "/"+url.PathEscape(key), opt, nil, options)
 }
 
 // IsErrorVariableNotFound helper function to test for errGroupNotFound error.
@@ -57,7 +152,7 @@ func IsErrorVariableNotFound(err error) bool {
 
 // LateInitializeVariable fills the empty fields in the groupVariable spec with the
 // values seen in gitlab.Variable.
-func LateInitializeVariable(in *v1alpha1.VariableParameters, variable *gitlab.GroupVariable) { // nolint:gocyclo
+func LateInitializeVariable(in *v1alpha1.VariableParameters, variable *Variable) { // nolint:gocyclo
 	if variable == nil {
 		return
 	}
@@ -85,45 +180,82 @@ func LateInitializeVariable(in *v1alpha1.VariableParameters, variable *gitlab.Gr
 
 // VariableToParameters coonverts a GitLab API representation of a
 // Group Variable back into our local VariableParameters format
-func VariableToParameters(in gitlab.GroupVariable) v1alpha1.VariableParameters {
+func VariableToParameters(in Variable) v1alpha1.VariableParameters {
 	return v1alpha1.VariableParameters{
 		Key:              in.Key,
 		Value:            &in.Value,
+		Description:      &in.Description,
 		VariableType:     (*v1alpha1.VariableType)(&in.VariableType),
 		Protected:        &in.Protected,
 		Masked:           &in.Masked,
+		Hidden:           &in.Hidden,
 		EnvironmentScope: &in.EnvironmentScope,
 		Raw:              &in.Raw,
 	}
 }
 
 // GenerateCreateVariableOptions generates group creation options
-func GenerateCreateVariableOptions(p *v1alpha1.VariableParameters) *gitlab.CreateGroupVariableOptions {
-	variable := &gitlab.CreateGroupVariableOptions{
-		Key:              &p.Key,
-		Value:            p.Value,
-		VariableType:     (*gitlab.VariableTypeValue)(p.VariableType),
-		Protected:        p.Protected,
-		Masked:           p.Masked,
-		EnvironmentScope: p.EnvironmentScope,
-		Raw:              p.Raw,
+func GenerateCreateVariableOptions(p *v1alpha1.VariableParameters) *CreateVariableOptions {
+	variable := &CreateVariableOptions{
+		CreateGroupVariableOptions: gitlab.CreateGroupVariableOptions{
+			Key:              &p.Key,
+			Value:            p.Value,
+			VariableType:     (*gitlab.VariableTypeValue)(p.VariableType),
+			Protected:        p.Protected,
+			Masked:           p.Masked,
+			EnvironmentScope: p.EnvironmentScope,
+			Raw:              p.Raw,
+		},
+		Description: p.Description,
+	}
+	// Gitlab only hides variables on creation, and rejects masked
+	// together with masked_and_hidden.
+	if p.Hidden != nil && *p.Hidden {
+		variable.Masked = nil
+		variable.MaskedAndHidden = p.Hidden
 	}
 	return variable
 }
 
 // GenerateUpdateVariableOptions generates group update options
-func GenerateUpdateVariableOptions(p *v1alpha1.VariableParameters) *gitlab.UpdateGroupVariableOptions {
-	variable := &gitlab.UpdateGroupVariableOptions{
-		Value:            p.Value,
-		VariableType:     (*gitlab.VariableTypeValue)(p.VariableType),
-		Protected:        p.Protected,
-		Masked:           p.Masked,
-		EnvironmentScope: p.EnvironmentScope,
-		Raw:              p.Raw,
+func GenerateUpdateVariableOptions(p *v1alpha1.VariableParameters) *UpdateVariableOptions {
+	variable := &UpdateVariableOptions{
+		UpdateGroupVariableOptions: gitlab.UpdateGroupVariableOptions{
+			Value:            p.Value,
+			VariableType:     (*gitlab.VariableTypeValue)(p.VariableType),
+			Protected:        p.Protected,
+			Masked:           p.Masked,
+			EnvironmentScope: p.EnvironmentScope,
+			Raw:              p.Raw,
+		},
+		Description: p.Description,
+		Filter:      GenerateVariableFilter(p),
 	}
 	return variable
 }
 
+// GenerateGetVariableOptions generates group get options
+func GenerateGetVariableOptions(p *v1alpha1.VariableParameters) *GetVariableOptions {
+	if p.EnvironmentScope == nil {
+		return nil
+	}
+
+	return &GetVariableOptions{
+		Filter: GenerateVariableFilter(p),
+	}
+}
+
+// GenerateRemoveVariableOptions generates group remove options.
+func GenerateRemoveVariableOptions(p *v1alpha1.VariableParameters) *RemoveVariableOptions {
+	if p.EnvironmentScope == nil {
+		return nil
+	}
+
+	return &RemoveVariableOptions{
+		Filter: GenerateVariableFilter(p),
+	}
+}
+
 // GenerateVariableFilter generates a variable filter that matches the variable parameters' environment scope.
 func GenerateVariableFilter(p *v1alpha1.VariableParameters) *gitlab.VariableFilter {
 	if p.EnvironmentScope == nil {
@@ -136,15 +268,24 @@ func GenerateVariableFilter(p *v1alpha1.VariableParameters) *gitlab.VariableFilt
 }
 
 // IsVariableUpToDate checks whether there is a change in any of the modifiable fields.
-func IsVariableUpToDate(p *v1alpha1.VariableParameters, g *gitlab.GroupVariable) bool {
+// Hidden can only be set on creation, and the value of a hidden variable
+// cannot be read.
+func IsVariableUpToDate(p *v1alpha1.VariableParameters, g *Variable) bool {
 	if p == nil {
 		return true
 	}
 
+	ignore := []string{"GroupID", "Hidden"}
+	if p.Description == nil {
+		ignore = append(ignore, "Description")
+	}
+	if g.Hidden {
+		ignore = append(ignore, "Value")
+	}
 	return cmp.Equal(*p,
 		VariableToParameters(*g),
 		cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}, &xpv1.SecretKeySelector{}),
-		cmpopts.IgnoreFields(v1alpha1.VariableParameters{}, "GroupID"),
+		cmpopts.IgnoreFields(v1alpha1.VariableParameters{}, ignore...),
 	)
 }
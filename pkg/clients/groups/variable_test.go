@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+func TestGenerateCreateVariableOptions(t *testing.T) {
+	key := "KEY"
+	value := "value"
+	description := "description"
+	scope := "production"
+	cases := map[string]struct {
+		parameters *v1alpha1.VariableParameters
+		want       *CreateVariableOptions
+	}{
+		"Masked": {
+			parameters: &v1alpha1.VariableParameters{Key: key, Value: &value, Description: &description, Masked: gitlab.Bool(true), EnvironmentScope: &scope},
+			want: &CreateVariableOptions{
+				CreateGroupVariableOptions: gitlab.CreateGroupVariableOptions{Key: &key, Value: &value, Masked: gitlab.Bool(true), EnvironmentScope: &scope},
+				Description:                &description,
+			},
+		},
+		"Hidden": {
+			parameters: &v1alpha1.VariableParameters{Key: key, Value: &value, Masked: gitlab.Bool(true), Hidden: gitlab.Bool(true)},
+			want: &CreateVariableOptions{
+				CreateGroupVariableOptions: gitlab.CreateGroupVariableOptions{Key: &key, Value: &value},
+				MaskedAndHidden:            gitlab.Bool(true),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateVariableOptions(tc.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsVariableUpToDate(t *testing.T) {
+	value := "value"
+	description := "description"
+	observed := func(v Variable) *Variable {
+		v.Key = "KEY"
+		return &v
+	}
+	cases := map[string]struct {
+		parameters *v1alpha1.VariableParameters
+		variable   *Variable
+		want       bool
+	}{
+		"UpToDate": {
+			parameters: &v1alpha1.VariableParameters{Key: "KEY", Value: &value},
+			variable:   observed(Variable{GroupVariable: gitlab.GroupVariable{Value: value}, Description: description}),
+			want:       true,
+		},
+		"DescriptionChanged": {
+			parameters: &v1alpha1.VariableParameters{Key: "KEY", Value: &value, Description: &description},
+			variable:   observed(Variable{GroupVariable: gitlab.GroupVariable{Value: value}}),
+			want:       false,
+		},
+		"HiddenValueIsIgnored": {
+			parameters: &v1alpha1.VariableParameters{Key: "KEY", Value: &value, Hidden: gitlab.Bool(true)},
+			variable:   observed(Variable{Hidden: true}),
+			want:       true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeVariable(tc.parameters, tc.variable)
+			got := IsVariableUpToDate(tc.parameters, tc.variable)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Gitlab normalizes some values before storing them, so the value it returns
+// can differ from the desired one although both mean the same. Comparing
+// them as they are would update the external resource on every poll. The
+// normalizers below canonicalize both sides before they are compared.
+
+// NormalizePath canonicalizes the path of a project or group. Gitlab
+// matches paths case-insensitively and may return them lowercased.
+func NormalizePath(p string) string {
+	return strings.ToLower(strings.TrimSpace(p))
+}
+
+// NormalizeURL canonicalizes a URL the way Gitlab stores it: the scheme and
+// host are lowercased, default ports and an empty root path are dropped. A
+// value that is not an absolute URL is only trimmed.
+func NormalizeURL(u string) string {
+	u = strings.TrimSpace(u)
+	parsed, err := url.Parse(u)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return u
+	}
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	host, port := strings.ToLower(parsed.Hostname()), parsed.Port()
+	if (parsed.Scheme == "http" && port == "80") || (parsed.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	if port != "" {
+		host += ":" + port
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	parsed.Host = host
+	if parsed.Path == "/" && parsed.RawQuery == "" && parsed.Fragment == "" {
+		parsed.Path = ""
+	}
+	return parsed.String()
+}
+
+// NormalizeStringList canonicalizes an unordered list of strings, e.g. tags
+// or topics, which Gitlab returns sorted and without duplicates. It returns
+// nil for an empty list.
+func NormalizeStringList(l []string) []string {
+	if len(l) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(l))
+	out := make([]string, 0, len(l))
+	for _, s := range l {
+		s = strings.TrimSpace(s)
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// EquateNormalized returns a cmp.Option that compares strings after
+// normalizing both of them with f.
+func EquateNormalized(f func(string) string) cmp.Option {
+	return cmp.Comparer(func(a, b string) bool { return f(a) == f(b) })
+}
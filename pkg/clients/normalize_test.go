@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNormalizePath(t *testing.T) {
+	cases := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"Equal": {
+			a:    "my-project",
+			b:    "my-project",
+			want: true,
+		},
+		"Lowercased": {
+			a:    "My-Project",
+			b:    "my-project",
+			want: true,
+		},
+		"Different": {
+			a:    "my-project",
+			b:    "other-project",
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NormalizePath(tc.a) == NormalizePath(tc.b)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeURL(t *testing.T) {
+	cases := map[string]struct {
+		url  string
+		want string
+	}{
+		"Canonical": {
+			url:  "https://example.com/hook",
+			want: "https://example.com/hook",
+		},
+		"UppercaseSchemeAndHost": {
+			url:  "HTTPS://Example.COM/Hook",
+			want: "https://example.com/Hook",
+		},
+		"DefaultHTTPSPort": {
+			url:  "https://example.com:443/hook",
+			want: "https://example.com/hook",
+		},
+		"DefaultHTTPPort": {
+			url:  "http://example.com:80/hook",
+			want: "http://example.com/hook",
+		},
+		"OtherPort": {
+			url:  "https://example.com:8443/hook",
+			want: "https://example.com:8443/hook",
+		},
+		"RootPath": {
+			url:  "https://example.com/",
+			want: "https://example.com",
+		},
+		"IPv6": {
+			url:  "http://[::1]:80/hook",
+			want: "http://[::1]/hook",
+		},
+		"Whitespace": {
+			url:  " https://example.com/hook\n",
+			want: "https://example.com/hook",
+		},
+		"NotAURL": {
+			url:  "not a url",
+			want: "not a url",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NormalizeURL(tc.url)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeStringList(t *testing.T) {
+	cases := map[string]struct {
+		list []string
+		want []string
+	}{
+		"Nil": {
+			list: nil,
+			want: nil,
+		},
+		"Empty": {
+			list: []string{},
+			want: nil,
+		},
+		"Reordered": {
+			list: []string{"go", "api", "crossplane"},
+			want: []string{"api", "crossplane", "go"},
+		},
+		"Duplicates": {
+			list: []string{"go", " go", "api"},
+			want: []string{"api", "go"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NormalizeStringList(tc.list)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEquateNormalized(t *testing.T) {
+	a, b := "HTTPS://Example.com:443/", "https://example.com"
+	if !cmp.Equal(&a, &b, EquateNormalized(NormalizeURL)) {
+		t.Errorf("cmp.Equal(%q, %q): want true, got false", a, b)
+	}
+	c := "https://example.com/other"
+	if cmp.Equal(&a, &c, EquateNormalized(NormalizeURL)) {
+		t.Errorf("cmp.Equal(%q, %q): want false, got true", a, c)
+	}
+}
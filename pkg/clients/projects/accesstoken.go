@@ -17,9 +17,12 @@ limitations under the License.
 package projects
 
 import (
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
@@ -30,6 +33,15 @@ type AccessTokenClient interface {
 	GetProjectAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
 	CreateProjectAccessToken(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
 	RevokeProjectAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetInheritedProjectMember(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	clients.ProviderTokenClient
+}
+
+type accessTokenClient struct {
+	*gitlab.ProjectAccessTokensService
+	*gitlab.ProjectMembersService
+	*gitlab.PersonalAccessTokensService
+	*gitlab.UsersService
 }
 
 // IsErrorProjectAccessTokenNotFound helper function to test for errProjectAccessTokenNotFound error.
@@ -43,7 +55,40 @@ func IsErrorProjectAccessTokenNotFound(err error) bool {
 // NewAccessTokenClient returns a new Gitlab ProjectAccessToken service
 func NewAccessTokenClient(cfg clients.Config) AccessTokenClient {
 	git := clients.NewClient(cfg)
-	return git.ProjectAccessTokens
+	return &accessTokenClient{
+		ProjectAccessTokensService:  git.ProjectAccessTokens,
+		ProjectMembersService:       git.ProjectMembers,
+		PersonalAccessTokensService: git.PersonalAccessTokens,
+		UsersService:                git.Users,
+	}
+}
+
+// InsufficientProviderToken returns why the provider token cannot create
+// the access token p, or "" if it can. Creating project access tokens
+// requires at least the Maintainer role, creating group access tokens the
+// Owner role.
+func InsufficientProviderToken(c AccessTokenClient, p *v1alpha1.AccessTokenParameters, options ...gitlab.RequestOptionFunc) (string, error) {
+	member := func(user int) (gitlab.AccessLevelValue, *gitlab.Response, error) {
+		m, res, err := c.GetInheritedProjectMember(*AccessTokenScopeID(p), user, options...)
+		if err != nil {
+			return gitlab.NoPermissions, res, err
+		}
+		return m.AccessLevel, res, nil
+	}
+	required := gitlab.MaintainerPermissions
+	if p.GroupID != nil {
+		required = gitlab.OwnerPermissions
+	}
+	return clients.InsufficientProviderToken(c, member, required, requestedAccessLevel(p.AccessLevel), options...)
+}
+
+// requestedAccessLevel returns the access level of an access token, which
+// Gitlab defaults to Maintainer.
+func requestedAccessLevel(l *v1alpha1.AccessLevelValue) gitlab.AccessLevelValue {
+	if l == nil {
+		return gitlab.MaintainerPermissions
+	}
+	return gitlab.AccessLevelValue(*l)
 }
 
 // GenerateCreateProjectAccessTokenOptions generates project creation options
@@ -63,3 +108,46 @@ func GenerateCreateProjectAccessTokenOptions(name string, p *v1alpha1.AccessToke
 
 	return accesstoken
 }
+
+// IsAccessTokenUpToDate checks whether the scopes, access level or expiry of
+// the access token drifted from the spec, or whether it was revoked.
+func IsAccessTokenUpToDate(p *v1alpha1.AccessTokenParameters, at *gitlab.ProjectAccessToken) bool {
+	if at.Revoked {
+		return false
+	}
+	if !isScopesEqual(p.Scopes, at.Scopes) {
+		return false
+	}
+	if p.AccessLevel != nil && int(*p.AccessLevel) != int(at.AccessLevel) {
+		return false
+	}
+	return isExpiryEqual(p.ExpiresAt, at.ExpiresAt)
+}
+
+func isScopesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string(nil), a...)
+	y := append([]string(nil), b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isExpiryEqual compares expiry dates with day precision, as Gitlab stores
+// them.
+func isExpiryEqual(want *metav1.Time, got *gitlab.ISOTime) bool {
+	if want == nil {
+		return true
+	}
+	if got == nil {
+		return false
+	}
+	return want.UTC().Format(time.DateOnly) == time.Time(*got).UTC().Format(time.DateOnly)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestIsAccessTokenUpToDate(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+	accessLevel := v1alpha1.AccessLevelValue(40)
+	at := &gitlab.ProjectAccessToken{
+		Scopes:      []string{"api", "read_repository"},
+		AccessLevel: 40,
+		ExpiresAt:   (*gitlab.ISOTime)(&expiresAt),
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.AccessTokenParameters
+		at   *gitlab.ProjectAccessToken
+		want bool
+	}{
+		"UpToDate": {
+			p: &v1alpha1.AccessTokenParameters{
+				Scopes:      []string{"read_repository", "api"},
+				AccessLevel: &accessLevel,
+				ExpiresAt:   &metav1.Time{Time: expiresAt.Add(13 * time.Hour)},
+			},
+			at:   at,
+			want: true,
+		},
+		"ScopesDrifted": {
+			p:    &v1alpha1.AccessTokenParameters{Scopes: []string{"api"}},
+			at:   at,
+			want: false,
+		},
+		"AccessLevelDrifted": {
+			p: &v1alpha1.AccessTokenParameters{
+				Scopes:      []string{"api", "read_repository"},
+				AccessLevel: (*v1alpha1.AccessLevelValue)(gitlab.Int(30)),
+			},
+			at:   at,
+			want: false,
+		},
+		"ExpiryDrifted": {
+			p: &v1alpha1.AccessTokenParameters{
+				Scopes:    []string{"api", "read_repository"},
+				ExpiresAt: &metav1.Time{Time: expiresAt.AddDate(0, 0, 1)},
+			},
+			at:   at,
+			want: false,
+		},
+		"Revoked": {
+			p:    &v1alpha1.AccessTokenParameters{Scopes: []string{"api", "read_repository"}},
+			at:   &gitlab.ProjectAccessToken{Scopes: []string{"api", "read_repository"}, Revoked: true},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsAccessTokenUpToDate(tc.p, tc.at)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
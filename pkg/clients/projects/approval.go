@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	// MergeRequestStateOpened is the state of merge requests that are
+	// neither merged nor closed.
+	MergeRequestStateOpened = "opened"
+)
+
+// ApprovalClient defines Gitlab merge request and merge request approval
+// service operations
+type ApprovalClient interface {
+	GetMergeRequest(pid interface{}, mergeRequest int, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	GetConfiguration(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error)
+	ApproveMergeRequest(pid interface{}, mr int, opt *gitlab.ApproveMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error)
+	UnapproveMergeRequest(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type approvalClient struct {
+	*gitlab.MergeRequestsService
+	*gitlab.MergeRequestApprovalsService
+}
+
+// NewApprovalClient returns a new Gitlab merge request and merge request
+// approval service
+func NewApprovalClient(cfg clients.Config) ApprovalClient {
+	git := clients.NewClient(cfg)
+	return &approvalClient{MergeRequestsService: git.MergeRequests, MergeRequestApprovalsService: git.MergeRequestApprovals}
+}
+
+// UnmetApprovalConditions returns the conditions of p that mr does not meet,
+// in the order they are declared. The merge request may be approved if
+// there are none.
+func UnmetApprovalConditions(p *v1alpha1.ApprovalParameters, mr *gitlab.MergeRequest) []string {
+	var unmet []string
+	if mr.State != MergeRequestStateOpened {
+		unmet = append(unmet, fmt.Sprintf("merge request is %s", mr.State))
+	}
+	labels := make(map[string]bool, len(mr.Labels))
+	for _, l := range mr.Labels {
+		labels[l] = true
+	}
+	for _, l := range p.RequiredLabels {
+		if !labels[l] {
+			unmet = append(unmet, fmt.Sprintf("label %q is missing", l))
+		}
+	}
+	if p.AuthorUsername != nil && (mr.Author == nil || mr.Author.Username != *p.AuthorUsername) {
+		unmet = append(unmet, fmt.Sprintf("author is not %q", *p.AuthorUsername))
+	}
+	return unmet
+}
+
+// IsApprovalUpToDate checks whether the merge request is approved exactly
+// when it meets the conditions of p. Merge requests that are merged or
+// closed can no longer be approved or unapproved, so they are always up to
+// date.
+func IsApprovalUpToDate(p *v1alpha1.ApprovalParameters, mr *gitlab.MergeRequest, a *gitlab.MergeRequestApprovals) bool {
+	if mr.State != MergeRequestStateOpened {
+		return true
+	}
+	return a.UserHasApproved == (len(UnmetApprovalConditions(p, mr)) == 0)
+}
+
+// GenerateApprovalObservation is used to produce
+// v1alpha1.ApprovalObservation from gitlab.MergeRequest and
+// gitlab.MergeRequestApprovals.
+func GenerateApprovalObservation(p *v1alpha1.ApprovalParameters, mr *gitlab.MergeRequest, a *gitlab.MergeRequestApprovals) v1alpha1.ApprovalObservation {
+	if mr == nil {
+		return v1alpha1.ApprovalObservation{}
+	}
+	o := v1alpha1.ApprovalObservation{
+		UnmetConditions: UnmetApprovalConditions(p, mr),
+		State:           mr.State,
+		SHA:             mr.SHA,
+		WebURL:          mr.WebURL,
+	}
+	if a != nil {
+		o.Approved = a.UserHasApproved
+	}
+	return o
+}
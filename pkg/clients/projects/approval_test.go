@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestUnmetApprovalConditions(t *testing.T) {
+	p := &v1alpha1.ApprovalParameters{
+		RequiredLabels: []string{"dependencies", "automerge"},
+		AuthorUsername: ptr.To("renovate-bot"),
+	}
+
+	cases := map[string]struct {
+		mr   *gitlab.MergeRequest
+		want []string
+	}{
+		"Met": {
+			mr: &gitlab.MergeRequest{
+				State:  "opened",
+				Labels: gitlab.Labels{"automerge", "dependencies", "minor"},
+				Author: &gitlab.BasicUser{Username: "renovate-bot"},
+			},
+		},
+		"Unmet": {
+			mr: &gitlab.MergeRequest{
+				State:  "merged",
+				Labels: gitlab.Labels{"dependencies"},
+				Author: &gitlab.BasicUser{Username: "someone"},
+			},
+			want: []string{`merge request is merged`, `label "automerge" is missing`, `author is not "renovate-bot"`},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := UnmetApprovalConditions(p, tc.mr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsApprovalUpToDate(t *testing.T) {
+	p := &v1alpha1.ApprovalParameters{
+		RequiredLabels: []string{"automerge"},
+	}
+
+	cases := map[string]struct {
+		mr   *gitlab.MergeRequest
+		a    *gitlab.MergeRequestApprovals
+		want bool
+	}{
+		"ApprovedAndMet": {
+			mr:   &gitlab.MergeRequest{State: "opened", Labels: gitlab.Labels{"automerge"}},
+			a:    &gitlab.MergeRequestApprovals{UserHasApproved: true},
+			want: true,
+		},
+		"NotApprovedAndMet": {
+			mr:   &gitlab.MergeRequest{State: "opened", Labels: gitlab.Labels{"automerge"}},
+			a:    &gitlab.MergeRequestApprovals{},
+			want: false,
+		},
+		"ApprovedAndUnmet": {
+			mr:   &gitlab.MergeRequest{State: "opened"},
+			a:    &gitlab.MergeRequestApprovals{UserHasApproved: true},
+			want: false,
+		},
+		"NotOpened": {
+			mr:   &gitlab.MergeRequest{State: "merged"},
+			a:    &gitlab.MergeRequestApprovals{UserHasApproved: true},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsApprovalUpToDate(p, tc.mr, tc.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
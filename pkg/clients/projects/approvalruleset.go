@@ -0,0 +1,229 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"sort"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// defaultApprovalRuleType is the type Gitlab gives rules created without one.
+const defaultApprovalRuleType = "regular"
+
+// ApprovalRuleClient defines Gitlab project approval rule service operations
+type ApprovalRuleClient interface {
+	GetProjectApprovalRules(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	CreateProjectApprovalRule(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	UpdateProjectApprovalRule(pid interface{}, approvalRule int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	DeleteProjectApprovalRule(pid interface{}, approvalRule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewApprovalRuleClient returns a new Gitlab project approval rule service
+func NewApprovalRuleClient(cfg clients.Config) ApprovalRuleClient {
+	git := clients.NewClient(cfg)
+	return git.Projects
+}
+
+// ListProjectApprovalRules returns all approval rules of the project,
+// following pagination.
+func ListProjectApprovalRules(c ApprovalRuleClient, pid interface{}, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, error) {
+	opt := &gitlab.GetProjectApprovalRulesListsOptions{PerPage: 100}
+
+	var all []*gitlab.ProjectApprovalRule
+	for {
+		rules, res, err := c.GetProjectApprovalRules(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, rules...)
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// ApprovalRuleUpdate is an approval rule to update in place.
+type ApprovalRuleUpdate struct {
+	ID   int
+	Rule v1alpha1.ApprovalRule
+}
+
+// ApprovalRuleSetDiff lists the changes needed to bring the approval rules of
+// a project in line with an ApprovalRuleSet.
+type ApprovalRuleSetDiff struct {
+	Create []v1alpha1.ApprovalRule
+	Update []ApprovalRuleUpdate
+	// Delete holds the IDs of the rules to delete, including rules whose
+	// type changed, which are created again.
+	Delete []int
+}
+
+// IsEmpty returns true if no changes are needed.
+func (d ApprovalRuleSetDiff) IsEmpty() bool {
+	return len(d.Create) == 0 && len(d.Update) == 0 && len(d.Delete) == 0
+}
+
+// DiffApprovalRuleSet compares the desired approval rules with the ones found
+// at Gitlab. Rules not in the spec are only scheduled for deletion if pruning
+// is enabled.
+func DiffApprovalRuleSet(p *v1alpha1.ApprovalRuleSetParameters, rules []*gitlab.ProjectApprovalRule) ApprovalRuleSetDiff {
+	d := ApprovalRuleSetDiff{}
+
+	existing := make(map[string]*gitlab.ProjectApprovalRule, len(rules))
+	for _, r := range rules {
+		existing[r.Name] = r
+	}
+
+	desired := make(map[string]bool, len(p.Rules))
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		desired[r.Name] = true
+		e, ok := existing[r.Name]
+		switch {
+		case !ok:
+			d.Create = append(d.Create, *r)
+		case ptr.Deref(r.RuleType, defaultApprovalRuleType) != e.RuleType:
+			d.Delete = append(d.Delete, e.ID)
+			d.Create = append(d.Create, *r)
+		case !IsApprovalRuleUpToDate(r, e):
+			d.Update = append(d.Update, ApprovalRuleUpdate{ID: e.ID, Rule: *r})
+		}
+	}
+
+	if ptr.Deref(p.Prune, true) {
+		for _, r := range rules {
+			if !desired[r.Name] {
+				d.Delete = append(d.Delete, r.ID)
+			}
+		}
+	}
+	return d
+}
+
+// IsApprovalRuleUpToDate checks whether there is a change in any of the
+// modifiable fields of an approval rule.
+func IsApprovalRuleUpToDate(r *v1alpha1.ApprovalRule, g *gitlab.ProjectApprovalRule) bool {
+	if r.ApprovalsRequired != g.ApprovalsRequired {
+		return false
+	}
+	if r.AppliesToAllProtectedBranches != nil && *r.AppliesToAllProtectedBranches != g.AppliesToAllProtectedBranches {
+		return false
+	}
+	if !sameIDs(r.UserIDs, userIDs(g.Users)) || !sameIDs(r.GroupIDs, groupIDs(g.Groups)) {
+		return false
+	}
+	// Branches are ignored by Gitlab for rules applying to all of them.
+	return g.AppliesToAllProtectedBranches || sameIDs(r.ProtectedBranchIDs, protectedBranchIDs(g.ProtectedBranches))
+}
+
+// GenerateApprovalRuleSetObservation is used to produce
+// v1alpha1.ApprovalRuleSetObservation from a list of
+// gitlab.ProjectApprovalRule.
+func GenerateApprovalRuleSetObservation(rules []*gitlab.ProjectApprovalRule) v1alpha1.ApprovalRuleSetObservation {
+	o := v1alpha1.ApprovalRuleSetObservation{}
+	for _, r := range rules {
+		o.Rules = append(o.Rules, v1alpha1.ApprovalRuleObservation{
+			ID:                            r.ID,
+			Name:                          r.Name,
+			RuleType:                      r.RuleType,
+			ApprovalsRequired:             r.ApprovalsRequired,
+			UserIDs:                       userIDs(r.Users),
+			GroupIDs:                      groupIDs(r.Groups),
+			ProtectedBranchIDs:            protectedBranchIDs(r.ProtectedBranches),
+			AppliesToAllProtectedBranches: r.AppliesToAllProtectedBranches,
+		})
+	}
+	return o
+}
+
+// GenerateCreateApprovalRuleOptions generates approval rule creation options
+func GenerateCreateApprovalRuleOptions(r *v1alpha1.ApprovalRule) *gitlab.CreateProjectLevelRuleOptions {
+	return &gitlab.CreateProjectLevelRuleOptions{
+		Name:                          &r.Name,
+		ApprovalsRequired:             &r.ApprovalsRequired,
+		RuleType:                      r.RuleType,
+		UserIDs:                       idList(r.UserIDs),
+		GroupIDs:                      idList(r.GroupIDs),
+		ProtectedBranchIDs:            idList(r.ProtectedBranchIDs),
+		AppliesToAllProtectedBranches: r.AppliesToAllProtectedBranches,
+	}
+}
+
+// GenerateUpdateApprovalRuleOptions generates approval rule update options
+func GenerateUpdateApprovalRuleOptions(r *v1alpha1.ApprovalRule) *gitlab.UpdateProjectLevelRuleOptions {
+	return &gitlab.UpdateProjectLevelRuleOptions{
+		Name:                          &r.Name,
+		ApprovalsRequired:             &r.ApprovalsRequired,
+		UserIDs:                       idList(r.UserIDs),
+		GroupIDs:                      idList(r.GroupIDs),
+		ProtectedBranchIDs:            idList(r.ProtectedBranchIDs),
+		AppliesToAllProtectedBranches: r.AppliesToAllProtectedBranches,
+	}
+}
+
+func userIDs(users []*gitlab.BasicUser) []int {
+	var ids []int
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
+
+func groupIDs(groups []*gitlab.Group) []int {
+	var ids []int
+	for _, g := range groups {
+		ids = append(ids, g.ID)
+	}
+	return ids
+}
+
+func protectedBranchIDs(branches []*gitlab.ProtectedBranch) []int {
+	var ids []int
+	for _, b := range branches {
+		ids = append(ids, b.ID)
+	}
+	return ids
+}
+
+// idList returns ids as a list that is sent as [] rather than null when
+// empty, so updates clear the IDs removed from a rule.
+func idList(ids []int) *[]int {
+	l := append([]int{}, ids...)
+	return &l
+}
+
+// sameIDs returns true if a and b hold the same IDs in any order.
+func sameIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa, sb := append([]int{}, a...), append([]int{}, b...)
+	sort.Ints(sa)
+	sort.Ints(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
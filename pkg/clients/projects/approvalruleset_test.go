@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestDiffApprovalRuleSet(t *testing.T) {
+	security := v1alpha1.ApprovalRule{Name: "security", ApprovalsRequired: 2, UserIDs: []int{3, 1}}
+	anyone := v1alpha1.ApprovalRule{Name: "anyone", ApprovalsRequired: 1, RuleType: ptr.To("any_approver")}
+
+	existing := []*gitlab.ProjectApprovalRule{
+		{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2, Users: []*gitlab.BasicUser{{ID: 1}, {ID: 3}}},
+		{ID: 2, Name: "manual", RuleType: "regular", ApprovalsRequired: 1},
+	}
+
+	cases := map[string]struct {
+		p     *v1alpha1.ApprovalRuleSetParameters
+		rules []*gitlab.ProjectApprovalRule
+		want  ApprovalRuleSetDiff
+	}{
+		"UpToDateIgnoresOrder": {
+			p:     &v1alpha1.ApprovalRuleSetParameters{Rules: []v1alpha1.ApprovalRule{security}, Prune: ptr.To(false)},
+			rules: existing,
+			want:  ApprovalRuleSetDiff{},
+		},
+		"CreateMissing": {
+			p:     &v1alpha1.ApprovalRuleSetParameters{Rules: []v1alpha1.ApprovalRule{security, anyone}, Prune: ptr.To(false)},
+			rules: existing,
+			want:  ApprovalRuleSetDiff{Create: []v1alpha1.ApprovalRule{anyone}},
+		},
+		"UpdateChanged": {
+			p:     &v1alpha1.ApprovalRuleSetParameters{Rules: []v1alpha1.ApprovalRule{{Name: "security", ApprovalsRequired: 2, UserIDs: []int{1}}}, Prune: ptr.To(false)},
+			rules: existing,
+			want:  ApprovalRuleSetDiff{Update: []ApprovalRuleUpdate{{ID: 1, Rule: v1alpha1.ApprovalRule{Name: "security", ApprovalsRequired: 2, UserIDs: []int{1}}}}},
+		},
+		"RecreateChangedType": {
+			p:     &v1alpha1.ApprovalRuleSetParameters{Rules: []v1alpha1.ApprovalRule{{Name: "manual", ApprovalsRequired: 1, RuleType: ptr.To("any_approver")}}, Prune: ptr.To(false)},
+			rules: existing,
+			want: ApprovalRuleSetDiff{
+				Create: []v1alpha1.ApprovalRule{{Name: "manual", ApprovalsRequired: 1, RuleType: ptr.To("any_approver")}},
+				Delete: []int{2},
+			},
+		},
+		"PruneByDefault": {
+			p:     &v1alpha1.ApprovalRuleSetParameters{Rules: []v1alpha1.ApprovalRule{security}},
+			rules: existing,
+			want:  ApprovalRuleSetDiff{Delete: []int{2}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffApprovalRuleSet(tc.p, tc.rules)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ApprovalSettingsClient defines Gitlab project approval configuration
+// service operations
+type ApprovalSettingsClient interface {
+	GetApprovalConfiguration(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+	ChangeApprovalConfiguration(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+}
+
+// NewApprovalSettingsClient returns a new Gitlab project approval
+// configuration service
+func NewApprovalSettingsClient(cfg clients.Config) ApprovalSettingsClient {
+	git := clients.NewClient(cfg)
+	return git.Projects
+}
+
+// IsApprovalSettingsUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsApprovalSettingsUpToDate(p *v1alpha1.ApprovalSettingsParameters, g *gitlab.ProjectApprovals) bool {
+	return clients.IsBoolEqualToBoolPtr(p.ResetApprovalsOnPush, g.ResetApprovalsOnPush) &&
+		clients.IsBoolEqualToBoolPtr(p.DisableOverridingApproversPerMergeRequest, g.DisableOverridingApproversPerMergeRequest) &&
+		clients.IsBoolEqualToBoolPtr(p.MergeRequestsAuthorApproval, g.MergeRequestsAuthorApproval) &&
+		clients.IsBoolEqualToBoolPtr(p.RequirePasswordToApprove, g.RequirePasswordToApprove) &&
+		clients.IsBoolEqualToBoolPtr(p.SelectiveCodeOwnerRemovals, g.SelectiveCodeOwnerRemovals)
+}
+
+// LateInitializeApprovalSettings fills the settings that are not set in p
+// with the ones found at Gitlab.
+func LateInitializeApprovalSettings(p *v1alpha1.ApprovalSettingsParameters, g *gitlab.ProjectApprovals) {
+	if g == nil {
+		return
+	}
+	if p.ResetApprovalsOnPush == nil {
+		p.ResetApprovalsOnPush = &g.ResetApprovalsOnPush
+	}
+	if p.DisableOverridingApproversPerMergeRequest == nil {
+		p.DisableOverridingApproversPerMergeRequest = &g.DisableOverridingApproversPerMergeRequest
+	}
+	if p.MergeRequestsAuthorApproval == nil {
+		p.MergeRequestsAuthorApproval = &g.MergeRequestsAuthorApproval
+	}
+	if p.RequirePasswordToApprove == nil {
+		p.RequirePasswordToApprove = &g.RequirePasswordToApprove
+	}
+	if p.SelectiveCodeOwnerRemovals == nil {
+		p.SelectiveCodeOwnerRemovals = &g.SelectiveCodeOwnerRemovals
+	}
+}
+
+// GenerateApprovalSettingsObservation is used to produce
+// v1alpha1.ApprovalSettingsObservation from gitlab.ProjectApprovals.
+func GenerateApprovalSettingsObservation(g *gitlab.ProjectApprovals) v1alpha1.ApprovalSettingsObservation {
+	if g == nil {
+		return v1alpha1.ApprovalSettingsObservation{}
+	}
+	return v1alpha1.ApprovalSettingsObservation{
+		ResetApprovalsOnPush:                      g.ResetApprovalsOnPush,
+		DisableOverridingApproversPerMergeRequest: g.DisableOverridingApproversPerMergeRequest,
+		MergeRequestsAuthorApproval:               g.MergeRequestsAuthorApproval,
+		RequirePasswordToApprove:                  g.RequirePasswordToApprove,
+		SelectiveCodeOwnerRemovals:                g.SelectiveCodeOwnerRemovals,
+	}
+}
+
+// GenerateChangeApprovalConfigurationOptions generates project approval
+// configuration change options
+func GenerateChangeApprovalConfigurationOptions(p *v1alpha1.ApprovalSettingsParameters) *gitlab.ChangeApprovalConfigurationOptions {
+	return &gitlab.ChangeApprovalConfigurationOptions{
+		ResetApprovalsOnPush:                      p.ResetApprovalsOnPush,
+		DisableOverridingApproversPerMergeRequest: p.DisableOverridingApproversPerMergeRequest,
+		MergeRequestsAuthorApproval:               p.MergeRequestsAuthorApproval,
+		RequirePasswordToApprove:                  p.RequirePasswordToApprove,
+		SelectiveCodeOwnerRemovals:                p.SelectiveCodeOwnerRemovals,
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestIsApprovalSettingsUpToDate(t *testing.T) {
+	g := &gitlab.ProjectApprovals{
+		ResetApprovalsOnPush:        true,
+		MergeRequestsAuthorApproval: false,
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.ApprovalSettingsParameters
+		want bool
+	}{
+		"NothingSet": {
+			p:    &v1alpha1.ApprovalSettingsParameters{},
+			want: true,
+		},
+		"UpToDate": {
+			p: &v1alpha1.ApprovalSettingsParameters{
+				ResetApprovalsOnPush:        ptr.To(true),
+				MergeRequestsAuthorApproval: ptr.To(false),
+			},
+			want: true,
+		},
+		"Changed": {
+			p: &v1alpha1.ApprovalSettingsParameters{
+				ResetApprovalsOnPush:        ptr.To(true),
+				MergeRequestsAuthorApproval: ptr.To(true),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsApprovalSettingsUpToDate(tc.p, g)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeApprovalSettings(t *testing.T) {
+	g := &gitlab.ProjectApprovals{
+		ResetApprovalsOnPush:       true,
+		RequirePasswordToApprove:   true,
+		SelectiveCodeOwnerRemovals: false,
+	}
+	p := &v1alpha1.ApprovalSettingsParameters{
+		ProjectID:                ptr.To("1234"),
+		RequirePasswordToApprove: ptr.To(false),
+	}
+	want := &v1alpha1.ApprovalSettingsParameters{
+		ProjectID:            ptr.To("1234"),
+		ResetApprovalsOnPush: ptr.To(true),
+		DisableOverridingApproversPerMergeRequest: ptr.To(false),
+		MergeRequestsAuthorApproval:               ptr.To(false),
+		RequirePasswordToApprove:                  ptr.To(false),
+		SelectiveCodeOwnerRemovals:                ptr.To(false),
+	}
+
+	LateInitializeApprovalSettings(p, g)
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	errUnknownPlaceholder = "unknown badge placeholder %q"
+)
+
+// badgePlaceholders are the placeholders Gitlab renders in badge URLs.
+//
+// GitLab docs:
+// https://docs.gitlab.com/ee/user/project/badges.html#placeholders
+var badgePlaceholders = map[string]bool{
+	"project_path":        true,
+	"project_title":       true,
+	"project_name":        true,
+	"project_id":          true,
+	"project_namespace":   true,
+	"group_name":          true,
+	"gitlab_server":       true,
+	"gitlab_pages_domain": true,
+	"default_branch":      true,
+	"commit_sha":          true,
+	"latest_tag":          true,
+}
+
+// placeholderRegexp matches %{name} placeholders, tolerating whitespace and
+// URL-escaped braces.
+var placeholderRegexp = regexp.MustCompile(`(?i)(?:%|%25)(?:\{|%7B)\s*([a-z_]+)\s*(?:\}|%7D)`)
+
+// BadgeClient defines Gitlab ProjectBadge service operations
+type BadgeClient interface {
+	GetProjectBadge(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error)
+	AddProjectBadge(pid interface{}, opt *gitlab.AddProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error)
+	EditProjectBadge(pid interface{}, badge int, opt *gitlab.EditProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error)
+	DeleteProjectBadge(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewBadgeClient returns a new Gitlab ProjectBadge service
+func NewBadgeClient(cfg clients.Config) BadgeClient {
+	git := clients.NewClient(cfg)
+	return git.ProjectBadges
+}
+
+// ValidateBadgeURL returns an error if the URL contains a placeholder Gitlab
+// does not render.
+func ValidateBadgeURL(u string) error {
+	for _, m := range placeholderRegexp.FindAllStringSubmatch(u, -1) {
+		if !badgePlaceholders[strings.ToLower(m[1])] {
+			return errors.Errorf(errUnknownPlaceholder, m[0])
+		}
+	}
+	return nil
+}
+
+// NormalizeBadgeURL canonicalizes the placeholders of a badge URL so that
+// equivalent spellings, e.g. %{ Project_Path } or %25%7Bproject_path%7D,
+// compare equal to the %{project_path} Gitlab stores.
+func NormalizeBadgeURL(u string) string {
+	return placeholderRegexp.ReplaceAllStringFunc(strings.TrimSpace(u), func(m string) string {
+		return "%{" + strings.ToLower(placeholderRegexp.FindStringSubmatch(m)[1]) + "}"
+	})
+}
+
+// isBadgeURLUpToDate compares a desired badge URL to the one stored at
+// Gitlab. A desired URL without placeholders is also accepted if it equals
+// the rendered URL.
+func isBadgeURLUpToDate(desired, stored, rendered string) bool {
+	d := NormalizeBadgeURL(desired)
+	return d == NormalizeBadgeURL(stored) || d == strings.TrimSpace(rendered)
+}
+
+// IsBadgeUpToDate checks whether there is a change in any of the modifiable
+// fields. Placeholders are compared with the stored template, never with the
+// rendered URL, so rendering does not cause perpetual updates.
+func IsBadgeUpToDate(p *v1alpha1.BadgeParameters, g *gitlab.ProjectBadge) bool {
+	if !isBadgeURLUpToDate(p.LinkURL, g.LinkURL, g.RenderedLinkURL) {
+		return false
+	}
+	if !isBadgeURLUpToDate(p.ImageURL, g.ImageURL, g.RenderedImageURL) {
+		return false
+	}
+	return clients.IsStringEqualToStringPtr(p.Name, g.Name)
+}
+
+// LateInitializeBadge fills the empty fields in the badge spec with the
+// values seen in gitlab.ProjectBadge.
+func LateInitializeBadge(in *v1alpha1.BadgeParameters, badge *gitlab.ProjectBadge) {
+	if badge == nil {
+		return
+	}
+	in.Name = clients.LateInitializeStringPtr(in.Name, badge.Name)
+}
+
+// GenerateBadgeObservation is used to produce v1alpha1.BadgeObservation from
+// gitlab.ProjectBadge.
+func GenerateBadgeObservation(badge *gitlab.ProjectBadge) v1alpha1.BadgeObservation {
+	if badge == nil {
+		return v1alpha1.BadgeObservation{}
+	}
+	return v1alpha1.BadgeObservation{
+		ID:               badge.ID,
+		RenderedLinkURL:  badge.RenderedLinkURL,
+		RenderedImageURL: badge.RenderedImageURL,
+		Kind:             badge.Kind,
+	}
+}
+
+// GenerateAddBadgeOptions generates badge creation options
+func GenerateAddBadgeOptions(p *v1alpha1.BadgeParameters) *gitlab.AddProjectBadgeOptions {
+	return &gitlab.AddProjectBadgeOptions{
+		LinkURL:  gitlab.String(NormalizeBadgeURL(p.LinkURL)),
+		ImageURL: gitlab.String(NormalizeBadgeURL(p.ImageURL)),
+		Name:     p.Name,
+	}
+}
+
+// GenerateEditBadgeOptions generates badge edit options
+func GenerateEditBadgeOptions(p *v1alpha1.BadgeParameters) *gitlab.EditProjectBadgeOptions {
+	return &gitlab.EditProjectBadgeOptions{
+		LinkURL:  gitlab.String(NormalizeBadgeURL(p.LinkURL)),
+		ImageURL: gitlab.String(NormalizeBadgeURL(p.ImageURL)),
+		Name:     p.Name,
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestValidateBadgeURL(t *testing.T) {
+	cases := map[string]struct {
+		url  string
+		want error
+	}{
+		"NoPlaceholders": {
+			url: "https://example.com/badge.svg",
+		},
+		"KnownPlaceholders": {
+			url: "https://example.com/%{project_path}/-/pipelines?ref=%{default_branch}",
+		},
+		"UnknownPlaceholder": {
+			url:  "https://example.com/%{project_slug}",
+			want: errors.Errorf(errUnknownPlaceholder, "%{project_slug}"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateBadgeURL(tc.url)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeBadgeURL(t *testing.T) {
+	cases := map[string]struct {
+		url  string
+		want string
+	}{
+		"Canonical": {
+			url:  "https://example.com/%{project_path}",
+			want: "https://example.com/%{project_path}",
+		},
+		"Whitespace": {
+			url:  " https://example.com/%{ Project_Path } ",
+			want: "https://example.com/%{project_path}",
+		},
+		"Escaped": {
+			url:  "https://example.com/%25%7Bdefault_branch%7D",
+			want: "https://example.com/%{default_branch}",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NormalizeBadgeURL(tc.url)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsBadgeUpToDate(t *testing.T) {
+	stored := &gitlab.ProjectBadge{
+		Name:             "pipeline",
+		LinkURL:          "https://example.com/%{project_path}",
+		ImageURL:         "https://example.com/%{project_path}/badge.svg",
+		RenderedLinkURL:  "https://example.com/group/project",
+		RenderedImageURL: "https://example.com/group/project/badge.svg",
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.BadgeParameters
+		want bool
+	}{
+		"Placeholders": {
+			p: &v1alpha1.BadgeParameters{
+				Name:     gitlab.String("pipeline"),
+				LinkURL:  "https://example.com/%{project_path}",
+				ImageURL: "https://example.com/%{ project_path }/badge.svg",
+			},
+			want: true,
+		},
+		"Rendered": {
+			p: &v1alpha1.BadgeParameters{
+				LinkURL:  "https://example.com/group/project",
+				ImageURL: "https://example.com/group/project/badge.svg",
+			},
+			want: true,
+		},
+		"LinkChanged": {
+			p: &v1alpha1.BadgeParameters{
+				LinkURL:  "https://example.com/%{project_path}/-/pipelines",
+				ImageURL: "https://example.com/%{project_path}/badge.svg",
+			},
+			want: false,
+		},
+		"NameChanged": {
+			p: &v1alpha1.BadgeParameters{
+				Name:     gitlab.String("coverage"),
+				LinkURL:  "https://example.com/%{project_path}",
+				ImageURL: "https://example.com/%{project_path}/badge.svg",
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsBadgeUpToDate(tc.p, stored)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
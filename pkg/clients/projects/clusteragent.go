@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ClusterAgentClient defines Gitlab cluster agent service operations
+type ClusterAgentClient interface {
+	ListAgents(pid interface{}, opt *gitlab.ListAgentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Agent, *gitlab.Response, error)
+	GetAgent(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error)
+	RegisterAgent(pid interface{}, opt *gitlab.RegisterAgentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error)
+	DeleteAgent(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewClusterAgentClient returns a new Gitlab cluster agent service
+func NewClusterAgentClient(cfg clients.Config) ClusterAgentClient {
+	git := clients.NewClient(cfg)
+	return git.ClusterAgents
+}
+
+// FindAgentByName returns the agent of a project with name, or nil if the
+// project has no such agent.
+func FindAgentByName(c ClusterAgentClient, pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, error) {
+	opt := &gitlab.ListAgentsOptions{PerPage: 100}
+	for {
+		agents, res, err := c.ListAgents(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range agents {
+			if a.Name == name {
+				return a, nil
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// GenerateClusterAgentObservation is used to produce
+// v1alpha1.ClusterAgentObservation from gitlab.Agent.
+func GenerateClusterAgentObservation(a *gitlab.Agent) v1alpha1.ClusterAgentObservation {
+	if a == nil {
+		return v1alpha1.ClusterAgentObservation{}
+	}
+	return v1alpha1.ClusterAgentObservation{
+		ID:                             a.ID,
+		CreatedAt:                      clients.TimeToMetaTime(a.CreatedAt),
+		CreatedByUserID:                a.CreatedByUserID,
+		ConfigProjectID:                a.ConfigProject.ID,
+		ConfigProjectPathWithNamespace: a.ConfigProject.PathWithNamespace,
+	}
+}
+
+// GenerateRegisterAgentOptions generates cluster agent register options
+func GenerateRegisterAgentOptions(p *v1alpha1.ClusterAgentParameters) *gitlab.RegisterAgentOptions {
+	return &gitlab.RegisterAgentOptions{
+		Name: &p.Name,
+	}
+}
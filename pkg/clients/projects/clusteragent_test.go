@@ -0,0 +1,118 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+// pagedAgents lists one page of agents per request.
+type pagedAgents struct {
+	ClusterAgentClient
+	pages [][]*gitlab.Agent
+}
+
+func (c *pagedAgents) ListAgents(pid interface{}, opt *gitlab.ListAgentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Agent, *gitlab.Response, error) {
+	page := opt.Page
+	if page == 0 {
+		page = 1
+	}
+	res := &gitlab.Response{}
+	if page < len(c.pages) {
+		res.NextPage = page + 1
+	}
+	return c.pages[page-1], res, nil
+}
+
+func TestFindAgentByName(t *testing.T) {
+	pages := [][]*gitlab.Agent{
+		{{ID: 1, Name: "staging"}},
+		{{ID: 2, Name: "production"}},
+	}
+	c := &pagedAgents{pages: pages}
+
+	cases := map[string]struct {
+		name string
+		want *gitlab.Agent
+	}{
+		"FirstPage": {
+			name: "staging",
+			want: pages[0][0],
+		},
+		"LaterPage": {
+			name: "production",
+			want: pages[1][0],
+		},
+		"NotRegistered": {
+			name: "development",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FindAgentByName(c, 1, tc.name)
+			if err != nil {
+				t.Fatalf("FindAgentByName(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateClusterAgentObservation(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		a    *gitlab.Agent
+		want v1alpha1.ClusterAgentObservation
+	}{
+		"Nil": {},
+		"Agent": {
+			a: &gitlab.Agent{
+				ID:              7,
+				Name:            "production",
+				CreatedAt:       &createdAt,
+				CreatedByUserID: 3,
+				ConfigProject:   gitlab.ConfigProject{ID: 1, PathWithNamespace: "org/agents"},
+			},
+			want: v1alpha1.ClusterAgentObservation{
+				ID:                             7,
+				CreatedAt:                      &metav1.Time{Time: createdAt},
+				CreatedByUserID:                3,
+				ConfigProjectID:                1,
+				ConfigProjectPathWithNamespace: "org/agents",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateClusterAgentObservation(tc.a)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
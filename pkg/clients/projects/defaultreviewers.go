@@ -0,0 +1,165 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	defaultCodeOwnersBranch        = "main"
+	defaultCodeOwnersPath          = ".gitlab/CODEOWNERS"
+	defaultCodeOwnersCommitMessage = "Update CODEOWNERS"
+
+	codeOwnersHeader = "# Managed by Crossplane. Changes made to this file in Gitlab are overwritten.\n"
+)
+
+// DefaultReviewersClient defines Gitlab repository file and project approval
+// rule service operations
+type DefaultReviewersClient interface {
+	ApprovalRuleClient
+	GetFile(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error)
+	CreateFile(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error)
+	UpdateFile(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error)
+	DeleteFile(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type defaultReviewersClient struct {
+	*gitlab.ProjectsService
+	*gitlab.RepositoryFilesService
+}
+
+// NewDefaultReviewersClient returns a new Gitlab repository file and project
+// approval rule service
+func NewDefaultReviewersClient(cfg clients.Config) DefaultReviewersClient {
+	git := clients.NewClient(cfg)
+	return &defaultReviewersClient{ProjectsService: git.Projects, RepositoryFilesService: git.RepositoryFiles}
+}
+
+// CodeOwnersBranch returns the branch the CODEOWNERS file is committed to.
+func CodeOwnersBranch(p *v1alpha1.DefaultReviewersParameters) string {
+	return ptr.Deref(p.Branch, defaultCodeOwnersBranch)
+}
+
+// CodeOwnersPath returns the path of the CODEOWNERS file.
+func CodeOwnersPath(p *v1alpha1.DefaultReviewersParameters) string {
+	return ptr.Deref(p.FilePath, defaultCodeOwnersPath)
+}
+
+// RenderCodeOwners returns the content of the CODEOWNERS file with the
+// given sections.
+func RenderCodeOwners(sections []v1alpha1.CodeOwnersSection) string {
+	b := &strings.Builder{}
+	b.WriteString(codeOwnersHeader)
+	for _, s := range sections {
+		b.WriteString("\n")
+		if ptr.Deref(s.Optional, false) {
+			b.WriteString("^")
+		}
+		b.WriteString("[" + s.Name + "]")
+		if s.ApprovalsRequired != nil {
+			b.WriteString("[" + strconv.Itoa(*s.ApprovalsRequired) + "]")
+		}
+		writeOwners(b, s.DefaultOwners)
+		for _, e := range s.Entries {
+			b.WriteString(e.Pattern)
+			writeOwners(b, e.Owners)
+		}
+	}
+	return b.String()
+}
+
+func writeOwners(b *strings.Builder, owners []string) {
+	for _, o := range owners {
+		b.WriteString(" " + o)
+	}
+	b.WriteString("\n")
+}
+
+// FileContent returns the decoded content of a repository file.
+func FileContent(f *gitlab.File) (string, error) {
+	if f.Encoding != "base64" {
+		return f.Content, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(f.Content)
+	return string(b), err
+}
+
+// DiffDefaultReviewersRules compares the approval rules of a DefaultReviewers
+// with the ones found at Gitlab. Other rules of the project are left alone.
+func DiffDefaultReviewersRules(p *v1alpha1.DefaultReviewersParameters, rules []*gitlab.ProjectApprovalRule) ApprovalRuleSetDiff {
+	return DiffApprovalRuleSet(&v1alpha1.ApprovalRuleSetParameters{Rules: p.Rules, Prune: ptr.To(false)}, rules)
+}
+
+// GenerateDefaultReviewersObservation is used to produce
+// v1alpha1.DefaultReviewersObservation from the CODEOWNERS file and the
+// approval rules of a project. Only the rules of the DefaultReviewers are
+// observed.
+func GenerateDefaultReviewersObservation(p *v1alpha1.DefaultReviewersParameters, f *gitlab.File, rules []*gitlab.ProjectApprovalRule) v1alpha1.DefaultReviewersObservation {
+	names := make(map[string]bool, len(p.Rules))
+	for _, r := range p.Rules {
+		names[r.Name] = true
+	}
+	var managed []*gitlab.ProjectApprovalRule
+	for _, r := range rules {
+		if names[r.Name] {
+			managed = append(managed, r)
+		}
+	}
+	return v1alpha1.DefaultReviewersObservation{
+		LastCommitID: f.LastCommitID,
+		Rules:        GenerateApprovalRuleSetObservation(managed).Rules,
+	}
+}
+
+// GenerateCreateCodeOwnersOptions generates options to create the CODEOWNERS
+// file
+func GenerateCreateCodeOwnersOptions(p *v1alpha1.DefaultReviewersParameters) *gitlab.CreateFileOptions {
+	return &gitlab.CreateFileOptions{
+		Branch:        ptr.To(CodeOwnersBranch(p)),
+		Content:       ptr.To(RenderCodeOwners(p.Sections)),
+		CommitMessage: ptr.To(ptr.Deref(p.CommitMessage, defaultCodeOwnersCommitMessage)),
+	}
+}
+
+// GenerateUpdateCodeOwnersOptions generates options to update the CODEOWNERS
+// file, failing if it changed since its last commit was observed.
+func GenerateUpdateCodeOwnersOptions(p *v1alpha1.DefaultReviewersParameters, lastCommitID string) *gitlab.UpdateFileOptions {
+	return &gitlab.UpdateFileOptions{
+		Branch:        ptr.To(CodeOwnersBranch(p)),
+		Content:       ptr.To(RenderCodeOwners(p.Sections)),
+		CommitMessage: ptr.To(ptr.Deref(p.CommitMessage, defaultCodeOwnersCommitMessage)),
+		LastCommitID:  &lastCommitID,
+	}
+}
+
+// GenerateDeleteCodeOwnersOptions generates options to delete the CODEOWNERS
+// file
+func GenerateDeleteCodeOwnersOptions(p *v1alpha1.DefaultReviewersParameters) *gitlab.DeleteFileOptions {
+	return &gitlab.DeleteFileOptions{
+		Branch:        ptr.To(CodeOwnersBranch(p)),
+		CommitMessage: ptr.To(ptr.Deref(p.CommitMessage, defaultCodeOwnersCommitMessage)),
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestRenderCodeOwners(t *testing.T) {
+	cases := map[string]struct {
+		sections []v1alpha1.CodeOwnersSection
+		want     string
+	}{
+		"Empty": {
+			want: codeOwnersHeader,
+		},
+		"Sections": {
+			sections: []v1alpha1.CodeOwnersSection{
+				{
+					Name:              "Backend",
+					ApprovalsRequired: ptr.To(2),
+					DefaultOwners:     []string{"@backend", "@lead"},
+					Entries: []v1alpha1.CodeOwnersEntry{
+						{Pattern: "*.go"},
+						{Pattern: "/api/", Owners: []string{"@api-team"}},
+					},
+				},
+				{
+					Name:     "Docs",
+					Optional: ptr.To(true),
+					Entries:  []v1alpha1.CodeOwnersEntry{{Pattern: "/docs/", Owners: []string{"writer@example.org"}}},
+				},
+			},
+			want: codeOwnersHeader + `
+[Backend][2] @backend @lead
+*.go
+/api/ @api-team
+
+^[Docs]
+/docs/ writer@example.org
+`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, RenderCodeOwners(tc.sections)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
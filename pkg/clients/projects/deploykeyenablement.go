@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"sort"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// DeployKeyEnablementClient defines the Gitlab operations to enable a deploy
+// key for the projects of a group.
+type DeployKeyEnablementClient interface {
+	GroupProjectLister
+	GetDeployKey(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	EnableDeployKey(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	DeleteDeployKey(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type deployKeyEnablementClient struct {
+	*gitlab.DeployKeysService
+	groups *gitlab.GroupsService
+}
+
+// NewDeployKeyEnablementClient returns a new Gitlab client to enable deploy
+// keys with.
+func NewDeployKeyEnablementClient(cfg clients.Config) DeployKeyEnablementClient {
+	git := clients.NewClient(cfg)
+	return &deployKeyEnablementClient{DeployKeysService: git.DeployKeys, groups: git.Groups}
+}
+
+// ListGroupProjects lists the projects of a group.
+func (c *deployKeyEnablementClient) ListGroupProjects(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	return c.groups.ListGroupProjects(gid, opt, options...)
+}
+
+// DiffDeployKeyProjects returns the projects a deploy key has to be enabled
+// for and the projects it has to be removed from, so that it is enabled for
+// exactly the desired projects. Both are sorted.
+func DiffDeployKeyProjects(desired, enabled []int) (enable, remove []int) {
+	want := make(map[int]bool, len(desired))
+	for _, id := range desired {
+		want[id] = true
+	}
+	has := make(map[int]bool, len(enabled))
+	for _, id := range enabled {
+		has[id] = true
+		if !want[id] {
+			remove = append(remove, id)
+		}
+	}
+	for _, id := range desired {
+		if !has[id] {
+			enable = append(enable, id)
+		}
+	}
+	sort.Ints(enable)
+	sort.Ints(remove)
+	return enable, remove
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffDeployKeyProjects(t *testing.T) {
+	type want struct {
+		enable []int
+		remove []int
+	}
+
+	cases := map[string]struct {
+		desired []int
+		enabled []int
+		want    want
+	}{
+		"UpToDate": {
+			desired: []int{1, 2},
+			enabled: []int{2, 1},
+			want:    want{},
+		},
+		"Joined": {
+			desired: []int{3, 1, 2},
+			enabled: []int{1},
+			want:    want{enable: []int{2, 3}},
+		},
+		"Left": {
+			desired: []int{1},
+			enabled: []int{4, 1, 3},
+			want:    want{remove: []int{3, 4}},
+		},
+		"NothingDesired": {
+			enabled: []int{1},
+			want:    want{remove: []int{1}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			enable, remove := DiffDeployKeyProjects(tc.desired, tc.enabled)
+			if diff := cmp.Diff(tc.want.enable, enable); diff != "" {
+				t.Errorf("enable: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -30,6 +30,12 @@ type DeployTokenClient interface {
 	CreateProjectDeployToken(pid interface{}, opt *gitlab.CreateProjectDeployTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error)
 	DeleteProjectDeployToken(pid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	GetProjectDeployToken(pid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error)
+	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+}
+
+type deployTokenClient struct {
+	*gitlab.DeployTokensService
+	projects *gitlab.ProjectsService
 }
 
 // IsErrorProjectDeployTokenNotFound helper function to test for errProjectDeployTokenNotFound error.
@@ -43,7 +49,11 @@ func IsErrorProjectDeployTokenNotFound(err error) bool {
 // NewDeployTokenClient returns a new Gitlab ProjectDeployToken service
 func NewDeployTokenClient(cfg clients.Config) DeployTokenClient {
 	git := clients.NewClient(cfg)
-	return git.DeployTokens
+	return &deployTokenClient{DeployTokensService: git.DeployTokens, projects: git.Projects}
+}
+
+func (c *deployTokenClient) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.projects.GetProject(pid, opt, options...)
 }
 
 // GenerateCreateProjectDeployTokenOptions generates project creation options
@@ -63,3 +73,9 @@ func GenerateCreateProjectDeployTokenOptions(name string, p *v1alpha1.DeployToke
 
 	return deploytoken
 }
+
+// IsDeployTokenUpToDate checks whether the scopes of the deploy token match
+// the spec, regardless of their order.
+func IsDeployTokenUpToDate(p *v1alpha1.DeployTokenParameters, dt *gitlab.DeployToken) bool {
+	return isScopesEqual(p.Scopes, dt.Scopes)
+}
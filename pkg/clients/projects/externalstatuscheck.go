@@ -0,0 +1,258 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	errProtectedBranchNotFound = "cannot find protected branch %q"
+)
+
+// ExternalStatusCheck is an external status check of a project. It is
+// defined here rather than in go-gitlab as that does not return the created
+// check nor whether it is signed.
+type ExternalStatusCheck struct {
+	ID                int                                 `json:"id"`
+	Name              string                              `json:"name"`
+	ProjectID         int                                 `json:"project_id"`
+	ExternalURL       string                              `json:"external_url"`
+	HMAC              bool                                `json:"hmac"`
+	ProtectedBranches []gitlab.StatusCheckProtectedBranch `json:"protected_branches"`
+}
+
+// ExternalStatusCheckOptions are the options to create or update an external
+// status check with.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/status_checks.html#create-external-status-check
+type ExternalStatusCheckOptions struct {
+	Name               *string `url:"name,omitempty" json:"name,omitempty"`
+	ExternalURL        *string `url:"external_url,omitempty" json:"external_url,omitempty"`
+	SharedSecret       *string `url:"shared_secret,omitempty" json:"shared_secret,omitempty"`
+	ProtectedBranchIDs *[]int  `url:"protected_branch_ids,omitempty" json:"protected_branch_ids,omitempty"`
+}
+
+// ExternalStatusCheckClient defines Gitlab external status check service
+// operations
+type ExternalStatusCheckClient interface {
+	ListProjectStatusChecks(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*ExternalStatusCheck, *gitlab.Response, error)
+	CreateExternalStatusCheck(pid interface{}, opt *ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*ExternalStatusCheck, *gitlab.Response, error)
+	UpdateExternalStatusCheck(pid interface{}, check int, opt *ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*ExternalStatusCheck, *gitlab.Response, error)
+	DeleteExternalStatusCheck(pid interface{}, check int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ListProtectedBranches(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error)
+}
+
+type externalStatusCheckClient struct {
+	*gitlab.ProtectedBranchesService
+	git *gitlab.Client
+}
+
+// NewExternalStatusCheckClient returns a new Gitlab external status check
+// service
+func NewExternalStatusCheckClient(cfg clients.Config) ExternalStatusCheckClient {
+	git := clients.NewClient(cfg)
+	return &externalStatusCheckClient{ProtectedBranchesService: git.ProtectedBranches, git: git}
+}
+
+// ListProjectStatusChecks lists the external status checks of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/status_checks.html#get-project-external-status-checks
+func (c *externalStatusCheckClient) ListProjectStatusChecks(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*ExternalStatusCheck, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.git.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/external_status_checks", project), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var checks []*ExternalStatusCheck
+	resp, err := c.git.Do(req, &checks)
+	if err != nil {
+		return nil, resp, err
+	}
+	return checks, resp, nil
+}
+
+// CreateExternalStatusCheck creates an external status check.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/status_checks.html#create-external-status-check
+func (c *externalStatusCheckClient) CreateExternalStatusCheck(pid interface{}, opt *ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*ExternalStatusCheck, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.do(http.MethodPost, fmt.Sprintf("projects/%s/external_status_checks", project), opt, options)
+}
+
+// UpdateExternalStatusCheck updates an external status check.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/status_checks.html#update-external-status-check
+func (c *externalStatusCheckClient) UpdateExternalStatusCheck(pid interface{}, check int, opt *ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*ExternalStatusCheck, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.do(http.MethodPut, fmt.Sprintf("projects/%s/external_status_checks/%d", project, check), opt, options)
+}
+
+// DeleteExternalStatusCheck deletes an external status check.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/status_checks.html#delete-external-status-check
+func (c *externalStatusCheckClient) DeleteExternalStatusCheck(pid interface{}, check int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.git.ExternalStatusChecks.DeleteExternalStatusCheck(pid, check, options...)
+}
+
+func (c *externalStatusCheckClient) do(method, u string, opt *ExternalStatusCheckOptions, options []gitlab.RequestOptionFunc) (*ExternalStatusCheck, *gitlab.Response, error) {
+	req, err := c.git.NewRequest(method, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	check := new(ExternalStatusCheck)
+	resp, err := c.git.Do(req, check)
+	if err != nil {
+		return nil, resp, err
+	}
+	return check, resp, nil
+}
+
+// GetExternalStatusCheck returns the external status check with the given ID,
+// or nil if the project has none. Gitlab has no endpoint for a single check,
+// so all pages of checks are searched.
+func GetExternalStatusCheck(c ExternalStatusCheckClient, pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*ExternalStatusCheck, error) {
+	opt := &gitlab.ListOptions{PerPage: 100}
+	for {
+		checks, res, err := c.ListProjectStatusChecks(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, check := range checks {
+			if check.ID == id {
+				return check, nil
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// ProtectedBranchIDs resolves the names of protected branches to their IDs.
+func ProtectedBranchIDs(c ExternalStatusCheckClient, pid interface{}, names []string, options ...gitlab.RequestOptionFunc) ([]int, error) {
+	if len(names) == 0 {
+		return []int{}, nil
+	}
+
+	opt := &gitlab.ListProtectedBranchesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	byName := map[string]int{}
+	for {
+		branches, res, err := c.ListProtectedBranches(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range branches {
+			byName[b.Name] = b.ID
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opt.Page = res.NextPage
+	}
+
+	ids := make([]int, len(names))
+	for i, n := range names {
+		id, ok := byName[n]
+		if !ok {
+			return nil, errors.Errorf(errProtectedBranchNotFound, n)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// HashSharedSecret returns the hash of a shared secret recorded in the
+// observation.
+func HashSharedSecret(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+// IsExternalStatusCheckUpToDate checks whether there is a change in any of
+// the modifiable fields. secretHash is the hash of the desired shared secret.
+// Signing is not compared if it is empty, as Gitlab offers no way to remove
+// the secret of a check.
+func IsExternalStatusCheckUpToDate(p *v1alpha1.ExternalStatusCheckParameters, check *ExternalStatusCheck, o v1alpha1.ExternalStatusCheckObservation, secretHash string) bool {
+	if p.Name != check.Name || clients.NormalizeURL(p.ExternalURL) != clients.NormalizeURL(check.ExternalURL) {
+		return false
+	}
+
+	branches := make([]string, len(check.ProtectedBranches))
+	for i, b := range check.ProtectedBranches {
+		branches[i] = b.Name
+	}
+	want := append([]string{}, p.ProtectedBranches...)
+	sort.Strings(branches)
+	sort.Strings(want)
+	if len(want) != len(branches) {
+		return false
+	}
+	for i := range want {
+		if want[i] != branches[i] {
+			return false
+		}
+	}
+
+	if secretHash == "" {
+		return true
+	}
+	return check.HMAC && o.SharedSecretHash == secretHash
+}
+
+// GenerateExternalStatusCheckOptions generates the options to create or
+// update an external status check with. An empty secret leaves the secret
+// of the check as is.
+func GenerateExternalStatusCheckOptions(p *v1alpha1.ExternalStatusCheckParameters, branchIDs []int, secret string) *ExternalStatusCheckOptions {
+	o := &ExternalStatusCheckOptions{
+		Name:               &p.Name,
+		ExternalURL:        &p.ExternalURL,
+		ProtectedBranchIDs: &branchIDs,
+	}
+	if secret != "" {
+		o.SharedSecret = &secret
+	}
+	return o
+}
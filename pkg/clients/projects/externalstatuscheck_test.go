@@ -0,0 +1,128 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+type protectedBranchLister struct {
+	ExternalStatusCheckClient
+	branches []*gitlab.ProtectedBranch
+}
+
+func (l *protectedBranchLister) ListProtectedBranches(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return l.branches, &gitlab.Response{}, nil
+}
+
+func TestProtectedBranchIDs(t *testing.T) {
+	c := &protectedBranchLister{branches: []*gitlab.ProtectedBranch{{ID: 1, Name: "main"}, {ID: 2, Name: "release/*"}}}
+
+	cases := map[string]struct {
+		names []string
+		want  []int
+		err   error
+	}{
+		"AllBranches": {
+			want: []int{},
+		},
+		"Resolved": {
+			names: []string{"release/*", "main"},
+			want:  []int{2, 1},
+		},
+		"NotProtected": {
+			names: []string{"develop"},
+			err:   errors.Errorf(errProtectedBranchNotFound, "develop"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ProtectedBranchIDs(c, 1, tc.names)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsExternalStatusCheckUpToDate(t *testing.T) {
+	check := &ExternalStatusCheck{
+		ID:          1,
+		Name:        "compliance",
+		ExternalURL: "https://example.com/check",
+		HMAC:        true,
+		ProtectedBranches: []gitlab.StatusCheckProtectedBranch{
+			{ID: 1, Name: "main"},
+			{ID: 2, Name: "release/*"},
+		},
+	}
+	hash := HashSharedSecret("s3cret")
+
+	cases := map[string]struct {
+		p          *v1alpha1.ExternalStatusCheckParameters
+		o          v1alpha1.ExternalStatusCheckObservation
+		secretHash string
+		want       bool
+	}{
+		"UpToDate": {
+			p:          &v1alpha1.ExternalStatusCheckParameters{Name: "compliance", ExternalURL: "https://example.com/check", ProtectedBranches: []string{"release/*", "main"}},
+			o:          v1alpha1.ExternalStatusCheckObservation{SharedSecretHash: hash},
+			secretHash: hash,
+			want:       true,
+		},
+		"SecretUnmanaged": {
+			p:    &v1alpha1.ExternalStatusCheckParameters{Name: "compliance", ExternalURL: "https://example.com/check", ProtectedBranches: []string{"main", "release/*"}},
+			want: true,
+		},
+		"SecretRotated": {
+			p:          &v1alpha1.ExternalStatusCheckParameters{Name: "compliance", ExternalURL: "https://example.com/check", ProtectedBranches: []string{"main", "release/*"}},
+			o:          v1alpha1.ExternalStatusCheckObservation{SharedSecretHash: hash},
+			secretHash: HashSharedSecret("rotated"),
+			want:       false,
+		},
+		"BranchRemoved": {
+			p:    &v1alpha1.ExternalStatusCheckParameters{Name: "compliance", ExternalURL: "https://example.com/check", ProtectedBranches: []string{"main"}},
+			want: false,
+		},
+		"URLNormalized": {
+			p:    &v1alpha1.ExternalStatusCheckParameters{Name: "compliance", ExternalURL: "HTTPS://Example.com:443/check", ProtectedBranches: []string{"main", "release/*"}},
+			want: true,
+		},
+		"URLChanged": {
+			p:    &v1alpha1.ExternalStatusCheckParameters{Name: "compliance", ExternalURL: "https://example.com/other", ProtectedBranches: []string{"main", "release/*"}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsExternalStatusCheckUpToDate(tc.p, check, tc.o, tc.secretHash)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,20 +28,33 @@ var _ projects.Client = &MockClient{}
 type MockClient struct {
 	projects.Client
 
-	MockGetProject    func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockCreateProject func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockEditProject   func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockDeleteProject func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
-
-	MockGetHook    func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
-	MockAddHook    func(pid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
-	MockEditHook   func(pid interface{}, hook int, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
-	MockDeleteHook func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
-
-	MockGetMember    func(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
-	MockAddMember    func(pid interface{}, opt *gitlab.AddProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
-	MockEditMember   func(pid interface{}, user int, opt *gitlab.EditProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
-	MockDeleteMember func(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetProject                   func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockCreateProject                func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockEditProject                  func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockDeleteProject                func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetProjectPullMirrorDetails  func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPullMirrorDetails, *gitlab.Response, error)
+	MockStartMirroringProject        func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockRestoreProject               func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockListGroupProjects            func(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error)
+	MockGetProjectForkSettings       func(pid interface{}, options ...gitlab.RequestOptionFunc) (*projects.ProjectForkSettings, *gitlab.Response, error)
+	MockEditProjectForkSettings      func(pid interface{}, opt *projects.ProjectForkSettings, options ...gitlab.RequestOptionFunc) (*projects.ProjectForkSettings, *gitlab.Response, error)
+	MockListCustomProjectAttributes  func(project int, options ...gitlab.RequestOptionFunc) ([]*gitlab.CustomAttribute, *gitlab.Response, error)
+	MockSetCustomProjectAttribute    func(project int, c gitlab.CustomAttribute, options ...gitlab.RequestOptionFunc) (*gitlab.CustomAttribute, *gitlab.Response, error)
+	MockDeleteCustomProjectAttribute func(project int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetHook       func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
+	MockAddHook       func(pid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
+	MockEditHook      func(pid interface{}, hook int, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
+	MockDeleteHook    func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockListHooks     func(pid interface{}, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error)
+	MockGetHookStatus func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error)
+	MockTestHook      func(pid interface{}, hook int, trigger string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetMember          func(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	MockGetInheritedMember func(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	MockAddMember          func(pid interface{}, opt *gitlab.AddProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	MockEditMember         func(pid interface{}, user int, opt *gitlab.EditProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	MockDeleteMember       func(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
 	MockCreateDeployToken     func(pid interface{}, opt *gitlab.CreateProjectDeployTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error)
 	MockDeleteDeployToken     func(pid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
@@ -53,14 +66,23 @@ type MockClient struct {
 	MockListVariables  func(pid interface{}, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error)
 	MockRemoveVariable func(pid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
+	MockListProjectMembers func(pid interface{}, opt *gitlab.ListProjectMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error)
+
+	MockScheduleExport func(pid interface{}, opt *gitlab.ScheduleExportOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockExportStatus   func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error)
+
 	MockGetProjectAccessToken    func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
 	MockCreateProjectAccessToken func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
 	MockRevokeProjectAccessToken func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
+	MockGetSinglePersonalAccessToken func(options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	MockCurrentUser                  func(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
+
 	MockAddDeployKey    func(pid interface{}, opt *gitlab.AddDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
 	MockDeleteDeployKey func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	MockUpdateDeployKey func(pid interface{}, deployKey int, opt *gitlab.UpdateDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
 	MockGetDeployKey    func(pid interface{}, deployKey int, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
+	MockEnableDeployKey func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
 
 	MockGetPipelineSchedule            func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
 	MockCreatePipelineSchedule         func(pid interface{}, opt *gitlab.CreatePipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
@@ -70,7 +92,134 @@ type MockClient struct {
 	MockEditPipelineScheduleVariable   func(pid interface{}, schedule int, key string, opt *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 	MockDeletePipelineScheduleVariable func(pid interface{}, schedule int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 
+	MockListLabels  func(pid interface{}, opt *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error)
+	MockCreateLabel func(pid interface{}, opt *gitlab.CreateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error)
+	MockUpdateLabel func(pid interface{}, opt *gitlab.UpdateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error)
+	MockDeleteLabel func(pid interface{}, opt *gitlab.DeleteLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetProjectApprovalRules   func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	MockCreateProjectApprovalRule func(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	MockUpdateProjectApprovalRule func(pid interface{}, approvalRule int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	MockDeleteProjectApprovalRule func(pid interface{}, approvalRule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetApprovalConfiguration    func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+	MockChangeApprovalConfiguration func(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+
+	MockListProjectMirror   func(pid interface{}, opt *gitlab.ListProjectMirrorOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMirror, *gitlab.Response, error)
+	MockGetProjectMirror    func(pid interface{}, mirror int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, *gitlab.Response, error)
+	MockAddProjectMirror    func(pid interface{}, opt *gitlab.AddProjectMirrorOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, *gitlab.Response, error)
+	MockEditProjectMirror   func(pid interface{}, mirror int, opt *gitlab.EditProjectMirrorOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, *gitlab.Response, error)
+	MockDeleteProjectMirror func(pid interface{}, mirror int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockListProjectSharedWithGroups  func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]*projects.SharedWithGroup, *gitlab.Response, error)
+	MockShareProjectWithGroup        func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockDeleteSharedProjectFromGroup func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockListAgents    func(pid interface{}, opt *gitlab.ListAgentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Agent, *gitlab.Response, error)
+	MockGetAgent      func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error)
+	MockRegisterAgent func(pid interface{}, opt *gitlab.RegisterAgentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error)
+	MockDeleteAgent   func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetFile    func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error)
+	MockCreateFile func(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error)
+	MockUpdateFile func(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error)
+	MockDeleteFile func(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetSnippet         func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	MockCreateSnippet      func(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	MockUpdateSnippet      func(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	MockDeleteSnippet      func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockSnippetFileContent func(pid interface{}, snippet int, ref, filename string, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error)
+
+	MockGetWikiPage    func(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	MockCreateWikiPage func(pid interface{}, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	MockEditWikiPage   func(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	MockDeleteWikiPage func(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockListProtectedBranches       func(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error)
+	MockProtectRepositoryBranches   func(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error)
+	MockUpdateProtectedBranch       func(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error)
+	MockUnprotectRepositoryBranches func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetBadge    func(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error)
+	MockAddBadge    func(pid interface{}, opt *gitlab.AddProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error)
+	MockEditBadge   func(pid interface{}, badge int, opt *gitlab.EditProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error)
+	MockDeleteBadge func(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetRelease        func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	MockGetReleaseDetails func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.ReleaseDetails, *gitlab.Response, error)
+	MockCreateRelease     func(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	MockUpdateRelease     func(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	MockDeleteRelease     func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	MockCreateReleaseLink func(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+	MockUpdateReleaseLink func(pid interface{}, tagName string, link int, opt *gitlab.UpdateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+	MockDeleteReleaseLink func(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+
+	MockGetFreezePeriod    func(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error)
+	MockCreateFreezePeriod func(pid interface{}, opt *gitlab.CreateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error)
+	MockUpdateFreezePeriod func(pid interface{}, freezePeriod int, opt *gitlab.UpdateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error)
+	MockDeleteFreezePeriod func(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockListProjectStatusChecks   func(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*projects.ExternalStatusCheck, *gitlab.Response, error)
+	MockCreateExternalStatusCheck func(pid interface{}, opt *projects.ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*projects.ExternalStatusCheck, *gitlab.Response, error)
+	MockUpdateExternalStatusCheck func(pid interface{}, check int, opt *projects.ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*projects.ExternalStatusCheck, *gitlab.Response, error)
+	MockDeleteExternalStatusCheck func(pid interface{}, check int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetJobTokenAccessSettings                 func(pid interface{}, options ...gitlab.RequestOptionFunc) (*projects.JobTokenAccessSettings, *gitlab.Response, error)
+	MockPatchJobTokenAccessSettings               func(pid interface{}, opt *projects.PatchJobTokenAccessSettingsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockListJobTokenInboundAllowList              func(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error)
+	MockAddProjectToJobTokenInboundAllowList      func(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockRemoveProjectFromJobTokenInboundAllowList func(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockListJobTokenAllowlistGroups               func(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Group, *gitlab.Response, error)
+	MockAddGroupToJobTokenAllowlist               func(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockRemoveGroupFromJobTokenAllowlist          func(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockCreateProjectForkRelation func(pid interface{}, fork int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectForkRelation, *gitlab.Response, error)
+	MockDeleteProjectForkRelation func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetJiraService    func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error)
+	MockSetJiraService    func(pid interface{}, opt *gitlab.SetJiraServiceOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockDeleteJiraService func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetSettingsForProject    func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error)
+	MockUpdateSettingsForProject func(pid interface{}, opt *gitlab.NotificationSettingsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error)
+
+	MockListIssueNotes         func(pid interface{}, issue int, opt *gitlab.ListIssueNotesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error)
+	MockGetIssueNote           func(pid interface{}, issue, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	MockCreateIssueNote        func(pid interface{}, issue int, opt *gitlab.CreateIssueNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	MockUpdateIssueNote        func(pid interface{}, issue, note int, opt *gitlab.UpdateIssueNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	MockDeleteIssueNote        func(pid interface{}, issue, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockListMergeRequestNotes  func(pid interface{}, mergeRequest int, opt *gitlab.ListMergeRequestNotesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error)
+	MockGetMergeRequestNote    func(pid interface{}, mergeRequest, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	MockCreateMergeRequestNote func(pid interface{}, mergeRequest int, opt *gitlab.CreateMergeRequestNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	MockUpdateMergeRequestNote func(pid interface{}, mergeRequest, note int, opt *gitlab.UpdateMergeRequestNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	MockDeleteMergeRequestNote func(pid interface{}, mergeRequest, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetMergeRequest       func(pid interface{}, mergeRequest int, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error)
+	MockGetConfiguration      func(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error)
+	MockApproveMergeRequest   func(pid interface{}, mr int, opt *gitlab.ApproveMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error)
+	MockUnapproveMergeRequest func(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockListIssueRelations func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error)
+	MockCreateIssueLink    func(pid interface{}, issue int, opt *gitlab.CreateIssueLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error)
+	MockDeleteIssueLink    func(pid interface{}, issue, issueLink int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error)
+
+	MockListProjectPipelines func(pid interface{}, opt *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error)
+	MockDeletePipeline       func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockListMilestones     func(pid interface{}, opt *gitlab.ListMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error)
+	MockGetMilestone       func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, *gitlab.Response, error)
+	MockCreateMilestone    func(pid interface{}, opt *gitlab.CreateMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, *gitlab.Response, error)
+	MockUpdateMilestone    func(pid interface{}, milestone int, opt *gitlab.UpdateMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, *gitlab.Response, error)
+	MockDeleteMilestone    func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetMilestoneIssues func(pid interface{}, milestone int, opt *gitlab.GetMilestoneIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error)
+	MockUpdateIssue        func(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+
+	MockGetGroup           func(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	MockListGroupVariables func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error)
+
 	MockListUsers func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	MockGetUser   func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
 }
 
 // GetPipelineSchedule calls the underlying MockGetPipelineSchedule method.
@@ -133,7 +282,11 @@ func (c *MockClient) GetProjectHook(pid interface{}, hook int, options ...gitlab
 	return c.MockGetHook(pid, hook)
 }
 
-// AddProjectHook calls the underlying MockAddHook method.
+// ListProjectHooks calls the underlying MockListHooks method.
+func (c *MockClient) ListProjectHooks(pid interface{}, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error) {
+	return c.MockListHooks(pid, opt)
+}
+
 // AddProjectHook calls the underlying MockAddHook method.
 func (c *MockClient) AddProjectHook(pid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
 	return c.MockAddHook(pid, opt)
@@ -149,12 +302,27 @@ func (c *MockClient) DeleteProjectHook(pid interface{}, hook int, options ...git
 	return c.MockDeleteHook(pid, hook)
 }
 
+// GetProjectHookStatus calls the underlying MockGetHookStatus method.
+func (c *MockClient) GetProjectHookStatus(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error) {
+	return c.MockGetHookStatus(pid, hook)
+}
+
+// TestProjectHook calls the underlying MockTestHook method.
+func (c *MockClient) TestProjectHook(pid interface{}, hook int, trigger string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockTestHook(pid, hook, trigger)
+}
+
 // GetProjectMember calls the underlying MockGetMember method.
 // GetProjectMember calls the underlying MockGetMember method.
 func (c *MockClient) GetProjectMember(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
 	return c.MockGetMember(pid, user)
 }
 
+// GetInheritedProjectMember calls the underlying MockGetInheritedMember method.
+func (c *MockClient) GetInheritedProjectMember(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+	return c.MockGetInheritedMember(pid, user)
+}
+
 // AddProjectMember calls the underlying MockAddMember method.
 // AddProjectMember calls the underlying MockAddMember method.
 func (c *MockClient) AddProjectMember(pid interface{}, opt *gitlab.AddProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
@@ -208,11 +376,26 @@ func (c *MockClient) RemoveVariable(pid interface{}, key string, opt *gitlab.Rem
 	return c.MockRemoveVariable(pid, key, opt)
 }
 
+// ScheduleExport calls the underlying MockScheduleExport method.
+func (c *MockClient) ScheduleExport(pid interface{}, opt *gitlab.ScheduleExportOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockScheduleExport(pid, opt)
+}
+
+// ExportStatus calls the underlying MockExportStatus method.
+func (c *MockClient) ExportStatus(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error) {
+	return c.MockExportStatus(pid)
+}
+
 // ListVariables calls the underlying MockListVariables
 func (c *MockClient) ListVariables(pid interface{}, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
 	return c.MockListVariables(pid, opt)
 }
 
+// ListProjectMembers calls the underlying MockListProjectMembers
+func (c *MockClient) ListProjectMembers(pid interface{}, opt *gitlab.ListProjectMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error) {
+	return c.MockListProjectMembers(pid, opt)
+}
+
 // GetDeployKey calls the underlying MockGetDeployKey
 func (c *MockClient) GetDeployKey(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
 	return c.MockGetDeployKey(pid, deployKey)
@@ -233,6 +416,11 @@ func (c *MockClient) UpdateDeployKey(pid interface{}, deployKey int, opt *gitlab
 	return c.MockUpdateDeployKey(pid, deployKey, opt)
 }
 
+// EnableDeployKey calls the underlying MockEnableDeployKey
+func (c *MockClient) EnableDeployKey(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+	return c.MockEnableDeployKey(pid, deployKey)
+}
+
 // GetProjectAccessToken calls the underlying MockGetProjectAccessToken method.
 func (c *MockClient) GetProjectAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
 	return c.MockGetProjectAccessToken(pid, id)
@@ -248,7 +436,577 @@ func (c *MockClient) RevokeProjectAccessToken(pid interface{}, id int, options .
 	return c.MockRevokeProjectAccessToken(pid, id)
 }
 
+// GetSinglePersonalAccessToken calls the underlying MockGetSinglePersonalAccessToken method.
+func (c *MockClient) GetSinglePersonalAccessToken(options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return c.MockGetSinglePersonalAccessToken()
+}
+
+// CurrentUser calls the underlying MockCurrentUser method.
+func (c *MockClient) CurrentUser(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+	return c.MockCurrentUser()
+}
+
 // ListUsers calls the underlying MockListUsers method.
 func (c *MockClient) ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error) {
 	return c.MockListUsers(opt)
 }
+
+// GetUser calls the underlying MockGetUser method.
+func (c *MockClient) GetUser(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+	return c.MockGetUser(user, opt)
+}
+
+// ListLabels calls the underlying MockListLabels method.
+func (c *MockClient) ListLabels(pid interface{}, opt *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+	return c.MockListLabels(pid, opt)
+}
+
+// CreateLabel calls the underlying MockCreateLabel method.
+func (c *MockClient) CreateLabel(pid interface{}, opt *gitlab.CreateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error) {
+	return c.MockCreateLabel(pid, opt)
+}
+
+// UpdateLabel calls the underlying MockUpdateLabel method.
+func (c *MockClient) UpdateLabel(pid interface{}, opt *gitlab.UpdateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error) {
+	return c.MockUpdateLabel(pid, opt)
+}
+
+// DeleteLabel calls the underlying MockDeleteLabel method.
+func (c *MockClient) DeleteLabel(pid interface{}, opt *gitlab.DeleteLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteLabel(pid, opt)
+}
+
+// GetProjectApprovalRules calls the underlying MockGetProjectApprovalRules method.
+func (c *MockClient) GetProjectApprovalRules(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	return c.MockGetProjectApprovalRules(pid, opt)
+}
+
+// CreateProjectApprovalRule calls the underlying MockCreateProjectApprovalRule method.
+func (c *MockClient) CreateProjectApprovalRule(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	return c.MockCreateProjectApprovalRule(pid, opt)
+}
+
+// UpdateProjectApprovalRule calls the underlying MockUpdateProjectApprovalRule method.
+func (c *MockClient) UpdateProjectApprovalRule(pid interface{}, approvalRule int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	return c.MockUpdateProjectApprovalRule(pid, approvalRule, opt)
+}
+
+// DeleteProjectApprovalRule calls the underlying MockDeleteProjectApprovalRule method.
+func (c *MockClient) DeleteProjectApprovalRule(pid interface{}, approvalRule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteProjectApprovalRule(pid, approvalRule)
+}
+
+// GetApprovalConfiguration calls the underlying MockGetApprovalConfiguration method.
+func (c *MockClient) GetApprovalConfiguration(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+	return c.MockGetApprovalConfiguration(pid)
+}
+
+// ChangeApprovalConfiguration calls the underlying MockChangeApprovalConfiguration method.
+func (c *MockClient) ChangeApprovalConfiguration(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+	return c.MockChangeApprovalConfiguration(pid, opt)
+}
+
+// ListProjectMirror calls the underlying MockListProjectMirror method.
+func (c *MockClient) ListProjectMirror(pid interface{}, opt *gitlab.ListProjectMirrorOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMirror, *gitlab.Response, error) {
+	return c.MockListProjectMirror(pid, opt)
+}
+
+// GetProjectMirror calls the underlying MockGetProjectMirror method.
+func (c *MockClient) GetProjectMirror(pid interface{}, mirror int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, *gitlab.Response, error) {
+	return c.MockGetProjectMirror(pid, mirror)
+}
+
+// AddProjectMirror calls the underlying MockAddProjectMirror method.
+func (c *MockClient) AddProjectMirror(pid interface{}, opt *gitlab.AddProjectMirrorOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, *gitlab.Response, error) {
+	return c.MockAddProjectMirror(pid, opt)
+}
+
+// EditProjectMirror calls the underlying MockEditProjectMirror method.
+func (c *MockClient) EditProjectMirror(pid interface{}, mirror int, opt *gitlab.EditProjectMirrorOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, *gitlab.Response, error) {
+	return c.MockEditProjectMirror(pid, mirror, opt)
+}
+
+// DeleteProjectMirror calls the underlying MockDeleteProjectMirror method.
+func (c *MockClient) DeleteProjectMirror(pid interface{}, mirror int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteProjectMirror(pid, mirror)
+}
+
+// ListProjectSharedWithGroups calls the underlying MockListProjectSharedWithGroups method.
+func (c *MockClient) ListProjectSharedWithGroups(pid interface{}, options ...gitlab.RequestOptionFunc) ([]*projects.SharedWithGroup, *gitlab.Response, error) {
+	return c.MockListProjectSharedWithGroups(pid)
+}
+
+// ShareProjectWithGroup calls the underlying MockShareProjectWithGroup method.
+func (c *MockClient) ShareProjectWithGroup(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockShareProjectWithGroup(pid, opt)
+}
+
+// DeleteSharedProjectFromGroup calls the underlying MockDeleteSharedProjectFromGroup method.
+func (c *MockClient) DeleteSharedProjectFromGroup(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteSharedProjectFromGroup(pid, groupID)
+}
+
+// ListAgents calls the underlying MockListAgents method.
+func (c *MockClient) ListAgents(pid interface{}, opt *gitlab.ListAgentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Agent, *gitlab.Response, error) {
+	return c.MockListAgents(pid, opt)
+}
+
+// GetAgent calls the underlying MockGetAgent method.
+func (c *MockClient) GetAgent(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+	return c.MockGetAgent(pid, id)
+}
+
+// RegisterAgent calls the underlying MockRegisterAgent method.
+func (c *MockClient) RegisterAgent(pid interface{}, opt *gitlab.RegisterAgentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+	return c.MockRegisterAgent(pid, opt)
+}
+
+// DeleteAgent calls the underlying MockDeleteAgent method.
+func (c *MockClient) DeleteAgent(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteAgent(pid, id)
+}
+
+// GetFile calls the underlying MockGetFile method.
+func (c *MockClient) GetFile(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+	return c.MockGetFile(pid, fileName, opt)
+}
+
+// CreateFile calls the underlying MockCreateFile method.
+func (c *MockClient) CreateFile(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+	return c.MockCreateFile(pid, fileName, opt)
+}
+
+// UpdateFile calls the underlying MockUpdateFile method.
+func (c *MockClient) UpdateFile(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+	return c.MockUpdateFile(pid, fileName, opt)
+}
+
+// DeleteFile calls the underlying MockDeleteFile method.
+func (c *MockClient) DeleteFile(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteFile(pid, fileName, opt)
+}
+
+// GetSnippet calls the underlying MockGetSnippet method.
+func (c *MockClient) GetSnippet(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+	return c.MockGetSnippet(pid, snippet)
+}
+
+// CreateSnippet calls the underlying MockCreateSnippet method.
+func (c *MockClient) CreateSnippet(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+	return c.MockCreateSnippet(pid, opt)
+}
+
+// UpdateSnippet calls the underlying MockUpdateSnippet method.
+func (c *MockClient) UpdateSnippet(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+	return c.MockUpdateSnippet(pid, snippet, opt)
+}
+
+// DeleteSnippet calls the underlying MockDeleteSnippet method.
+func (c *MockClient) DeleteSnippet(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteSnippet(pid, snippet)
+}
+
+// SnippetFileContent calls the underlying MockSnippetFileContent method.
+func (c *MockClient) SnippetFileContent(pid interface{}, snippet int, ref, filename string, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+	return c.MockSnippetFileContent(pid, snippet, ref, filename)
+}
+
+// GetWikiPage calls the underlying MockGetWikiPage method.
+func (c *MockClient) GetWikiPage(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+	return c.MockGetWikiPage(pid, slug, opt)
+}
+
+// CreateWikiPage calls the underlying MockCreateWikiPage method.
+func (c *MockClient) CreateWikiPage(pid interface{}, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+	return c.MockCreateWikiPage(pid, opt)
+}
+
+// EditWikiPage calls the underlying MockEditWikiPage method.
+func (c *MockClient) EditWikiPage(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+	return c.MockEditWikiPage(pid, slug, opt)
+}
+
+// DeleteWikiPage calls the underlying MockDeleteWikiPage method.
+func (c *MockClient) DeleteWikiPage(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteWikiPage(pid, slug)
+}
+
+// ListProtectedBranches calls the underlying MockListProtectedBranches method.
+func (c *MockClient) ListProtectedBranches(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return c.MockListProtectedBranches(pid, opt)
+}
+
+// ProtectRepositoryBranches calls the underlying MockProtectRepositoryBranches method.
+func (c *MockClient) ProtectRepositoryBranches(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return c.MockProtectRepositoryBranches(pid, opt)
+}
+
+// UpdateProtectedBranch calls the underlying MockUpdateProtectedBranch method.
+func (c *MockClient) UpdateProtectedBranch(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return c.MockUpdateProtectedBranch(pid, branch, opt)
+}
+
+// UnprotectRepositoryBranches calls the underlying MockUnprotectRepositoryBranches method.
+func (c *MockClient) UnprotectRepositoryBranches(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockUnprotectRepositoryBranches(pid, branch)
+}
+
+// GetProjectBadge calls the underlying MockGetBadge method.
+func (c *MockClient) GetProjectBadge(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+	return c.MockGetBadge(pid, badge)
+}
+
+// AddProjectBadge calls the underlying MockAddBadge method.
+func (c *MockClient) AddProjectBadge(pid interface{}, opt *gitlab.AddProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+	return c.MockAddBadge(pid, opt)
+}
+
+// EditProjectBadge calls the underlying MockEditBadge method.
+func (c *MockClient) EditProjectBadge(pid interface{}, badge int, opt *gitlab.EditProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+	return c.MockEditBadge(pid, badge, opt)
+}
+
+// DeleteProjectBadge calls the underlying MockDeleteBadge method.
+func (c *MockClient) DeleteProjectBadge(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteBadge(pid, badge)
+}
+
+// GetRelease calls the underlying MockGetRelease method.
+func (c *MockClient) GetRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return c.MockGetRelease(pid, tagName)
+}
+
+// GetReleaseDetails calls the underlying MockGetReleaseDetails method.
+func (c *MockClient) GetReleaseDetails(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.ReleaseDetails, *gitlab.Response, error) {
+	return c.MockGetReleaseDetails(pid, tagName)
+}
+
+// CreateRelease calls the underlying MockCreateRelease method.
+func (c *MockClient) CreateRelease(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return c.MockCreateRelease(pid, opts)
+}
+
+// UpdateRelease calls the underlying MockUpdateRelease method.
+func (c *MockClient) UpdateRelease(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return c.MockUpdateRelease(pid, tagName, opts)
+}
+
+// DeleteRelease calls the underlying MockDeleteRelease method.
+func (c *MockClient) DeleteRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return c.MockDeleteRelease(pid, tagName)
+}
+
+// CreateReleaseLink calls the underlying MockCreateReleaseLink method.
+func (c *MockClient) CreateReleaseLink(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return c.MockCreateReleaseLink(pid, tagName, opt)
+}
+
+// UpdateReleaseLink calls the underlying MockUpdateReleaseLink method.
+func (c *MockClient) UpdateReleaseLink(pid interface{}, tagName string, link int, opt *gitlab.UpdateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return c.MockUpdateReleaseLink(pid, tagName, link, opt)
+}
+
+// DeleteReleaseLink calls the underlying MockDeleteReleaseLink method.
+func (c *MockClient) DeleteReleaseLink(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return c.MockDeleteReleaseLink(pid, tagName, link)
+}
+
+// GetFreezePeriod calls the underlying MockGetFreezePeriod method.
+func (c *MockClient) GetFreezePeriod(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+	return c.MockGetFreezePeriod(pid, freezePeriod)
+}
+
+// CreateFreezePeriodOptions calls the underlying MockCreateFreezePeriod method.
+func (c *MockClient) CreateFreezePeriodOptions(pid interface{}, opt *gitlab.CreateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+	return c.MockCreateFreezePeriod(pid, opt)
+}
+
+// UpdateFreezePeriodOptions calls the underlying MockUpdateFreezePeriod method.
+func (c *MockClient) UpdateFreezePeriodOptions(pid interface{}, freezePeriod int, opt *gitlab.UpdateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+	return c.MockUpdateFreezePeriod(pid, freezePeriod, opt)
+}
+
+// DeleteFreezePeriod calls the underlying MockDeleteFreezePeriod method.
+func (c *MockClient) DeleteFreezePeriod(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteFreezePeriod(pid, freezePeriod)
+}
+
+// ListProjectStatusChecks calls the underlying MockListProjectStatusChecks method.
+func (c *MockClient) ListProjectStatusChecks(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*projects.ExternalStatusCheck, *gitlab.Response, error) {
+	return c.MockListProjectStatusChecks(pid, opt)
+}
+
+// CreateExternalStatusCheck calls the underlying MockCreateExternalStatusCheck method.
+func (c *MockClient) CreateExternalStatusCheck(pid interface{}, opt *projects.ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*projects.ExternalStatusCheck, *gitlab.Response, error) {
+	return c.MockCreateExternalStatusCheck(pid, opt)
+}
+
+// UpdateExternalStatusCheck calls the underlying MockUpdateExternalStatusCheck method.
+func (c *MockClient) UpdateExternalStatusCheck(pid interface{}, check int, opt *projects.ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*projects.ExternalStatusCheck, *gitlab.Response, error) {
+	return c.MockUpdateExternalStatusCheck(pid, check, opt)
+}
+
+// DeleteExternalStatusCheck calls the underlying MockDeleteExternalStatusCheck method.
+func (c *MockClient) DeleteExternalStatusCheck(pid interface{}, check int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteExternalStatusCheck(pid, check)
+}
+
+// GetJobTokenAccessSettings calls the underlying MockGetJobTokenAccessSettings method.
+func (c *MockClient) GetJobTokenAccessSettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*projects.JobTokenAccessSettings, *gitlab.Response, error) {
+	return c.MockGetJobTokenAccessSettings(pid)
+}
+
+// PatchJobTokenAccessSettings calls the underlying MockPatchJobTokenAccessSettings method.
+func (c *MockClient) PatchJobTokenAccessSettings(pid interface{}, opt *projects.PatchJobTokenAccessSettingsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockPatchJobTokenAccessSettings(pid, opt)
+}
+
+// ListJobTokenInboundAllowList calls the underlying MockListJobTokenInboundAllowList method.
+func (c *MockClient) ListJobTokenInboundAllowList(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	return c.MockListJobTokenInboundAllowList(pid, opt)
+}
+
+// AddProjectToJobTokenInboundAllowList calls the underlying MockAddProjectToJobTokenInboundAllowList method.
+func (c *MockClient) AddProjectToJobTokenInboundAllowList(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockAddProjectToJobTokenInboundAllowList(pid, target)
+}
+
+// RemoveProjectFromJobTokenInboundAllowList calls the underlying MockRemoveProjectFromJobTokenInboundAllowList method.
+func (c *MockClient) RemoveProjectFromJobTokenInboundAllowList(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockRemoveProjectFromJobTokenInboundAllowList(pid, target)
+}
+
+// ListJobTokenAllowlistGroups calls the underlying MockListJobTokenAllowlistGroups method.
+func (c *MockClient) ListJobTokenAllowlistGroups(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Group, *gitlab.Response, error) {
+	return c.MockListJobTokenAllowlistGroups(pid, opt)
+}
+
+// AddGroupToJobTokenAllowlist calls the underlying MockAddGroupToJobTokenAllowlist method.
+func (c *MockClient) AddGroupToJobTokenAllowlist(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockAddGroupToJobTokenAllowlist(pid, target)
+}
+
+// RemoveGroupFromJobTokenAllowlist calls the underlying MockRemoveGroupFromJobTokenAllowlist method.
+func (c *MockClient) RemoveGroupFromJobTokenAllowlist(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockRemoveGroupFromJobTokenAllowlist(pid, target)
+}
+
+// CreateProjectForkRelation calls the underlying MockCreateProjectForkRelation method.
+func (c *MockClient) CreateProjectForkRelation(pid interface{}, fork int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectForkRelation, *gitlab.Response, error) {
+	return c.MockCreateProjectForkRelation(pid, fork)
+}
+
+// DeleteProjectForkRelation calls the underlying MockDeleteProjectForkRelation method.
+func (c *MockClient) DeleteProjectForkRelation(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteProjectForkRelation(pid)
+}
+
+// GetJiraService calls the underlying MockGetJiraService method.
+func (c *MockClient) GetJiraService(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error) {
+	return c.MockGetJiraService(pid)
+}
+
+// SetJiraService calls the underlying MockSetJiraService method.
+func (c *MockClient) SetJiraService(pid interface{}, opt *gitlab.SetJiraServiceOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockSetJiraService(pid, opt)
+}
+
+// DeleteJiraService calls the underlying MockDeleteJiraService method.
+func (c *MockClient) DeleteJiraService(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteJiraService(pid)
+}
+
+// GetSettingsForProject calls the underlying MockGetSettingsForProject method.
+func (c *MockClient) GetSettingsForProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error) {
+	return c.MockGetSettingsForProject(pid)
+}
+
+// UpdateSettingsForProject calls the underlying MockUpdateSettingsForProject method.
+func (c *MockClient) UpdateSettingsForProject(pid interface{}, opt *gitlab.NotificationSettingsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error) {
+	return c.MockUpdateSettingsForProject(pid, opt)
+}
+
+// ListIssueNotes calls the underlying MockListIssueNotes method.
+func (c *MockClient) ListIssueNotes(pid interface{}, issue int, opt *gitlab.ListIssueNotesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error) {
+	return c.MockListIssueNotes(pid, issue, opt)
+}
+
+// GetIssueNote calls the underlying MockGetIssueNote method.
+func (c *MockClient) GetIssueNote(pid interface{}, issue, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+	return c.MockGetIssueNote(pid, issue, note)
+}
+
+// CreateIssueNote calls the underlying MockCreateIssueNote method.
+func (c *MockClient) CreateIssueNote(pid interface{}, issue int, opt *gitlab.CreateIssueNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+	return c.MockCreateIssueNote(pid, issue, opt)
+}
+
+// UpdateIssueNote calls the underlying MockUpdateIssueNote method.
+func (c *MockClient) UpdateIssueNote(pid interface{}, issue, note int, opt *gitlab.UpdateIssueNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+	return c.MockUpdateIssueNote(pid, issue, note, opt)
+}
+
+// DeleteIssueNote calls the underlying MockDeleteIssueNote method.
+func (c *MockClient) DeleteIssueNote(pid interface{}, issue, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteIssueNote(pid, issue, note)
+}
+
+// ListMergeRequestNotes calls the underlying MockListMergeRequestNotes method.
+func (c *MockClient) ListMergeRequestNotes(pid interface{}, mergeRequest int, opt *gitlab.ListMergeRequestNotesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error) {
+	return c.MockListMergeRequestNotes(pid, mergeRequest, opt)
+}
+
+// GetMergeRequestNote calls the underlying MockGetMergeRequestNote method.
+func (c *MockClient) GetMergeRequestNote(pid interface{}, mergeRequest, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+	return c.MockGetMergeRequestNote(pid, mergeRequest, note)
+}
+
+// CreateMergeRequestNote calls the underlying MockCreateMergeRequestNote method.
+func (c *MockClient) CreateMergeRequestNote(pid interface{}, mergeRequest int, opt *gitlab.CreateMergeRequestNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+	return c.MockCreateMergeRequestNote(pid, mergeRequest, opt)
+}
+
+// UpdateMergeRequestNote calls the underlying MockUpdateMergeRequestNote method.
+func (c *MockClient) UpdateMergeRequestNote(pid interface{}, mergeRequest, note int, opt *gitlab.UpdateMergeRequestNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+	return c.MockUpdateMergeRequestNote(pid, mergeRequest, note, opt)
+}
+
+// DeleteMergeRequestNote calls the underlying MockDeleteMergeRequestNote method.
+func (c *MockClient) DeleteMergeRequestNote(pid interface{}, mergeRequest, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteMergeRequestNote(pid, mergeRequest, note)
+}
+
+// GetMergeRequest calls the underlying MockGetMergeRequest method.
+func (c *MockClient) GetMergeRequest(pid interface{}, mergeRequest int, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	return c.MockGetMergeRequest(pid, mergeRequest, opt)
+}
+
+// GetConfiguration calls the underlying MockGetConfiguration method.
+func (c *MockClient) GetConfiguration(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+	return c.MockGetConfiguration(pid, mr)
+}
+
+// ApproveMergeRequest calls the underlying MockApproveMergeRequest method.
+func (c *MockClient) ApproveMergeRequest(pid interface{}, mr int, opt *gitlab.ApproveMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+	return c.MockApproveMergeRequest(pid, mr, opt)
+}
+
+// UnapproveMergeRequest calls the underlying MockUnapproveMergeRequest method.
+func (c *MockClient) UnapproveMergeRequest(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockUnapproveMergeRequest(pid, mr)
+}
+
+// ListIssueRelations calls the underlying MockListIssueRelations method.
+func (c *MockClient) ListIssueRelations(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error) {
+	return c.MockListIssueRelations(pid, issue)
+}
+
+// CreateIssueLink calls the underlying MockCreateIssueLink method.
+func (c *MockClient) CreateIssueLink(pid interface{}, issue int, opt *gitlab.CreateIssueLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+	return c.MockCreateIssueLink(pid, issue, opt)
+}
+
+// DeleteIssueLink calls the underlying MockDeleteIssueLink method.
+func (c *MockClient) DeleteIssueLink(pid interface{}, issue, issueLink int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+	return c.MockDeleteIssueLink(pid, issue, issueLink)
+}
+
+// ListProjectPipelines calls the underlying MockListProjectPipelines method.
+func (c *MockClient) ListProjectPipelines(pid interface{}, opt *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+	return c.MockListProjectPipelines(pid, opt)
+}
+
+// DeletePipeline calls the underlying MockDeletePipeline method.
+func (c *MockClient) DeletePipeline(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeletePipeline(pid, pipeline)
+}
+
+// ListMilestones calls the underlying MockListMilestones method.
+func (c *MockClient) ListMilestones(pid interface{}, opt *gitlab.ListMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error) {
+	return c.MockListMilestones(pid, opt)
+}
+
+// GetMilestone calls the underlying MockGetMilestone method.
+func (c *MockClient) GetMilestone(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, *gitlab.Response, error) {
+	return c.MockGetMilestone(pid, milestone)
+}
+
+// CreateMilestone calls the underlying MockCreateMilestone method.
+func (c *MockClient) CreateMilestone(pid interface{}, opt *gitlab.CreateMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, *gitlab.Response, error) {
+	return c.MockCreateMilestone(pid, opt)
+}
+
+// UpdateMilestone calls the underlying MockUpdateMilestone method.
+func (c *MockClient) UpdateMilestone(pid interface{}, milestone int, opt *gitlab.UpdateMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, *gitlab.Response, error) {
+	return c.MockUpdateMilestone(pid, milestone, opt)
+}
+
+// DeleteMilestone calls the underlying MockDeleteMilestone method.
+func (c *MockClient) DeleteMilestone(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteMilestone(pid, milestone)
+}
+
+// GetMilestoneIssues calls the underlying MockGetMilestoneIssues method.
+func (c *MockClient) GetMilestoneIssues(pid interface{}, milestone int, opt *gitlab.GetMilestoneIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockGetMilestoneIssues(pid, milestone, opt)
+}
+
+// UpdateIssue calls the underlying MockUpdateIssue method.
+func (c *MockClient) UpdateIssue(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockUpdateIssue(pid, issue, opt)
+}
+
+// GetGroup calls the underlying MockGetGroup method.
+func (c *MockClient) GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	return c.MockGetGroup(gid, opt)
+}
+
+// ListGroupVariables calls the underlying MockListGroupVariables method.
+func (c *MockClient) ListGroupVariables(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+	return c.MockListGroupVariables(gid, opt)
+}
+
+// GetProjectPullMirrorDetails calls the underlying MockGetProjectPullMirrorDetails method.
+func (c *MockClient) GetProjectPullMirrorDetails(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPullMirrorDetails, *gitlab.Response, error) {
+	return c.MockGetProjectPullMirrorDetails(pid)
+}
+
+// StartMirroringProject calls the underlying MockStartMirroringProject method.
+func (c *MockClient) StartMirroringProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockStartMirroringProject(pid)
+}
+
+// RestoreProject calls the underlying MockRestoreProject method.
+func (c *MockClient) RestoreProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockRestoreProject(pid)
+}
+
+// ListGroupProjects calls the underlying MockListGroupProjects method.
+func (c *MockClient) ListGroupProjects(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	return c.MockListGroupProjects(gid, opt)
+}
+
+// GetProjectForkSettings calls the underlying MockGetProjectForkSettings method.
+func (c *MockClient) GetProjectForkSettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*projects.ProjectForkSettings, *gitlab.Response, error) {
+	return c.MockGetProjectForkSettings(pid)
+}
+
+// EditProjectForkSettings calls the underlying MockEditProjectForkSettings method.
+func (c *MockClient) EditProjectForkSettings(pid interface{}, opt *projects.ProjectForkSettings, options ...gitlab.RequestOptionFunc) (*projects.ProjectForkSettings, *gitlab.Response, error) {
+	return c.MockEditProjectForkSettings(pid, opt)
+}
+
+// ListCustomProjectAttributes calls the underlying MockListCustomProjectAttributes method.
+func (c *MockClient) ListCustomProjectAttributes(project int, options ...gitlab.RequestOptionFunc) ([]*gitlab.CustomAttribute, *gitlab.Response, error) {
+	return c.MockListCustomProjectAttributes(project)
+}
+
+// SetCustomProjectAttribute calls the underlying MockSetCustomProjectAttribute method.
+func (c *MockClient) SetCustomProjectAttribute(project int, ca gitlab.CustomAttribute, options ...gitlab.RequestOptionFunc) (*gitlab.CustomAttribute, *gitlab.Response, error) {
+	return c.MockSetCustomProjectAttribute(project, ca)
+}
+
+// DeleteCustomProjectAttribute calls the underlying MockDeleteCustomProjectAttribute method.
+func (c *MockClient) DeleteCustomProjectAttribute(project int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteCustomProjectAttribute(project, key)
+}
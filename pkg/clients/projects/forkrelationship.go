@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const errForkedFromProjectIDNotInt = "ForkedFromProjectID is not the ID of a project"
+
+// ForkRelationshipClient defines Gitlab fork relationship service operations
+type ForkRelationshipClient interface {
+	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	CreateProjectForkRelation(pid interface{}, fork int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectForkRelation, *gitlab.Response, error)
+	DeleteProjectForkRelation(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewForkRelationshipClient returns a new Gitlab fork relationship service
+func NewForkRelationshipClient(cfg clients.Config) ForkRelationshipClient {
+	git := clients.NewClient(cfg)
+	return git.Projects
+}
+
+// ForkedFromID returns the ID of the project p is forked from. Gitlab only
+// accepts the ID of a project, not its path.
+func ForkedFromID(p *v1alpha1.ForkRelationshipParameters) (int, error) {
+	if p.ForkedFromProjectID == nil {
+		return 0, errors.New(errForkedFromProjectIDNotInt)
+	}
+	id, err := strconv.Atoi(*p.ForkedFromProjectID)
+	return id, errors.Wrap(err, errForkedFromProjectIDNotInt)
+}
+
+// IsForkRelationshipUpToDate checks whether the project is forked from the
+// project of the spec.
+func IsForkRelationshipUpToDate(p *v1alpha1.ForkRelationshipParameters, g *gitlab.Project) bool {
+	if g.ForkedFromProject == nil || p.ForkedFromProjectID == nil {
+		return false
+	}
+	return *p.ForkedFromProjectID == strconv.Itoa(g.ForkedFromProject.ID)
+}
+
+// GenerateForkRelationshipObservation is used to produce
+// v1alpha1.ForkRelationshipObservation from gitlab.Project.
+func GenerateForkRelationshipObservation(g *gitlab.Project) v1alpha1.ForkRelationshipObservation {
+	if g == nil || g.ForkedFromProject == nil {
+		return v1alpha1.ForkRelationshipObservation{}
+	}
+	return v1alpha1.ForkRelationshipObservation{
+		ForkedFromProjectID:   g.ForkedFromProject.ID,
+		ForkedFromProjectPath: g.ForkedFromProject.PathWithNamespace,
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestIsForkRelationshipUpToDate(t *testing.T) {
+	upstream := "10"
+	other := "11"
+
+	cases := map[string]struct {
+		p    *v1alpha1.ForkRelationshipParameters
+		g    *gitlab.Project
+		want bool
+	}{
+		"NotForked": {
+			p:    &v1alpha1.ForkRelationshipParameters{ForkedFromProjectID: &upstream},
+			g:    &gitlab.Project{},
+			want: false,
+		},
+		"ForkedFromProject": {
+			p:    &v1alpha1.ForkRelationshipParameters{ForkedFromProjectID: &upstream},
+			g:    &gitlab.Project{ForkedFromProject: &gitlab.ForkParent{ID: 10}},
+			want: true,
+		},
+		"ForkedFromAnotherProject": {
+			p:    &v1alpha1.ForkRelationshipParameters{ForkedFromProjectID: &other},
+			g:    &gitlab.Project{ForkedFromProject: &gitlab.ForkParent{ID: 10}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsForkRelationshipUpToDate(tc.p, tc.g); got != tc.want {
+				t.Errorf("IsForkRelationshipUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
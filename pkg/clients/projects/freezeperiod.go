@@ -0,0 +1,98 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// FreezePeriodClient defines Gitlab FreezePeriod service operations
+type FreezePeriodClient interface {
+	GetFreezePeriod(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error)
+	CreateFreezePeriodOptions(pid interface{}, opt *gitlab.CreateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error)
+	UpdateFreezePeriodOptions(pid interface{}, freezePeriod int, opt *gitlab.UpdateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error)
+	DeleteFreezePeriod(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewFreezePeriodClient returns a new Gitlab FreezePeriod service
+func NewFreezePeriodClient(cfg clients.Config) FreezePeriodClient {
+	git := clients.NewClient(cfg)
+	return git.FreezePeriods
+}
+
+// normalizeCron collapses the whitespace of a cron schedule, which Gitlab
+// stores as given.
+func normalizeCron(c string) string {
+	return strings.Join(strings.Fields(c), " ")
+}
+
+// IsFreezePeriodUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsFreezePeriodUpToDate(p *v1alpha1.FreezePeriodParameters, g *gitlab.FreezePeriod) bool {
+	if normalizeCron(p.FreezeStart) != normalizeCron(g.FreezeStart) {
+		return false
+	}
+	if normalizeCron(p.FreezeEnd) != normalizeCron(g.FreezeEnd) {
+		return false
+	}
+	return clients.IsStringEqualToStringPtr(p.CronTimezone, g.CronTimezone)
+}
+
+// LateInitializeFreezePeriod fills the empty fields in the freeze period spec
+// with the values seen in gitlab.FreezePeriod.
+func LateInitializeFreezePeriod(in *v1alpha1.FreezePeriodParameters, fp *gitlab.FreezePeriod) {
+	if fp == nil {
+		return
+	}
+	in.CronTimezone = clients.LateInitializeStringPtr(in.CronTimezone, fp.CronTimezone)
+}
+
+// GenerateFreezePeriodObservation is used to produce
+// v1alpha1.FreezePeriodObservation from gitlab.FreezePeriod.
+func GenerateFreezePeriodObservation(fp *gitlab.FreezePeriod) v1alpha1.FreezePeriodObservation {
+	if fp == nil {
+		return v1alpha1.FreezePeriodObservation{}
+	}
+	return v1alpha1.FreezePeriodObservation{
+		ID:        fp.ID,
+		CreatedAt: clients.TimeToMetaTime(fp.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(fp.UpdatedAt),
+	}
+}
+
+// GenerateCreateFreezePeriodOptions generates freeze period creation options
+func GenerateCreateFreezePeriodOptions(p *v1alpha1.FreezePeriodParameters) *gitlab.CreateFreezePeriodOptions {
+	return &gitlab.CreateFreezePeriodOptions{
+		FreezeStart:  gitlab.String(normalizeCron(p.FreezeStart)),
+		FreezeEnd:    gitlab.String(normalizeCron(p.FreezeEnd)),
+		CronTimezone: p.CronTimezone,
+	}
+}
+
+// GenerateUpdateFreezePeriodOptions generates freeze period update options
+func GenerateUpdateFreezePeriodOptions(p *v1alpha1.FreezePeriodParameters) *gitlab.UpdateFreezePeriodOptions {
+	return &gitlab.UpdateFreezePeriodOptions{
+		FreezeStart:  gitlab.String(normalizeCron(p.FreezeStart)),
+		FreezeEnd:    gitlab.String(normalizeCron(p.FreezeEnd)),
+		CronTimezone: p.CronTimezone,
+	}
+}
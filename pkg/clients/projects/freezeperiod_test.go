@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestIsFreezePeriodUpToDate(t *testing.T) {
+	fp := &gitlab.FreezePeriod{ID: 1, FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "UTC"}
+
+	cases := map[string]struct {
+		p    *v1alpha1.FreezePeriodParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    &v1alpha1.FreezePeriodParameters{FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: ptr.To("UTC")},
+			want: true,
+		},
+		"IgnoresCronWhitespace": {
+			p:    &v1alpha1.FreezePeriodParameters{FreezeStart: " 0 23 *  * 5", FreezeEnd: "0 7 * *\t1 "},
+			want: true,
+		},
+		"FreezeStartChanged": {
+			p:    &v1alpha1.FreezePeriodParameters{FreezeStart: "0 22 * * 5", FreezeEnd: "0 7 * * 1"},
+			want: false,
+		},
+		"FreezeEndChanged": {
+			p:    &v1alpha1.FreezePeriodParameters{FreezeStart: "0 23 * * 5", FreezeEnd: "0 8 * * 1"},
+			want: false,
+		},
+		"CronTimezoneChanged": {
+			p:    &v1alpha1.FreezePeriodParameters{FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: ptr.To("Europe/Berlin")},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsFreezePeriodUpToDate(tc.p, fp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// GitLabImportClient defines the Gitlab operations to list the projects of
+// a group with their members and variables.
+type GitLabImportClient interface {
+	GroupProjectLister
+	ListProjectMembers(pid interface{}, opt *gitlab.ListProjectMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error)
+	ListVariables(pid interface{}, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error)
+}
+
+type gitLabImportClient struct {
+	*gitlab.ProjectMembersService
+	*gitlab.ProjectVariablesService
+	groups *gitlab.GroupsService
+}
+
+// NewGitLabImportClient returns a new Gitlab client to import groups with.
+func NewGitLabImportClient(cfg clients.Config) GitLabImportClient {
+	git := clients.NewClient(cfg)
+	return &gitLabImportClient{ProjectMembersService: git.ProjectMembers, ProjectVariablesService: git.ProjectVariables, groups: git.Groups}
+}
+
+// ListGroupProjects lists the projects of a group.
+func (c *gitLabImportClient) ListGroupProjects(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	return c.groups.ListGroupProjects(gid, opt, options...)
+}
+
+// ListAllProjectMembers lists all pages of the direct members of a project.
+func ListAllProjectMembers(c GitLabImportClient, pid interface{}, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, error) {
+	var all []*gitlab.ProjectMember
+	opt := &gitlab.ListProjectMembersOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		members, res, err := c.ListProjectMembers(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, members...)
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// ListAllProjectVariables lists all pages of the variables of a project.
+func ListAllProjectVariables(c GitLabImportClient, pid interface{}, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, error) {
+	var all []*gitlab.ProjectVariable
+	opt := &gitlab.ListProjectVariablesOptions{PerPage: 100}
+	for {
+		variables, res, err := c.ListVariables(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, variables...)
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
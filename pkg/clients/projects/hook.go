@@ -17,7 +17,10 @@ limitations under the License.
 package projects
 
 import (
+	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/xanzy/go-gitlab"
@@ -34,15 +37,103 @@ const (
 // HookClient defines Gitlab Hook service operations
 type HookClient interface {
 	GetProjectHook(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
+	ListProjectHooks(pid interface{}, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error)
 	AddProjectHook(pid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	EditProjectHook(pid interface{}, hook int, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	DeleteProjectHook(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetProjectHookStatus(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*ProjectHookStatus, *gitlab.Response, error)
+	TestProjectHook(pid interface{}, hook int, trigger string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+}
+
+// ProjectHookStatus is a project hook along with the delivery health
+// fields GitLab reports for it, which gitlab.ProjectHook does not carry.
+type ProjectHookStatus struct {
+	gitlab.ProjectHook
+	AlertStatus   string     `json:"alert_status"`
+	DisabledUntil *time.Time `json:"disabled_until"`
+}
+
+type hookClient struct {
+	*gitlab.ProjectsService
+	git *gitlab.Client
 }
 
 // NewHookClient returns a new Gitlab Project service
 func NewHookClient(cfg clients.Config) HookClient {
 	git := clients.NewClient(cfg)
-	return git.Projects
+	return &hookClient{ProjectsService: git.Projects, git: git}
+}
+
+// GetProjectHookStatus gets a specific hook for a project including its
+// alert status.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-project-hook
+func (c *hookClient) GetProjectHookStatus(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*ProjectHookStatus, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d", project, hook)
+
+	req, err := c.git.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ph := new(ProjectHookStatus)
+	resp, err := c.git.Do(req, ph)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ph, resp, nil
+}
+
+// TestProjectHook triggers a test event for a project hook. A successful
+// delivery re-enables a hook GitLab has disabled.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#trigger-a-test-project-hook
+func (c *hookClient) TestProjectHook(pid interface{}, hook int, trigger string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d/test/%s", project, hook, trigger)
+
+	req, err := c.git.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return c.git.Do(req, nil)
+}
+
+// FindHookByURL returns the hook of a project delivering events to url, or
+// nil if the project has no such hook.
+func FindHookByURL(c HookClient, pid interface{}, url string, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, error) {
+	opt := &gitlab.ListProjectHooksOptions{PerPage: 100}
+	for {
+		hooks, res, err := c.ListProjectHooks(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range hooks {
+			if clients.NormalizeURL(h.URL) == clients.NormalizeURL(url) {
+				return h, nil
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// IsHookDisabled returns true if GitLab stopped delivering events to the
+// hook after repeated failures.
+func IsHookDisabled(alertStatus string) bool {
+	return alertStatus == v1alpha1.HookAlertStatusDisabled || alertStatus == v1alpha1.HookAlertStatusTemporarilyDisabled
 }
 
 // IsErrorHookNotFound helper function to test for errProjectNotFound error.
@@ -113,6 +204,19 @@ func GenerateHookObservation(hook *gitlab.ProjectHook) v1alpha1.HookObservation
 	return o
 }
 
+// GenerateHookStatusObservation is used to produce v1alpha1.HookObservation
+// from a hook along with its alert status.
+func GenerateHookStatusObservation(hook *ProjectHookStatus) v1alpha1.HookObservation {
+	if hook == nil {
+		return v1alpha1.HookObservation{}
+	}
+
+	o := GenerateHookObservation(&hook.ProjectHook)
+	o.AlertStatus = hook.AlertStatus
+	o.DisabledUntil = clients.TimeToMetaTime(hook.DisabledUntil)
+	return o
+}
+
 // GenerateCreateHookOptions generates project creation options
 func GenerateCreateHookOptions(p *v1alpha1.HookParameters) *gitlab.AddProjectHookOptions {
 	hook := &gitlab.AddProjectHookOptions{
@@ -159,7 +263,7 @@ func GenerateEditHookOptions(p *v1alpha1.HookParameters) *gitlab.EditProjectHook
 
 // IsHookUpToDate checks whether there is a change in any of the modifiable fields.
 func IsHookUpToDate(p *v1alpha1.HookParameters, g *gitlab.ProjectHook) bool { // nolint:gocyclo
-	if !cmp.Equal(p.URL, clients.StringToPtr(g.URL)) {
+	if !cmp.Equal(p.URL, clients.StringToPtr(g.URL), clients.EquateNormalized(clients.NormalizeURL)) {
 		return false
 	}
 	if !clients.IsBoolEqualToBoolPtr(p.ConfidentialNoteEvents, g.ConfidentialNoteEvents) {
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/xanzy/go-gitlab"
@@ -79,6 +80,48 @@ func TestGenerateHookObservation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateHookStatusObservation(t *testing.T) {
+	id := 0
+	disabledUntil := time.Now()
+
+	type args struct {
+		ph *ProjectHookStatus
+	}
+
+	cases := map[string]struct {
+		args args
+		want v1alpha1.HookObservation
+	}{
+		"Nil": {
+			args: args{},
+			want: v1alpha1.HookObservation{},
+		},
+		"TemporarilyDisabled": {
+			args: args{
+				ph: &ProjectHookStatus{
+					ProjectHook:   gitlab.ProjectHook{ID: id},
+					AlertStatus:   v1alpha1.HookAlertStatusTemporarilyDisabled,
+					DisabledUntil: &disabledUntil,
+				},
+			},
+			want: v1alpha1.HookObservation{
+				ID:            id,
+				AlertStatus:   v1alpha1.HookAlertStatusTemporarilyDisabled,
+				DisabledUntil: &metav1.Time{Time: disabledUntil},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateHookStatusObservation(tc.args.ph)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLateInitializeHook(t *testing.T) {
 	cases := map[string]struct {
 		parameters  *v1alpha1.HookParameters
@@ -330,6 +373,17 @@ func TestIsHookUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"NormalizedURL": {
+			args: args{
+				p: &v1alpha1.HookParameters{
+					URL: ptr.To("HTTPS://My-Project.example.com:443/"),
+				},
+				projecthook: &gitlab.ProjectHook{
+					URL: url,
+				},
+			},
+			want: true,
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// IssueLinkClient defines Gitlab issue link service operations
+type IssueLinkClient interface {
+	ListIssueRelations(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error)
+	CreateIssueLink(pid interface{}, issue int, opt *gitlab.CreateIssueLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error)
+	DeleteIssueLink(pid interface{}, issue, issueLink int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error)
+}
+
+// NewIssueLinkClient returns a new Gitlab issue link service
+func NewIssueLinkClient(cfg clients.Config) IssueLinkClient {
+	git := clients.NewClient(cfg)
+	return git.IssueLinks
+}
+
+// FindIssueRelation returns the relation of the source issue with the given
+// link ID, or nil if there is none.
+func FindIssueRelation(relations []*gitlab.IssueRelation, linkID int) *gitlab.IssueRelation {
+	for _, r := range relations {
+		if r.IssueLinkID == linkID {
+			return r
+		}
+	}
+	return nil
+}
+
+// FindIssueRelationTo returns the relation of the source issue to the target
+// issue, or nil if there is none. Gitlab does not return the ID of a link it
+// created, so it is looked up through it.
+func FindIssueRelationTo(relations []*gitlab.IssueRelation, target *gitlab.Issue) *gitlab.IssueRelation {
+	if target == nil {
+		return nil
+	}
+	for _, r := range relations {
+		if r.ID == target.ID {
+			return r
+		}
+	}
+	return nil
+}
+
+// GenerateIssueLinkObservation is used to produce
+// v1alpha1.IssueLinkObservation from gitlab.IssueRelation.
+func GenerateIssueLinkObservation(r *gitlab.IssueRelation) v1alpha1.IssueLinkObservation {
+	if r == nil {
+		return v1alpha1.IssueLinkObservation{}
+	}
+	return v1alpha1.IssueLinkObservation{
+		ID:                r.IssueLinkID,
+		LinkType:          r.LinkType,
+		TargetIssueWebURL: r.WebURL,
+		CreatedAt:         clients.TimeToMetaTime(r.LinkCreatedAt),
+	}
+}
+
+// GenerateCreateIssueLinkOptions generates issue link creation options
+func GenerateCreateIssueLinkOptions(p *v1alpha1.IssueLinkParameters) *gitlab.CreateIssueLinkOptions {
+	targetProjectID := p.TargetProjectID
+	if targetProjectID == nil {
+		targetProjectID = p.ProjectID
+	}
+	return &gitlab.CreateIssueLinkOptions{
+		TargetProjectID: targetProjectID,
+		TargetIssueIID:  gitlab.String(strconv.Itoa(p.TargetIssueIID)),
+		LinkType:        p.LinkType,
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestGenerateCreateIssueLinkOptions(t *testing.T) {
+	source := "1234"
+	target := "group/other"
+	blocks := "blocks"
+
+	cases := map[string]struct {
+		p    *v1alpha1.IssueLinkParameters
+		want *gitlab.CreateIssueLinkOptions
+	}{
+		"SameProject": {
+			p: &v1alpha1.IssueLinkParameters{ProjectID: &source, IssueIID: 1, TargetIssueIID: 2},
+			want: &gitlab.CreateIssueLinkOptions{
+				TargetProjectID: &source,
+				TargetIssueIID:  gitlab.String("2"),
+			},
+		},
+		"OtherProject": {
+			p: &v1alpha1.IssueLinkParameters{ProjectID: &source, IssueIID: 1, TargetProjectID: &target, TargetIssueIID: 2, LinkType: &blocks},
+			want: &gitlab.CreateIssueLinkOptions{
+				TargetProjectID: &target,
+				TargetIssueIID:  gitlab.String("2"),
+				LinkType:        &blocks,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateIssueLinkOptions(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindIssueRelationTo(t *testing.T) {
+	relations := []*gitlab.IssueRelation{
+		{ID: 10, IssueLinkID: 1},
+		{ID: 20, IssueLinkID: 2},
+	}
+
+	cases := map[string]struct {
+		target *gitlab.Issue
+		want   *gitlab.IssueRelation
+	}{
+		"NoTarget": {},
+		"Found": {
+			target: &gitlab.Issue{ID: 20},
+			want:   relations[1],
+		},
+		"NotFound": {
+			target: &gitlab.Issue{ID: 30},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindIssueRelationTo(relations, tc.target)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
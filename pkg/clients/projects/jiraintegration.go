@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// JiraIntegrationClient defines Gitlab project Jira integration service
+// operations
+type JiraIntegrationClient interface {
+	GetJiraService(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error)
+	SetJiraService(pid interface{}, opt *gitlab.SetJiraServiceOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	DeleteJiraService(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewJiraIntegrationClient returns a new Gitlab project Jira integration
+// service
+func NewJiraIntegrationClient(cfg clients.Config) JiraIntegrationClient {
+	git := clients.NewClient(cfg)
+	return git.Services
+}
+
+// IsJiraIntegrationUpToDate checks whether there is a change in any of the
+// modifiable fields. Credentials are not compared, as Gitlab does not return
+// the password, and the username is only compared if it is not read from a
+// secret.
+func IsJiraIntegrationUpToDate(p *v1alpha1.JiraIntegrationParameters, g *gitlab.JiraService) bool {
+	props := g.Properties
+	if props == nil {
+		props = &gitlab.JiraServiceProperties{}
+	}
+	return p.URL == props.URL &&
+		clients.IsStringEqualToStringPtr(p.APIURL, props.APIURL) &&
+		clients.IsStringEqualToStringPtr(p.ProjectKey, props.ProjectKey) &&
+		(p.UsernameSecretRef != nil || clients.IsStringEqualToStringPtr(p.Username, props.Username)) &&
+		clients.IsStringEqualToStringPtr(p.JiraIssueTransitionID, props.JiraIssueTransitionID) &&
+		clients.IsBoolEqualToBoolPtr(p.CommitEvents, g.CommitEvents) &&
+		clients.IsBoolEqualToBoolPtr(p.MergeRequestsEvents, g.MergeRequestsEvents) &&
+		clients.IsBoolEqualToBoolPtr(p.CommentOnEventEnabled, g.CommentOnEventEnabled)
+}
+
+// LateInitializeJiraIntegration fills the settings that are not set in p
+// with the ones found at Gitlab.
+func LateInitializeJiraIntegration(p *v1alpha1.JiraIntegrationParameters, g *gitlab.JiraService) {
+	if g == nil {
+		return
+	}
+	if props := g.Properties; props != nil {
+		p.APIURL = clients.LateInitializeStringPtr(p.APIURL, props.APIURL)
+		p.ProjectKey = clients.LateInitializeStringPtr(p.ProjectKey, props.ProjectKey)
+		p.JiraIssueTransitionID = clients.LateInitializeStringPtr(p.JiraIssueTransitionID, props.JiraIssueTransitionID)
+	}
+	if p.CommitEvents == nil {
+		p.CommitEvents = &g.CommitEvents
+	}
+	if p.MergeRequestsEvents == nil {
+		p.MergeRequestsEvents = &g.MergeRequestsEvents
+	}
+	if p.CommentOnEventEnabled == nil {
+		p.CommentOnEventEnabled = &g.CommentOnEventEnabled
+	}
+}
+
+// GenerateJiraIntegrationObservation is used to produce
+// v1alpha1.JiraIntegrationObservation from gitlab.JiraService.
+func GenerateJiraIntegrationObservation(g *gitlab.JiraService) v1alpha1.JiraIntegrationObservation {
+	if g == nil {
+		return v1alpha1.JiraIntegrationObservation{}
+	}
+	return v1alpha1.JiraIntegrationObservation{
+		Active:    g.Active,
+		CreatedAt: clients.TimeToMetaTime(g.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(g.UpdatedAt),
+	}
+}
+
+// GenerateSetJiraServiceOptions generates Jira integration set options with
+// the given credentials. A nil username leaves the username at Gitlab as it
+// is.
+func GenerateSetJiraServiceOptions(p *v1alpha1.JiraIntegrationParameters, username *string, password string) *gitlab.SetJiraServiceOptions {
+	return &gitlab.SetJiraServiceOptions{
+		URL:                   &p.URL,
+		APIURL:                p.APIURL,
+		ProjectKey:            p.ProjectKey,
+		Username:              username,
+		Password:              &password,
+		JiraIssueTransitionID: p.JiraIssueTransitionID,
+		CommitEvents:          p.CommitEvents,
+		MergeRequestsEvents:   p.MergeRequestsEvents,
+		CommentOnEventEnabled: p.CommentOnEventEnabled,
+	}
+}
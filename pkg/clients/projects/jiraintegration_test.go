@@ -0,0 +1,128 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestIsJiraIntegrationUpToDate(t *testing.T) {
+	g := &gitlab.JiraService{
+		Service: gitlab.Service{
+			CommitEvents:        true,
+			MergeRequestsEvents: false,
+		},
+		Properties: &gitlab.JiraServiceProperties{
+			URL:        "https://jira.example.com",
+			ProjectKey: "PRJ",
+			Username:   "bot",
+		},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.JiraIntegrationParameters
+		want bool
+	}{
+		"OnlyURLSet": {
+			p:    &v1alpha1.JiraIntegrationParameters{URL: "https://jira.example.com"},
+			want: true,
+		},
+		"UpToDate": {
+			p: &v1alpha1.JiraIntegrationParameters{
+				URL:          "https://jira.example.com",
+				ProjectKey:   ptr.To("PRJ"),
+				Username:     ptr.To("bot"),
+				CommitEvents: ptr.To(true),
+			},
+			want: true,
+		},
+		"URLChanged": {
+			p:    &v1alpha1.JiraIntegrationParameters{URL: "https://jira.example.org"},
+			want: false,
+		},
+		"UsernameChanged": {
+			p: &v1alpha1.JiraIntegrationParameters{
+				URL:      "https://jira.example.com",
+				Username: ptr.To("admin"),
+			},
+			want: false,
+		},
+		"UsernameFromSecret": {
+			p: &v1alpha1.JiraIntegrationParameters{
+				URL:               "https://jira.example.com",
+				Username:          ptr.To("admin"),
+				UsernameSecretRef: &xpv1.SecretKeySelector{Key: "username"},
+			},
+			want: true,
+		},
+		"EventsChanged": {
+			p: &v1alpha1.JiraIntegrationParameters{
+				URL:                 "https://jira.example.com",
+				MergeRequestsEvents: ptr.To(true),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsJiraIntegrationUpToDate(tc.p, g)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeJiraIntegration(t *testing.T) {
+	g := &gitlab.JiraService{
+		Service: gitlab.Service{
+			CommitEvents:        true,
+			MergeRequestsEvents: true,
+		},
+		Properties: &gitlab.JiraServiceProperties{
+			URL:                   "https://jira.example.com",
+			ProjectKey:            "PRJ",
+			JiraIssueTransitionID: "21",
+		},
+	}
+	p := &v1alpha1.JiraIntegrationParameters{
+		URL:                 "https://jira.example.com",
+		MergeRequestsEvents: ptr.To(false),
+	}
+	want := &v1alpha1.JiraIntegrationParameters{
+		URL:                   "https://jira.example.com",
+		ProjectKey:            ptr.To("PRJ"),
+		JiraIssueTransitionID: ptr.To("21"),
+		CommitEvents:          ptr.To(true),
+		MergeRequestsEvents:   ptr.To(false),
+		CommentOnEventEnabled: ptr.To(false),
+	}
+
+	LateInitializeJiraIntegration(p, g)
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
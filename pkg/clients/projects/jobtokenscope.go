@@ -0,0 +1,411 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	errAllowedIDInvalid = "allowlist entry %q is not an ID"
+)
+
+// JobTokenAccessSettings are the job token access settings of a project.
+// They are defined here rather than in go-gitlab as that does not support
+// the job token scope API.
+type JobTokenAccessSettings struct {
+	InboundEnabled  bool `json:"inbound_enabled"`
+	OutboundEnabled bool `json:"outbound_enabled"`
+}
+
+// PatchJobTokenAccessSettingsOptions are the options to change the job token
+// access settings of a project with.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#patch-a-projects-cicd-job-token-access-settings
+type PatchJobTokenAccessSettingsOptions struct {
+	Enabled bool `url:"enabled" json:"enabled"`
+}
+
+// JobTokenAllowlistOptions are the options to add a project or group to the
+// job token allowlist of a project with.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#add-a-project-to-a-cicd-job-token-inbound-allowlist
+type JobTokenAllowlistOptions struct {
+	TargetProjectID *int `url:"target_project_id,omitempty" json:"target_project_id,omitempty"`
+	TargetGroupID   *int `url:"target_group_id,omitempty" json:"target_group_id,omitempty"`
+}
+
+// JobTokenScopeClient defines Gitlab job token scope service operations
+type JobTokenScopeClient interface {
+	GetJobTokenAccessSettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*JobTokenAccessSettings, *gitlab.Response, error)
+	PatchJobTokenAccessSettings(pid interface{}, opt *PatchJobTokenAccessSettingsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ListJobTokenInboundAllowList(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error)
+	AddProjectToJobTokenInboundAllowList(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	RemoveProjectFromJobTokenInboundAllowList(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ListJobTokenAllowlistGroups(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Group, *gitlab.Response, error)
+	AddGroupToJobTokenAllowlist(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	RemoveGroupFromJobTokenAllowlist(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type jobTokenScopeClient struct {
+	git *gitlab.Client
+}
+
+// NewJobTokenScopeClient returns a new Gitlab job token scope service
+func NewJobTokenScopeClient(cfg clients.Config) JobTokenScopeClient {
+	return &jobTokenScopeClient{git: clients.NewClient(cfg)}
+}
+
+// GetJobTokenAccessSettings gets the job token access settings of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#get-a-projects-cicd-job-token-access-settings
+func (c *jobTokenScopeClient) GetJobTokenAccessSettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*JobTokenAccessSettings, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.git.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/job_token_scope", project), nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s := new(JobTokenAccessSettings)
+	resp, err := c.git.Do(req, s)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s, resp, nil
+}
+
+// PatchJobTokenAccessSettings changes the job token access settings of a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#patch-a-projects-cicd-job-token-access-settings
+func (c *jobTokenScopeClient) PatchJobTokenAccessSettings(pid interface{}, opt *PatchJobTokenAccessSettingsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(http.MethodPatch, fmt.Sprintf("projects/%s/job_token_scope", project), opt, options)
+}
+
+// ListJobTokenInboundAllowList lists the projects of the job token allowlist
+// of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#get-a-projects-cicd-job-token-inbound-allowlist
+func (c *jobTokenScopeClient) ListJobTokenInboundAllowList(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.git.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/job_token_scope/allowlist", project), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ps []*gitlab.Project
+	resp, err := c.git.Do(req, &ps)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ps, resp, nil
+}
+
+// AddProjectToJobTokenInboundAllowList adds a project to the job token
+// allowlist of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#add-a-project-to-a-cicd-job-token-inbound-allowlist
+func (c *jobTokenScopeClient) AddProjectToJobTokenInboundAllowList(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(http.MethodPost, fmt.Sprintf("projects/%s/job_token_scope/allowlist", project), &JobTokenAllowlistOptions{TargetProjectID: &target}, options)
+}
+
+// RemoveProjectFromJobTokenInboundAllowList removes a project from the job
+// token allowlist of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#remove-a-project-from-a-cicd-job-token-inbound-allowlist
+func (c *jobTokenScopeClient) RemoveProjectFromJobTokenInboundAllowList(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(http.MethodDelete, fmt.Sprintf("projects/%s/job_token_scope/allowlist/%d", project, target), nil, options)
+}
+
+// ListJobTokenAllowlistGroups lists the groups of the job token allowlist of
+// a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#get-a-projects-cicd-job-token-allowlist-of-groups
+func (c *jobTokenScopeClient) ListJobTokenAllowlistGroups(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Group, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.git.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/job_token_scope/groups_allowlist", project), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gs []*gitlab.Group
+	resp, err := c.git.Do(req, &gs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return gs, resp, nil
+}
+
+// AddGroupToJobTokenAllowlist adds a group to the job token allowlist of a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#add-a-group-to-a-cicd-job-token-allowlist
+func (c *jobTokenScopeClient) AddGroupToJobTokenAllowlist(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(http.MethodPost, fmt.Sprintf("projects/%s/job_token_scope/groups_allowlist", project), &JobTokenAllowlistOptions{TargetGroupID: &target}, options)
+}
+
+// RemoveGroupFromJobTokenAllowlist removes a group from the job token
+// allowlist of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_job_token_scopes.html#remove-a-group-from-a-cicd-job-token-allowlist
+func (c *jobTokenScopeClient) RemoveGroupFromJobTokenAllowlist(pid interface{}, target int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(http.MethodDelete, fmt.Sprintf("projects/%s/job_token_scope/groups_allowlist/%d", project, target), nil, options)
+}
+
+func (c *jobTokenScopeClient) do(method, u string, opt interface{}, options []gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	req, err := c.git.NewRequest(method, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+	return c.git.Do(req, nil)
+}
+
+// JobTokenAllowlist is the job token allowlist of a project.
+type JobTokenAllowlist struct {
+	Projects []*gitlab.Project
+	Groups   []*gitlab.Group
+}
+
+// GetJobTokenAllowlist returns the projects and groups of the job token
+// allowlist of a project, following pagination.
+func GetJobTokenAllowlist(c JobTokenScopeClient, pid interface{}, options ...gitlab.RequestOptionFunc) (*JobTokenAllowlist, error) {
+	l := &JobTokenAllowlist{}
+
+	opt := &gitlab.ListOptions{PerPage: 100}
+	for {
+		ps, res, err := c.ListJobTokenInboundAllowList(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		l.Projects = append(l.Projects, ps...)
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opt.Page = res.NextPage
+	}
+
+	opt = &gitlab.ListOptions{PerPage: 100}
+	for {
+		gs, res, err := c.ListJobTokenAllowlistGroups(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		l.Groups = append(l.Groups, gs...)
+		if res == nil || res.NextPage == 0 {
+			return l, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// JobTokenScopeDiff lists the changes needed to bring the job token
+// allowlist of a project in line with a JobTokenScope.
+type JobTokenScopeDiff struct {
+	AddProjects    []int
+	RemoveProjects []int
+	AddGroups      []int
+	RemoveGroups   []int
+}
+
+// IsEmpty returns true if no changes are needed.
+func (d JobTokenScopeDiff) IsEmpty() bool {
+	return len(d.AddProjects) == 0 && len(d.RemoveProjects) == 0 && len(d.AddGroups) == 0 && len(d.RemoveGroups) == 0
+}
+
+// DiffJobTokenScope compares the desired allowlist with the one found at
+// Gitlab. Entries not in the spec are only scheduled for removal if pruning
+// is enabled. The project itself is never removed, Gitlab always allows it.
+func DiffJobTokenScope(p *v1alpha1.JobTokenScopeParameters, l *JobTokenAllowlist) (JobTokenScopeDiff, error) {
+	d := JobTokenScopeDiff{}
+	prune := ptr.Deref(p.Prune, true)
+
+	projectIDs, err := parseIDs(p.AllowedProjectIDs)
+	if err != nil {
+		return d, err
+	}
+	existing := make(map[int]bool, len(l.Projects))
+	for _, pr := range l.Projects {
+		existing[pr.ID] = true
+	}
+	for _, id := range projectIDs {
+		if !existing[id] {
+			d.AddProjects = append(d.AddProjects, id)
+		}
+	}
+	if prune {
+		desired := idSet(projectIDs)
+		for _, pr := range l.Projects {
+			if !desired[pr.ID] && !isProject(p.ProjectID, pr) {
+				d.RemoveProjects = append(d.RemoveProjects, pr.ID)
+			}
+		}
+	}
+
+	groupIDs, err := parseIDs(p.AllowedGroupIDs)
+	if err != nil {
+		return d, err
+	}
+	existing = make(map[int]bool, len(l.Groups))
+	for _, g := range l.Groups {
+		existing[g.ID] = true
+	}
+	for _, id := range groupIDs {
+		if !existing[id] {
+			d.AddGroups = append(d.AddGroups, id)
+		}
+	}
+	if prune {
+		desired := idSet(groupIDs)
+		for _, g := range l.Groups {
+			if !desired[g.ID] {
+				d.RemoveGroups = append(d.RemoveGroups, g.ID)
+			}
+		}
+	}
+	return d, nil
+}
+
+// AllowedIDs returns the IDs of the projects and groups of the spec that are
+// removed from the allowlist when the JobTokenScope is deleted.
+func AllowedIDs(p *v1alpha1.JobTokenScopeParameters) (projectIDs, groupIDs []int, err error) {
+	if projectIDs, err = parseIDs(p.AllowedProjectIDs); err != nil {
+		return nil, nil, err
+	}
+	if groupIDs, err = parseIDs(p.AllowedGroupIDs); err != nil {
+		return nil, nil, err
+	}
+	return projectIDs, groupIDs, nil
+}
+
+func parseIDs(ids []string) ([]int, error) {
+	out := make([]int, len(ids))
+	for i, id := range ids {
+		n, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, errors.Errorf(errAllowedIDInvalid, id)
+		}
+		out[i] = n
+	}
+	return out, nil
+}
+
+func idSet(ids []int) map[int]bool {
+	s := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		s[id] = true
+	}
+	return s
+}
+
+// isProject returns true if pr is the project with the given ID or path.
+func isProject(pid *string, pr *gitlab.Project) bool {
+	if pid == nil {
+		return false
+	}
+	return *pid == strconv.Itoa(pr.ID) || *pid == pr.PathWithNamespace
+}
+
+// IsJobTokenScopeEnabledUpToDate checks whether the job token scope of a
+// project is enabled as desired.
+func IsJobTokenScopeEnabledUpToDate(p *v1alpha1.JobTokenScopeParameters, s *JobTokenAccessSettings) bool {
+	return clients.IsBoolEqualToBoolPtr(p.Enabled, s.InboundEnabled)
+}
+
+// LateInitializeJobTokenScope fills the settings that are not set in p with
+// the ones found at Gitlab.
+func LateInitializeJobTokenScope(p *v1alpha1.JobTokenScopeParameters, s *JobTokenAccessSettings) {
+	if s == nil {
+		return
+	}
+	if p.Enabled == nil {
+		p.Enabled = &s.InboundEnabled
+	}
+}
+
+// GenerateJobTokenScopeObservation is used to produce
+// v1alpha1.JobTokenScopeObservation from the job token access settings and
+// allowlist of a project.
+func GenerateJobTokenScopeObservation(s *JobTokenAccessSettings, l *JobTokenAllowlist) v1alpha1.JobTokenScopeObservation {
+	o := v1alpha1.JobTokenScopeObservation{}
+	if s != nil {
+		o.Enabled = s.InboundEnabled
+	}
+	if l == nil {
+		return o
+	}
+	for _, pr := range l.Projects {
+		o.AllowedProjectIDs = append(o.AllowedProjectIDs, pr.ID)
+	}
+	for _, g := range l.Groups {
+		o.AllowedGroupIDs = append(o.AllowedGroupIDs, g.ID)
+	}
+	sort.Ints(o.AllowedProjectIDs)
+	sort.Ints(o.AllowedGroupIDs)
+	return o
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestDiffJobTokenScope(t *testing.T) {
+	self := &gitlab.Project{ID: 1, PathWithNamespace: "group/self"}
+	allowlist := &JobTokenAllowlist{
+		Projects: []*gitlab.Project{self, {ID: 10}},
+		Groups:   []*gitlab.Group{{ID: 20}},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.JobTokenScopeParameters
+		want JobTokenScopeDiff
+		err  bool
+	}{
+		"UpToDate": {
+			p:    &v1alpha1.JobTokenScopeParameters{ProjectID: ptr.To("1"), AllowedProjectIDs: []string{"10"}, AllowedGroupIDs: []string{"20"}},
+			want: JobTokenScopeDiff{},
+		},
+		"AddMissing": {
+			p:    &v1alpha1.JobTokenScopeParameters{ProjectID: ptr.To("1"), AllowedProjectIDs: []string{"10", "11"}, AllowedGroupIDs: []string{"20", "21"}},
+			want: JobTokenScopeDiff{AddProjects: []int{11}, AddGroups: []int{21}},
+		},
+		"PruneByDefaultKeepsProjectByPath": {
+			p:    &v1alpha1.JobTokenScopeParameters{ProjectID: ptr.To("group/self")},
+			want: JobTokenScopeDiff{RemoveProjects: []int{10}, RemoveGroups: []int{20}},
+		},
+		"NoPrune": {
+			p:    &v1alpha1.JobTokenScopeParameters{ProjectID: ptr.To("1"), Prune: ptr.To(false)},
+			want: JobTokenScopeDiff{},
+		},
+		"InvalidID": {
+			p:   &v1alpha1.JobTokenScopeParameters{ProjectID: ptr.To("1"), AllowedGroupIDs: []string{"group"}},
+			err: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := DiffJobTokenScope(tc.p, allowlist)
+			if (err != nil) != tc.err {
+				t.Fatalf("DiffJobTokenScope(...): want error %t, got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateJobTokenScopeObservation(t *testing.T) {
+	s := &JobTokenAccessSettings{InboundEnabled: true}
+	l := &JobTokenAllowlist{
+		Projects: []*gitlab.Project{{ID: 10}, {ID: 1}},
+		Groups:   []*gitlab.Group{{ID: 20}},
+	}
+	want := v1alpha1.JobTokenScopeObservation{Enabled: true, AllowedProjectIDs: []int{1, 10}, AllowedGroupIDs: []int{20}}
+	if diff := cmp.Diff(want, GenerateJobTokenScopeObservation(s, l)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
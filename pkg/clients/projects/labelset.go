@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// LabelClient defines Gitlab Label service operations
+type LabelClient interface {
+	ListLabels(pid interface{}, opt *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error)
+	CreateLabel(pid interface{}, opt *gitlab.CreateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error)
+	UpdateLabel(pid interface{}, opt *gitlab.UpdateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error)
+	DeleteLabel(pid interface{}, opt *gitlab.DeleteLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewLabelClient returns a new Gitlab Label service
+func NewLabelClient(cfg clients.Config) LabelClient {
+	git := clients.NewClient(cfg)
+	return git.Labels
+}
+
+// ListProjectLabels returns all labels defined on the project itself,
+// following pagination. Labels inherited from ancestor groups are excluded.
+func ListProjectLabels(c LabelClient, pid interface{}, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, error) {
+	return listLabels(c, pid, func(l *gitlab.Label) bool { return l.IsProjectLabel }, options...)
+}
+
+// ListGroupLabels returns all labels defined on the group itself, following
+// pagination. Labels inherited from ancestor groups are excluded.
+func ListGroupLabels(c LabelClient, gid interface{}, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, error) {
+	// Without ancestor groups, a group lists only its own labels.
+	return listLabels(c, gid, func(*gitlab.Label) bool { return true }, options...)
+}
+
+func listLabels(c LabelClient, id interface{}, own func(*gitlab.Label) bool, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, error) {
+	opt := &gitlab.ListLabelsOptions{
+		ListOptions:           gitlab.ListOptions{PerPage: 100},
+		IncludeAncestorGroups: ptr.To(false),
+	}
+
+	var all []*gitlab.Label
+	for {
+		labels, res, err := c.ListLabels(id, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range labels {
+			if own(l) {
+				all = append(all, l)
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// LabelSetDiff lists the changes needed to bring the labels of a project in
+// line with a LabelSet.
+type LabelSetDiff struct {
+	Create []v1alpha1.Label
+	Update []v1alpha1.Label
+	Delete []string
+}
+
+// IsEmpty returns true if no changes are needed.
+func (d LabelSetDiff) IsEmpty() bool {
+	return len(d.Create) == 0 && len(d.Update) == 0 && len(d.Delete) == 0
+}
+
+// DiffLabelSet compares the desired labels with the ones found at Gitlab.
+// Labels not in the spec are only scheduled for deletion if pruning is
+// enabled.
+func DiffLabelSet(p *v1alpha1.LabelSetParameters, labels []*gitlab.Label) LabelSetDiff {
+	d := LabelSetDiff{}
+
+	existing := make(map[string]*gitlab.Label, len(labels))
+	for _, l := range labels {
+		existing[l.Name] = l
+	}
+
+	desired := make(map[string]bool, len(p.Labels))
+	for i := range p.Labels {
+		l := &p.Labels[i]
+		desired[l.Name] = true
+		e, ok := existing[l.Name]
+		switch {
+		case !ok:
+			d.Create = append(d.Create, *l)
+		case !IsLabelUpToDate(l, e):
+			d.Update = append(d.Update, *l)
+		}
+	}
+
+	if ptr.Deref(p.Prune, false) {
+		for _, l := range labels {
+			if !desired[l.Name] {
+				d.Delete = append(d.Delete, l.Name)
+			}
+		}
+	}
+	return d
+}
+
+// IsLabelUpToDate checks whether there is a change in any of the modifiable
+// fields of a label.
+func IsLabelUpToDate(l *v1alpha1.Label, g *gitlab.Label) bool {
+	if !strings.EqualFold(l.Color, g.Color) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(l.Description, g.Description) {
+		return false
+	}
+	return clients.IsIntEqualToIntPtr(l.Priority, g.Priority)
+}
+
+// GenerateLabelSetObservation is used to produce v1alpha1.LabelSetObservation
+// from a list of gitlab.Label.
+func GenerateLabelSetObservation(labels []*gitlab.Label) v1alpha1.LabelSetObservation {
+	o := v1alpha1.LabelSetObservation{}
+	for _, l := range labels {
+		o.Labels = append(o.Labels, v1alpha1.LabelObservation{
+			ID:          l.ID,
+			Name:        l.Name,
+			Color:       l.Color,
+			Description: l.Description,
+			Priority:    l.Priority,
+		})
+	}
+	return o
+}
+
+// GenerateCreateLabelOptions generates label creation options
+func GenerateCreateLabelOptions(l *v1alpha1.Label) *gitlab.CreateLabelOptions {
+	return &gitlab.CreateLabelOptions{
+		Name:        &l.Name,
+		Color:       &l.Color,
+		Description: l.Description,
+		Priority:    l.Priority,
+	}
+}
+
+// GenerateUpdateLabelOptions generates label update options
+func GenerateUpdateLabelOptions(l *v1alpha1.Label) *gitlab.UpdateLabelOptions {
+	return &gitlab.UpdateLabelOptions{
+		Name:        &l.Name,
+		Color:       &l.Color,
+		Description: l.Description,
+		Priority:    l.Priority,
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestDiffLabelSet(t *testing.T) {
+	description := "a bug"
+	prune := true
+
+	bug := v1alpha1.Label{Name: "bug", Color: "#FF0000", Description: &description}
+	feature := v1alpha1.Label{Name: "feature", Color: "#00FF00"}
+
+	existing := []*gitlab.Label{
+		{ID: 1, Name: "bug", Color: "#ff0000", Description: description},
+		{ID: 2, Name: "stale", Color: "#cccccc"},
+	}
+
+	cases := map[string]struct {
+		p      *v1alpha1.LabelSetParameters
+		labels []*gitlab.Label
+		want   LabelSetDiff
+	}{
+		"UpToDateIgnoresColorCase": {
+			p:      &v1alpha1.LabelSetParameters{Labels: []v1alpha1.Label{bug}},
+			labels: existing,
+			want:   LabelSetDiff{},
+		},
+		"CreateMissing": {
+			p:      &v1alpha1.LabelSetParameters{Labels: []v1alpha1.Label{bug, feature}},
+			labels: existing,
+			want:   LabelSetDiff{Create: []v1alpha1.Label{feature}},
+		},
+		"UpdateChanged": {
+			p:      &v1alpha1.LabelSetParameters{Labels: []v1alpha1.Label{{Name: "bug", Color: "#0000FF"}}},
+			labels: existing,
+			want:   LabelSetDiff{Update: []v1alpha1.Label{{Name: "bug", Color: "#0000FF"}}},
+		},
+		"Prune": {
+			p:      &v1alpha1.LabelSetParameters{Labels: []v1alpha1.Label{bug}, Prune: &prune},
+			labels: existing,
+			want:   LabelSetDiff{Delete: []string{"stale"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffLabelSet(tc.p, tc.labels)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListProjectLabels(t *testing.T) {
+	pages := map[int][]*gitlab.Label{
+		0: {{Name: "bug", IsProjectLabel: true}, {Name: "inherited"}},
+		2: {{Name: "feature", IsProjectLabel: true}},
+	}
+	c := &labelLister{pages: pages, next: map[int]int{0: 2}}
+
+	got, err := ListProjectLabels(c, "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []*gitlab.Label{{Name: "bug", IsProjectLabel: true}, {Name: "feature", IsProjectLabel: true}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestListGroupLabels(t *testing.T) {
+	pages := map[int][]*gitlab.Label{
+		0: {{Name: "bug"}},
+		2: {{Name: "feature"}},
+	}
+	c := &labelLister{pages: pages, next: map[int]int{0: 2}}
+
+	got, err := ListGroupLabels(c, "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []*gitlab.Label{{Name: "bug"}, {Name: "feature"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+type labelLister struct {
+	LabelClient
+	pages map[int][]*gitlab.Label
+	next  map[int]int
+}
+
+func (l *labelLister) ListLabels(pid interface{}, opt *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+	return l.pages[opt.Page], &gitlab.Response{NextPage: l.next[opt.Page]}, nil
+}
@@ -33,15 +33,26 @@ const (
 // MemberClient defines Gitlab Member service operations
 type MemberClient interface {
 	GetProjectMember(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
+	GetInheritedProjectMember(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
 	AddProjectMember(pid interface{}, opt *gitlab.AddProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
 	EditProjectMember(pid interface{}, user int, opt *gitlab.EditProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
 	DeleteProjectMember(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+}
+
+type memberClient struct {
+	*gitlab.ProjectMembersService
+	projects *gitlab.ProjectsService
 }
 
 // NewMemberClient returns a new Gitlab Project Member service
 func NewMemberClient(cfg clients.Config) MemberClient {
 	git := clients.NewClient(cfg)
-	return git.ProjectMembers
+	return &memberClient{ProjectMembersService: git.ProjectMembers, projects: git.Projects}
+}
+
+func (c *memberClient) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.projects.GetProject(pid, opt, options...)
 }
 
 // IsErrorMemberNotFound helper function to test for errMemberNotFound error.
@@ -0,0 +1,239 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	// MilestoneStateActive is the state of open milestones.
+	MilestoneStateActive = "active"
+	// MilestoneStateClosed is the state of closed milestones.
+	MilestoneStateClosed = "closed"
+
+	// MilestoneStateEventActivate reopens a closed milestone.
+	MilestoneStateEventActivate = "activate"
+	// MilestoneStateEventClose closes an active milestone.
+	MilestoneStateEventClose = "close"
+
+	// IssueStateOpened is the state of issues that are not closed.
+	IssueStateOpened = "opened"
+)
+
+// MilestoneClient defines Gitlab milestone service operations, and the
+// issue service operation moving issues to another milestone.
+type MilestoneClient interface {
+	ListMilestones(pid interface{}, opt *gitlab.ListMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error)
+	GetMilestone(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, *gitlab.Response, error)
+	CreateMilestone(pid interface{}, opt *gitlab.CreateMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, *gitlab.Response, error)
+	UpdateMilestone(pid interface{}, milestone int, opt *gitlab.UpdateMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, *gitlab.Response, error)
+	DeleteMilestone(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetMilestoneIssues(pid interface{}, milestone int, opt *gitlab.GetMilestoneIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error)
+	UpdateIssue(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+}
+
+type milestoneClient struct {
+	*gitlab.MilestonesService
+	*gitlab.IssuesService
+}
+
+// NewMilestoneClient returns a new Gitlab milestone and issue service
+func NewMilestoneClient(cfg clients.Config) MilestoneClient {
+	git := clients.NewClient(cfg)
+	return &milestoneClient{MilestonesService: git.Milestones, IssuesService: git.Issues}
+}
+
+// isDateEqual returns true if want is not set or is the date d.
+func isDateEqual(want *string, d *gitlab.ISOTime) bool {
+	if want == nil {
+		return true
+	}
+	return d != nil && *want == d.String()
+}
+
+// IsMilestoneUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsMilestoneUpToDate(p *v1alpha1.MilestoneParameters, m *gitlab.Milestone) bool {
+	if p.Title != m.Title {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Description, m.Description) {
+		return false
+	}
+	if !isDateEqual(p.StartDate, m.StartDate) || !isDateEqual(p.DueDate, m.DueDate) {
+		return false
+	}
+	return clients.IsStringEqualToStringPtr(p.State, m.State)
+}
+
+// IsMilestoneDue returns true if the auto close policy of p applies to m,
+// which is the case if m is still active a day after its due date.
+func IsMilestoneDue(p *v1alpha1.MilestoneParameters, m *gitlab.Milestone, now time.Time) bool {
+	return p.AutoClosePolicy != nil && p.State == nil &&
+		m.State == MilestoneStateActive && clients.IsDateBefore(m.DueDate, now)
+}
+
+// LateInitializeMilestone fills the empty fields in the milestone spec with
+// the values seen in gitlab.Milestone.
+func LateInitializeMilestone(in *v1alpha1.MilestoneParameters, m *gitlab.Milestone) {
+	if m == nil {
+		return
+	}
+	in.Description = clients.LateInitializeStringPtr(in.Description, m.Description)
+	if in.StartDate == nil && m.StartDate != nil {
+		in.StartDate = ptr.To(m.StartDate.String())
+	}
+	if in.DueDate == nil && m.DueDate != nil {
+		in.DueDate = ptr.To(m.DueDate.String())
+	}
+}
+
+// GenerateMilestoneObservation is used to produce
+// v1alpha1.MilestoneObservation from gitlab.Milestone.
+func GenerateMilestoneObservation(m *gitlab.Milestone) v1alpha1.MilestoneObservation {
+	if m == nil {
+		return v1alpha1.MilestoneObservation{}
+	}
+	return v1alpha1.MilestoneObservation{
+		ID:        m.ID,
+		IID:       m.IID,
+		State:     m.State,
+		WebURL:    m.WebURL,
+		CreatedAt: clients.TimeToMetaTime(m.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(m.UpdatedAt),
+	}
+}
+
+// GenerateCreateMilestoneOptions generates milestone creation options. It
+// fails if StartDate or DueDate is not a date in the format YEAR-MONTH-DAY.
+func GenerateCreateMilestoneOptions(p *v1alpha1.MilestoneParameters) (*gitlab.CreateMilestoneOptions, error) {
+	start, err := clients.ParseDate(p.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	due, err := clients.ParseDate(p.DueDate)
+	if err != nil {
+		return nil, err
+	}
+	return &gitlab.CreateMilestoneOptions{
+		Title:       &p.Title,
+		Description: p.Description,
+		StartDate:   start,
+		DueDate:     due,
+	}, nil
+}
+
+// GenerateUpdateMilestoneOptions generates milestone update options. It
+// fails if StartDate or DueDate is not a date in the format YEAR-MONTH-DAY.
+func GenerateUpdateMilestoneOptions(p *v1alpha1.MilestoneParameters) (*gitlab.UpdateMilestoneOptions, error) {
+	start, err := clients.ParseDate(p.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	due, err := clients.ParseDate(p.DueDate)
+	if err != nil {
+		return nil, err
+	}
+	opt := &gitlab.UpdateMilestoneOptions{
+		Title:       &p.Title,
+		Description: p.Description,
+		StartDate:   start,
+		DueDate:     due,
+	}
+	switch ptr.Deref(p.State, "") {
+	case MilestoneStateActive:
+		opt.StateEvent = ptr.To(MilestoneStateEventActivate)
+	case MilestoneStateClosed:
+		opt.StateEvent = ptr.To(MilestoneStateEventClose)
+	}
+	return opt, nil
+}
+
+// NextMilestone returns the active milestone of ms with the earliest due
+// date after the one of m, or nil if there is none.
+func NextMilestone(ms []*gitlab.Milestone, m *gitlab.Milestone) *gitlab.Milestone {
+	if m.DueDate == nil {
+		return nil
+	}
+	var next *gitlab.Milestone
+	for _, c := range ms {
+		if c.ID == m.ID || c.State != MilestoneStateActive || c.DueDate == nil || c.DueDate.String() <= m.DueDate.String() {
+			continue
+		}
+		if next == nil || c.DueDate.String() < next.DueDate.String() {
+			next = c
+		}
+	}
+	return next
+}
+
+// RollOverOpenIssues moves the open issues of the milestone m of the
+// project pid to the next milestone of the project. The issues stay if
+// there is no next milestone. It returns the next milestone.
+func RollOverOpenIssues(c MilestoneClient, pid interface{}, m *gitlab.Milestone, options ...gitlab.RequestOptionFunc) (*gitlab.Milestone, error) {
+	var active []*gitlab.Milestone
+	lopt := &gitlab.ListMilestonesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		State:       ptr.To(MilestoneStateActive),
+	}
+	for {
+		ms, res, err := c.ListMilestones(pid, lopt, options...)
+		if err != nil {
+			return nil, err
+		}
+		active = append(active, ms...)
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		lopt.Page = res.NextPage
+	}
+	next := NextMilestone(active, m)
+	if next == nil {
+		return nil, nil
+	}
+
+	var open []*gitlab.Issue
+	iopt := &gitlab.GetMilestoneIssuesOptions{PerPage: 100}
+	for {
+		issues, res, err := c.GetMilestoneIssues(pid, m.ID, iopt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range issues {
+			if i.State == IssueStateOpened {
+				open = append(open, i)
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		iopt.Page = res.NextPage
+	}
+	for _, i := range open {
+		if _, _, err := c.UpdateIssue(i.ProjectID, i.IID, &gitlab.UpdateIssueOptions{MilestoneID: &next.ID}, options...); err != nil {
+			return nil, err
+		}
+	}
+	return next, nil
+}
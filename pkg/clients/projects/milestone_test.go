@@ -0,0 +1,248 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func isoDate(y int, m time.Month, d int) *gitlab.ISOTime {
+	t := gitlab.ISOTime(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+	return &t
+}
+
+func TestIsMilestoneUpToDate(t *testing.T) {
+	m := &gitlab.Milestone{Title: "v1", Description: "first", DueDate: isoDate(2024, 6, 30), State: MilestoneStateActive}
+
+	cases := map[string]struct {
+		p    *v1alpha1.MilestoneParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    &v1alpha1.MilestoneParameters{Title: "v1", Description: ptr.To("first"), DueDate: ptr.To("2024-06-30")},
+			want: true,
+		},
+		"TitleChanged": {
+			p:    &v1alpha1.MilestoneParameters{Title: "v2"},
+			want: false,
+		},
+		"DueDateChanged": {
+			p:    &v1alpha1.MilestoneParameters{Title: "v1", DueDate: ptr.To("2024-07-31")},
+			want: false,
+		},
+		"StartDateMissing": {
+			p:    &v1alpha1.MilestoneParameters{Title: "v1", StartDate: ptr.To("2024-06-01")},
+			want: false,
+		},
+		"StateChanged": {
+			p:    &v1alpha1.MilestoneParameters{Title: "v1", State: ptr.To(MilestoneStateClosed)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsMilestoneUpToDate(tc.p, m)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMilestoneDue(t *testing.T) {
+	now := time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC)
+	policy := &v1alpha1.MilestoneAutoClosePolicy{}
+
+	cases := map[string]struct {
+		p    *v1alpha1.MilestoneParameters
+		m    *gitlab.Milestone
+		want bool
+	}{
+		"Due": {
+			p:    &v1alpha1.MilestoneParameters{AutoClosePolicy: policy},
+			m:    &gitlab.Milestone{State: MilestoneStateActive, DueDate: isoDate(2024, 6, 30)},
+			want: true,
+		},
+		"NoPolicy": {
+			p:    &v1alpha1.MilestoneParameters{},
+			m:    &gitlab.Milestone{State: MilestoneStateActive, DueDate: isoDate(2024, 6, 30)},
+			want: false,
+		},
+		"StateSet": {
+			p:    &v1alpha1.MilestoneParameters{AutoClosePolicy: policy, State: ptr.To(MilestoneStateActive)},
+			m:    &gitlab.Milestone{State: MilestoneStateActive, DueDate: isoDate(2024, 6, 30)},
+			want: false,
+		},
+		"DueToday": {
+			p:    &v1alpha1.MilestoneParameters{AutoClosePolicy: policy},
+			m:    &gitlab.Milestone{State: MilestoneStateActive, DueDate: isoDate(2024, 7, 1)},
+			want: false,
+		},
+		"NoDueDate": {
+			p:    &v1alpha1.MilestoneParameters{AutoClosePolicy: policy},
+			m:    &gitlab.Milestone{State: MilestoneStateActive},
+			want: false,
+		},
+		"Closed": {
+			p:    &v1alpha1.MilestoneParameters{AutoClosePolicy: policy},
+			m:    &gitlab.Milestone{State: MilestoneStateClosed, DueDate: isoDate(2024, 6, 30)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsMilestoneDue(tc.p, tc.m, now)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateMilestoneOptions(t *testing.T) {
+	cases := map[string]struct {
+		p          *v1alpha1.MilestoneParameters
+		stateEvent *string
+		err        bool
+	}{
+		"NoState": {
+			p: &v1alpha1.MilestoneParameters{Title: "v1", DueDate: ptr.To("2024-06-30")},
+		},
+		"Closed": {
+			p:          &v1alpha1.MilestoneParameters{Title: "v1", State: ptr.To(MilestoneStateClosed)},
+			stateEvent: ptr.To(MilestoneStateEventClose),
+		},
+		"Active": {
+			p:          &v1alpha1.MilestoneParameters{Title: "v1", State: ptr.To(MilestoneStateActive)},
+			stateEvent: ptr.To(MilestoneStateEventActivate),
+		},
+		"InvalidDueDate": {
+			p:   &v1alpha1.MilestoneParameters{Title: "v1", DueDate: ptr.To("30.06.2024")},
+			err: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			opt, err := GenerateUpdateMilestoneOptions(tc.p)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.stateEvent, opt.StateEvent); diff != "" {
+				t.Errorf("StateEvent: -want, +got:\n%s", diff)
+			}
+			if tc.p.DueDate != nil && (opt.DueDate == nil || opt.DueDate.String() != *tc.p.DueDate) {
+				t.Errorf("DueDate: want %s, got %v", *tc.p.DueDate, opt.DueDate)
+			}
+		})
+	}
+}
+
+func TestNextMilestone(t *testing.T) {
+	m := &gitlab.Milestone{ID: 1, State: MilestoneStateActive, DueDate: isoDate(2024, 6, 30)}
+	ms := []*gitlab.Milestone{
+		m,
+		{ID: 2, State: MilestoneStateActive, DueDate: isoDate(2024, 8, 31)},
+		{ID: 3, State: MilestoneStateActive, DueDate: isoDate(2024, 7, 31)},
+		{ID: 4, State: MilestoneStateClosed, DueDate: isoDate(2024, 7, 15)},
+		{ID: 5, State: MilestoneStateActive, DueDate: isoDate(2024, 5, 31)},
+		{ID: 6, State: MilestoneStateActive},
+	}
+
+	if next := NextMilestone(ms, m); next == nil || next.ID != 3 {
+		t.Errorf("want milestone 3, got %v", next)
+	}
+	if next := NextMilestone(ms, &gitlab.Milestone{ID: 7}); next != nil {
+		t.Errorf("want no milestone without due date, got %v", next)
+	}
+}
+
+func TestRollOverOpenIssues(t *testing.T) {
+	m := &gitlab.Milestone{ID: 1, State: MilestoneStateActive, DueDate: isoDate(2024, 6, 30)}
+
+	cases := map[string]struct {
+		milestones []*gitlab.Milestone
+		wantNext   int
+		wantMoved  []int
+	}{
+		"RolledOver": {
+			milestones: []*gitlab.Milestone{m, {ID: 2, State: MilestoneStateActive, DueDate: isoDate(2024, 7, 31)}},
+			wantNext:   2,
+			wantMoved:  []int{11, 13},
+		},
+		"NoNextMilestone": {
+			milestones: []*gitlab.Milestone{m},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &milestoneIssues{milestones: tc.milestones}
+			next, err := RollOverOpenIssues(c, "1", m)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := 0
+			if next != nil {
+				got = next.ID
+			}
+			if diff := cmp.Diff(tc.wantNext, got); diff != "" {
+				t.Errorf("next: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantMoved, c.moved); diff != "" {
+				t.Errorf("moved: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// milestoneIssues lists two pages of issues of milestone 1, and records the
+// issues moved to milestone 2.
+type milestoneIssues struct {
+	MilestoneClient
+	milestones []*gitlab.Milestone
+	moved      []int
+}
+
+func (c *milestoneIssues) ListMilestones(pid interface{}, opt *gitlab.ListMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Milestone, *gitlab.Response, error) {
+	return c.milestones, &gitlab.Response{}, nil
+}
+
+func (c *milestoneIssues) GetMilestoneIssues(pid interface{}, milestone int, opt *gitlab.GetMilestoneIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+	pages := map[int][]*gitlab.Issue{
+		0: {{IID: 11, ProjectID: 1, State: IssueStateOpened}, {IID: 12, ProjectID: 1, State: "closed"}},
+		2: {{IID: 13, ProjectID: 1, State: IssueStateOpened}},
+	}
+	next := map[int]int{0: 2}
+	return pages[opt.Page], &gitlab.Response{NextPage: next[opt.Page]}, nil
+}
+
+func (c *milestoneIssues) UpdateIssue(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+	if ptr.Deref(opt.MilestoneID, 0) == 2 {
+		c.moved = append(c.moved, issue)
+	}
+	return &gitlab.Issue{}, &gitlab.Response{}, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	// NoteableTypeIssue is the noteable type of comments on issues.
+	NoteableTypeIssue = "Issue"
+
+	// NoteableTypeMergeRequest is the noteable type of comments on merge
+	// requests.
+	NoteableTypeMergeRequest = "MergeRequest"
+)
+
+// NoteClient defines Gitlab issue and merge request note service operations
+type NoteClient interface {
+	ListIssueNotes(pid interface{}, issue int, opt *gitlab.ListIssueNotesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error)
+	GetIssueNote(pid interface{}, issue, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	CreateIssueNote(pid interface{}, issue int, opt *gitlab.CreateIssueNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	UpdateIssueNote(pid interface{}, issue, note int, opt *gitlab.UpdateIssueNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	DeleteIssueNote(pid interface{}, issue, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ListMergeRequestNotes(pid interface{}, mergeRequest int, opt *gitlab.ListMergeRequestNotesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error)
+	GetMergeRequestNote(pid interface{}, mergeRequest, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	CreateMergeRequestNote(pid interface{}, mergeRequest int, opt *gitlab.CreateMergeRequestNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	UpdateMergeRequestNote(pid interface{}, mergeRequest, note int, opt *gitlab.UpdateMergeRequestNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error)
+	DeleteMergeRequestNote(pid interface{}, mergeRequest, note int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewNoteClient returns a new Gitlab note service
+func NewNoteClient(cfg clients.Config) NoteClient {
+	git := clients.NewClient(cfg)
+	return git.Notes
+}
+
+// NoteMarker returns the HTML comment that marks a note with marker. It is
+// not rendered by Gitlab.
+func NoteMarker(marker string) string {
+	return "<!-- " + marker + " -->"
+}
+
+// NoteBody returns the body of the note of p, with its marker appended.
+func NoteBody(p *v1alpha1.NoteParameters) string {
+	if p.Marker == nil {
+		return p.Body
+	}
+	return p.Body + "\n\n" + NoteMarker(*p.Marker)
+}
+
+// GetNote returns note id on the issue or merge request of p.
+func GetNote(c NoteClient, p *v1alpha1.NoteParameters, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+	if p.NoteableType == NoteableTypeMergeRequest {
+		return c.GetMergeRequestNote(*p.ProjectID, p.NoteableIID, id, options...)
+	}
+	return c.GetIssueNote(*p.ProjectID, p.NoteableIID, id, options...)
+}
+
+// FindNoteByMarker returns the note on the issue or merge request of p that
+// contains the marker of p, or nil if there is none. System notes are
+// ignored.
+func FindNoteByMarker(c NoteClient, p *v1alpha1.NoteParameters, options ...gitlab.RequestOptionFunc) (*gitlab.Note, error) {
+	if p.Marker == nil {
+		return nil, nil
+	}
+	marker := NoteMarker(*p.Marker)
+	lo := gitlab.ListOptions{PerPage: 100}
+	for {
+		var notes []*gitlab.Note
+		var res *gitlab.Response
+		var err error
+		if p.NoteableType == NoteableTypeMergeRequest {
+			notes, res, err = c.ListMergeRequestNotes(*p.ProjectID, p.NoteableIID, &gitlab.ListMergeRequestNotesOptions{ListOptions: lo}, options...)
+		} else {
+			notes, res, err = c.ListIssueNotes(*p.ProjectID, p.NoteableIID, &gitlab.ListIssueNotesOptions{ListOptions: lo}, options...)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range notes {
+			if !n.System && strings.Contains(n.Body, marker) {
+				return n, nil
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		lo.Page = res.NextPage
+	}
+}
+
+// CreateNote posts the note of p on its issue or merge request.
+func CreateNote(c NoteClient, p *v1alpha1.NoteParameters, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+	body := NoteBody(p)
+	if p.NoteableType == NoteableTypeMergeRequest {
+		return c.CreateMergeRequestNote(*p.ProjectID, p.NoteableIID, &gitlab.CreateMergeRequestNoteOptions{Body: &body}, options...)
+	}
+	return c.CreateIssueNote(*p.ProjectID, p.NoteableIID, &gitlab.CreateIssueNoteOptions{Body: &body}, options...)
+}
+
+// UpdateNote changes the body of note id to the one of p.
+func UpdateNote(c NoteClient, p *v1alpha1.NoteParameters, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+	body := NoteBody(p)
+	if p.NoteableType == NoteableTypeMergeRequest {
+		return c.UpdateMergeRequestNote(*p.ProjectID, p.NoteableIID, id, &gitlab.UpdateMergeRequestNoteOptions{Body: &body}, options...)
+	}
+	return c.UpdateIssueNote(*p.ProjectID, p.NoteableIID, id, &gitlab.UpdateIssueNoteOptions{Body: &body}, options...)
+}
+
+// DeleteNote deletes note id from the issue or merge request of p.
+func DeleteNote(c NoteClient, p *v1alpha1.NoteParameters, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	if p.NoteableType == NoteableTypeMergeRequest {
+		return c.DeleteMergeRequestNote(*p.ProjectID, p.NoteableIID, id, options...)
+	}
+	return c.DeleteIssueNote(*p.ProjectID, p.NoteableIID, id, options...)
+}
+
+// IsNoteUpToDate checks whether the body of the note changed. Surrounding
+// whitespace is ignored, as Gitlab trims it.
+func IsNoteUpToDate(p *v1alpha1.NoteParameters, g *gitlab.Note) bool {
+	return strings.TrimSpace(NoteBody(p)) == strings.TrimSpace(g.Body)
+}
+
+// GenerateNoteObservation is used to produce v1alpha1.NoteObservation from
+// gitlab.Note.
+func GenerateNoteObservation(g *gitlab.Note) v1alpha1.NoteObservation {
+	if g == nil {
+		return v1alpha1.NoteObservation{}
+	}
+	return v1alpha1.NoteObservation{
+		ID:             g.ID,
+		AuthorUsername: g.Author.Username,
+		CreatedAt:      clients.TimeToMetaTime(g.CreatedAt),
+		UpdatedAt:      clients.TimeToMetaTime(g.UpdatedAt),
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestNoteBody(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.NoteParameters
+		want string
+	}{
+		"NoMarker": {
+			p:    &v1alpha1.NoteParameters{Body: "Provisioned."},
+			want: "Provisioned.",
+		},
+		"Marker": {
+			p:    &v1alpha1.NoteParameters{Body: "Provisioned.", Marker: ptr.To("crossplane/status")},
+			want: "Provisioned.\n\n<!-- crossplane/status -->",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NoteBody(tc.p)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNoteUpToDate(t *testing.T) {
+	p := &v1alpha1.NoteParameters{Body: "Provisioned.", Marker: ptr.To("crossplane/status")}
+
+	cases := map[string]struct {
+		g    *gitlab.Note
+		want bool
+	}{
+		"UpToDate": {
+			g:    &gitlab.Note{Body: "Provisioned.\n\n<!-- crossplane/status -->"},
+			want: true,
+		},
+		"Trimmed": {
+			g:    &gitlab.Note{Body: "Provisioned.\n\n<!-- crossplane/status -->\n"},
+			want: true,
+		},
+		"BodyChanged": {
+			g:    &gitlab.Note{Body: "Provisioning.\n\n<!-- crossplane/status -->"},
+			want: false,
+		},
+		"MarkerRemoved": {
+			g:    &gitlab.Note{Body: "Provisioned."},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNoteUpToDate(p, tc.g)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+)
+
+const errResolveProjectPath = "cannot resolve project path %q"
+
+// ProjectGetter gets a project by its ID or path.
+type ProjectGetter interface {
+	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+}
+
+// ResolveProjectID returns the ID of the project with path. Controllers
+// persist the ID in the spec of their resource, the way resolved references
+// are persisted, so a path is only resolved once.
+func ResolveProjectID(ctx context.Context, c ProjectGetter, path string) (*int, error) {
+	prj, _, err := c.GetProject(path, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, errResolveProjectPath, path)
+	}
+	return &prj.ID, nil
+}
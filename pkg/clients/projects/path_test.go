@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+)
+
+// projectsByPath gets the projects it maps the paths of to their IDs.
+type projectsByPath map[string]int
+
+func (p projectsByPath) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	id, ok := p[pid.(string)]
+	if !ok {
+		return nil, &gitlab.Response{}, errors.New("404 Project Not Found")
+	}
+	return &gitlab.Project{ID: id}, &gitlab.Response{}, nil
+}
+
+func TestResolveProjectID(t *testing.T) {
+	c := projectsByPath{"group/project": 42}
+
+	cases := map[string]struct {
+		path    string
+		want    *int
+		wantErr bool
+	}{
+		"Path": {
+			path: "group/project",
+			want: ptr.To(42),
+		},
+		"UnknownPath": {
+			path:    "group/other",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ResolveProjectID(context.Background(), c, tc.path)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("ResolveProjectID: error: -want, +got:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ResolveProjectID: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
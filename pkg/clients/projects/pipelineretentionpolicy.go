@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// DefaultMaxPipelineDeletions is the number of pipelines a retention policy
+// deletes per run if it does not set one.
+const DefaultMaxPipelineDeletions = 100
+
+// PipelineClient defines Gitlab Pipeline service operations
+type PipelineClient interface {
+	ListProjectPipelines(pid interface{}, opt *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error)
+	DeletePipeline(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewPipelineClient returns a new Gitlab Pipeline service
+func NewPipelineClient(cfg clients.Config) PipelineClient {
+	git := clients.NewClient(cfg)
+	return git.Pipelines
+}
+
+// ListRetainedPipelines returns the pipelines a retention policy applies to
+// at the given time, newest first. Only the pipelines last updated before the
+// OlderThanDays cutoff are listed, and listing stops after KeepLast plus
+// MaxDeletionsPerRun pipelines, as a run deletes no more than that.
+func ListRetainedPipelines(c PipelineClient, pid interface{}, p *v1alpha1.PipelineRetentionPolicyParameters, now time.Time, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, error) {
+	limit := ptr.Deref(p.KeepLast, 0) + ptr.Deref(p.MaxDeletionsPerRun, DefaultMaxPipelineDeletions)
+	opt := &gitlab.ListProjectPipelinesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: min(limit, 100)},
+		Ref:         p.Ref,
+		OrderBy:     ptr.To("id"),
+		Sort:        ptr.To("desc"),
+	}
+	if p.OlderThanDays != nil {
+		opt.UpdatedBefore = ptr.To(now.AddDate(0, 0, -*p.OlderThanDays))
+	}
+
+	var all []*gitlab.PipelineInfo
+	for {
+		pipelines, res, err := c.ListProjectPipelines(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, pipelines...)
+		if len(all) >= limit {
+			return all[:limit], nil
+		}
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// isPipelineFinished returns true if a pipeline with the given status has
+// stopped running.
+func isPipelineFinished(status string) bool {
+	switch gitlab.BuildStateValue(status) {
+	case gitlab.Success, gitlab.Failed, gitlab.Canceled, gitlab.Skipped:
+		return true
+	}
+	return false
+}
+
+// ExpiredPipelines returns the pipelines a retention policy deletes at the
+// given time. pipelines must be ordered newest first, as returned by
+// ListRetainedPipelines.
+func ExpiredPipelines(p *v1alpha1.PipelineRetentionPolicyParameters, pipelines []*gitlab.PipelineInfo, now time.Time) []*gitlab.PipelineInfo {
+	if p.KeepLast == nil && p.OlderThanDays == nil {
+		return nil
+	}
+
+	var cutoff time.Time
+	if p.OlderThanDays != nil {
+		cutoff = now.AddDate(0, 0, -*p.OlderThanDays)
+	}
+
+	var expired []*gitlab.PipelineInfo
+	for i, pl := range pipelines {
+		if i < ptr.Deref(p.KeepLast, 0) || !isPipelineFinished(pl.Status) {
+			continue
+		}
+		if p.OlderThanDays != nil && (pl.CreatedAt == nil || !pl.CreatedAt.Before(cutoff)) {
+			continue
+		}
+		expired = append(expired, pl)
+	}
+	return expired
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestExpiredPipelines(t *testing.T) {
+	now := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) *time.Time {
+		t := now.AddDate(0, 0, -d)
+		return &t
+	}
+
+	pipelines := []*gitlab.PipelineInfo{
+		{ID: 5, Status: "running", CreatedAt: daysAgo(40)},
+		{ID: 4, Status: "success", CreatedAt: daysAgo(1)},
+		{ID: 3, Status: "failed", CreatedAt: daysAgo(10)},
+		{ID: 2, Status: "canceled", CreatedAt: daysAgo(31)},
+		{ID: 1, Status: "success", CreatedAt: daysAgo(60)},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.PipelineRetentionPolicyParameters
+		want []int
+	}{
+		"NoPolicy": {
+			p: &v1alpha1.PipelineRetentionPolicyParameters{},
+		},
+		"OlderThanDays": {
+			p:    &v1alpha1.PipelineRetentionPolicyParameters{OlderThanDays: ptr.To(30)},
+			want: []int{2, 1},
+		},
+		"KeepLast": {
+			p:    &v1alpha1.PipelineRetentionPolicyParameters{KeepLast: ptr.To(2)},
+			want: []int{3, 2, 1},
+		},
+		"KeepLastAndOlderThanDays": {
+			p:    &v1alpha1.PipelineRetentionPolicyParameters{KeepLast: ptr.To(4), OlderThanDays: ptr.To(30)},
+			want: []int{1},
+		},
+		"RunningPipelinesAreKept": {
+			p:    &v1alpha1.PipelineRetentionPolicyParameters{KeepLast: ptr.To(0)},
+			want: []int{4, 3, 2, 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ids(ExpiredPipelines(tc.p, pipelines, now))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func ids(pipelines []*gitlab.PipelineInfo) []int {
+	var ids []int
+	for _, p := range pipelines {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func TestListRetainedPipelines(t *testing.T) {
+	now := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	c := &pipelineLister{}
+	p := &v1alpha1.PipelineRetentionPolicyParameters{OlderThanDays: ptr.To(30), KeepLast: ptr.To(1), MaxDeletionsPerRun: ptr.To(2)}
+
+	got, err := ListRetainedPipelines(c, "1", p, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]int{6, 5, 4}, ids(got)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{0, 2}, c.pages); diff != "" {
+		t.Errorf("listed pages: -want, +got:\n%s", diff)
+	}
+	if want := now.AddDate(0, 0, -30); c.updatedBefore == nil || !c.updatedBefore.Equal(want) {
+		t.Errorf("UpdatedBefore: want %v, got %v", want, c.updatedBefore)
+	}
+}
+
+// pipelineLister lists three pages of two pipelines each.
+type pipelineLister struct {
+	PipelineClient
+	pages         []int
+	updatedBefore *time.Time
+}
+
+func (l *pipelineLister) ListProjectPipelines(pid interface{}, opt *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+	l.pages = append(l.pages, opt.Page)
+	l.updatedBefore = opt.UpdatedBefore
+	pages := map[int][]*gitlab.PipelineInfo{
+		0: {{ID: 6}, {ID: 5}},
+		2: {{ID: 4}, {ID: 3}},
+		3: {{ID: 2}, {ID: 1}},
+	}
+	next := map[int]int{0: 2, 2: 3}
+	return pages[opt.Page], &gitlab.Response{NextPage: next[opt.Page]}, nil
+}
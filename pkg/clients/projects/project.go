@@ -17,11 +17,14 @@ limitations under the License.
 package projects
 
 import (
+	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
@@ -37,12 +40,281 @@ type Client interface {
 	CreateProject(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	EditProject(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	DeleteProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetProjectPullMirrorDetails(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPullMirrorDetails, *gitlab.Response, error)
+	StartMirroringProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	RestoreProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	ListGroupProjects(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error)
+	GetProjectForkSettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*ProjectForkSettings, *gitlab.Response, error)
+	EditProjectForkSettings(pid interface{}, opt *ProjectForkSettings, options ...gitlab.RequestOptionFunc) (*ProjectForkSettings, *gitlab.Response, error)
+	ListCustomProjectAttributes(project int, options ...gitlab.RequestOptionFunc) ([]*gitlab.CustomAttribute, *gitlab.Response, error)
+	SetCustomProjectAttribute(project int, c gitlab.CustomAttribute, options ...gitlab.RequestOptionFunc) (*gitlab.CustomAttribute, *gitlab.Response, error)
+	DeleteCustomProjectAttribute(project int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetVariable(pid interface{}, key string, opt *gitlab.GetProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error)
+	CreateVariable(pid interface{}, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error)
+	UpdateVariable(pid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error)
+	RemoveVariable(pid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ScheduleExport(pid interface{}, opt *gitlab.ScheduleExportOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ExportStatus(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error)
+}
+
+// Statuses of a project export.
+const (
+	ExportStatusNone     = "none"
+	ExportStatusQueued   = "queued"
+	ExportStatusFinished = "finished"
+	ExportStatusFailed   = "failed"
+)
+
+// ProjectForkSettings are the project settings for fork based workflows that
+// go-gitlab does not support yet. A nil field was not returned by Gitlab,
+// either because it does not apply to the project or because the edition of
+// Gitlab does not support it.
+type ProjectForkSettings struct {
+	MRDefaultTargetSelf  *bool `url:"mr_default_target_self,omitempty" json:"mr_default_target_self,omitempty"`
+	CIAllowForkPipelines *bool `url:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty" json:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty"`
+}
+
+type projectClient struct {
+	*gitlab.ProjectsService
+	*gitlab.CustomAttributesService
+	*gitlab.ProjectVariablesService
+	*gitlab.ProjectImportExportService
+	git *gitlab.Client
 }
 
 // NewProjectClient returns a new Gitlab Project service
 func NewProjectClient(cfg clients.Config) Client {
 	git := clients.NewClient(cfg)
-	return git.Projects
+	return &projectClient{ProjectsService: git.Projects, CustomAttributesService: git.CustomAttribute, ProjectVariablesService: git.ProjectVariables, ProjectImportExportService: git.ProjectImportExport, git: git}
+}
+
+// RestoreProject restores a project that is marked for deletion.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#restore-project-marked-for-deletion
+func (c *projectClient) RestoreProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/restore", project)
+
+	req, err := c.git.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(gitlab.Project)
+	resp, err := c.git.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+	return p, resp, nil
+}
+
+// GetProjectForkSettings gets the fork settings of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-single-project
+func (c *projectClient) GetProjectForkSettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*ProjectForkSettings, *gitlab.Response, error) {
+	return c.doForkSettings(http.MethodGet, pid, nil, options)
+}
+
+// EditProjectForkSettings updates the fork settings of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#edit-project
+func (c *projectClient) EditProjectForkSettings(pid interface{}, opt *ProjectForkSettings, options ...gitlab.RequestOptionFunc) (*ProjectForkSettings, *gitlab.Response, error) {
+	return c.doForkSettings(http.MethodPut, pid, opt, options)
+}
+
+func (c *projectClient) doForkSettings(method string, pid interface{}, opt *ProjectForkSettings, options []gitlab.RequestOptionFunc) (*ProjectForkSettings, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.git.NewRequest(method, "projects/"+project, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fs := new(ProjectForkSettings)
+	resp, err := c.git.Do(req, fs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fs, resp, nil
+}
+
+// HasForkSettings returns true if any of the fork settings of the project
+// spec is set. The fork settings are only read and written if it is.
+func HasForkSettings(p *v1alpha1.ProjectParameters) bool {
+	return p.MRDefaultTargetSelf != nil || p.CIAllowForkPipelines != nil
+}
+
+// IsForkSettingsUpToDate checks whether the fork settings differ from the
+// spec. Settings Gitlab does not return for the project are not compared,
+// so they do not cause perpetual updates.
+func IsForkSettingsUpToDate(p *v1alpha1.ProjectParameters, fs *ProjectForkSettings) bool {
+	if p.MRDefaultTargetSelf != nil && fs.MRDefaultTargetSelf != nil && *p.MRDefaultTargetSelf != *fs.MRDefaultTargetSelf {
+		return false
+	}
+	if p.CIAllowForkPipelines != nil && fs.CIAllowForkPipelines != nil && *p.CIAllowForkPipelines != *fs.CIAllowForkPipelines {
+		return false
+	}
+	return true
+}
+
+// GenerateEditProjectForkSettingsOptions generates the options to update the
+// fork settings of a project with.
+func GenerateEditProjectForkSettingsOptions(p *v1alpha1.ProjectParameters) *ProjectForkSettings {
+	return &ProjectForkSettings{
+		MRDefaultTargetSelf:  p.MRDefaultTargetSelf,
+		CIAllowForkPipelines: p.CIAllowForkPipelines,
+	}
+}
+
+// HasCustomAttributes returns true if the custom attributes of the project
+// are managed by the spec. They are only read and written if they are.
+func HasCustomAttributes(p *v1alpha1.ProjectParameters) bool {
+	return len(p.CustomAttributes) > 0 || ptr.Deref(p.PruneCustomAttributes, false)
+}
+
+// CustomAttributesDiff lists the changes needed to bring the custom
+// attributes of a project in line with the spec.
+type CustomAttributesDiff struct {
+	Set    []v1alpha1.CustomAttribute
+	Delete []string
+}
+
+// IsEmpty returns true if no changes are needed.
+func (d CustomAttributesDiff) IsEmpty() bool {
+	return len(d.Set) == 0 && len(d.Delete) == 0
+}
+
+// DiffCustomAttributes compares the desired custom attributes with the ones
+// found at Gitlab. Attributes not in the spec are only scheduled for deletion
+// if pruning is enabled.
+func DiffCustomAttributes(p *v1alpha1.ProjectParameters, attrs []*gitlab.CustomAttribute) CustomAttributesDiff {
+	d := CustomAttributesDiff{}
+
+	existing := make(map[string]string, len(attrs))
+	for _, a := range attrs {
+		existing[a.Key] = a.Value
+	}
+
+	desired := make(map[string]bool, len(p.CustomAttributes))
+	for _, a := range p.CustomAttributes {
+		desired[a.Key] = true
+		if v, ok := existing[a.Key]; !ok || v != a.Value {
+			d.Set = append(d.Set, a)
+		}
+	}
+
+	if ptr.Deref(p.PruneCustomAttributes, false) {
+		for _, a := range attrs {
+			if !desired[a.Key] {
+				d.Delete = append(d.Delete, a.Key)
+			}
+		}
+	}
+	return d
+}
+
+// ScanningVariable is a CI variable that disables a security scanner.
+type ScanningVariable struct {
+	Key      string
+	Disabled bool
+}
+
+// SecurityScanningVariables returns the CI variables managed by the security
+// scanning toggles of the spec.
+func SecurityScanningVariables(p *v1alpha1.ProjectParameters) []ScanningVariable {
+	s := p.SecurityScanning
+	if s == nil {
+		return nil
+	}
+	var vs []ScanningVariable
+	for _, t := range []struct {
+		key     string
+		enabled *bool
+	}{
+		{key: "SAST_DISABLED", enabled: s.SAST},
+		{key: "DAST_DISABLED", enabled: s.DAST},
+		{key: "DEPENDENCY_SCANNING_DISABLED", enabled: s.DependencyScanning},
+	} {
+		if t.enabled != nil {
+			vs = append(vs, ScanningVariable{Key: t.key, Disabled: !*t.enabled})
+		}
+	}
+	return vs
+}
+
+// IsContainerExpirationPolicyUpToDate checks whether the container
+// expiration policy found at Gitlab has the attributes that are set in a.
+func IsContainerExpirationPolicyUpToDate(a *v1alpha1.ContainerExpirationPolicyAttributes, g *gitlab.ContainerExpirationPolicy) bool {
+	if a == nil {
+		return true
+	}
+	if g == nil {
+		return false
+	}
+	nameRegexDelete := a.NameRegexDelete
+	if nameRegexDelete == nil {
+		nameRegexDelete = a.NameRegex
+	}
+	return clients.IsBoolEqualToBoolPtr(a.Enabled, g.Enabled) &&
+		clients.IsIntEqualToIntPtr(a.KeepN, g.KeepN) &&
+		(a.Cadence == nil || *a.Cadence == g.Cadence) &&
+		(a.OlderThan == nil || *a.OlderThan == g.OlderThan) &&
+		(nameRegexDelete == nil || *nameRegexDelete == g.NameRegexDelete) &&
+		(a.NameRegexKeep == nil || *a.NameRegexKeep == g.NameRegexKeep)
+}
+
+// IsScanningVariableUpToDate checks whether the variable found at Gitlab
+// matches the toggle. A nil variable does not exist.
+func IsScanningVariableUpToDate(sv ScanningVariable, v *gitlab.ProjectVariable) bool {
+	if !sv.Disabled {
+		return v == nil
+	}
+	return v != nil && v.Value == "true"
+}
+
+// ListGroupProjects lists the projects of a group.
+func (c *projectClient) ListGroupProjects(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	return c.git.Groups.ListGroupProjects(gid, opt, options...)
+}
+
+// A GroupProjectLister lists the projects of a group.
+type GroupProjectLister interface {
+	ListGroupProjects(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error)
+}
+
+// ListAllGroupProjects lists all pages of the projects of a group that are
+// neither archived nor marked for deletion.
+func ListAllGroupProjects(c GroupProjectLister, gid interface{}, includeSubgroups bool, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, error) {
+	var all []*gitlab.Project
+	opt := &gitlab.ListGroupProjectsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: 100},
+		Archived:         gitlab.Bool(false),
+		IncludeSubGroups: gitlab.Bool(includeSubgroups),
+	}
+	for {
+		projects, res, err := c.ListGroupProjects(gid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range projects {
+			if p.MarkedForDeletionAt == nil {
+				all = append(all, p)
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
 }
 
 // IsErrorProjectNotFound helper function to test for errProjectNotFound error.
@@ -53,6 +325,72 @@ func IsErrorProjectNotFound(err error) bool {
 	return strings.Contains(err.Error(), errProjectNotFound)
 }
 
+// GeneratePullMirrorObservation is used to produce
+// v1alpha1.PullMirrorObservation from gitlab.ProjectPullMirrorDetails.
+func GeneratePullMirrorObservation(d *gitlab.ProjectPullMirrorDetails, lastPullRequest string) *v1alpha1.PullMirrorObservation {
+	if d == nil {
+		return nil
+	}
+
+	o := &v1alpha1.PullMirrorObservation{
+		LastError:       d.LastError,
+		UpdateStatus:    d.UpdateStatus,
+		LastPullRequest: lastPullRequest,
+	}
+	if d.LastSuccessfulUpdateAt != nil {
+		o.LastSuccessfulUpdateAt = &metav1.Time{Time: *d.LastSuccessfulUpdateAt}
+	}
+	if d.LastUpdateAt != nil {
+		o.LastUpdateAt = &metav1.Time{Time: *d.LastUpdateAt}
+	}
+	return o
+}
+
+// IsMirrorPullRequested reports whether the mirror-pull annotation holds a
+// value no pull was started for yet.
+func IsMirrorPullRequested(cr *v1alpha1.Project) bool {
+	req := cr.GetAnnotations()[v1alpha1.AnnotationKeyMirrorPull]
+	if req == "" {
+		return false
+	}
+	return cr.Status.AtProvider.PullMirror == nil || cr.Status.AtProvider.PullMirror.LastPullRequest != req
+}
+
+// GenerateImportURL returns the ImportURL of p with the ImportURLUsername and
+// password embedded, or nil if p has no ImportURL.
+func GenerateImportURL(p *v1alpha1.ProjectParameters, password string) (*string, error) {
+	if p.ImportURL == nil {
+		return nil, nil
+	}
+	u, err := withMirrorCredentials(*p.ImportURL, ptr.Deref(p.ImportURLUsername, ""), password)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// GenerateScheduleExportOptions returns the options to export a project and
+// upload the export to uploadURL.
+func GenerateScheduleExportOptions(uploadURL string) *gitlab.ScheduleExportOptions {
+	opt := &gitlab.ScheduleExportOptions{}
+	opt.Upload.URL = &uploadURL
+	opt.Upload.HTTPMethod = ptr.To(http.MethodPut)
+	return opt
+}
+
+// GenerateGetProjectOptions generates the options to get a project with,
+// requesting only the optional fields enabled in o.
+func GenerateGetProjectOptions(o *v1alpha1.ProjectObservationOptions) *gitlab.GetProjectOptions {
+	if o == nil {
+		return nil
+	}
+	return &gitlab.GetProjectOptions{
+		Statistics:           o.Statistics,
+		License:              o.License,
+		WithCustomAttributes: o.CustomAttributes,
+	}
+}
+
 // GenerateObservation is used to produce v1alpha1.ProjectObservation from
 // gitlab.Project.
 func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { // nolint:gocyclo
@@ -85,6 +423,7 @@ func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { // n
 		AvatarURL:            prj.AvatarURL,
 		LicenseURL:           prj.LicenseURL,
 		ServiceDeskAddress:   prj.ServiceDeskAddress,
+		Topics:               prj.Topics,
 	}
 
 	if prj.ContainerExpirationPolicy != nil {
@@ -95,7 +434,9 @@ func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { // n
 			NameRegexDelete: prj.ContainerExpirationPolicy.NameRegexDelete,
 			NameRegexKeep:   prj.ContainerExpirationPolicy.NameRegexKeep,
 			Enabled:         prj.ContainerExpirationPolicy.Enabled,
-			NextRunAt:       &metav1.Time{Time: *prj.ContainerExpirationPolicy.NextRunAt},
+		}
+		if prj.ContainerExpirationPolicy.NextRunAt != nil {
+			o.ContainerExpirationPolicy.NextRunAt = &metav1.Time{Time: *prj.ContainerExpirationPolicy.NextRunAt}
 		}
 	}
 
@@ -139,6 +480,7 @@ func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { // n
 				LfsObjectsSize:   prj.Statistics.LFSObjectsSize,
 				JobArtifactsSize: prj.Statistics.JobArtifactsSize,
 			},
+			CommitCount: int(prj.Statistics.CommitCount),
 		}
 	}
 
@@ -268,6 +610,52 @@ func GenerateOwnerObservation(usr *gitlab.User) *v1alpha1.User {
 	return o
 }
 
+// EmailsDisabled returns whether email notifications of the project are to be
+// disabled, preferring EmailsEnabled over the deprecated EmailsDisabled.
+// Requests keep using the deprecated name, which the Gitlab client renames
+// for the Gitlab versions that know the new one.
+func EmailsDisabled(p *v1alpha1.ProjectParameters) *bool {
+	if p.EmailsEnabled != nil {
+		return ptr.To(!*p.EmailsEnabled)
+	}
+	return p.EmailsDisabled
+}
+
+// PublicJobs returns whether the jobs of the project are to be public,
+// preferring PublicJobs over the deprecated PublicBuilds.
+func PublicJobs(p *v1alpha1.ProjectParameters) *bool {
+	if p.PublicJobs != nil {
+		return p.PublicJobs
+	}
+	return p.PublicBuilds
+}
+
+// ContainerRegistryAccessLevel returns the access level of the container
+// registry of the project, translating the deprecated ContainerRegistryEnabled
+// if ContainerRegistryAccessLevel is not set.
+func ContainerRegistryAccessLevel(p *v1alpha1.ProjectParameters) *v1alpha1.AccessControlValue {
+	switch {
+	case p.ContainerRegistryAccessLevel != nil:
+		return p.ContainerRegistryAccessLevel
+	case p.ContainerRegistryEnabled == nil:
+		return nil
+	case *p.ContainerRegistryEnabled:
+		return ptr.To(v1alpha1.AccessControlValue(gitlab.EnabledAccessControl))
+	default:
+		return ptr.To(v1alpha1.AccessControlValue(gitlab.DisabledAccessControl))
+	}
+}
+
+// deprecatedContainerRegistryEnabled returns the deprecated ContainerRegistryEnabled
+// unless ContainerRegistryAccessLevel is set, so the two never contradict
+// each other in a request.
+func deprecatedContainerRegistryEnabled(p *v1alpha1.ProjectParameters) *bool {
+	if p.ContainerRegistryAccessLevel != nil {
+		return nil
+	}
+	return p.ContainerRegistryEnabled
+}
+
 // GenerateCreateProjectOptions generates project creation options
 func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *gitlab.CreateProjectOptions {
 	// Name field overrides resource name
@@ -282,6 +670,7 @@ func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *g
 		Description:                         p.Description,
 		IssuesAccessLevel:                   clients.AccessControlValueV1alpha1ToGitlab(p.IssuesAccessLevel),
 		RepositoryAccessLevel:               clients.AccessControlValueV1alpha1ToGitlab(p.RepositoryAccessLevel),
+		RepositoryStorage:                   p.RepositoryStorage,
 		MergeRequestsAccessLevel:            clients.AccessControlValueV1alpha1ToGitlab(p.MergeRequestsAccessLevel),
 		ForkingAccessLevel:                  clients.AccessControlValueV1alpha1ToGitlab(p.ForkingAccessLevel),
 		BuildsAccessLevel:                   clients.AccessControlValueV1alpha1ToGitlab(p.BuildsAccessLevel),
@@ -289,14 +678,15 @@ func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *g
 		SnippetsAccessLevel:                 clients.AccessControlValueV1alpha1ToGitlab(p.SnippetsAccessLevel),
 		PagesAccessLevel:                    clients.AccessControlValueV1alpha1ToGitlab(p.PagesAccessLevel),
 		OperationsAccessLevel:               clients.AccessControlValueV1alpha1ToGitlab(p.OperationsAccessLevel),
-		EmailsDisabled:                      p.EmailsDisabled,
+		EmailsDisabled:                      EmailsDisabled(p),
 		ResolveOutdatedDiffDiscussions:      p.ResolveOutdatedDiffDiscussions,
 		ContainerExpirationPolicyAttributes: clients.ContainerExpirationPolicyAttributesV1alpha1ToGitlab(p.ContainerExpirationPolicyAttributes),
-		ContainerRegistryEnabled:            p.ContainerRegistryEnabled,
+		ContainerRegistryEnabled:            deprecatedContainerRegistryEnabled(p),
+		ContainerRegistryAccessLevel:        clients.AccessControlValueV1alpha1ToGitlab(ContainerRegistryAccessLevel(p)),
 		SharedRunnersEnabled:                p.SharedRunnersEnabled,
 		Visibility:                          clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
 		ImportURL:                           p.ImportURL,
-		PublicBuilds:                        p.PublicBuilds,
+		PublicBuilds:                        PublicJobs(p),
 		AllowMergeOnSkippedPipeline:         p.AllowMergeOnSkippedPipeline,
 		OnlyAllowMergeIfPipelineSucceeds:    p.OnlyAllowMergeIfPipelineSucceeds,
 		OnlyAllowMergeIfAllDiscussionsAreResolved: p.OnlyAllowMergeIfAllDiscussionsAreResolved,
@@ -329,6 +719,8 @@ func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *g
 		SuggestionCommitMessage:                  p.SuggestionCommitMessage,
 		IssuesTemplate:                           p.IssuesTemplate,
 		MergeRequestsTemplate:                    p.MergeRequestsTemplate,
+		MergeCommitTemplate:                      p.MergeCommitTemplate,
+		SquashCommitTemplate:                     p.SquashCommitTemplate,
 	}
 	return project
 }
@@ -346,6 +738,7 @@ func GenerateEditProjectOptions(name string, p *v1alpha1.ProjectParameters) *git
 		Description:                         p.Description,
 		IssuesAccessLevel:                   clients.AccessControlValueV1alpha1ToGitlab(p.IssuesAccessLevel),
 		RepositoryAccessLevel:               clients.AccessControlValueV1alpha1ToGitlab(p.RepositoryAccessLevel),
+		RepositoryStorage:                   p.RepositoryStorage,
 		MergeRequestsAccessLevel:            clients.AccessControlValueV1alpha1ToGitlab(p.MergeRequestsAccessLevel),
 		ForkingAccessLevel:                  clients.AccessControlValueV1alpha1ToGitlab(p.ForkingAccessLevel),
 		BuildsAccessLevel:                   clients.AccessControlValueV1alpha1ToGitlab(p.BuildsAccessLevel),
@@ -353,14 +746,15 @@ func GenerateEditProjectOptions(name string, p *v1alpha1.ProjectParameters) *git
 		SnippetsAccessLevel:                 clients.AccessControlValueV1alpha1ToGitlab(p.SnippetsAccessLevel),
 		PagesAccessLevel:                    clients.AccessControlValueV1alpha1ToGitlab(p.PagesAccessLevel),
 		OperationsAccessLevel:               clients.AccessControlValueV1alpha1ToGitlab(p.OperationsAccessLevel),
-		EmailsDisabled:                      p.EmailsDisabled,
+		EmailsDisabled:                      EmailsDisabled(p),
 		ResolveOutdatedDiffDiscussions:      p.ResolveOutdatedDiffDiscussions,
 		ContainerExpirationPolicyAttributes: clients.ContainerExpirationPolicyAttributesV1alpha1ToGitlab(p.ContainerExpirationPolicyAttributes),
-		ContainerRegistryEnabled:            p.ContainerRegistryEnabled,
+		ContainerRegistryEnabled:            deprecatedContainerRegistryEnabled(p),
+		ContainerRegistryAccessLevel:        clients.AccessControlValueV1alpha1ToGitlab(ContainerRegistryAccessLevel(p)),
 		SharedRunnersEnabled:                p.SharedRunnersEnabled,
 		Visibility:                          clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
 		ImportURL:                           p.ImportURL,
-		PublicBuilds:                        p.PublicBuilds,
+		PublicBuilds:                        PublicJobs(p),
 		AllowMergeOnSkippedPipeline:         p.AllowMergeOnSkippedPipeline,
 		OnlyAllowMergeIfPipelineSucceeds:    p.OnlyAllowMergeIfPipelineSucceeds,
 		OnlyAllowMergeIfAllDiscussionsAreResolved: p.OnlyAllowMergeIfAllDiscussionsAreResolved,
@@ -391,6 +785,8 @@ func GenerateEditProjectOptions(name string, p *v1alpha1.ProjectParameters) *git
 		SuggestionCommitMessage:                  p.SuggestionCommitMessage,
 		IssuesTemplate:                           p.IssuesTemplate,
 		MergeRequestsTemplate:                    p.MergeRequestsTemplate,
+		MergeCommitTemplate:                      p.MergeCommitTemplate,
+		SquashCommitTemplate:                     p.SquashCommitTemplate,
 	}
 	return o
 }
@@ -0,0 +1,203 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ProjectCache caches the projects of groups, so the Projects of a group
+// are observed with one paginated list of its projects per refresh instead
+// of one request per Project.
+//
+// The group of a project is learned from the first request for it, so each
+// project is requested once on its own. Projects that are not in a group,
+// not in the cached list, or requested with options the list does not
+// support are always requested on their own.
+type ProjectCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu         sync.Mutex
+	groups     map[string]projectCacheEntry
+	namespaces map[string]int
+}
+
+type projectCacheEntry struct {
+	expires  time.Time
+	projects map[int]*gitlab.Project
+}
+
+// NewProjectCache returns a cache that refreshes the projects of a group
+// after ttl.
+func NewProjectCache(ttl time.Duration) *ProjectCache {
+	return &ProjectCache{
+		ttl:        ttl,
+		now:        time.Now,
+		groups:     map[string]projectCacheEntry{},
+		namespaces: map[string]int{},
+	}
+}
+
+// Client returns a Client that gets projects from the cache, filling it
+// with c. Changes made through the client invalidate the cached projects of
+// the group of the changed project. cfg separates the entries of different
+// Gitlab instances and tokens.
+func (pc *ProjectCache) Client(cfg clients.Config, c Client) Client {
+	return &cachedProjectClient{Client: c, cache: pc, instance: cfg.CacheKey()}
+}
+
+func (pc *ProjectCache) namespace(key string) (int, bool) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	gid, ok := pc.namespaces[key]
+	return gid, ok
+}
+
+func (pc *ProjectCache) setNamespace(key string, gid int) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	pc.namespaces[key] = gid
+}
+
+func (pc *ProjectCache) get(key string) (map[int]*gitlab.Project, bool) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	e, ok := pc.groups[key]
+	if !ok || !pc.now().Before(e.expires) {
+		delete(pc.groups, key)
+		return nil, false
+	}
+	return e.projects, true
+}
+
+func (pc *ProjectCache) set(key string, projects map[int]*gitlab.Project) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	pc.groups[key] = projectCacheEntry{expires: pc.now().Add(pc.ttl), projects: projects}
+}
+
+func (pc *ProjectCache) invalidate(key string) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	delete(pc.groups, key)
+}
+
+type cachedProjectClient struct {
+	Client
+	cache    *ProjectCache
+	instance string
+}
+
+func (c *cachedProjectClient) projectKey(pid interface{}) string {
+	return fmt.Sprintf("%s/projects/%v", c.instance, pid)
+}
+
+func (c *cachedProjectClient) groupKey(gid int) string {
+	return fmt.Sprintf("%s/groups/%d", c.instance, gid)
+}
+
+// GetProject gets a project from the cached projects of its group.
+func (c *cachedProjectClient) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	id, ok := pid.(int)
+	if !ok || (opt != nil && (opt.Statistics != nil || opt.License != nil || opt.WithCustomAttributes != nil)) {
+		return c.Client.GetProject(pid, opt, options...)
+	}
+
+	if gid, ok := c.cache.namespace(c.projectKey(id)); ok {
+		if prj := c.getGroupProject(gid, id, options...); prj != nil {
+			return prj, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+		}
+	}
+
+	prj, res, err := c.Client.GetProject(pid, opt, options...)
+	if err == nil && prj.Namespace != nil && prj.Namespace.Kind == "group" {
+		c.cache.setNamespace(c.projectKey(id), prj.Namespace.ID)
+	}
+	return prj, res, err
+}
+
+// getGroupProject returns a copy of the project from the cached projects of
+// its group, or nil if it is not in there.
+func (c *cachedProjectClient) getGroupProject(gid, id int, options ...gitlab.RequestOptionFunc) *gitlab.Project {
+	projects, ok := c.cache.get(c.groupKey(gid))
+	if !ok {
+		var err error
+		if projects, err = c.listAllGroupProjects(gid, options...); err != nil {
+			return nil
+		}
+		c.cache.set(c.groupKey(gid), projects)
+	}
+	prj, ok := projects[id]
+	if !ok {
+		return nil
+	}
+	cp := *prj
+	return &cp
+}
+
+// EditProject edits a project and invalidates the cached projects of its
+// group.
+func (c *cachedProjectClient) EditProject(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	defer c.invalidate(pid)
+	return c.Client.EditProject(pid, opt, options...)
+}
+
+// DeleteProject deletes a project and invalidates the cached projects of
+// its group.
+func (c *cachedProjectClient) DeleteProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	defer c.invalidate(pid)
+	return c.Client.DeleteProject(pid, options...)
+}
+
+// RestoreProject restores a project and invalidates the cached projects of
+// its group.
+func (c *cachedProjectClient) RestoreProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	defer c.invalidate(pid)
+	return c.Client.RestoreProject(pid, options...)
+}
+
+func (c *cachedProjectClient) invalidate(pid interface{}) {
+	if gid, ok := c.cache.namespace(c.projectKey(pid)); ok {
+		c.cache.invalidate(c.groupKey(gid))
+	}
+}
+
+func (c *cachedProjectClient) listAllGroupProjects(gid int, options ...gitlab.RequestOptionFunc) (map[int]*gitlab.Project, error) {
+	all := map[int]*gitlab.Project{}
+	opt := &gitlab.ListGroupProjectsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		projects, res, err := c.ListGroupProjects(gid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range projects {
+			all[p.ID] = p
+		}
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
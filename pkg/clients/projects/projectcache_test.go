@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// listingProjectClient serves the projects of group 10 and counts how often
+// projects are requested and listed.
+type listingProjectClient struct {
+	Client
+	gets  int
+	lists int
+}
+
+func (c *listingProjectClient) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	c.gets++
+	return &gitlab.Project{ID: pid.(int), Name: "get", Namespace: &gitlab.ProjectNamespace{ID: 10, Kind: "group"}}, &gitlab.Response{}, nil
+}
+
+func (c *listingProjectClient) ListGroupProjects(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	c.lists++
+	if opt.Page == 0 {
+		return []*gitlab.Project{{ID: 1, Name: "list"}}, &gitlab.Response{NextPage: 2}, nil
+	}
+	return []*gitlab.Project{{ID: 2, Name: "list"}}, &gitlab.Response{}, nil
+}
+
+func (c *listingProjectClient) EditProject(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return &gitlab.Project{}, &gitlab.Response{}, nil
+}
+
+func TestProjectCache(t *testing.T) {
+	mc := &listingProjectClient{}
+
+	now := time.Unix(0, 0)
+	cache := NewProjectCache(time.Minute)
+	cache.now = func() time.Time { return now }
+	c := cache.Client(clients.Config{BaseURL: "https://gitlab.example.com/", Token: "t"}, mc)
+
+	type calls struct {
+		Name  string
+		Gets  int
+		Lists int
+	}
+	get := func(pid int, opt *gitlab.GetProjectOptions) calls {
+		t.Helper()
+		prj, _, err := c.GetProject(pid, opt)
+		if err != nil {
+			t.Fatalf("GetProject(%d): %v", pid, err)
+		}
+		return calls{Name: prj.Name, Gets: mc.gets, Lists: mc.lists}
+	}
+
+	// The group of a project is learned from requesting it on its own.
+	if diff := cmp.Diff(calls{Name: "get", Gets: 1}, get(1, nil)); diff != "" {
+		t.Errorf("first GetProject(1): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(calls{Name: "list", Gets: 1, Lists: 2}, get(1, nil)); diff != "" {
+		t.Errorf("second GetProject(1): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(calls{Name: "get", Gets: 2, Lists: 2}, get(2, nil)); diff != "" {
+		t.Errorf("first GetProject(2): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(calls{Name: "list", Gets: 2, Lists: 2}, get(2, nil)); diff != "" {
+		t.Errorf("second GetProject(2): -want, +got:\n%s", diff)
+	}
+
+	// Options the list does not support bypass the cache.
+	if diff := cmp.Diff(calls{Name: "get", Gets: 3, Lists: 2}, get(2, &gitlab.GetProjectOptions{License: gitlab.Bool(true)})); diff != "" {
+		t.Errorf("GetProject(2) with license: -want, +got:\n%s", diff)
+	}
+
+	// Changes and expiry refresh the projects of the group.
+	if _, _, err := c.EditProject(1, &gitlab.EditProjectOptions{}); err != nil {
+		t.Fatalf("EditProject(1): %v", err)
+	}
+	if diff := cmp.Diff(calls{Name: "list", Gets: 3, Lists: 4}, get(1, nil)); diff != "" {
+		t.Errorf("GetProject(1) after edit: -want, +got:\n%s", diff)
+	}
+	now = now.Add(time.Minute)
+	if diff := cmp.Diff(calls{Name: "list", Gets: 3, Lists: 6}, get(1, nil)); diff != "" {
+		t.Errorf("GetProject(1) after expiry: -want, +got:\n%s", diff)
+	}
+}
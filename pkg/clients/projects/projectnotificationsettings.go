@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ProjectNotificationSettingsClient defines Gitlab project notification
+// settings service operations
+type ProjectNotificationSettingsClient interface {
+	GetSettingsForProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error)
+	UpdateSettingsForProject(pid interface{}, opt *gitlab.NotificationSettingsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error)
+}
+
+// NewProjectNotificationSettingsClient returns a new Gitlab project
+// notification settings service
+func NewProjectNotificationSettingsClient(cfg clients.Config) ProjectNotificationSettingsClient {
+	git := clients.NewClient(cfg)
+	return git.NotificationSettings
+}
+
+// NotificationLevel returns the Gitlab notification level named l, and
+// false if there is none.
+func NotificationLevel(l string) (gitlab.NotificationLevelValue, bool) {
+	for v := gitlab.DisabledNotificationLevel; v <= gitlab.CustomNotificationLevel; v++ {
+		if v.String() == l {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// IsProjectNotificationSettingsUpToDate checks whether there is a change in
+// any of the modifiable fields. Events are only compared if the level is
+// custom, as Gitlab ignores them otherwise.
+func IsProjectNotificationSettingsUpToDate(p *v1alpha1.ProjectNotificationSettingsParameters, g *gitlab.NotificationSettings) bool {
+	if !clients.IsStringEqualToStringPtr(p.Level, g.Level.String()) {
+		return false
+	}
+	if g.Level != gitlab.CustomNotificationLevel || p.Events == nil {
+		return true
+	}
+	ge := g.Events
+	if ge == nil {
+		ge = &gitlab.NotificationEvents{}
+	}
+	e := p.Events
+	return clients.IsBoolEqualToBoolPtr(e.CloseIssue, ge.CloseIssue) &&
+		clients.IsBoolEqualToBoolPtr(e.CloseMergeRequest, ge.CloseMergeRequest) &&
+		clients.IsBoolEqualToBoolPtr(e.FailedPipeline, ge.FailedPipeline) &&
+		clients.IsBoolEqualToBoolPtr(e.MergeMergeRequest, ge.MergeMergeRequest) &&
+		clients.IsBoolEqualToBoolPtr(e.NewIssue, ge.NewIssue) &&
+		clients.IsBoolEqualToBoolPtr(e.NewMergeRequest, ge.NewMergeRequest) &&
+		clients.IsBoolEqualToBoolPtr(e.NewNote, ge.NewNote) &&
+		clients.IsBoolEqualToBoolPtr(e.ReassignIssue, ge.ReassignIssue) &&
+		clients.IsBoolEqualToBoolPtr(e.ReassignMergeRequest, ge.ReassignMergeRequest) &&
+		clients.IsBoolEqualToBoolPtr(e.ReopenIssue, ge.ReopenIssue) &&
+		clients.IsBoolEqualToBoolPtr(e.ReopenMergeRequest, ge.ReopenMergeRequest) &&
+		clients.IsBoolEqualToBoolPtr(e.SuccessPipeline, ge.SuccessPipeline)
+}
+
+// LateInitializeProjectNotificationSettings fills the level in p with the
+// one found at Gitlab if it is not set.
+func LateInitializeProjectNotificationSettings(p *v1alpha1.ProjectNotificationSettingsParameters, g *gitlab.NotificationSettings) {
+	if g == nil {
+		return
+	}
+	p.Level = clients.LateInitializeStringPtr(p.Level, g.Level.String())
+}
+
+// GenerateProjectNotificationSettingsObservation is used to produce
+// v1alpha1.ProjectNotificationSettingsObservation from
+// gitlab.NotificationSettings.
+func GenerateProjectNotificationSettingsObservation(g *gitlab.NotificationSettings) v1alpha1.ProjectNotificationSettingsObservation {
+	if g == nil {
+		return v1alpha1.ProjectNotificationSettingsObservation{}
+	}
+	return v1alpha1.ProjectNotificationSettingsObservation{
+		Level: g.Level.String(),
+	}
+}
+
+// GenerateNotificationSettingsOptions generates project notification
+// settings update options
+func GenerateNotificationSettingsOptions(p *v1alpha1.ProjectNotificationSettingsParameters) *gitlab.NotificationSettingsOptions {
+	opt := &gitlab.NotificationSettingsOptions{}
+	if p.Level != nil {
+		if l, ok := NotificationLevel(*p.Level); ok {
+			opt.Level = &l
+		}
+	}
+	if e := p.Events; e != nil {
+		opt.CloseIssue = e.CloseIssue
+		opt.CloseMergeRequest = e.CloseMergeRequest
+		opt.FailedPipeline = e.FailedPipeline
+		opt.MergeMergeRequest = e.MergeMergeRequest
+		opt.NewIssue = e.NewIssue
+		opt.NewMergeRequest = e.NewMergeRequest
+		opt.NewNote = e.NewNote
+		opt.ReassignIssue = e.ReassignIssue
+		opt.ReassignMergeRequest = e.ReassignMergeRequest
+		opt.ReopenIssue = e.ReopenIssue
+		opt.ReopenMergeRequest = e.ReopenMergeRequest
+		opt.SuccessPipeline = e.SuccessPipeline
+	}
+	return opt
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestIsProjectNotificationSettingsUpToDate(t *testing.T) {
+	custom := &gitlab.NotificationSettings{
+		Level:  gitlab.CustomNotificationLevel,
+		Events: &gitlab.NotificationEvents{FailedPipeline: true},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.ProjectNotificationSettingsParameters
+		g    *gitlab.NotificationSettings
+		want bool
+	}{
+		"NothingSet": {
+			p:    &v1alpha1.ProjectNotificationSettingsParameters{},
+			g:    &gitlab.NotificationSettings{Level: gitlab.GlobalNotificationLevel},
+			want: true,
+		},
+		"LevelChanged": {
+			p:    &v1alpha1.ProjectNotificationSettingsParameters{Level: ptr.To("disabled")},
+			g:    &gitlab.NotificationSettings{Level: gitlab.GlobalNotificationLevel},
+			want: false,
+		},
+		"EventsIgnored": {
+			p: &v1alpha1.ProjectNotificationSettingsParameters{
+				Level:  ptr.To("disabled"),
+				Events: &v1alpha1.NotificationEvents{NewNote: ptr.To(true)},
+			},
+			g:    &gitlab.NotificationSettings{Level: gitlab.DisabledNotificationLevel},
+			want: true,
+		},
+		"EventsUpToDate": {
+			p: &v1alpha1.ProjectNotificationSettingsParameters{
+				Level:  ptr.To("custom"),
+				Events: &v1alpha1.NotificationEvents{FailedPipeline: ptr.To(true), NewNote: ptr.To(false)},
+			},
+			g:    custom,
+			want: true,
+		},
+		"EventsChanged": {
+			p: &v1alpha1.ProjectNotificationSettingsParameters{
+				Level:  ptr.To("custom"),
+				Events: &v1alpha1.NotificationEvents{FailedPipeline: ptr.To(false)},
+			},
+			g:    custom,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsProjectNotificationSettingsUpToDate(tc.p, tc.g)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNotificationSettingsOptions(t *testing.T) {
+	p := &v1alpha1.ProjectNotificationSettingsParameters{
+		Level:  ptr.To("custom"),
+		Events: &v1alpha1.NotificationEvents{FailedPipeline: ptr.To(true)},
+	}
+	want := &gitlab.NotificationSettingsOptions{
+		Level:          gitlab.NotificationLevel(gitlab.CustomNotificationLevel),
+		FailedPipeline: ptr.To(true),
+	}
+
+	got := GenerateNotificationSettingsOptions(p)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
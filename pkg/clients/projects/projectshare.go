@@ -0,0 +1,128 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ProjectShareClient defines Gitlab project share service operations
+type ProjectShareClient interface {
+	ListProjectSharedWithGroups(pid interface{}, options ...gitlab.RequestOptionFunc) ([]*SharedWithGroup, *gitlab.Response, error)
+	ShareProjectWithGroup(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	DeleteSharedProjectFromGroup(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// SharedWithGroup is a group a project is shared with, along with the
+// expiry of the share, which gitlab.Project does not carry.
+type SharedWithGroup struct {
+	GroupID          int             `json:"group_id"`
+	GroupName        string          `json:"group_name"`
+	GroupFullPath    string          `json:"group_full_path"`
+	GroupAccessLevel int             `json:"group_access_level"`
+	ExpiresAt        *gitlab.ISOTime `json:"expires_at"`
+}
+
+type projectShareClient struct {
+	*gitlab.ProjectsService
+	git *gitlab.Client
+}
+
+// NewProjectShareClient returns a new Gitlab project share service
+func NewProjectShareClient(cfg clients.Config) ProjectShareClient {
+	git := clients.NewClient(cfg)
+	return &projectShareClient{ProjectsService: git.Projects, git: git}
+}
+
+// ListProjectSharedWithGroups gets the groups a project is shared with.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-single-project
+func (c *projectShareClient) ListProjectSharedWithGroups(pid interface{}, options ...gitlab.RequestOptionFunc) ([]*SharedWithGroup, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.git.NewRequest(http.MethodGet, "projects/"+project, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(struct {
+		SharedWithGroups []*SharedWithGroup `json:"shared_with_groups"`
+	})
+	resp, err := c.git.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+	return p.SharedWithGroups, resp, nil
+}
+
+// FindSharedWithGroup returns the share of a project with the group
+// groupID, or nil if the project is not shared with the group.
+func FindSharedWithGroup(shares []*SharedWithGroup, groupID int) *SharedWithGroup {
+	for _, s := range shares {
+		if s.GroupID == groupID {
+			return s
+		}
+	}
+	return nil
+}
+
+// GenerateProjectShareObservation is used to produce
+// v1alpha1.ProjectShareObservation from SharedWithGroup.
+func GenerateProjectShareObservation(s *SharedWithGroup) v1alpha1.ProjectShareObservation {
+	if s == nil {
+		return v1alpha1.ProjectShareObservation{}
+	}
+	o := v1alpha1.ProjectShareObservation{
+		GroupName:        s.GroupName,
+		GroupFullPath:    s.GroupFullPath,
+		GroupAccessLevel: s.GroupAccessLevel,
+	}
+	if s.ExpiresAt != nil {
+		o.ExpiresAt = s.ExpiresAt.String()
+	}
+	return o
+}
+
+// IsProjectShareUpToDate checks whether the access level or the expiry of
+// the share differ from the spec.
+func IsProjectShareUpToDate(p *v1alpha1.ProjectShareParameters, s *SharedWithGroup) bool {
+	expiresAt := ""
+	if s.ExpiresAt != nil {
+		expiresAt = s.ExpiresAt.String()
+	}
+	return int(p.GroupAccess) == s.GroupAccessLevel &&
+		ptr.Deref(p.ExpiresAt, "") == expiresAt
+}
+
+// GenerateShareWithGroupOptions generates project share options
+func GenerateShareWithGroupOptions(groupID int, p *v1alpha1.ProjectShareParameters) *gitlab.ShareWithGroupOptions {
+	return &gitlab.ShareWithGroupOptions{
+		GroupID:     &groupID,
+		GroupAccess: ptr.To(gitlab.AccessLevelValue(p.GroupAccess)),
+		ExpiresAt:   p.ExpiresAt,
+	}
+}
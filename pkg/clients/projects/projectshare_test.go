@@ -0,0 +1,121 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestFindSharedWithGroup(t *testing.T) {
+	shares := []*SharedWithGroup{{GroupID: 5}, {GroupID: 6}}
+
+	cases := map[string]struct {
+		groupID int
+		want    *SharedWithGroup
+	}{
+		"Shared": {
+			groupID: 6,
+			want:    shares[1],
+		},
+		"NotShared": {
+			groupID: 7,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, FindSharedWithGroup(shares, tc.groupID)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateProjectShareObservation(t *testing.T) {
+	expiresAt := gitlab.ISOTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	cases := map[string]struct {
+		s    *SharedWithGroup
+		want v1alpha1.ProjectShareObservation
+	}{
+		"Nil": {},
+		"Shared": {
+			s: &SharedWithGroup{
+				GroupID:          5,
+				GroupName:        "devs",
+				GroupFullPath:    "org/devs",
+				GroupAccessLevel: 30,
+				ExpiresAt:        &expiresAt,
+			},
+			want: v1alpha1.ProjectShareObservation{
+				GroupName:        "devs",
+				GroupFullPath:    "org/devs",
+				GroupAccessLevel: 30,
+				ExpiresAt:        "2030-01-01",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateProjectShareObservation(tc.s)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsProjectShareUpToDate(t *testing.T) {
+	expiresAt := gitlab.ISOTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	cases := map[string]struct {
+		p    *v1alpha1.ProjectShareParameters
+		s    *SharedWithGroup
+		want bool
+	}{
+		"UpToDate": {
+			p:    &v1alpha1.ProjectShareParameters{GroupAccess: 30, ExpiresAt: ptr.To("2030-01-01")},
+			s:    &SharedWithGroup{GroupAccessLevel: 30, ExpiresAt: &expiresAt},
+			want: true,
+		},
+		"AccessLevelChanged": {
+			p:    &v1alpha1.ProjectShareParameters{GroupAccess: 40},
+			s:    &SharedWithGroup{GroupAccessLevel: 30},
+			want: false,
+		},
+		"ExpiryRemoved": {
+			p:    &v1alpha1.ProjectShareParameters{GroupAccess: 30},
+			s:    &SharedWithGroup{GroupAccessLevel: 30, ExpiresAt: &expiresAt},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsProjectShareUpToDate(tc.p, tc.s)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
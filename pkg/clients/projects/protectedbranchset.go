@@ -0,0 +1,219 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// defaultBranchAccessLevel is the access level Gitlab allows to push, merge
+// and unprotect when a branch is protected without one.
+const defaultBranchAccessLevel = gitlab.MaintainerPermissions
+
+// ProtectedBranchClient defines Gitlab protected branch service operations
+type ProtectedBranchClient interface {
+	ListProtectedBranches(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error)
+	ProtectRepositoryBranches(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error)
+	UpdateProtectedBranch(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error)
+	UnprotectRepositoryBranches(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewProtectedBranchClient returns a new Gitlab protected branch service
+func NewProtectedBranchClient(cfg clients.Config) ProtectedBranchClient {
+	git := clients.NewClient(cfg)
+	return git.ProtectedBranches
+}
+
+// ListProjectProtectedBranches returns all protected branches of the
+// project, following pagination.
+func ListProjectProtectedBranches(c ProtectedBranchClient, pid interface{}, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, error) {
+	opt := &gitlab.ListProtectedBranchesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+
+	var all []*gitlab.ProtectedBranch
+	for {
+		branches, res, err := c.ListProtectedBranches(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, branches...)
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// ProtectedBranchUpdate is a branch protection to update in place.
+type ProtectedBranchUpdate struct {
+	Protection v1alpha1.BranchProtection
+	Current    *gitlab.ProtectedBranch
+}
+
+// ProtectedBranchSetDiff lists the changes needed to bring the protected
+// branches of a project in line with a ProtectedBranchSet.
+type ProtectedBranchSetDiff struct {
+	Protect   []v1alpha1.BranchProtection
+	Update    []ProtectedBranchUpdate
+	Unprotect []string
+}
+
+// IsEmpty returns true if no changes are needed.
+func (d ProtectedBranchSetDiff) IsEmpty() bool {
+	return len(d.Protect) == 0 && len(d.Update) == 0 && len(d.Unprotect) == 0
+}
+
+// DiffProtectedBranchSet compares the desired branch protections with the
+// ones found at Gitlab. Protections not in the spec are only scheduled for
+// removal if pruning is enabled.
+func DiffProtectedBranchSet(p *v1alpha1.ProtectedBranchSetParameters, branches []*gitlab.ProtectedBranch) ProtectedBranchSetDiff {
+	d := ProtectedBranchSetDiff{}
+
+	existing := make(map[string]*gitlab.ProtectedBranch, len(branches))
+	for _, b := range branches {
+		existing[b.Name] = b
+	}
+
+	desired := make(map[string]bool, len(p.Protections))
+	for i := range p.Protections {
+		bp := &p.Protections[i]
+		desired[bp.Name] = true
+		e, ok := existing[bp.Name]
+		switch {
+		case !ok:
+			d.Protect = append(d.Protect, *bp)
+		case !IsBranchProtectionUpToDate(bp, e):
+			d.Update = append(d.Update, ProtectedBranchUpdate{Protection: *bp, Current: e})
+		}
+	}
+
+	if ptr.Deref(p.Prune, true) {
+		for _, b := range branches {
+			if !desired[b.Name] {
+				d.Unprotect = append(d.Unprotect, b.Name)
+			}
+		}
+	}
+	return d
+}
+
+// IsBranchProtectionUpToDate checks whether a protected branch allows
+// exactly the access levels of a branch protection.
+func IsBranchProtectionUpToDate(bp *v1alpha1.BranchProtection, b *gitlab.ProtectedBranch) bool {
+	if ptr.Deref(bp.AllowForcePush, false) != b.AllowForcePush {
+		return false
+	}
+	if ptr.Deref(bp.CodeOwnerApprovalRequired, false) != b.CodeOwnerApprovalRequired {
+		return false
+	}
+	return isAccessUpToDate(bp.PushAccessLevel, b.PushAccessLevels) &&
+		isAccessUpToDate(bp.MergeAccessLevel, b.MergeAccessLevels) &&
+		isAccessUpToDate(bp.UnprotectAccessLevel, b.UnprotectAccessLevels)
+}
+
+// isAccessUpToDate returns true if the only access granted is to the role of
+// the desired access level.
+func isAccessUpToDate(level *v1alpha1.AccessLevelValue, access []*gitlab.BranchAccessDescription) bool {
+	return len(access) == 1 && isRoleAccess(access[0], branchAccessLevel(level))
+}
+
+func isRoleAccess(a *gitlab.BranchAccessDescription, level gitlab.AccessLevelValue) bool {
+	return a.UserID == 0 && a.GroupID == 0 && a.AccessLevel == level
+}
+
+func branchAccessLevel(level *v1alpha1.AccessLevelValue) gitlab.AccessLevelValue {
+	if level == nil {
+		return defaultBranchAccessLevel
+	}
+	return gitlab.AccessLevelValue(*level)
+}
+
+// GenerateProtectedBranchSetObservation is used to produce
+// v1alpha1.ProtectedBranchSetObservation from a list of
+// gitlab.ProtectedBranch.
+func GenerateProtectedBranchSetObservation(branches []*gitlab.ProtectedBranch) v1alpha1.ProtectedBranchSetObservation {
+	o := v1alpha1.ProtectedBranchSetObservation{}
+	for _, b := range branches {
+		o.Protections = append(o.Protections, v1alpha1.BranchProtectionObservation{
+			ID:                        b.ID,
+			Name:                      b.Name,
+			PushAccessLevels:          branchAccessLevels(b.PushAccessLevels),
+			MergeAccessLevels:         branchAccessLevels(b.MergeAccessLevels),
+			UnprotectAccessLevels:     branchAccessLevels(b.UnprotectAccessLevels),
+			AllowForcePush:            b.AllowForcePush,
+			CodeOwnerApprovalRequired: b.CodeOwnerApprovalRequired,
+		})
+	}
+	return o
+}
+
+func branchAccessLevels(access []*gitlab.BranchAccessDescription) []v1alpha1.AccessLevelValue {
+	var levels []v1alpha1.AccessLevelValue
+	for _, a := range access {
+		levels = append(levels, v1alpha1.AccessLevelValue(a.AccessLevel))
+	}
+	return levels
+}
+
+// GenerateProtectBranchOptions generates branch protection options
+func GenerateProtectBranchOptions(bp *v1alpha1.BranchProtection) *gitlab.ProtectRepositoryBranchesOptions {
+	return &gitlab.ProtectRepositoryBranchesOptions{
+		Name:                      &bp.Name,
+		PushAccessLevel:           ptr.To(branchAccessLevel(bp.PushAccessLevel)),
+		MergeAccessLevel:          ptr.To(branchAccessLevel(bp.MergeAccessLevel)),
+		UnprotectAccessLevel:      ptr.To(branchAccessLevel(bp.UnprotectAccessLevel)),
+		AllowForcePush:            ptr.To(ptr.Deref(bp.AllowForcePush, false)),
+		CodeOwnerApprovalRequired: bp.CodeOwnerApprovalRequired,
+	}
+}
+
+// GenerateUpdateProtectedBranchOptions generates options updating the
+// protected branch b in place, removing any access not granted by bp.
+func GenerateUpdateProtectedBranchOptions(bp *v1alpha1.BranchProtection, b *gitlab.ProtectedBranch) *gitlab.UpdateProtectedBranchOptions {
+	return &gitlab.UpdateProtectedBranchOptions{
+		AllowForcePush:            ptr.To(ptr.Deref(bp.AllowForcePush, false)),
+		CodeOwnerApprovalRequired: ptr.To(ptr.Deref(bp.CodeOwnerApprovalRequired, false)),
+		AllowedToPush:             accessUpdate(bp.PushAccessLevel, b.PushAccessLevels),
+		AllowedToMerge:            accessUpdate(bp.MergeAccessLevel, b.MergeAccessLevels),
+		AllowedToUnprotect:        accessUpdate(bp.UnprotectAccessLevel, b.UnprotectAccessLevels),
+	}
+}
+
+// accessUpdate returns the permission changes that leave only the role of
+// the desired access level, or nil if there are none.
+func accessUpdate(level *v1alpha1.AccessLevelValue, access []*gitlab.BranchAccessDescription) *[]*gitlab.BranchPermissionOptions {
+	want := branchAccessLevel(level)
+	var opts []*gitlab.BranchPermissionOptions
+	found := false
+	for _, a := range access {
+		if isRoleAccess(a, want) && !found {
+			found = true
+			continue
+		}
+		opts = append(opts, &gitlab.BranchPermissionOptions{ID: ptr.To(a.ID), Destroy: ptr.To(true)})
+	}
+	if !found {
+		opts = append(opts, &gitlab.BranchPermissionOptions{AccessLevel: ptr.To(want)})
+	}
+	if len(opts) == 0 {
+		return nil
+	}
+	return &opts
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func maintainers(id int) []*gitlab.BranchAccessDescription {
+	return []*gitlab.BranchAccessDescription{{ID: id, AccessLevel: gitlab.MaintainerPermissions}}
+}
+
+func TestDiffProtectedBranchSet(t *testing.T) {
+	developers := v1alpha1.AccessLevelValue(gitlab.DeveloperPermissions)
+	main := v1alpha1.BranchProtection{Name: "main"}
+	release := v1alpha1.BranchProtection{Name: "release/*", MergeAccessLevel: &developers}
+
+	mainBranch := &gitlab.ProtectedBranch{ID: 1, Name: "main", PushAccessLevels: maintainers(1), MergeAccessLevels: maintainers(2), UnprotectAccessLevels: maintainers(3)}
+	weakened := &gitlab.ProtectedBranch{ID: 1, Name: "main", PushAccessLevels: append(maintainers(1), &gitlab.BranchAccessDescription{ID: 4, UserID: 9}), MergeAccessLevels: maintainers(2), UnprotectAccessLevels: maintainers(3)}
+	manual := &gitlab.ProtectedBranch{ID: 2, Name: "feature/*", PushAccessLevels: maintainers(5), MergeAccessLevels: maintainers(6), UnprotectAccessLevels: maintainers(7)}
+
+	cases := map[string]struct {
+		p        *v1alpha1.ProtectedBranchSetParameters
+		branches []*gitlab.ProtectedBranch
+		want     ProtectedBranchSetDiff
+	}{
+		"UpToDateWithDefaults": {
+			p:        &v1alpha1.ProtectedBranchSetParameters{Protections: []v1alpha1.BranchProtection{main}},
+			branches: []*gitlab.ProtectedBranch{mainBranch},
+			want:     ProtectedBranchSetDiff{},
+		},
+		"ProtectMissingWildcard": {
+			p:        &v1alpha1.ProtectedBranchSetParameters{Protections: []v1alpha1.BranchProtection{main, release}},
+			branches: []*gitlab.ProtectedBranch{mainBranch},
+			want:     ProtectedBranchSetDiff{Protect: []v1alpha1.BranchProtection{release}},
+		},
+		"UpdateWeakened": {
+			p:        &v1alpha1.ProtectedBranchSetParameters{Protections: []v1alpha1.BranchProtection{main}},
+			branches: []*gitlab.ProtectedBranch{weakened},
+			want:     ProtectedBranchSetDiff{Update: []ProtectedBranchUpdate{{Protection: main, Current: weakened}}},
+		},
+		"PruneByDefault": {
+			p:        &v1alpha1.ProtectedBranchSetParameters{Protections: []v1alpha1.BranchProtection{main}},
+			branches: []*gitlab.ProtectedBranch{mainBranch, manual},
+			want:     ProtectedBranchSetDiff{Unprotect: []string{"feature/*"}},
+		},
+		"NoPrune": {
+			p:        &v1alpha1.ProtectedBranchSetParameters{Protections: []v1alpha1.BranchProtection{main}, Prune: ptr.To(false)},
+			branches: []*gitlab.ProtectedBranch{mainBranch, manual},
+			want:     ProtectedBranchSetDiff{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffProtectedBranchSet(tc.p, tc.branches)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateProtectedBranchOptions(t *testing.T) {
+	developers := v1alpha1.AccessLevelValue(gitlab.DeveloperPermissions)
+	bp := &v1alpha1.BranchProtection{Name: "main", MergeAccessLevel: &developers}
+	b := &gitlab.ProtectedBranch{
+		Name:                  "main",
+		PushAccessLevels:      append(maintainers(1), &gitlab.BranchAccessDescription{ID: 4, UserID: 9}),
+		MergeAccessLevels:     maintainers(2),
+		UnprotectAccessLevels: maintainers(3),
+	}
+
+	want := &gitlab.UpdateProtectedBranchOptions{
+		AllowForcePush:            ptr.To(false),
+		CodeOwnerApprovalRequired: ptr.To(false),
+		AllowedToPush:             &[]*gitlab.BranchPermissionOptions{{ID: ptr.To(4), Destroy: ptr.To(true)}},
+		AllowedToMerge: &[]*gitlab.BranchPermissionOptions{
+			{ID: ptr.To(2), Destroy: ptr.To(true)},
+			{AccessLevel: ptr.To(gitlab.DeveloperPermissions)},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateUpdateProtectedBranchOptions(bp, b)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ReleaseClient defines Gitlab Release and ReleaseLink service operations
+type ReleaseClient interface {
+	GetRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	GetReleaseDetails(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*ReleaseDetails, *gitlab.Response, error)
+	CreateRelease(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	UpdateRelease(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	DeleteRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	CreateReleaseLink(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+	UpdateReleaseLink(pid interface{}, tagName string, link int, opt *gitlab.UpdateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+	DeleteReleaseLink(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+}
+
+// ReleaseMilestone is a milestone associated with a release.
+type ReleaseMilestone struct {
+	ID    int    `json:"id"`
+	IID   int    `json:"iid"`
+	Title string `json:"title"`
+}
+
+// ReleaseEvidence is a snapshot of the data of a release GitLab collects
+// when the release is created or on request.
+type ReleaseEvidence struct {
+	SHA         string     `json:"sha"`
+	Filepath    string     `json:"filepath"`
+	CollectedAt *time.Time `json:"collected_at"`
+}
+
+// ReleaseDetails is a release along with its milestones and evidences,
+// which gitlab.Release does not carry.
+type ReleaseDetails struct {
+	gitlab.Release
+	Milestones []*ReleaseMilestone `json:"milestones"`
+	Evidences  []*ReleaseEvidence  `json:"evidences"`
+}
+
+type releaseClient struct {
+	*gitlab.ReleasesService
+	*gitlab.ReleaseLinksService
+	git *gitlab.Client
+}
+
+// NewReleaseClient returns a new Gitlab Release service
+func NewReleaseClient(cfg clients.Config) ReleaseClient {
+	git := clients.NewClient(cfg)
+	return &releaseClient{ReleasesService: git.Releases, ReleaseLinksService: git.ReleaseLinks, git: git}
+}
+
+// GetReleaseDetails gets a release of a project including its milestones
+// and evidences.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/#get-a-release-by-a-tag-name
+func (c *releaseClient) GetReleaseDetails(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*ReleaseDetails, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/releases/%s", project, gitlab.PathEscape(tagName))
+
+	req, err := c.git.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(ReleaseDetails)
+	resp, err := c.git.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// ReleaseAssetLinkUpdate is a change of an existing asset link.
+type ReleaseAssetLinkUpdate struct {
+	ID   int
+	Link v1alpha1.ReleaseAssetLink
+}
+
+// ReleaseAssetLinkDiff lists the changes needed to bring the asset links of
+// a release in line with the spec.
+type ReleaseAssetLinkDiff struct {
+	Create []v1alpha1.ReleaseAssetLink
+	Update []ReleaseAssetLinkUpdate
+	Delete []int
+}
+
+// IsEmpty returns true if no changes are needed.
+func (d ReleaseAssetLinkDiff) IsEmpty() bool {
+	return len(d.Create) == 0 && len(d.Update) == 0 && len(d.Delete) == 0
+}
+
+// DiffReleaseAssetLinks compares the desired asset links with the ones of
+// the release, matching them by name. Links not in the spec are deleted.
+func DiffReleaseAssetLinks(p *v1alpha1.ReleaseParameters, r *gitlab.Release) ReleaseAssetLinkDiff {
+	d := ReleaseAssetLinkDiff{}
+
+	existing := make(map[string]*gitlab.ReleaseLink, len(r.Assets.Links))
+	for _, l := range r.Assets.Links {
+		existing[l.Name] = l
+	}
+
+	desired := make(map[string]bool, len(p.AssetLinks))
+	for i := range p.AssetLinks {
+		l := p.AssetLinks[i]
+		desired[l.Name] = true
+		e, ok := existing[l.Name]
+		switch {
+		case !ok:
+			d.Create = append(d.Create, l)
+		case !IsReleaseAssetLinkUpToDate(&l, e):
+			d.Update = append(d.Update, ReleaseAssetLinkUpdate{ID: e.ID, Link: l})
+		}
+	}
+
+	for _, l := range r.Assets.Links {
+		if !desired[l.Name] {
+			d.Delete = append(d.Delete, l.ID)
+		}
+	}
+	return d
+}
+
+// IsReleaseAssetLinkUpToDate checks whether there is a change in any of the
+// modifiable fields of an asset link. Gitlab does not return the file path,
+// so it is compared with the end of the direct asset URL.
+func IsReleaseAssetLinkUpToDate(l *v1alpha1.ReleaseAssetLink, g *gitlab.ReleaseLink) bool {
+	if l.URL != g.URL {
+		return false
+	}
+	if l.LinkType != nil && *l.LinkType != string(g.LinkType) {
+		return false
+	}
+	if l.FilePath != nil && !strings.HasSuffix(g.DirectAssetURL, "/downloads"+*l.FilePath) {
+		return false
+	}
+	return true
+}
+
+// IsReleaseUpToDate checks whether there is a change in any of the
+// modifiable fields of a release, including its milestones and asset links.
+func IsReleaseUpToDate(p *v1alpha1.ReleaseParameters, r *ReleaseDetails) bool {
+	if !clients.IsStringEqualToStringPtr(p.Name, r.Name) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Description, r.Description) {
+		return false
+	}
+	if !isReleasedAtEqual(p.ReleasedAt, r.ReleasedAt) {
+		return false
+	}
+	if p.Milestones != nil && !cmp.Equal(clients.NormalizeStringList(p.Milestones), clients.NormalizeStringList(releaseMilestoneTitles(r.Milestones))) {
+		return false
+	}
+	return DiffReleaseAssetLinks(p, &r.Release).IsEmpty()
+}
+
+func releaseMilestoneTitles(ms []*ReleaseMilestone) []string {
+	titles := make([]string, 0, len(ms))
+	for _, m := range ms {
+		titles = append(titles, m.Title)
+	}
+	return titles
+}
+
+// latestReleaseEvidence returns the evidence collected last, or nil if the
+// release has none.
+func latestReleaseEvidence(es []*ReleaseEvidence) *ReleaseEvidence {
+	var latest *ReleaseEvidence
+	for _, e := range es {
+		if latest == nil || (e.CollectedAt != nil && (latest.CollectedAt == nil || e.CollectedAt.After(*latest.CollectedAt))) {
+			latest = e
+		}
+	}
+	return latest
+}
+
+// isReleasedAtEqual compares the desired release date, which is stored with
+// second precision, with the one reported by Gitlab.
+func isReleasedAtEqual(want *metav1.Time, got *time.Time) bool {
+	if want == nil {
+		return true
+	}
+	if got == nil {
+		return false
+	}
+	return want.Time.Truncate(time.Second).Equal(got.Truncate(time.Second))
+}
+
+// LateInitializeRelease fills the empty fields in the release spec with the
+// values seen in gitlab.Release.
+func LateInitializeRelease(in *v1alpha1.ReleaseParameters, r *gitlab.Release) {
+	if r == nil {
+		return
+	}
+	in.Name = clients.LateInitializeStringPtr(in.Name, r.Name)
+	in.Description = clients.LateInitializeStringPtr(in.Description, r.Description)
+	if in.ReleasedAt == nil {
+		in.ReleasedAt = clients.TimeToMetaTime(r.ReleasedAt)
+	}
+}
+
+// GenerateReleaseObservation is used to produce v1alpha1.ReleaseObservation
+// from ReleaseDetails.
+func GenerateReleaseObservation(r *ReleaseDetails) v1alpha1.ReleaseObservation {
+	if r == nil {
+		return v1alpha1.ReleaseObservation{}
+	}
+	o := v1alpha1.ReleaseObservation{
+		CreatedAt:       clients.TimeToMetaTime(r.CreatedAt),
+		CommitID:        r.Commit.ID,
+		UpcomingRelease: r.UpcomingRelease,
+	}
+	if len(r.Milestones) > 0 {
+		o.Milestones = releaseMilestoneTitles(r.Milestones)
+	}
+	if e := latestReleaseEvidence(r.Evidences); e != nil {
+		o.EvidenceSHA = e.SHA
+		o.EvidenceCollectedAt = clients.TimeToMetaTime(e.CollectedAt)
+	}
+	for _, l := range r.Assets.Links {
+		o.AssetLinks = append(o.AssetLinks, v1alpha1.ReleaseAssetLinkObservation{
+			ID:             l.ID,
+			Name:           l.Name,
+			URL:            l.URL,
+			DirectAssetURL: l.DirectAssetURL,
+			External:       l.External,
+			LinkType:       string(l.LinkType),
+		})
+	}
+	return o
+}
+
+// GenerateCreateReleaseOptions generates release creation options. The asset
+// links are created together with the release.
+func GenerateCreateReleaseOptions(p *v1alpha1.ReleaseParameters) *gitlab.CreateReleaseOptions {
+	opt := &gitlab.CreateReleaseOptions{
+		TagName:     &p.TagName,
+		TagMessage:  p.TagMessage,
+		Ref:         p.Ref,
+		Name:        p.Name,
+		Description: p.Description,
+	}
+	if p.ReleasedAt != nil {
+		opt.ReleasedAt = &p.ReleasedAt.Time
+	}
+	if p.Milestones != nil {
+		opt.Milestones = &p.Milestones
+	}
+	if len(p.AssetLinks) > 0 {
+		opt.Assets = &gitlab.ReleaseAssetsOptions{}
+		for i := range p.AssetLinks {
+			l := &p.AssetLinks[i]
+			opt.Assets.Links = append(opt.Assets.Links, &gitlab.ReleaseAssetLinkOptions{
+				Name:     &l.Name,
+				URL:      &l.URL,
+				FilePath: l.FilePath,
+				LinkType: linkTypeV1alpha1ToGitlab(l.LinkType),
+			})
+		}
+	}
+	return opt
+}
+
+// GenerateUpdateReleaseOptions generates release update options
+func GenerateUpdateReleaseOptions(p *v1alpha1.ReleaseParameters) *gitlab.UpdateReleaseOptions {
+	opt := &gitlab.UpdateReleaseOptions{
+		Name:        p.Name,
+		Description: p.Description,
+	}
+	if p.ReleasedAt != nil {
+		opt.ReleasedAt = &p.ReleasedAt.Time
+	}
+	if p.Milestones != nil {
+		opt.Milestones = &p.Milestones
+	}
+	return opt
+}
+
+// GenerateCreateReleaseLinkOptions generates asset link creation options
+func GenerateCreateReleaseLinkOptions(l *v1alpha1.ReleaseAssetLink) *gitlab.CreateReleaseLinkOptions {
+	return &gitlab.CreateReleaseLinkOptions{
+		Name:     &l.Name,
+		URL:      &l.URL,
+		FilePath: l.FilePath,
+		LinkType: linkTypeV1alpha1ToGitlab(l.LinkType),
+	}
+}
+
+// GenerateUpdateReleaseLinkOptions generates asset link update options
+func GenerateUpdateReleaseLinkOptions(l *v1alpha1.ReleaseAssetLink) *gitlab.UpdateReleaseLinkOptions {
+	return &gitlab.UpdateReleaseLinkOptions{
+		Name:     &l.Name,
+		URL:      &l.URL,
+		FilePath: l.FilePath,
+		LinkType: linkTypeV1alpha1ToGitlab(l.LinkType),
+	}
+}
+
+func linkTypeV1alpha1ToGitlab(from *string) *gitlab.LinkTypeValue {
+	if from == nil {
+		return nil
+	}
+	return gitlab.LinkType(gitlab.LinkTypeValue(*from))
+}
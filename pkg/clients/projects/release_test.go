@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func releaseWithLinks(links ...*gitlab.ReleaseLink) *gitlab.Release {
+	r := &gitlab.Release{TagName: "v1.0.0", Name: "v1.0.0"}
+	r.Assets.Links = links
+	return r
+}
+
+func TestDiffReleaseAssetLinks(t *testing.T) {
+	binary := v1alpha1.ReleaseAssetLink{Name: "binary", URL: "https://example.com/binary", FilePath: ptr.To("/bin/app"), LinkType: ptr.To("package")}
+	runbook := v1alpha1.ReleaseAssetLink{Name: "runbook", URL: "https://example.com/runbook"}
+
+	existing := releaseWithLinks(
+		&gitlab.ReleaseLink{ID: 1, Name: "binary", URL: "https://example.com/binary", DirectAssetURL: "https://gitlab.com/p/-/releases/v1.0.0/downloads/bin/app", LinkType: gitlab.PackageLinkType},
+		&gitlab.ReleaseLink{ID: 2, Name: "stale", URL: "https://example.com/stale", LinkType: gitlab.OtherLinkType},
+	)
+
+	cases := map[string]struct {
+		p    *v1alpha1.ReleaseParameters
+		r    *gitlab.Release
+		want ReleaseAssetLinkDiff
+	}{
+		"UpToDate": {
+			p:    &v1alpha1.ReleaseParameters{AssetLinks: []v1alpha1.ReleaseAssetLink{binary}},
+			r:    releaseWithLinks(existing.Assets.Links[0]),
+			want: ReleaseAssetLinkDiff{},
+		},
+		"CreateMissingAndDeleteStale": {
+			p:    &v1alpha1.ReleaseParameters{AssetLinks: []v1alpha1.ReleaseAssetLink{binary, runbook}},
+			r:    existing,
+			want: ReleaseAssetLinkDiff{Create: []v1alpha1.ReleaseAssetLink{runbook}, Delete: []int{2}},
+		},
+		"UpdateChangedFilePath": {
+			p:    &v1alpha1.ReleaseParameters{AssetLinks: []v1alpha1.ReleaseAssetLink{{Name: "binary", URL: "https://example.com/binary", FilePath: ptr.To("/bin/other")}}},
+			r:    releaseWithLinks(existing.Assets.Links[0]),
+			want: ReleaseAssetLinkDiff{Update: []ReleaseAssetLinkUpdate{{ID: 1, Link: v1alpha1.ReleaseAssetLink{Name: "binary", URL: "https://example.com/binary", FilePath: ptr.To("/bin/other")}}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffReleaseAssetLinks(tc.p, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsReleaseUpToDate(t *testing.T) {
+	releasedAt := time.Date(2024, 3, 1, 12, 0, 0, 539000000, time.UTC)
+	r := releaseWithLinks()
+	r.Description = "notes"
+	r.ReleasedAt = &releasedAt
+
+	cases := map[string]struct {
+		p    *v1alpha1.ReleaseParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    &v1alpha1.ReleaseParameters{Name: ptr.To("v1.0.0"), Description: ptr.To("notes")},
+			want: true,
+		},
+		"ReleasedAtIgnoresSubSeconds": {
+			p:    &v1alpha1.ReleaseParameters{ReleasedAt: &metav1.Time{Time: releasedAt.Truncate(time.Second)}},
+			want: true,
+		},
+		"ReleasedAtChanged": {
+			p:    &v1alpha1.ReleaseParameters{ReleasedAt: &metav1.Time{Time: releasedAt.Add(time.Hour)}},
+			want: false,
+		},
+		"DescriptionChanged": {
+			p:    &v1alpha1.ReleaseParameters{Description: ptr.To("other notes")},
+			want: false,
+		},
+		"MilestonesInAnyOrder": {
+			p:    &v1alpha1.ReleaseParameters{Milestones: []string{"v1.1", "v1.0"}},
+			want: true,
+		},
+		"MilestoneRemoved": {
+			p:    &v1alpha1.ReleaseParameters{Milestones: []string{"v1.0"}},
+			want: false,
+		},
+		"LinkMissing": {
+			p:    &v1alpha1.ReleaseParameters{AssetLinks: []v1alpha1.ReleaseAssetLink{{Name: "runbook", URL: "https://example.com/runbook"}}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsReleaseUpToDate(tc.p, &ReleaseDetails{Release: *r, Milestones: []*ReleaseMilestone{{ID: 1, Title: "v1.0"}, {ID: 2, Title: "v1.1"}}})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateReleaseObservation(t *testing.T) {
+	first := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	cases := map[string]struct {
+		r    *ReleaseDetails
+		want v1alpha1.ReleaseObservation
+	}{
+		"NoEvidence": {
+			r:    &ReleaseDetails{Release: *releaseWithLinks()},
+			want: v1alpha1.ReleaseObservation{},
+		},
+		"LatestEvidence": {
+			r: &ReleaseDetails{
+				Release:    *releaseWithLinks(),
+				Milestones: []*ReleaseMilestone{{ID: 1, Title: "v1.0"}},
+				Evidences: []*ReleaseEvidence{
+					{SHA: "second", CollectedAt: &second},
+					{SHA: "first", CollectedAt: &first},
+				},
+			},
+			want: v1alpha1.ReleaseObservation{
+				Milestones:          []string{"v1.0"},
+				EvidenceSHA:         "second",
+				EvidenceCollectedAt: &metav1.Time{Time: second},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateReleaseObservation(tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	neturl "net/url"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	// RemoteMirrorUpdateStatusFailed is the update status of a remote
+	// mirror whose last push failed.
+	RemoteMirrorUpdateStatusFailed = "failed"
+
+	// defaultMirrorUsername is used to authenticate to a mirrored
+	// repository if only a password is given. Most Git hosts accept it
+	// together with a token.
+	defaultMirrorUsername = "oauth2"
+)
+
+// RemoteMirrorClient defines Gitlab project remote mirror service operations
+type RemoteMirrorClient interface {
+	ListProjectMirror(pid interface{}, opt *gitlab.ListProjectMirrorOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMirror, *gitlab.Response, error)
+	GetProjectMirror(pid interface{}, mirror int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, *gitlab.Response, error)
+	AddProjectMirror(pid interface{}, opt *gitlab.AddProjectMirrorOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, *gitlab.Response, error)
+	EditProjectMirror(pid interface{}, mirror int, opt *gitlab.EditProjectMirrorOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, *gitlab.Response, error)
+	DeleteProjectMirror(pid interface{}, mirror int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewRemoteMirrorClient returns a new Gitlab project remote mirror service
+func NewRemoteMirrorClient(cfg clients.Config) RemoteMirrorClient {
+	git := clients.NewClient(cfg)
+	return git.ProjectMirrors
+}
+
+// StripRemoteMirrorCredentials returns u without the credentials Gitlab
+// masks in the URLs of remote mirrors.
+func StripRemoteMirrorCredentials(u string) string {
+	parsed, err := neturl.Parse(u)
+	if err != nil || parsed.User == nil {
+		return u
+	}
+	parsed.User = nil
+	return parsed.String()
+}
+
+// withMirrorCredentials returns the URL u of a mirrored repository with the
+// username and password embedded.
+func withMirrorCredentials(u, username, password string) (string, error) {
+	parsed, err := neturl.Parse(u)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case password != "":
+		if username == "" {
+			username = defaultMirrorUsername
+		}
+		parsed.User = neturl.UserPassword(username, password)
+	case username != "":
+		parsed.User = neturl.User(username)
+	}
+	return parsed.String(), nil
+}
+
+// FindRemoteMirrorByURL returns the remote mirror of a project pushing to
+// url, or nil if the project has no such mirror. Credentials are ignored.
+func FindRemoteMirrorByURL(c RemoteMirrorClient, pid interface{}, u string, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMirror, error) {
+	u = StripRemoteMirrorCredentials(u)
+	opt := &gitlab.ListProjectMirrorOptions{PerPage: 100}
+	for {
+		mirrors, res, err := c.ListProjectMirror(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range mirrors {
+			if StripRemoteMirrorCredentials(m.URL) == u {
+				return m, nil
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// IsRemoteMirrorUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsRemoteMirrorUpToDate(p *v1alpha1.RemoteMirrorParameters, g *gitlab.ProjectMirror) bool {
+	return clients.IsBoolEqualToBoolPtr(p.Enabled, g.Enabled) &&
+		clients.IsBoolEqualToBoolPtr(p.OnlyProtectedBranches, g.OnlyProtectedBranches) &&
+		clients.IsBoolEqualToBoolPtr(p.KeepDivergentRefs, g.KeepDivergentRefs)
+}
+
+// LateInitializeRemoteMirror fills the empty fields in the remote mirror
+// spec with the values seen in gitlab.ProjectMirror.
+func LateInitializeRemoteMirror(p *v1alpha1.RemoteMirrorParameters, g *gitlab.ProjectMirror) {
+	if g == nil {
+		return
+	}
+	if p.Enabled == nil {
+		p.Enabled = &g.Enabled
+	}
+	if p.OnlyProtectedBranches == nil {
+		p.OnlyProtectedBranches = &g.OnlyProtectedBranches
+	}
+	if p.KeepDivergentRefs == nil {
+		p.KeepDivergentRefs = &g.KeepDivergentRefs
+	}
+}
+
+// GenerateRemoteMirrorObservation is used to produce
+// v1alpha1.RemoteMirrorObservation from gitlab.ProjectMirror.
+func GenerateRemoteMirrorObservation(g *gitlab.ProjectMirror) v1alpha1.RemoteMirrorObservation {
+	if g == nil {
+		return v1alpha1.RemoteMirrorObservation{}
+	}
+	return v1alpha1.RemoteMirrorObservation{
+		ID:                     g.ID,
+		URL:                    g.URL,
+		UpdateStatus:           g.UpdateStatus,
+		LastError:              g.LastError,
+		LastUpdateAt:           clients.TimeToMetaTime(g.LastUpdateAt),
+		LastUpdateStartedAt:    clients.TimeToMetaTime(g.LastUpdateStartedAt),
+		LastSuccessfulUpdateAt: clients.TimeToMetaTime(g.LastSuccessfulUpdateAt),
+	}
+}
+
+// GenerateAddRemoteMirrorOptions generates project remote mirror creation
+// options, with the username and password embedded in the URL.
+func GenerateAddRemoteMirrorOptions(p *v1alpha1.RemoteMirrorParameters, password string) (*gitlab.AddProjectMirrorOptions, error) {
+	u, err := withMirrorCredentials(p.URL, ptr.Deref(p.Username, ""), password)
+	if err != nil {
+		return nil, err
+	}
+	return &gitlab.AddProjectMirrorOptions{
+		URL:                   &u,
+		Enabled:               p.Enabled,
+		OnlyProtectedBranches: p.OnlyProtectedBranches,
+		KeepDivergentRefs:     p.KeepDivergentRefs,
+	}, nil
+}
+
+// GenerateEditRemoteMirrorOptions generates project remote mirror update
+// options
+func GenerateEditRemoteMirrorOptions(p *v1alpha1.RemoteMirrorParameters) *gitlab.EditProjectMirrorOptions {
+	return &gitlab.EditProjectMirrorOptions{
+		Enabled:               p.Enabled,
+		OnlyProtectedBranches: p.OnlyProtectedBranches,
+		KeepDivergentRefs:     p.KeepDivergentRefs,
+	}
+}
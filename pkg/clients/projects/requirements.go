@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/operations"
+)
+
+const errGetRequiredProject = "cannot get required Project"
+
+// UnmetRequirement returns why a requirement of a resource referencing its
+// Project by ref is not met yet, or "" if all are. A resource that does not
+// reference a Project has nothing to wait for.
+func UnmetRequirement(ctx context.Context, kube client.Reader, reqs []v1alpha1.Requirement, ref *xpv1.Reference) (string, error) {
+	for _, r := range reqs {
+		if r != v1alpha1.RequireProjectReady || ref == nil {
+			continue
+		}
+		p := &v1alpha1.Project{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name}, p); err != nil {
+			if kerrors.IsNotFound(err) {
+				return fmt.Sprintf("Project %s does not exist", ref.Name), nil
+			}
+			return "", errors.Wrap(err, errGetRequiredProject)
+		}
+		if p.GetCondition(xpv1.TypeReady).Status != corev1.ConditionTrue {
+			return fmt.Sprintf("Project %s is not ready", ref.Name), nil
+		}
+		if s := p.Status.AtProvider.ImportStatus; operations.ImportPhase(s) != commonv1alpha1.PhaseReady {
+			return fmt.Sprintf("Project %s import is %s", ref.Name, s), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestUnmetRequirement(t *testing.T) {
+	errBoom := errors.New("boom")
+	requires := []v1alpha1.Requirement{v1alpha1.RequireProjectReady}
+	ref := &xpv1.Reference{Name: "p"}
+	project := func(ready bool, importStatus string) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			p := obj.(*v1alpha1.Project)
+			if ready {
+				p.SetConditions(xpv1.Available())
+			}
+			p.Status.AtProvider.ImportStatus = importStatus
+			return nil
+		}
+	}
+
+	type want struct {
+		waiting string
+		err     error
+	}
+
+	cases := map[string]struct {
+		get      test.MockGetFn
+		requires []v1alpha1.Requirement
+		ref      *xpv1.Reference
+		want     want
+	}{
+		"NoRequirements": {
+			ref: ref,
+		},
+		"NoReference": {
+			requires: requires,
+		},
+		"Ready": {
+			get:      project(true, "finished"),
+			requires: requires,
+			ref:      ref,
+		},
+		"NotReady": {
+			get:      project(false, ""),
+			requires: requires,
+			ref:      ref,
+			want:     want{waiting: "Project p is not ready"},
+		},
+		"Importing": {
+			get:      project(true, "started"),
+			requires: requires,
+			ref:      ref,
+			want:     want{waiting: "Project p import is started"},
+		},
+		"NotFound": {
+			get:      test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "p")),
+			requires: requires,
+			ref:      ref,
+			want:     want{waiting: "Project p does not exist"},
+		},
+		"GetFailed": {
+			get:      test.NewMockGetFn(errBoom),
+			requires: requires,
+			ref:      ref,
+			want:     want{err: errors.Wrap(errBoom, errGetRequiredProject)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := UnmetRequirement(context.Background(), &test.MockClient{MockGet: tc.get}, tc.requires, tc.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("UnmetRequirement: error: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.waiting, got); diff != "" {
+				t.Errorf("UnmetRequirement: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// Hooks, badges, variables, labels, deploy tokens and access tokens can
+// belong to a project or a group. The group endpoints take the same options
+// and return the same representations as the project endpoints, so the
+// clients of their group endpoints implement the clients of the project
+// endpoints, and the controllers pass them the ID of the group instead of a
+// project.
+
+// HookScopeID returns the ID of the group of a group hook, or else the ID
+// of the project of the hook.
+func HookScopeID(p *v1alpha1.HookParameters) *int {
+	if p.GroupID != nil {
+		return p.GroupID
+	}
+	return p.ProjectID
+}
+
+// BadgeScopeID returns the ID of the group of a group badge, or else the ID
+// of the project of the badge.
+func BadgeScopeID(p *v1alpha1.BadgeParameters) *string {
+	if p.GroupID != nil {
+		return p.GroupID
+	}
+	return p.ProjectID
+}
+
+// VariableScopeID returns the ID of the group of a group variable, or else
+// the ID of the project of the variable.
+func VariableScopeID(p *v1alpha1.VariableParameters) *int {
+	if p.GroupID != nil {
+		return p.GroupID
+	}
+	return p.ProjectID
+}
+
+// LabelSetScopeID returns the ID of the group whose labels a LabelSet
+// manages, or else the ID of its project.
+func LabelSetScopeID(p *v1alpha1.LabelSetParameters) *string {
+	if p.GroupID != nil {
+		return p.GroupID
+	}
+	return p.ProjectID
+}
+
+// DeployTokenScopeID returns the ID of the group of a group deploy token, or
+// else the ID of the project of the deploy token.
+func DeployTokenScopeID(p *v1alpha1.DeployTokenParameters) *int {
+	if p.GroupID != nil {
+		return p.GroupID
+	}
+	return p.ProjectID
+}
+
+// AccessTokenScopeID returns the ID of the group of a group access token, or
+// else the ID of the project of the access token.
+func AccessTokenScopeID(p *v1alpha1.AccessTokenParameters) *string {
+	if p.GroupID != nil {
+		return p.GroupID
+	}
+	return p.ProjectID
+}
+
+// groupScoped makes requests to the endpoints of a group.
+type groupScoped struct {
+	git *gitlab.Client
+}
+
+func (g groupScoped) do(method string, gid interface{}, path string, opt, v interface{}, options []gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	group, err := clients.ParseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	req, err := g.git.NewRequest(method, "groups/"+group+path, opt, options)
+	if err != nil {
+		return nil, err
+	}
+	return g.git.Do(req, v)
+}
+
+type groupHookClient struct {
+	groupScoped
+}
+
+// NewGroupHookClient returns a HookClient managing the hooks of groups.
+func NewGroupHookClient(cfg clients.Config) HookClient {
+	return &groupHookClient{groupScoped{git: clients.NewClient(cfg)}}
+}
+
+func (c *groupHookClient) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.git.Projects.GetProject(pid, opt, options...)
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#get-group-hook
+func (c *groupHookClient) GetProjectHook(gid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+	h := new(gitlab.ProjectHook)
+	resp, err := c.do(http.MethodGet, gid, fmt.Sprintf("/hooks/%d", hook), nil, h, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return h, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#list-group-hooks
+func (c *groupHookClient) ListProjectHooks(gid interface{}, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error) {
+	var hs []*gitlab.ProjectHook
+	resp, err := c.do(http.MethodGet, gid, "/hooks", opt, &hs, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hs, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#add-group-hook
+func (c *groupHookClient) AddProjectHook(gid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+	h := new(gitlab.ProjectHook)
+	resp, err := c.do(http.MethodPost, gid, "/hooks", opt, h, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return h, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#edit-group-hook
+func (c *groupHookClient) EditProjectHook(gid interface{}, hook int, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+	h := new(gitlab.ProjectHook)
+	resp, err := c.do(http.MethodPut, gid, fmt.Sprintf("/hooks/%d", hook), opt, h, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return h, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#delete-group-hook
+func (c *groupHookClient) DeleteProjectHook(gid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.do(http.MethodDelete, gid, fmt.Sprintf("/hooks/%d", hook), nil, nil, options)
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#get-group-hook
+func (c *groupHookClient) GetProjectHookStatus(gid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*ProjectHookStatus, *gitlab.Response, error) {
+	h := new(ProjectHookStatus)
+	resp, err := c.do(http.MethodGet, gid, fmt.Sprintf("/hooks/%d", hook), nil, h, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return h, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#trigger-a-test-group-hook
+func (c *groupHookClient) TestProjectHook(gid interface{}, hook int, trigger string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.do(http.MethodPost, gid, fmt.Sprintf("/hooks/%d/test/%s", hook, trigger), nil, nil, options)
+}
+
+type groupBadgeClient struct {
+	groupScoped
+}
+
+// NewGroupBadgeClient returns a BadgeClient managing the badges of groups.
+func NewGroupBadgeClient(cfg clients.Config) BadgeClient {
+	return &groupBadgeClient{groupScoped{git: clients.NewClient(cfg)}}
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_badges.html#get-a-badge-of-a-group
+func (c *groupBadgeClient) GetProjectBadge(gid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+	b := new(gitlab.ProjectBadge)
+	resp, err := c.do(http.MethodGet, gid, fmt.Sprintf("/badges/%d", badge), nil, b, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return b, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_badges.html#add-a-badge-to-a-group
+func (c *groupBadgeClient) AddProjectBadge(gid interface{}, opt *gitlab.AddProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+	b := new(gitlab.ProjectBadge)
+	resp, err := c.do(http.MethodPost, gid, "/badges", opt, b, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return b, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_badges.html#edit-a-badge-of-a-group
+func (c *groupBadgeClient) EditProjectBadge(gid interface{}, badge int, opt *gitlab.EditProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+	b := new(gitlab.ProjectBadge)
+	resp, err := c.do(http.MethodPut, gid, fmt.Sprintf("/badges/%d", badge), opt, b, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return b, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_badges.html#remove-a-badge-from-a-group
+func (c *groupBadgeClient) DeleteProjectBadge(gid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.do(http.MethodDelete, gid, fmt.Sprintf("/badges/%d", badge), nil, nil, options)
+}
+
+type groupVariableClient struct {
+	VariableClient
+	groupScoped
+}
+
+// NewGroupVariableClient returns a VariableClient managing the variables of
+// groups.
+func NewGroupVariableClient(cfg clients.Config) VariableClient {
+	return &groupVariableClient{VariableClient: NewVariableClient(cfg), groupScoped: groupScoped{git: clients.NewClient(cfg)}}
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#list-group-variables
+func (c *groupVariableClient) ListVariables(gid interface{}, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+	var vs []*gitlab.ProjectVariable
+	resp, err := c.do(http.MethodGet, gid, "/variables", opt, &vs, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return vs, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#show-variable-details
+func (c *groupVariableClient) GetVariable(gid interface{}, key string, opt *gitlab.GetProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	v := new(gitlab.ProjectVariable)
+	resp, err := c.do(http.MethodGet, gid, "/variables/"+gitlab.PathEscape(key), opt, v, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#create-variable
+func (c *groupVariableClient) CreateVariable(gid interface{}, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	v := new(gitlab.ProjectVariable)
+	resp, err := c.do(http.MethodPost, gid, "/variables", opt, v, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#update-variable
+func (c *groupVariableClient) UpdateVariable(gid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	v := new(gitlab.ProjectVariable)
+	resp, err := c.do(http.MethodPut, gid, "/variables/"+gitlab.PathEscape(key), opt, v, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#remove-variable
+func (c *groupVariableClient) RemoveVariable(gid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.do(http.MethodDelete, gid, "/variables/"+gitlab.PathEscape(key), opt, nil, options)
+}
+
+type groupLabelClient struct {
+	groupScoped
+}
+
+// NewGroupLabelClient returns a LabelClient managing the labels of groups.
+func NewGroupLabelClient(cfg clients.Config) LabelClient {
+	return &groupLabelClient{groupScoped{git: clients.NewClient(cfg)}}
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_labels.html#list-group-labels
+func (c *groupLabelClient) ListLabels(gid interface{}, opt *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+	var ls []*gitlab.Label
+	resp, err := c.do(http.MethodGet, gid, "/labels", opt, &ls, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ls, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_labels.html#create-a-new-group-label
+func (c *groupLabelClient) CreateLabel(gid interface{}, opt *gitlab.CreateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error) {
+	l := new(gitlab.Label)
+	resp, err := c.do(http.MethodPost, gid, "/labels", opt, l, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_labels.html#update-a-group-label
+func (c *groupLabelClient) UpdateLabel(gid interface{}, opt *gitlab.UpdateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error) {
+	l := new(gitlab.Label)
+	resp, err := c.do(http.MethodPut, gid, "/labels", opt, l, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_labels.html#delete-a-group-label
+func (c *groupLabelClient) DeleteLabel(gid interface{}, opt *gitlab.DeleteLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.do(http.MethodDelete, gid, "/labels", opt, nil, options)
+}
+
+type groupDeployTokenClient struct {
+	groupScoped
+}
+
+// NewGroupDeployTokenClient returns a DeployTokenClient managing the deploy
+// tokens of groups.
+func NewGroupDeployTokenClient(cfg clients.Config) DeployTokenClient {
+	return &groupDeployTokenClient{groupScoped{git: clients.NewClient(cfg)}}
+}
+
+func (c *groupDeployTokenClient) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.git.Projects.GetProject(pid, opt, options...)
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deploy_tokens.html#get-a-group-deploy-token
+func (c *groupDeployTokenClient) GetProjectDeployToken(gid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error) {
+	t := new(gitlab.DeployToken)
+	resp, err := c.do(http.MethodGet, gid, fmt.Sprintf("/deploy_tokens/%d", deployToken), nil, t, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return t, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deploy_tokens.html#create-a-group-deploy-token
+func (c *groupDeployTokenClient) CreateProjectDeployToken(gid interface{}, opt *gitlab.CreateProjectDeployTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error) {
+	t := new(gitlab.DeployToken)
+	resp, err := c.do(http.MethodPost, gid, "/deploy_tokens", opt, t, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return t, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deploy_tokens.html#delete-a-group-deploy-token
+func (c *groupDeployTokenClient) DeleteProjectDeployToken(gid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.do(http.MethodDelete, gid, fmt.Sprintf("/deploy_tokens/%d", deployToken), nil, nil, options)
+}
+
+type groupAccessTokenClient struct {
+	AccessTokenClient
+	groupScoped
+}
+
+// NewGroupAccessTokenClient returns an AccessTokenClient managing the access
+// tokens of groups.
+func NewGroupAccessTokenClient(cfg clients.Config) AccessTokenClient {
+	return &groupAccessTokenClient{AccessTokenClient: NewAccessTokenClient(cfg), groupScoped: groupScoped{git: clients.NewClient(cfg)}}
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#get-a-group-access-token
+func (c *groupAccessTokenClient) GetProjectAccessToken(gid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+	t := new(gitlab.ProjectAccessToken)
+	resp, err := c.do(http.MethodGet, gid, fmt.Sprintf("/access_tokens/%d", id), nil, t, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return t, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#create-a-group-access-token
+func (c *groupAccessTokenClient) CreateProjectAccessToken(gid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+	t := new(gitlab.ProjectAccessToken)
+	resp, err := c.do(http.MethodPost, gid, "/access_tokens", opt, t, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return t, resp, nil
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#revoke-a-group-access-token
+func (c *groupAccessTokenClient) RevokeProjectAccessToken(gid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.do(http.MethodDelete, gid, fmt.Sprintf("/access_tokens/%d", id), nil, nil, options)
+}
+
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#get-a-member-of-a-group-or-project-including-inherited-and-invited-members
+func (c *groupAccessTokenClient) GetInheritedProjectMember(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+	m := new(gitlab.ProjectMember)
+	resp, err := c.do(http.MethodGet, gid, fmt.Sprintf("/members/all/%d", user), nil, m, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return m, resp, nil
+}
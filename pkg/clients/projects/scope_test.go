@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+func TestHookScopeID(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.HookParameters
+		want *int
+	}{
+		"Project": {
+			p:    v1alpha1.HookParameters{ProjectID: ptr.To(1)},
+			want: ptr.To(1),
+		},
+		"Group": {
+			p:    v1alpha1.HookParameters{GroupID: ptr.To(2)},
+			want: ptr.To(2),
+		},
+		"Unset": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, HookScopeID(&tc.p)); diff != "" {
+				t.Errorf("HookScopeID: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroupScopedClients(t *testing.T) {
+	requests := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 3, "url": "https://example.org", "link_url": "https://example.org"}`))
+	}))
+	defer srv.Close()
+	cfg := clients.Config{BaseURL: srv.URL, Retry: &clients.RetryPolicy{}}
+
+	hooks := NewGroupHookClient(cfg)
+	h, _, err := hooks.AddProjectHook(5, &gitlab.AddProjectHookOptions{URL: ptr.To("https://example.org")})
+	if err != nil {
+		t.Fatalf("AddProjectHook: %v", err)
+	}
+	if diff := cmp.Diff("https://example.org", h.URL); diff != "" {
+		t.Errorf("AddProjectHook: -want, +got:\n%s", diff)
+	}
+	if _, _, err := hooks.GetProjectHookStatus("group/sub", 3); err != nil {
+		t.Fatalf("GetProjectHookStatus: %v", err)
+	}
+	if _, err := hooks.DeleteProjectHook(5, 3); err != nil {
+		t.Fatalf("DeleteProjectHook: %v", err)
+	}
+
+	badges := NewGroupBadgeClient(cfg)
+	if _, _, err := badges.EditProjectBadge("5", 3, &gitlab.EditProjectBadgeOptions{}); err != nil {
+		t.Fatalf("EditProjectBadge: %v", err)
+	}
+
+	variables := NewGroupVariableClient(cfg)
+	if _, _, err := variables.GetVariable(5, "KEY", nil); err != nil {
+		t.Fatalf("GetVariable: %v", err)
+	}
+	if _, err := variables.RemoveVariable(5, "KEY", nil); err != nil {
+		t.Fatalf("RemoveVariable: %v", err)
+	}
+
+	labels := NewGroupLabelClient(cfg)
+	if _, _, err := labels.UpdateLabel("5", &gitlab.UpdateLabelOptions{Name: ptr.To("bug")}); err != nil {
+		t.Fatalf("UpdateLabel: %v", err)
+	}
+
+	deployTokens := NewGroupDeployTokenClient(cfg)
+	if _, _, err := deployTokens.CreateProjectDeployToken(5, &gitlab.CreateProjectDeployTokenOptions{}); err != nil {
+		t.Fatalf("CreateProjectDeployToken: %v", err)
+	}
+
+	accessTokens := NewGroupAccessTokenClient(cfg)
+	if _, _, err := accessTokens.GetInheritedProjectMember("5", 7); err != nil {
+		t.Fatalf("GetInheritedProjectMember: %v", err)
+	}
+	if _, err := accessTokens.RevokeProjectAccessToken("5", 3); err != nil {
+		t.Fatalf("RevokeProjectAccessToken: %v", err)
+	}
+
+	want := []string{
+		"POST /api/v4/groups/5/hooks",
+		"GET /api/v4/groups/group%2Fsub/hooks/3",
+		"DELETE /api/v4/groups/5/hooks/3",
+		"PUT /api/v4/groups/5/badges/3",
+		"GET /api/v4/groups/5/variables/KEY",
+		"DELETE /api/v4/groups/5/variables/KEY",
+		"PUT /api/v4/groups/5/labels",
+		"POST /api/v4/groups/5/deploy_tokens",
+		"GET /api/v4/groups/5/members/all/7",
+		"DELETE /api/v4/groups/5/access_tokens/3",
+	}
+	if diff := cmp.Diff(want, requests); diff != "" {
+		t.Errorf("requests: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	// defaultSnippetRef is the branch of the repository of a snippet, which
+	// is used if it cannot be told from the raw URL of a file.
+	defaultSnippetRef = "main"
+
+	snippetFileActionCreate = "create"
+	snippetFileActionUpdate = "update"
+	snippetFileActionDelete = "delete"
+)
+
+// SnippetClient defines Gitlab project snippet service operations
+type SnippetClient interface {
+	GetSnippet(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	CreateSnippet(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	UpdateSnippet(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	DeleteSnippet(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	SnippetFileContent(pid interface{}, snippet int, ref, filename string, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error)
+}
+
+type snippetClient struct {
+	*gitlab.ProjectSnippetsService
+	git *gitlab.Client
+}
+
+// NewSnippetClient returns a new Gitlab project snippet service
+func NewSnippetClient(cfg clients.Config) SnippetClient {
+	git := clients.NewClient(cfg)
+	return &snippetClient{ProjectSnippetsService: git.ProjectSnippets, git: git}
+}
+
+// SnippetFileContent returns the raw content of a file of a project snippet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_snippets.html#snippet-repository-file-content
+func (c *snippetClient) SnippetFileContent(pid interface{}, snippet int, ref, filename string, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+	project, err := clients.ParseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/snippets/%d/files/%s/%s/raw", project, snippet, gitlab.PathEscape(ref), gitlab.PathEscape(filename))
+
+	req, err := c.git.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := c.git.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+	return b.Bytes(), resp, nil
+}
+
+// SnippetFileRef returns the branch a file of a snippet is read from. Gitlab
+// does not report it other than in the raw URL of the file, which ends with
+// /raw/<ref>/<path>.
+func SnippetFileRef(rawURL, path string) string {
+	prefix := strings.TrimSuffix(rawURL, "/"+path)
+	i := strings.LastIndex(prefix, "/raw/")
+	if prefix == rawURL || i < 0 || i+len("/raw/") == len(prefix) {
+		return defaultSnippetRef
+	}
+	return prefix[i+len("/raw/"):]
+}
+
+// IsSnippetUpToDate checks whether there is a change in any of the
+// modifiable fields of a snippet. contents holds the content of the files
+// of the snippet by path.
+func IsSnippetUpToDate(p *v1alpha1.SnippetParameters, s *gitlab.Snippet, contents map[string]string) bool {
+	if p.Title != s.Title {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Description, s.Description) {
+		return false
+	}
+	if p.Visibility != nil && string(*p.Visibility) != s.Visibility {
+		return false
+	}
+	if len(p.Files) != len(s.Files) {
+		return false
+	}
+	for _, f := range p.Files {
+		c, ok := contents[f.FilePath]
+		if !ok || c != f.Content {
+			return false
+		}
+	}
+	return true
+}
+
+// LateInitializeSnippet fills the empty fields in the snippet spec with the
+// values seen in gitlab.Snippet.
+func LateInitializeSnippet(in *v1alpha1.SnippetParameters, s *gitlab.Snippet) {
+	if s == nil {
+		return
+	}
+	in.Description = clients.LateInitializeStringPtr(in.Description, s.Description)
+	if in.Visibility == nil && s.Visibility != "" {
+		in.Visibility = (*v1alpha1.VisibilityValue)(&s.Visibility)
+	}
+}
+
+// GenerateSnippetObservation is used to produce v1alpha1.SnippetObservation
+// from gitlab.Snippet.
+func GenerateSnippetObservation(s *gitlab.Snippet) v1alpha1.SnippetObservation {
+	if s == nil {
+		return v1alpha1.SnippetObservation{}
+	}
+	o := v1alpha1.SnippetObservation{
+		ID:        s.ID,
+		WebURL:    s.WebURL,
+		CreatedAt: clients.TimeToMetaTime(s.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(s.UpdatedAt),
+	}
+	for _, f := range s.Files {
+		o.Files = append(o.Files, v1alpha1.SnippetFileObservation{Path: f.Path, RawURL: f.RawURL})
+	}
+	return o
+}
+
+// GenerateCreateSnippetOptions generates snippet creation options
+func GenerateCreateSnippetOptions(p *v1alpha1.SnippetParameters) *gitlab.CreateProjectSnippetOptions {
+	files := make([]*gitlab.CreateSnippetFileOptions, 0, len(p.Files))
+	for i := range p.Files {
+		f := &p.Files[i]
+		files = append(files, &gitlab.CreateSnippetFileOptions{
+			FilePath: &f.FilePath,
+			Content:  &f.Content,
+		})
+	}
+	return &gitlab.CreateProjectSnippetOptions{
+		Title:       &p.Title,
+		Description: p.Description,
+		Visibility:  clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
+		Files:       &files,
+	}
+}
+
+// GenerateUpdateSnippetOptions generates snippet update options. Files of
+// the snippet that are not in the spec are deleted, the others are created
+// or updated.
+func GenerateUpdateSnippetOptions(p *v1alpha1.SnippetParameters, s *gitlab.Snippet) *gitlab.UpdateProjectSnippetOptions {
+	existing := make(map[string]bool, len(s.Files))
+	for _, f := range s.Files {
+		existing[f.Path] = true
+	}
+
+	desired := make(map[string]bool, len(p.Files))
+	files := make([]*gitlab.UpdateSnippetFileOptions, 0, len(p.Files))
+	for i := range p.Files {
+		f := &p.Files[i]
+		desired[f.FilePath] = true
+		action := snippetFileActionCreate
+		if existing[f.FilePath] {
+			action = snippetFileActionUpdate
+		}
+		files = append(files, &gitlab.UpdateSnippetFileOptions{
+			Action:   gitlab.String(action),
+			FilePath: &f.FilePath,
+			Content:  &f.Content,
+		})
+	}
+	for _, f := range s.Files {
+		if !desired[f.Path] {
+			files = append(files, &gitlab.UpdateSnippetFileOptions{
+				Action:   gitlab.String(snippetFileActionDelete),
+				FilePath: gitlab.String(f.Path),
+			})
+		}
+	}
+
+	return &gitlab.UpdateProjectSnippetOptions{
+		Title:       &p.Title,
+		Description: p.Description,
+		Visibility:  clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
+		Files:       &files,
+	}
+}
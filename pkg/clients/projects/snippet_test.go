@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func snippetWithFiles(paths ...string) *gitlab.Snippet {
+	s := &gitlab.Snippet{ID: 1, Title: "scripts", Visibility: "private"}
+	for _, p := range paths {
+		s.Files = append(s.Files, struct {
+			Path   string `json:"path"`
+			RawURL string `json:"raw_url"`
+		}{Path: p, RawURL: "https://gitlab.com/group/project/-/snippets/1/raw/main/" + p})
+	}
+	return s
+}
+
+func TestSnippetFileRef(t *testing.T) {
+	cases := map[string]struct {
+		rawURL string
+		path   string
+		want   string
+	}{
+		"Main": {
+			rawURL: "https://gitlab.com/group/project/-/snippets/1/raw/main/setup.sh",
+			path:   "setup.sh",
+			want:   "main",
+		},
+		"NestedPath": {
+			rawURL: "https://gitlab.com/group/project/-/snippets/1/raw/master/raw/setup.sh",
+			path:   "raw/setup.sh",
+			want:   "master",
+		},
+		"Unknown": {
+			rawURL: "https://gitlab.com/group/project/-/snippets/1/raw",
+			path:   "setup.sh",
+			want:   defaultSnippetRef,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := SnippetFileRef(tc.rawURL, tc.path)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSnippetUpToDate(t *testing.T) {
+	setup := v1alpha1.SnippetFile{FilePath: "setup.sh", Content: "#!/bin/sh\n"}
+
+	cases := map[string]struct {
+		p        *v1alpha1.SnippetParameters
+		contents map[string]string
+		want     bool
+	}{
+		"UpToDate": {
+			p:        &v1alpha1.SnippetParameters{Title: "scripts", Visibility: ptr.To(v1alpha1.PrivateVisibility), Files: []v1alpha1.SnippetFile{setup}},
+			contents: map[string]string{"setup.sh": "#!/bin/sh\n"},
+			want:     true,
+		},
+		"ContentChanged": {
+			p:        &v1alpha1.SnippetParameters{Title: "scripts", Files: []v1alpha1.SnippetFile{setup}},
+			contents: map[string]string{"setup.sh": "#!/bin/bash\n"},
+			want:     false,
+		},
+		"FileMissing": {
+			p:        &v1alpha1.SnippetParameters{Title: "scripts", Files: []v1alpha1.SnippetFile{{FilePath: "other.sh"}}},
+			contents: map[string]string{"setup.sh": "#!/bin/sh\n"},
+			want:     false,
+		},
+		"VisibilityChanged": {
+			p:        &v1alpha1.SnippetParameters{Title: "scripts", Visibility: ptr.To(v1alpha1.PublicVisibility), Files: []v1alpha1.SnippetFile{setup}},
+			contents: map[string]string{"setup.sh": "#!/bin/sh\n"},
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSnippetUpToDate(tc.p, snippetWithFiles("setup.sh"), tc.contents)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateSnippetOptions(t *testing.T) {
+	p := &v1alpha1.SnippetParameters{
+		Title: "scripts",
+		Files: []v1alpha1.SnippetFile{
+			{FilePath: "setup.sh", Content: "a"},
+			{FilePath: "teardown.sh", Content: "b"},
+		},
+	}
+	want := &gitlab.UpdateProjectSnippetOptions{
+		Title: ptr.To("scripts"),
+		Files: &[]*gitlab.UpdateSnippetFileOptions{
+			{Action: ptr.To("update"), FilePath: ptr.To("setup.sh"), Content: ptr.To("a")},
+			{Action: ptr.To("create"), FilePath: ptr.To("teardown.sh"), Content: ptr.To("b")},
+			{Action: ptr.To("delete"), FilePath: ptr.To("stale.sh")},
+		},
+	}
+	got := GenerateUpdateSnippetOptions(p, snippetWithFiles("setup.sh", "stale.sh"))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
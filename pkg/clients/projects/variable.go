@@ -39,12 +39,115 @@ type VariableClient interface {
 	CreateVariable(pid interface{}, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error)
 	UpdateVariable(pid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error)
 	RemoveVariable(pid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	ListGroupVariables(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error)
+}
+
+type variableClient struct {
+	*gitlab.ProjectVariablesService
+	projects       *gitlab.ProjectsService
+	groups         *gitlab.GroupsService
+	groupVariables *gitlab.GroupVariablesService
 }
 
 // NewVariableClient returns a new Gitlab Project service
 func NewVariableClient(cfg clients.Config) VariableClient {
 	git := clients.NewClient(cfg)
-	return git.ProjectVariables
+	return &variableClient{
+		ProjectVariablesService: git.ProjectVariables,
+		projects:                git.Projects,
+		groups:                  git.Groups,
+		groupVariables:          git.GroupVariables,
+	}
+}
+
+// GetProject gets the project the variables are defined in.
+func (c *variableClient) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.projects.GetProject(pid, opt, options...)
+}
+
+// GetGroup gets an ancestor group of the project.
+func (c *variableClient) GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	return c.groups.GetGroup(gid, opt, options...)
+}
+
+// ListGroupVariables lists the variables of an ancestor group of the project.
+func (c *variableClient) ListGroupVariables(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+	return c.groupVariables.ListVariables(gid, opt, options...)
+}
+
+// InheritedVariable is a group variable a project or group inherits.
+type InheritedVariable struct {
+	// GroupPath is the full path of the group defining the variable.
+	GroupPath string
+	Variable  *gitlab.GroupVariable
+}
+
+// FindInheritedVariable walks up the groups of a project, closest first, and
+// returns the first group variable with the given key applying to the given
+// environment scope. It returns nil if the project inherits no such variable.
+func FindInheritedVariable(c VariableClient, pid interface{}, key, environmentScope string, options ...gitlab.RequestOptionFunc) (*InheritedVariable, error) {
+	prj, _, err := c.GetProject(pid, nil, options...)
+	if err != nil {
+		return nil, err
+	}
+	if prj.Namespace == nil || prj.Namespace.Kind != "group" {
+		return nil, nil
+	}
+	return findAncestorVariable(c, prj.Namespace.ID, key, environmentScope, options...)
+}
+
+// FindInheritedGroupVariable walks up the ancestors of a group, closest
+// first, and returns the first group variable with the given key applying to
+// the given environment scope. It returns nil if the group inherits no such
+// variable.
+func FindInheritedGroupVariable(c VariableClient, gid interface{}, key, environmentScope string, options ...gitlab.RequestOptionFunc) (*InheritedVariable, error) {
+	grp, _, err := c.GetGroup(gid, &gitlab.GetGroupOptions{WithProjects: gitlab.Bool(false)}, options...)
+	if err != nil {
+		return nil, err
+	}
+	return findAncestorVariable(c, grp.ParentID, key, environmentScope, options...)
+}
+
+// findAncestorVariable walks up the groups starting at gid, closest first,
+// and returns the first group variable with the given key applying to the
+// given environment scope.
+func findAncestorVariable(c VariableClient, gid int, key, environmentScope string, options ...gitlab.RequestOptionFunc) (*InheritedVariable, error) {
+	for gid != 0 {
+		grp, _, err := c.GetGroup(gid, &gitlab.GetGroupOptions{WithProjects: gitlab.Bool(false)}, options...)
+		if err != nil {
+			return nil, err
+		}
+		v, err := findGroupVariable(c, gid, key, environmentScope, options...)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			return &InheritedVariable{GroupPath: grp.FullPath, Variable: v}, nil
+		}
+		gid = grp.ParentID
+	}
+	return nil, nil
+}
+
+func findGroupVariable(c VariableClient, gid int, key, environmentScope string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, error) {
+	opt := &gitlab.ListGroupVariablesOptions{PerPage: 100}
+	for {
+		vars, res, err := c.ListGroupVariables(gid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vars {
+			if v.Key == key && (v.EnvironmentScope == "*" || v.EnvironmentScope == environmentScope) {
+				return v, nil
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil, nil
+		}
+		opt.Page = res.NextPage
+	}
 }
 
 // IsErrorVariableNotFound helper function to test for errProjectNotFound error.
@@ -74,7 +177,7 @@ func LateInitializeVariable(in *v1alpha1.VariableParameters, variable *gitlab.Pr
 		in.Masked = &variable.Masked
 	}
 
-	if in.EnvironmentScope == nil {
+	if in.EnvironmentScope == nil && len(in.Scopes) == 0 {
 		in.EnvironmentScope = &variable.EnvironmentScope
 	}
 
@@ -97,6 +200,40 @@ func VariableToParameters(in gitlab.ProjectVariable) v1alpha1.VariableParameters
 	}
 }
 
+// ExpandVariableScopes returns the parameters of each Gitlab variable the
+// spec manages: one per entry of Scopes, or the spec itself if Scopes is empty.
+func ExpandVariableScopes(p *v1alpha1.VariableParameters) []v1alpha1.VariableParameters {
+	if len(p.Scopes) == 0 {
+		return []v1alpha1.VariableParameters{*p}
+	}
+
+	out := make([]v1alpha1.VariableParameters, 0, len(p.Scopes))
+	for _, scope := range p.Scopes {
+		v := *p.DeepCopy()
+		v.Scopes = nil
+		v.EnvironmentScope = gitlab.String(scope)
+		out = append(out, v)
+	}
+	return out
+}
+
+// StaleVariableScopes returns the previously applied scopes that are no
+// longer part of the spec.
+func StaleVariableScopes(p *v1alpha1.VariableParameters, applied []string) []string {
+	want := make(map[string]bool, len(p.Scopes))
+	for _, s := range p.Scopes {
+		want[s] = true
+	}
+
+	var stale []string
+	for _, s := range applied {
+		if !want[s] {
+			stale = append(stale, s)
+		}
+	}
+	return stale
+}
+
 // GenerateCreateVariableOptions generates project creation options
 func GenerateCreateVariableOptions(p *v1alpha1.VariableParameters) *gitlab.CreateProjectVariableOptions {
 	variable := &gitlab.CreateProjectVariableOptions{
@@ -170,6 +307,6 @@ func IsVariableUpToDate(p *v1alpha1.VariableParameters, g *gitlab.ProjectVariabl
 		VariableToParameters(*g),
 		cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}, &xpv1.SecretKeySelector{}),
-		cmpopts.IgnoreFields(v1alpha1.VariableParameters{}, "ProjectID"),
+		cmpopts.IgnoreFields(v1alpha1.VariableParameters{}, "ProjectID", "GroupID", "Scopes", "InheritancePolicy"),
 	)
 }
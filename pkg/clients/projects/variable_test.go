@@ -350,3 +350,154 @@ func TestGenerateRemoveVariableOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandVariableScopes(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.VariableParameters
+		want []v1alpha1.VariableParameters
+	}{
+		"NoScopes": {
+			p:    &v1alpha1.VariableParameters{Key: variableKey, EnvironmentScope: &variableEnvScope},
+			want: []v1alpha1.VariableParameters{{Key: variableKey, EnvironmentScope: &variableEnvScope}},
+		},
+		"Scopes": {
+			p: &v1alpha1.VariableParameters{Key: variableKey, Value: &variableValue, Scopes: []string{"production", "staging"}},
+			want: []v1alpha1.VariableParameters{
+				{Key: variableKey, Value: &variableValue, EnvironmentScope: gitlab.String("production")},
+				{Key: variableKey, Value: &variableValue, EnvironmentScope: gitlab.String("staging")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ExpandVariableScopes(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStaleVariableScopes(t *testing.T) {
+	p := &v1alpha1.VariableParameters{Scopes: []string{"production", "staging"}}
+	got := StaleVariableScopes(p, []string{"production", "review"})
+	if diff := cmp.Diff([]string{"review"}, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestFindInheritedVariable(t *testing.T) {
+	prj := &gitlab.Project{Namespace: &gitlab.ProjectNamespace{ID: 2, Kind: "group"}}
+	groups := map[int]*gitlab.Group{
+		2: {ID: 2, FullPath: "parent/child", ParentID: 1},
+		1: {ID: 1, FullPath: "parent"},
+	}
+
+	cases := map[string]struct {
+		project   *gitlab.Project
+		variables map[int][]*gitlab.GroupVariable
+		scope     string
+		want      *InheritedVariable
+	}{
+		"UserNamespace": {
+			project: &gitlab.Project{Namespace: &gitlab.ProjectNamespace{ID: 3, Kind: "user"}},
+			scope:   "*",
+		},
+		"NotInherited": {
+			project:   prj,
+			variables: map[int][]*gitlab.GroupVariable{1: {{Key: "OTHER", EnvironmentScope: "*"}}},
+			scope:     "*",
+		},
+		"InheritedFromAncestor": {
+			project:   prj,
+			variables: map[int][]*gitlab.GroupVariable{1: {{Key: variableKey, EnvironmentScope: "*"}}},
+			scope:     "production",
+			want:      &InheritedVariable{GroupPath: "parent", Variable: &gitlab.GroupVariable{Key: variableKey, EnvironmentScope: "*"}},
+		},
+		"ClosestGroupFirst": {
+			project: prj,
+			variables: map[int][]*gitlab.GroupVariable{
+				1: {{Key: variableKey, EnvironmentScope: "*"}},
+				2: {{Key: variableKey, EnvironmentScope: "production"}},
+			},
+			scope: "production",
+			want:  &InheritedVariable{GroupPath: "parent/child", Variable: &gitlab.GroupVariable{Key: variableKey, EnvironmentScope: "production"}},
+		},
+		"OtherScope": {
+			project:   prj,
+			variables: map[int][]*gitlab.GroupVariable{2: {{Key: variableKey, EnvironmentScope: "staging"}}},
+			scope:     "production",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &inheritedVariableLister{project: tc.project, groups: groups, variables: tc.variables}
+			got, err := FindInheritedVariable(c, 1, variableKey, tc.scope)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindInheritedGroupVariable(t *testing.T) {
+	groups := map[int]*gitlab.Group{
+		3: {ID: 3, FullPath: "parent/child/grandchild", ParentID: 2},
+		2: {ID: 2, FullPath: "parent/child", ParentID: 1},
+		1: {ID: 1, FullPath: "parent"},
+	}
+
+	cases := map[string]struct {
+		gid       int
+		variables map[int][]*gitlab.GroupVariable
+		want      *InheritedVariable
+	}{
+		"TopLevelGroup": {
+			gid:       1,
+			variables: map[int][]*gitlab.GroupVariable{1: {{Key: variableKey, EnvironmentScope: "*"}}},
+		},
+		"OwnVariableIsNotInherited": {
+			gid:       3,
+			variables: map[int][]*gitlab.GroupVariable{3: {{Key: variableKey, EnvironmentScope: "*"}}},
+		},
+		"InheritedFromAncestor": {
+			gid:       3,
+			variables: map[int][]*gitlab.GroupVariable{1: {{Key: variableKey, EnvironmentScope: "*"}}},
+			want:      &InheritedVariable{GroupPath: "parent", Variable: &gitlab.GroupVariable{Key: variableKey, EnvironmentScope: "*"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &inheritedVariableLister{groups: groups, variables: tc.variables}
+			got, err := FindInheritedGroupVariable(c, tc.gid, variableKey, "production")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type inheritedVariableLister struct {
+	VariableClient
+	project   *gitlab.Project
+	groups    map[int]*gitlab.Group
+	variables map[int][]*gitlab.GroupVariable
+}
+
+func (l *inheritedVariableLister) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return l.project, &gitlab.Response{}, nil
+}
+
+func (l *inheritedVariableLister) GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	return l.groups[gid.(int)], &gitlab.Response{}, nil
+}
+
+func (l *inheritedVariableLister) ListGroupVariables(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+	return l.variables[gid.(int)], &gitlab.Response{}, nil
+}
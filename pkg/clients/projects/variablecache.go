@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// VariableCache caches the variables of projects for a short time, so the
+// Variables of one project are observed with one list of its variables
+// instead of one request per Variable.
+type VariableCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]variableCacheEntry
+}
+
+type variableCacheEntry struct {
+	expires   time.Time
+	variables []*gitlab.ProjectVariable
+}
+
+// NewVariableCache returns a cache that keeps the variables of a project
+// for ttl.
+func NewVariableCache(ttl time.Duration) *VariableCache {
+	return &VariableCache{ttl: ttl, now: time.Now, entries: map[string]variableCacheEntry{}}
+}
+
+// Client returns a VariableClient that gets the variables of projects from
+// the cache, filling it with c. Changes made through the client invalidate
+// the cached variables of the changed project. cfg separates the entries of
+// different Gitlab instances and tokens.
+func (vc *VariableCache) Client(cfg clients.Config, c VariableClient) VariableClient {
+	return &cachedVariableClient{VariableClient: c, cache: vc, instance: cfg.CacheKey()}
+}
+
+func (vc *VariableCache) get(key string) ([]*gitlab.ProjectVariable, bool) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	e, ok := vc.entries[key]
+	if !ok || !vc.now().Before(e.expires) {
+		delete(vc.entries, key)
+		return nil, false
+	}
+	return e.variables, true
+}
+
+func (vc *VariableCache) set(key string, variables []*gitlab.ProjectVariable) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	vc.entries[key] = variableCacheEntry{expires: vc.now().Add(vc.ttl), variables: variables}
+}
+
+func (vc *VariableCache) invalidate(key string) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	delete(vc.entries, key)
+}
+
+type cachedVariableClient struct {
+	VariableClient
+	cache    *VariableCache
+	instance string
+}
+
+func (c *cachedVariableClient) key(pid interface{}) string {
+	return fmt.Sprintf("%s/%v", c.instance, pid)
+}
+
+// GetVariable gets a variable from the cached variables of its project.
+func (c *cachedVariableClient) GetVariable(pid interface{}, key string, opt *gitlab.GetProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	variables, ok := c.cache.get(c.key(pid))
+	if !ok {
+		var err error
+		if variables, err = c.listAllVariables(pid, options...); err != nil {
+			// Fall back to getting the single variable, e.g. if the token
+			// may not list variables.
+			return c.VariableClient.GetVariable(pid, key, opt, options...)
+		}
+		c.cache.set(c.key(pid), variables)
+	}
+
+	scope := ""
+	if opt != nil && opt.Filter != nil {
+		scope = opt.Filter.EnvironmentScope
+	}
+	for _, v := range variables {
+		if v.Key == key && (scope == "" || v.EnvironmentScope == scope) {
+			cp := *v
+			return &cp, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+		}
+	}
+	return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errors.New(errVariableNotFound)
+}
+
+// CreateVariable creates a variable and invalidates the cached variables of
+// its project.
+func (c *cachedVariableClient) CreateVariable(pid interface{}, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	defer c.cache.invalidate(c.key(pid))
+	return c.VariableClient.CreateVariable(pid, opt, options...)
+}
+
+// UpdateVariable updates a variable and invalidates the cached variables of
+// its project.
+func (c *cachedVariableClient) UpdateVariable(pid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	defer c.cache.invalidate(c.key(pid))
+	return c.VariableClient.UpdateVariable(pid, key, opt, options...)
+}
+
+// RemoveVariable removes a variable and invalidates the cached variables of
+// its project.
+func (c *cachedVariableClient) RemoveVariable(pid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	defer c.cache.invalidate(c.key(pid))
+	return c.VariableClient.RemoveVariable(pid, key, opt, options...)
+}
+
+func (c *cachedVariableClient) listAllVariables(pid interface{}, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, error) {
+	all := []*gitlab.ProjectVariable{}
+	opt := &gitlab.ListProjectVariablesOptions{PerPage: 100}
+	for {
+		variables, res, err := c.ListVariables(pid, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, variables...)
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
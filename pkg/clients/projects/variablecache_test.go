@@ -0,0 +1,97 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// listingVariableClient serves two pages of variables and counts how often
+// they are listed.
+type listingVariableClient struct {
+	VariableClient
+	lists int
+}
+
+func (c *listingVariableClient) ListVariables(pid interface{}, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+	c.lists++
+	if opt.Page == 0 {
+		return []*gitlab.ProjectVariable{{Key: "A", Value: "a", EnvironmentScope: "*"}}, &gitlab.Response{NextPage: 2}, nil
+	}
+	return []*gitlab.ProjectVariable{{Key: "B", Value: "b-prod", EnvironmentScope: "production"}}, &gitlab.Response{}, nil
+}
+
+func (c *listingVariableClient) UpdateVariable(pid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	return &gitlab.ProjectVariable{}, &gitlab.Response{}, nil
+}
+
+func TestVariableCache(t *testing.T) {
+	mc := &listingVariableClient{}
+
+	now := time.Unix(0, 0)
+	cache := NewVariableCache(time.Minute)
+	cache.now = func() time.Time { return now }
+	c := cache.Client(clients.Config{BaseURL: "https://gitlab.example.com/", Token: "t"}, mc)
+
+	v, _, err := c.GetVariable(1, "A", nil)
+	if err != nil {
+		t.Fatalf("GetVariable(A): %v", err)
+	}
+	if diff := cmp.Diff("a", v.Value); diff != "" {
+		t.Errorf("GetVariable(A): -want, +got:\n%s", diff)
+	}
+
+	v, _, err = c.GetVariable(1, "B", &gitlab.GetProjectVariableOptions{Filter: &gitlab.VariableFilter{EnvironmentScope: "production"}})
+	if err != nil {
+		t.Fatalf("GetVariable(B): %v", err)
+	}
+	if diff := cmp.Diff("b-prod", v.Value); diff != "" {
+		t.Errorf("GetVariable(B): -want, +got:\n%s", diff)
+	}
+
+	_, res, err := c.GetVariable(1, "B", &gitlab.GetProjectVariableOptions{Filter: &gitlab.VariableFilter{EnvironmentScope: "staging"}})
+	if !IsErrorVariableNotFound(err) || !clients.IsResponseNotFound(res) {
+		t.Errorf("GetVariable(B, staging): want not found, got %v", err)
+	}
+	if diff := cmp.Diff(2, mc.lists); diff != "" {
+		t.Errorf("lists while cached: -want, +got:\n%s", diff)
+	}
+
+	if _, _, err := c.UpdateVariable(1, "A", &gitlab.UpdateProjectVariableOptions{}); err != nil {
+		t.Fatalf("UpdateVariable(A): %v", err)
+	}
+	if _, _, err := c.GetVariable(1, "A", nil); err != nil {
+		t.Fatalf("GetVariable(A): %v", err)
+	}
+	if diff := cmp.Diff(4, mc.lists); diff != "" {
+		t.Errorf("lists after update: -want, +got:\n%s", diff)
+	}
+
+	now = now.Add(time.Minute)
+	if _, _, err := c.GetVariable(1, "A", nil); err != nil {
+		t.Fatalf("GetVariable(A): %v", err)
+	}
+	if diff := cmp.Diff(6, mc.lists); diff != "" {
+		t.Errorf("lists after expiry: -want, +got:\n%s", diff)
+	}
+}
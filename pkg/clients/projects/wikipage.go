@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// WikiPageClient defines Gitlab project wiki service operations
+type WikiPageClient interface {
+	GetWikiPage(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	CreateWikiPage(pid interface{}, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	EditWikiPage(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	DeleteWikiPage(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewWikiPageClient returns a new Gitlab project wiki service
+func NewWikiPageClient(cfg clients.Config) WikiPageClient {
+	git := clients.NewClient(cfg)
+	return git.Wikis
+}
+
+// IsWikiPageUpToDate checks whether there is a change in any of the
+// modifiable fields of a wiki page.
+func IsWikiPageUpToDate(p *v1alpha1.WikiPageParameters, w *gitlab.Wiki) bool {
+	if p.Title != w.Title || p.Content != w.Content {
+		return false
+	}
+	return p.Format == nil || string(*p.Format) == string(w.Format)
+}
+
+// LateInitializeWikiPage fills the empty fields in the wiki page spec with
+// the values seen in gitlab.Wiki.
+func LateInitializeWikiPage(in *v1alpha1.WikiPageParameters, w *gitlab.Wiki) {
+	if w == nil {
+		return
+	}
+	if in.Format == nil && w.Format != "" {
+		in.Format = (*v1alpha1.WikiFormatValue)(&w.Format)
+	}
+}
+
+// GenerateWikiPageObservation is used to produce
+// v1alpha1.WikiPageObservation from gitlab.Wiki.
+func GenerateWikiPageObservation(w *gitlab.Wiki) v1alpha1.WikiPageObservation {
+	if w == nil {
+		return v1alpha1.WikiPageObservation{}
+	}
+	return v1alpha1.WikiPageObservation{
+		Slug:     w.Slug,
+		Encoding: w.Encoding,
+	}
+}
+
+// GenerateCreateWikiPageOptions generates wiki page creation options
+func GenerateCreateWikiPageOptions(p *v1alpha1.WikiPageParameters) *gitlab.CreateWikiPageOptions {
+	return &gitlab.CreateWikiPageOptions{
+		Title:   &p.Title,
+		Content: &p.Content,
+		Format:  (*gitlab.WikiFormatValue)(p.Format),
+	}
+}
+
+// GenerateEditWikiPageOptions generates wiki page update options
+func GenerateEditWikiPageOptions(p *v1alpha1.WikiPageParameters) *gitlab.EditWikiPageOptions {
+	return &gitlab.EditWikiPageOptions{
+		Title:   &p.Title,
+		Content: &p.Content,
+		Format:  (*gitlab.WikiFormatValue)(p.Format),
+	}
+}
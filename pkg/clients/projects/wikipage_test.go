@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestIsWikiPageUpToDate(t *testing.T) {
+	w := &gitlab.Wiki{Title: "Runbook", Slug: "Runbook", Content: "# Runbook", Format: gitlab.WikiFormatMarkdown}
+
+	cases := map[string]struct {
+		p    *v1alpha1.WikiPageParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    &v1alpha1.WikiPageParameters{Title: "Runbook", Content: "# Runbook", Format: ptr.To(v1alpha1.WikiFormatMarkdown)},
+			want: true,
+		},
+		"FormatUnset": {
+			p:    &v1alpha1.WikiPageParameters{Title: "Runbook", Content: "# Runbook"},
+			want: true,
+		},
+		"TitleChanged": {
+			p:    &v1alpha1.WikiPageParameters{Title: "On-call runbook", Content: "# Runbook"},
+			want: false,
+		},
+		"ContentChanged": {
+			p:    &v1alpha1.WikiPageParameters{Title: "Runbook", Content: "# Runbook\n\nPage the on-call engineer."},
+			want: false,
+		},
+		"FormatChanged": {
+			p:    &v1alpha1.WikiPageParameters{Title: "Runbook", Content: "# Runbook", Format: ptr.To(v1alpha1.WikiFormatASCIIDoc)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsWikiPageUpToDate(tc.p, w)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeWikiPage(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.WikiPageParameters
+		w    *gitlab.Wiki
+		want *v1alpha1.WikiPageParameters
+	}{
+		"FormatUnset": {
+			p:    &v1alpha1.WikiPageParameters{Title: "Runbook"},
+			w:    &gitlab.Wiki{Format: gitlab.WikiFormatMarkdown},
+			want: &v1alpha1.WikiPageParameters{Title: "Runbook", Format: ptr.To(v1alpha1.WikiFormatMarkdown)},
+		},
+		"FormatSet": {
+			p:    &v1alpha1.WikiPageParameters{Title: "Runbook", Format: ptr.To(v1alpha1.WikiFormatOrg)},
+			w:    &gitlab.Wiki{Format: gitlab.WikiFormatMarkdown},
+			want: &v1alpha1.WikiPageParameters{Title: "Runbook", Format: ptr.To(v1alpha1.WikiFormatOrg)},
+		},
+		"NoWikiPage": {
+			p:    &v1alpha1.WikiPageParameters{Title: "Runbook"},
+			want: &v1alpha1.WikiPageParameters{Title: "Runbook"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeWikiPage(tc.p, tc.w)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
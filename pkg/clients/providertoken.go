@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+)
+
+// ScopeAPI is the scope a token requires to create access tokens.
+const ScopeAPI = "api"
+
+const (
+	errGetProviderToken       = "cannot get the provider token"
+	errGetProviderTokenUser   = "cannot get the user of the provider token"
+	errGetProviderTokenMember = "cannot get the membership of the user of the provider token"
+)
+
+// A ProviderTokenClient gets the token a client authenticates with and the
+// user it belongs to.
+type ProviderTokenClient interface {
+	GetSinglePersonalAccessToken(options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	CurrentUser(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
+}
+
+// A MemberAccessLevelFn returns the access level of a user on a group or
+// project, including inherited membership.
+type MemberAccessLevelFn func(user int) (gitlab.AccessLevelValue, *gitlab.Response, error)
+
+// InsufficientProviderToken returns why the provider token cannot create an
+// access token with access level requested on a group or project, where
+// creating access tokens requires access level required, or "" if it can.
+// The scopes of the token are only checked on Gitlab instances that report
+// them.
+func InsufficientProviderToken(c ProviderTokenClient, member MemberAccessLevelFn, required, requested gitlab.AccessLevelValue, options ...gitlab.RequestOptionFunc) (string, error) {
+	t, res, err := c.GetSinglePersonalAccessToken(options...)
+	if err != nil && !IsResponseNotFound(res) {
+		return "", errors.Wrap(err, errGetProviderToken)
+	}
+	if err == nil && !hasScope(t.Scopes, ScopeAPI) {
+		return fmt.Sprintf("provider token %q lacks the %s scope", t.Name, ScopeAPI), nil
+	}
+
+	u, _, err := c.CurrentUser(options...)
+	if err != nil {
+		return "", errors.Wrap(err, errGetProviderTokenUser)
+	}
+	if u.IsAdmin {
+		return "", nil
+	}
+
+	level, res, err := member(u.ID)
+	switch {
+	case IsResponseNotFound(res):
+		level = gitlab.NoPermissions
+	case err != nil:
+		return "", errors.Wrap(err, errGetProviderTokenMember)
+	}
+	if level < required {
+		return fmt.Sprintf("user %s of the provider token has access level %d, creating access tokens requires at least %d", u.Username, level, required), nil
+	}
+	if requested > level {
+		return fmt.Sprintf("user %s of the provider token has access level %d, which is lower than the requested access level %d", u.Username, level, requested), nil
+	}
+	return "", nil
+}
+
+// SetProviderTokenCondition sets the ProviderToken condition of mg to
+// report why the provider token is insufficient, and returns it as an error.
+// If reason is empty a previously insufficient provider token is reported as
+// sufficient.
+func SetProviderTokenCondition(mg resource.Conditioned, reason string) error {
+	if reason != "" {
+		mg.SetConditions(commonv1alpha1.ProviderTokenInsufficient(reason))
+		return errors.New(reason)
+	}
+	if mg.GetCondition(commonv1alpha1.TypeProviderToken).Status == corev1.ConditionFalse {
+		mg.SetConditions(commonv1alpha1.ProviderTokenSufficient())
+	}
+	return nil
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
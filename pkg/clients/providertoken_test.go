@@ -0,0 +1,166 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type providerTokenClient struct {
+	token *gitlab.PersonalAccessToken
+	user  *gitlab.User
+}
+
+func (c *providerTokenClient) GetSinglePersonalAccessToken(_ ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	if c.token == nil {
+		return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errors.New("404 Not Found")
+	}
+	return c.token, &gitlab.Response{}, nil
+}
+
+func (c *providerTokenClient) CurrentUser(_ ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+	return c.user, &gitlab.Response{}, nil
+}
+
+func memberWith(l gitlab.AccessLevelValue) MemberAccessLevelFn {
+	return func(_ int) (gitlab.AccessLevelValue, *gitlab.Response, error) {
+		return l, &gitlab.Response{}, nil
+	}
+}
+
+func TestInsufficientProviderToken(t *testing.T) {
+	errBoom := errors.New("boom")
+	api := &gitlab.PersonalAccessToken{Name: "provider", Scopes: []string{"api"}}
+	user := &gitlab.User{ID: 1, Username: "provider"}
+
+	type args struct {
+		c         ProviderTokenClient
+		member    MemberAccessLevelFn
+		required  gitlab.AccessLevelValue
+		requested gitlab.AccessLevelValue
+	}
+	type want struct {
+		reason string
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Sufficient": {
+			args: args{
+				c:         &providerTokenClient{token: api, user: user},
+				member:    memberWith(gitlab.OwnerPermissions),
+				required:  gitlab.OwnerPermissions,
+				requested: gitlab.MaintainerPermissions,
+			},
+		},
+		"MissingScope": {
+			args: args{
+				c:         &providerTokenClient{token: &gitlab.PersonalAccessToken{Name: "provider", Scopes: []string{"read_api"}}, user: user},
+				member:    memberWith(gitlab.OwnerPermissions),
+				required:  gitlab.OwnerPermissions,
+				requested: gitlab.MaintainerPermissions,
+			},
+			want: want{
+				reason: `provider token "provider" lacks the api scope`,
+			},
+		},
+		"ScopesNotReported": {
+			args: args{
+				c:         &providerTokenClient{user: user},
+				member:    memberWith(gitlab.MaintainerPermissions),
+				required:  gitlab.MaintainerPermissions,
+				requested: gitlab.MaintainerPermissions,
+			},
+		},
+		"InsufficientRole": {
+			args: args{
+				c:         &providerTokenClient{token: api, user: user},
+				member:    memberWith(gitlab.MaintainerPermissions),
+				required:  gitlab.OwnerPermissions,
+				requested: gitlab.MaintainerPermissions,
+			},
+			want: want{
+				reason: "user provider of the provider token has access level 40, creating access tokens requires at least 50",
+			},
+		},
+		"NotAMember": {
+			args: args{
+				c: &providerTokenClient{token: api, user: user},
+				member: func(_ int) (gitlab.AccessLevelValue, *gitlab.Response, error) {
+					return gitlab.NoPermissions, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errors.New("404 Not Found")
+				},
+				required:  gitlab.MaintainerPermissions,
+				requested: gitlab.MaintainerPermissions,
+			},
+			want: want{
+				reason: "user provider of the provider token has access level 0, creating access tokens requires at least 40",
+			},
+		},
+		"RequestedAboveOwn": {
+			args: args{
+				c:         &providerTokenClient{token: api, user: user},
+				member:    memberWith(gitlab.MaintainerPermissions),
+				required:  gitlab.MaintainerPermissions,
+				requested: gitlab.OwnerPermissions,
+			},
+			want: want{
+				reason: "user provider of the provider token has access level 40, which is lower than the requested access level 50",
+			},
+		},
+		"Admin": {
+			args: args{
+				c:         &providerTokenClient{token: api, user: &gitlab.User{ID: 1, Username: "root", IsAdmin: true}},
+				required:  gitlab.OwnerPermissions,
+				requested: gitlab.OwnerPermissions,
+			},
+		},
+		"MemberFailed": {
+			args: args{
+				c: &providerTokenClient{token: api, user: user},
+				member: func(_ int) (gitlab.AccessLevelValue, *gitlab.Response, error) {
+					return gitlab.NoPermissions, &gitlab.Response{}, errBoom
+				},
+				required:  gitlab.OwnerPermissions,
+				requested: gitlab.MaintainerPermissions,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderTokenMember),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reason, err := InsufficientProviderToken(tc.args.c, tc.args.member, tc.args.required, tc.args.requested)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reason, reason); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+// Defaults of a RetryPolicy, matching the retries of the Gitlab client.
+const (
+	defaultMaxRetries = 5
+	defaultBaseDelay  = 100 * time.Millisecond
+	defaultMaxDelay   = 400 * time.Millisecond
+)
+
+// RetryPolicy configures how requests failing with a rate limit or server
+// error are retried.
+type RetryPolicy struct {
+	MaxRetries    int
+	BaseDelay     time.Duration
+	MaxDelay      time.Duration
+	JitterPercent int
+}
+
+// GenerateRetryPolicy produces a RetryPolicy from the retry configuration
+// of a ProviderConfig, filling unset fields with defaults.
+func GenerateRetryPolicy(p *v1beta1.RetryPolicy) *RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	rp := &RetryPolicy{
+		MaxRetries:    ptr.Deref(p.MaxRetries, defaultMaxRetries),
+		BaseDelay:     defaultBaseDelay,
+		MaxDelay:      defaultMaxDelay,
+		JitterPercent: ptr.Deref(p.JitterPercent, 0),
+	}
+	if p.BaseDelay != nil {
+		rp.BaseDelay = p.BaseDelay.Duration
+	}
+	if p.MaxDelay != nil {
+		rp.MaxDelay = p.MaxDelay.Duration
+	}
+	if rp.MaxDelay < rp.BaseDelay {
+		rp.MaxDelay = rp.BaseDelay
+	}
+	return rp
+}
+
+// clientOptions returns the options of a Gitlab client retrying as
+// configured by p.
+func (p *RetryPolicy) clientOptions() []gitlab.ClientOptionFunc {
+	if p.MaxRetries == 0 {
+		return []gitlab.ClientOptionFunc{gitlab.WithoutRetries()}
+	}
+	return []gitlab.ClientOptionFunc{
+		gitlab.WithCustomRetryMax(p.MaxRetries),
+		gitlab.WithCustomRetryWaitMinMax(p.BaseDelay, p.MaxDelay),
+		gitlab.WithCustomBackoff(p.backoff),
+	}
+}
+
+// backoff returns the delay before retry attemptNum. The delay doubles with
+// every attempt, or is the one Gitlab asks for with a Retry-After header,
+// and is bounded by max before jitter is applied.
+func (p *RetryPolicy) backoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	d := min << uint(attemptNum) //nolint:gosec
+	if d <= 0 || d > max {
+		d = max
+	}
+	if resp != nil {
+		if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s > 0 {
+			d = time.Duration(s) * time.Second
+			if d > max {
+				d = max
+			}
+		}
+	}
+	if p.JitterPercent > 0 {
+		spread := int64(d) * int64(p.JitterPercent) / 100
+		if spread > 0 {
+			d += time.Duration(rand.Int63n(2*spread+1) - spread) //nolint:gosec
+		}
+	}
+	return d
+}
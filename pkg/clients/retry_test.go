@@ -0,0 +1,158 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+func TestGenerateRetryPolicy(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1beta1.RetryPolicy
+		want *RetryPolicy
+	}{
+		"Unset": {},
+		"Defaults": {
+			p:    &v1beta1.RetryPolicy{},
+			want: &RetryPolicy{MaxRetries: defaultMaxRetries, BaseDelay: defaultBaseDelay, MaxDelay: defaultMaxDelay},
+		},
+		"Configured": {
+			p: &v1beta1.RetryPolicy{
+				MaxRetries:    ptr.To(2),
+				BaseDelay:     &metav1.Duration{Duration: time.Second},
+				MaxDelay:      &metav1.Duration{Duration: time.Minute},
+				JitterPercent: ptr.To(20),
+			},
+			want: &RetryPolicy{MaxRetries: 2, BaseDelay: time.Second, MaxDelay: time.Minute, JitterPercent: 20},
+		},
+		"MaxDelayBelowBaseDelay": {
+			p: &v1beta1.RetryPolicy{
+				BaseDelay: &metav1.Duration{Duration: time.Second},
+			},
+			want: &RetryPolicy{MaxRetries: defaultMaxRetries, BaseDelay: time.Second, MaxDelay: time.Second},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRetryPolicy(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateRetryPolicy: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	retryAfter := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+
+	cases := map[string]struct {
+		p       RetryPolicy
+		attempt int
+		resp    *http.Response
+		min     time.Duration
+		max     time.Duration
+	}{
+		"FirstAttempt": {
+			attempt: 0,
+			min:     time.Second,
+			max:     time.Second,
+		},
+		"Doubles": {
+			attempt: 2,
+			min:     4 * time.Second,
+			max:     4 * time.Second,
+		},
+		"BoundedByMaxDelay": {
+			attempt: 10,
+			min:     time.Minute,
+			max:     time.Minute,
+		},
+		"RetryAfter": {
+			attempt: 0,
+			resp:    retryAfter,
+			min:     2 * time.Second,
+			max:     2 * time.Second,
+		},
+		"Jitter": {
+			p:       RetryPolicy{JitterPercent: 50},
+			attempt: 2,
+			min:     2 * time.Second,
+			max:     6 * time.Second,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.p.backoff(time.Second, time.Minute, tc.attempt, tc.resp)
+			if got < tc.min || got > tc.max {
+				t.Errorf("backoff: want between %s and %s, got %s", tc.min, tc.max, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyClient(t *testing.T) {
+	cases := map[string]struct {
+		retries   int
+		wantCalls int
+		wantErr   bool
+	}{
+		"Retried": {
+			retries:   2,
+			wantCalls: 3,
+		},
+		"RetriesDisabled": {
+			retries:   0,
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{"id": 1}`))
+			}))
+			defer srv.Close()
+
+			git := NewClient(Config{BaseURL: srv.URL, Retry: &RetryPolicy{MaxRetries: tc.retries, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}})
+			_, _, err := git.Projects.GetProject(1, nil)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("GetProject: error: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantCalls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+)
+
+// HeaderSudo is the header Gitlab reads the user a request is made as from.
+const HeaderSudo = "Sudo"
+
+// AnnotationKeySudo sets the user the requests for a managed resource are
+// made as, overriding the sudo user of its ProviderConfig. An empty
+// annotation makes the requests as the owner of the token.
+const AnnotationKeySudo = "gitlab.crossplane.io/sudo"
+
+// sudoTransport makes requests as another user, so that Gitlab attributes
+// them to that user.
+type sudoTransport struct {
+	base http.RoundTripper
+	user string
+}
+
+func (t *sudoTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(HeaderSudo) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(HeaderSudo, t.user)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSudoTransport(t *testing.T) {
+	cases := map[string]struct {
+		sudo string
+		want string
+	}{
+		"NoSudo": {},
+		"Sudo": {
+			sudo: "service-user",
+			want: "service-user",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get(HeaderSudo)
+				_, _ = w.Write([]byte(`{"id": 1}`))
+			}))
+			defer srv.Close()
+
+			git := NewClient(Config{BaseURL: srv.URL, Sudo: tc.sudo})
+			if _, _, err := git.Projects.GetProject(1, nil); err != nil {
+				t.Fatalf("GetProject: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Sudo header: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// HeaderRequestID is the header Gitlab reads a correlation ID from and
+// reports the ID of a request in.
+const HeaderRequestID = "X-Request-Id"
+
+// requestIDTransport sends the ID of the reconcile a request is made for as
+// its correlation ID and logs the request ID Gitlab answers with, so provider
+// logs can be matched with Gitlab logs.
+type requestIDTransport struct {
+	base http.RoundTripper
+}
+
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	id := string(controller.ReconcileIDFromContext(ctx))
+	if id != "" && req.Header.Get(HeaderRequestID) == "" {
+		req = req.Clone(ctx)
+		req.Header.Set(HeaderRequestID, id)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if resp != nil {
+		log.FromContext(ctx).V(1).Info("Gitlab API request",
+			"method", req.Method,
+			"path", req.URL.Path,
+			"status", resp.StatusCode,
+			"correlationID", id,
+			"gitlabRequestID", resp.Header.Get(HeaderRequestID))
+	}
+	return resp, err
+}
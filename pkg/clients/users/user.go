@@ -20,6 +20,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
@@ -28,9 +30,13 @@ const (
 	errPullUserID  = "cant determine user by userName. Amount of users received: %v"
 )
 
+// stateActive is the state of users that can sign in.
+const stateActive = "active"
+
 // UserClient defines Gitlab User service operations
 type UserClient interface {
 	ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+	GetUser(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
 }
 
 // NewUserClient returns a new Gitlab User service
@@ -54,3 +60,27 @@ func GetUserID(git UserClient, username string) (*int, error) {
 
 	return &pulledUserID, nil
 }
+
+// StateCondition returns the Ready condition of a member whose user is in
+// state. Members of users that are blocked, banned or deactivated cannot use
+// the access they were granted, so they are Unavailable.
+func StateCondition(state string) xpv1.Condition {
+	if state == "" || state == stateActive {
+		return xpv1.Available()
+	}
+	return xpv1.Unavailable().WithMessage("user is " + state)
+}
+
+// HasIdentity returns true if u has an identity of provider, such as a SAML
+// identity. Gitlab only returns the identities of users to administrators.
+func HasIdentity(u *gitlab.User, provider string) bool {
+	if u == nil {
+		return false
+	}
+	for _, id := range u.Identities {
+		if id != nil && id.Provider == provider {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultAPIPath is the path of the Gitlab REST API relative to the base URL
+// of a Gitlab instance.
+const DefaultAPIPath = "api/v4"
+
+// Version is a Gitlab version, e.g. 15.11.
+type Version struct {
+	Major int
+	Minor int
+}
+
+// ParseVersion parses a Gitlab version of the form major.minor, optionally
+// followed by a patch level and suffixes, e.g. 15.11 or 15.11.3-ee.
+func ParseVersion(s string) (Version, error) {
+	parts := strings.SplitN(s, ".", 3)
+	if len(parts) < 2 {
+		return Version{}, errors.Errorf("invalid Gitlab version %q, the version must be of the form major.minor", s)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return Version{}, errors.Wrapf(err, "invalid major version of Gitlab version %q", s)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Version{}, errors.Wrapf(err, "invalid minor version of Gitlab version %q", s)
+	}
+	return Version{Major: major, Minor: minor}, nil
+}
+
+// Before returns true if v is an earlier version than o.
+func (v Version) Before(o Version) bool {
+	return v.Major < o.Major || (v.Major == o.Major && v.Minor < o.Minor)
+}
+
+// versionedField is a request field that only Gitlab versions since the one
+// introducing it accept.
+type versionedField struct {
+	// resource matches the request paths, relative to the API path, the
+	// field is sent to.
+	resource *regexp.Regexp
+	field    string
+	since    Version
+}
+
+var (
+	projectResource            = regexp.MustCompile(`^projects(/[^/]+)?$`)
+	variableResource           = regexp.MustCompile(`^(projects|groups)/[^/]+/variables(/[^/]+)?$`)
+	projectAccessTokenResource = regexp.MustCompile(`^projects/[^/]+/access_tokens$`)
+)
+
+// versionedFields lists the fields requests are shaped by. Add fields here
+// when a parameter is added that older Gitlab versions reject.
+var versionedFields = []versionedField{
+	{resource: projectResource, field: "merge_commit_template", since: Version{Major: 14, Minor: 5}},
+	{resource: projectResource, field: "squash_commit_template", since: Version{Major: 14, Minor: 6}},
+	{resource: projectResource, field: "ci_separated_caches", since: Version{Major: 15, Minor: 0}},
+	{resource: projectResource, field: "enforce_auth_checks_on_uploads", since: Version{Major: 15, Minor: 5}},
+	{resource: projectResource, field: "issue_branch_template", since: Version{Major: 15, Minor: 6}},
+	{resource: variableResource, field: "raw", since: Version{Major: 15, Minor: 7}},
+	{resource: variableResource, field: "description", since: Version{Major: 16, Minor: 2}},
+	{resource: projectAccessTokenResource, field: "access_level", since: Version{Major: 14, Minor: 8}},
+}
+
+// renamedField is a request field that Gitlab versions since the one
+// deprecating it know by a new name.
+type renamedField struct {
+	resource *regexp.Regexp
+	field    string
+	to       string
+	// negate is set if the new field is the boolean opposite of the old
+	// one, e.g. emails_enabled of emails_disabled.
+	negate bool
+	since  Version
+}
+
+// renamedFields lists the deprecated fields requests are sent with by the
+// Gitlab client, which are renamed for the Gitlab versions that deprecated
+// them.
+var renamedFields = []renamedField{
+	{resource: projectResource, field: "emails_disabled", to: "emails_enabled", negate: true, since: Version{Major: 16, Minor: 5}},
+	{resource: projectResource, field: "public_builds", to: "public_jobs", since: Version{Major: 17, Minor: 0}},
+}
+
+// apiTransport shapes requests for the API of a Gitlab instance. It serves
+// the API from apiPath instead of the default path of the Gitlab client, and
+// omits the fields of request bodies the version of the instance does not
+// know yet or renames the ones it deprecated.
+type apiTransport struct {
+	base http.RoundTripper
+
+	// defaultPrefix is the path prefix of requests made by the Gitlab
+	// client, prefix the one of the instance.
+	defaultPrefix string
+	prefix        string
+
+	// version of the instance, nil if unknown.
+	version *Version
+}
+
+// newAPITransport returns a transport for the instance at baseURL, or base
+// if the instance needs no shaping of requests.
+func newAPITransport(base http.RoundTripper, baseURL, apiPath string, version *Version) (http.RoundTripper, error) {
+	apiPath = strings.Trim(apiPath, "/")
+	if (apiPath == "" || apiPath == DefaultAPIPath) && version == nil {
+		return base, nil
+	}
+	if apiPath == "" {
+		apiPath = DefaultAPIPath
+	}
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse base URL")
+	}
+	root := strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/"+DefaultAPIPath) + "/"
+	return &apiTransport{
+		base:          base,
+		defaultPrefix: root + DefaultAPIPath + "/",
+		prefix:        root + apiPath + "/",
+		version:       version,
+	}, nil
+}
+
+func (t *apiTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.HasPrefix(req.URL.Path, t.defaultPrefix) {
+		return t.base.RoundTrip(req)
+	}
+	resource := strings.TrimPrefix(req.URL.EscapedPath(), t.defaultPrefix)
+
+	req = req.Clone(req.Context())
+	req.URL.Path = t.prefix + strings.TrimPrefix(req.URL.Path, t.defaultPrefix)
+	if req.URL.RawPath != "" {
+		req.URL.RawPath = t.prefix + strings.TrimPrefix(req.URL.RawPath, t.defaultPrefix)
+	}
+
+	if t.version != nil && req.Body != nil && req.Header.Get("Content-Type") == "application/json" {
+		if err := t.shapeFields(req, resource); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+// shapeFields removes the fields of the JSON body of req that the Gitlab
+// version of the instance does not know yet and renames the ones it
+// deprecated.
+func (t *apiTransport) shapeFields(req *http.Request, resource string) error {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.Wrap(err, "cannot read request body")
+	}
+	_ = req.Body.Close()
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err == nil {
+		shaped := false
+		for _, f := range versionedFields {
+			if _, ok := fields[f.field]; ok && t.version.Before(f.since) && f.resource.MatchString(resource) {
+				delete(fields, f.field)
+				shaped = true
+			}
+		}
+		for _, f := range renamedFields {
+			if v, ok := fields[f.field]; ok && !t.version.Before(f.since) && f.resource.MatchString(resource) {
+				if f.negate {
+					v = negateBool(v)
+				}
+				if _, ok := fields[f.to]; !ok {
+					fields[f.to] = v
+				}
+				delete(fields, f.field)
+				shaped = true
+			}
+		}
+		if shaped {
+			if body, err = json.Marshal(fields); err != nil {
+				return errors.Wrap(err, "cannot encode request body")
+			}
+		}
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return nil
+}
+
+// negateBool returns the JSON boolean opposite of v, or v if it is not a
+// boolean.
+func negateBool(v json.RawMessage) json.RawMessage {
+	var b bool
+	if err := json.Unmarshal(v, &b); err != nil {
+		return v
+	}
+	return json.RawMessage(strconv.FormatBool(!b))
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+)
+
+func TestParseVersion(t *testing.T) {
+	cases := map[string]struct {
+		version string
+		want    Version
+		wantErr bool
+	}{
+		"MajorMinor":   {version: "15.11", want: Version{Major: 15, Minor: 11}},
+		"Patch":        {version: "15.11.3-ee", want: Version{Major: 15, Minor: 11}},
+		"MissingMinor": {version: "15", wantErr: true},
+		"NotANumber":   {version: "v15.11", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseVersion(tc.version)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("ParseVersion(%q): error: -want, +got:\n%s", tc.version, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseVersion(%q): -want, +got:\n%s", tc.version, diff)
+			}
+		})
+	}
+}
+
+func TestAPITransport(t *testing.T) {
+	type request struct {
+		Path string
+		Body map[string]interface{}
+	}
+
+	cases := map[string]struct {
+		apiPath string
+		version *Version
+		want    request
+	}{
+		"Default": {
+			want: request{
+				Path: "/gitlab/api/v4/projects/1",
+				Body: map[string]interface{}{"name": "p", "issue_branch_template": "t", "emails_disabled": true, "public_builds": true},
+			},
+		},
+		"APIPath": {
+			apiPath: "/api/v5/",
+			want: request{
+				Path: "/gitlab/api/v5/projects/1",
+				Body: map[string]interface{}{"name": "p", "issue_branch_template": "t", "emails_disabled": true, "public_builds": true},
+			},
+		},
+		"OlderVersion": {
+			version: &Version{Major: 15, Minor: 5},
+			want: request{
+				Path: "/gitlab/api/v4/projects/1",
+				Body: map[string]interface{}{"name": "p", "emails_disabled": true, "public_builds": true},
+			},
+		},
+		"NewerVersion": {
+			version: &Version{Major: 15, Minor: 6},
+			want: request{
+				Path: "/gitlab/api/v4/projects/1",
+				Body: map[string]interface{}{"name": "p", "issue_branch_template": "t", "emails_disabled": true, "public_builds": true},
+			},
+		},
+		"RenamedFields": {
+			version: &Version{Major: 17, Minor: 0},
+			want: request{
+				Path: "/gitlab/api/v4/projects/1",
+				Body: map[string]interface{}{"name": "p", "issue_branch_template": "t", "emails_enabled": false, "public_jobs": true},
+			},
+		},
+		"PartlyRenamedFields": {
+			version: &Version{Major: 16, Minor: 5},
+			want: request{
+				Path: "/gitlab/api/v4/projects/1",
+				Body: map[string]interface{}{"name": "p", "issue_branch_template": "t", "emails_enabled": false, "public_builds": true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got request
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got.Path = r.URL.Path
+				b, _ := io.ReadAll(r.Body)
+				_ = json.Unmarshal(b, &got.Body)
+				_, _ = w.Write([]byte(`{"id": 1}`))
+			}))
+			defer srv.Close()
+
+			git := NewClient(Config{BaseURL: srv.URL + "/gitlab/", APIPath: tc.apiPath, Version: tc.version})
+			_, _, err := git.Projects.EditProject(1, &gitlab.EditProjectOptions{
+				Name:                ptr.To("p"),
+				IssueBranchTemplate: ptr.To("t"),
+				EmailsDisabled:      ptr.To(true),
+				PublicBuilds:        ptr.To(true),
+			})
+			if err != nil {
+				t.Fatalf("EditProject: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("request: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,8 +28,12 @@ import (
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
+// their current usage, and a controller that checks their connectivity.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := SetupConnectivity(mgr, o); err != nil {
+		return err
+	}
+
 	name := providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
 
 	of := resource.ProviderConfigKinds{
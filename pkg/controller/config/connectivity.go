@@ -0,0 +1,132 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/expiry"
+)
+
+const (
+	connectivityTimeout  = 30 * time.Second
+	connectivityInterval = 5 * time.Minute
+
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errUpdateStatus      = "cannot update ProviderConfig status"
+
+	reasonDisconnected  event.Reason = "Disconnected"
+	reasonTokenExpiring event.Reason = "TokenExpiring"
+)
+
+// SetupConnectivity adds a controller that checks whether Gitlab is
+// reachable with the ProviderConfigs that request it, and reports the
+// metadata of their tokens.
+func SetupConnectivity(mgr ctrl.Manager, o controller.Options) error {
+	name := "connectivity/" + v1beta1.ProviderConfigGroupKind
+
+	r := &connectivityReconciler{
+		client: mgr.GetClient(),
+		log:    o.Logger.WithValues("controller", name),
+		record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		check: func(ctx context.Context, cfg clients.Config) error {
+			return clients.CheckConnectivity(ctx, clients.NewClient(cfg))
+		},
+		token: func(ctx context.Context, cfg clients.Config) (*gitlab.PersonalAccessToken, error) {
+			return clients.GetToken(ctx, clients.NewClient(cfg))
+		},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.ProviderConfig{}).
+		Complete(r)
+}
+
+// connectivityReconciler reports in the Connected condition of
+// ProviderConfigs whether Gitlab is reachable with their credentials, and
+// warns before their tokens expire.
+type connectivityReconciler struct {
+	client client.Client
+	log    logging.Logger
+	record event.Recorder
+	check  func(ctx context.Context, cfg clients.Config) error
+	token  func(ctx context.Context, cfg clients.Config) (*gitlab.PersonalAccessToken, error)
+}
+
+func (r *connectivityReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	ctx, cancel := context.WithTimeout(ctx, connectivityTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if pc.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	cfg, err := clients.ConfigFromProviderConfig(ctx, r.client, pc)
+	if ptr.Deref(pc.Spec.CheckConnectivity, false) {
+		if err == nil {
+			err = r.check(ctx, *cfg)
+		}
+		if err != nil {
+			log.Debug("Gitlab is not reachable", "error", err)
+			r.record.Event(pc, event.Warning(reasonDisconnected, err))
+			pc.SetConditions(v1beta1.Disconnected(err))
+		} else {
+			pc.SetConditions(v1beta1.Connected())
+		}
+	}
+	if cfg != nil {
+		r.observeToken(ctx, log, pc, *cfg)
+	}
+	return reconcile.Result{RequeueAfter: connectivityInterval}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+}
+
+// observeToken records the metadata of the token of pc in its status. Not
+// every kind of token can describe itself, so failing to get the metadata
+// only clears it.
+func (r *connectivityReconciler) observeToken(ctx context.Context, log logging.Logger, pc *v1beta1.ProviderConfig, cfg clients.Config) {
+	t, err := r.token(ctx, cfg)
+	if err != nil {
+		log.Debug("Cannot get token metadata", "error", err)
+		pc.Status.Token = nil
+		return
+	}
+	pc.Status.Token = clients.GenerateTokenStatus(t)
+	if ts := pc.Status.Token; ts != nil && ts.ExpiresAt != nil && time.Until(ts.ExpiresAt.Time) < expiry.Warning {
+		r.record.Event(pc, event.Warning(reasonTokenExpiring, errors.Errorf("token %s expires at %s", ts.Name, ts.ExpiresAt.UTC().Format(time.DateOnly))))
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+func TestConnectivityReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}}
+
+	providerConfig := func(check *bool) *v1beta1.ProviderConfig {
+		return &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{
+			BaseURL:           "https://gitlab.example.com/",
+			CheckConnectivity: check,
+			Credentials: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{Key: "token"},
+				},
+			},
+		}}
+	}
+	get := func(pc *v1beta1.ProviderConfig) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.ProviderConfig:
+				pc.DeepCopyInto(o)
+			case *corev1.Secret:
+				o.Data = map[string][]byte{"token": []byte("secret")}
+			}
+			return nil
+		}
+	}
+
+	expiresAt := gitlab.ISOTime(time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC))
+	token := &gitlab.PersonalAccessToken{ID: 1, Name: "crossplane", Scopes: []string{"api"}, Active: true, ExpiresAt: &expiresAt}
+
+	type want struct {
+		result     reconcile.Result
+		err        error
+		conditions []xpv1.Condition
+		token      *v1beta1.TokenStatus
+		checked    bool
+	}
+
+	cases := map[string]struct {
+		pc       *v1beta1.ProviderConfig
+		check    error
+		token    *gitlab.PersonalAccessToken
+		tokenErr error
+		want     want
+	}{
+		"CheckDisabled": {
+			pc: providerConfig(nil),
+			want: want{
+				result: reconcile.Result{RequeueAfter: connectivityInterval},
+			},
+		},
+		"TokenMetadata": {
+			pc:    providerConfig(nil),
+			token: token,
+			want: want{
+				result: reconcile.Result{RequeueAfter: connectivityInterval},
+				token: &v1beta1.TokenStatus{
+					ID:        1,
+					Name:      "crossplane",
+					Scopes:    []string{"api"},
+					Active:    true,
+					ExpiresAt: &metav1.Time{Time: time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+		},
+		"TokenMetadataUnavailable": {
+			pc:       providerConfig(nil),
+			tokenErr: errBoom,
+			want: want{
+				result: reconcile.Result{RequeueAfter: connectivityInterval},
+			},
+		},
+		"Connected": {
+			pc: providerConfig(ptr.To(true)),
+			want: want{
+				result:     reconcile.Result{RequeueAfter: connectivityInterval},
+				conditions: []xpv1.Condition{v1beta1.Connected()},
+				checked:    true,
+			},
+		},
+		"Disconnected": {
+			pc:    providerConfig(ptr.To(true)),
+			check: errBoom,
+			want: want{
+				result:     reconcile.Result{RequeueAfter: connectivityInterval},
+				conditions: []xpv1.Condition{v1beta1.Disconnected(errBoom)},
+				checked:    true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var conditions []xpv1.Condition
+			var tokenStatus *v1beta1.TokenStatus
+			checked := false
+			r := &connectivityReconciler{
+				client: &test.MockClient{
+					MockGet: get(tc.pc),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						conditions = obj.(*v1beta1.ProviderConfig).Status.Conditions
+						tokenStatus = obj.(*v1beta1.ProviderConfig).Status.Token
+						return nil
+					},
+				},
+				log:    logging.NewNopLogger(),
+				record: event.NewNopRecorder(),
+				check: func(_ context.Context, cfg clients.Config) error {
+					checked = true
+					if cfg.Token != "secret" {
+						t.Errorf("check: want token %q, got %q", "secret", cfg.Token)
+					}
+					return tc.check
+				},
+				token: func(_ context.Context, _ clients.Config) (*gitlab.PersonalAccessToken, error) {
+					return tc.token, tc.tokenErr
+				},
+			}
+
+			got, err := r.Reconcile(context.Background(), req)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.checked, checked); diff != "" {
+				t.Errorf("checked: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, conditions, test.EquateConditions(), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("conditions: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, tokenStatus); diff != "" {
+				t.Errorf("token: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
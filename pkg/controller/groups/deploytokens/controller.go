@@ -25,6 +25,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,9 +39,19 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/expiry"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -50,6 +61,9 @@ const (
 	errDeleteFailed   = "cannot delete Gitlab deploytoken"
 	errIDNotInt       = "ID is not integer value"
 	errGroupIDMissing = "GroupID is missing"
+
+	reasonRevoked       event.Reason = "RevokedExternalResource"
+	reasonRevokeSkipped event.Reason = "SkippedRevokingExternalResource"
 )
 
 // SetupDeployToken adds a controller that reconciles GroupDeployTokens.
@@ -61,12 +75,14 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewDeployTokenClient}),
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.DeployTokenGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.DeployTokenGroupVersionKind, clients.NewBreakerConnecter(expiry.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), recorder: recorder, newGitlabClientFn: groups.NewDeployTokenClient})))))))))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -74,18 +90,26 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.DeployTokenGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.DeployToken{}).
-		Complete(r)
+		For(&v1alpha1.DeployToken{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.DeployToken{}, &v1alpha1.DeployTokenList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
 	kube              client.Client
+	recorder          event.Recorder
 	newGitlabClientFn func(cfg clients.Config) groups.DeployTokenClient
 }
 
@@ -98,12 +122,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	return &external{kube: c.kube, recorder: c.recorder, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client groups.DeployTokenClient
+	kube     client.Client
+	recorder event.Recorder
+	client   groups.DeployTokenClient
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -117,6 +142,13 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
+	// Report a token kept valid on deletion as gone, so that the managed
+	// resource is finalized without calling Delete.
+	if meta.WasDeleted(cr) && ptr.Deref(cr.Spec.ForProvider.SkipRevokeOnDelete, false) {
+		e.recorder.Event(cr, event.Normal(reasonRevokeSkipped, "Not revoking "+clients.DescribeToken("group deploy token", externalName, cr.Name, cr.Spec.ForProvider.Scopes, cr.Spec.ForProvider.ExpiresAt)))
+		return managed.ExternalObservation{}, nil
+	}
+
 	id, err := strconv.Atoi(externalName)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.New(errIDNotInt)
@@ -126,7 +158,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
 	}
 
-	dt, res, err := e.client.GetGroupDeployToken(*cr.Spec.ForProvider.GroupID, id)
+	dt, res, err := e.client.GetGroupDeployToken(*cr.Spec.ForProvider.GroupID, id, gitlab.WithContext(ctx))
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -142,7 +174,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        true,
+		ResourceUpToDate:        groups.IsDeployTokenUpToDate(&cr.Spec.ForProvider, dt),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -200,8 +232,11 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		deployTokenID,
 		gitlab.WithContext(ctx),
 	)
-
-	return errors.Wrap(deleteError, errDeleteFailed)
+	if deleteError != nil {
+		return errors.Wrap(deleteError, errDeleteFailed)
+	}
+	e.recorder.Event(cr, event.Normal(reasonRevoked, "Revoked "+clients.DescribeToken("group deploy token", meta.GetExternalName(cr), cr.Name, cr.Spec.ForProvider.Scopes, cr.Spec.ForProvider.ExpiresAt)))
+	return nil
 }
 
 // lateInitializeGroupDeployToken fills the empty fields in the deploy token spec with the
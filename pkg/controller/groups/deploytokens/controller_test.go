@@ -29,6 +29,7 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -215,6 +216,7 @@ func TestObserve(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						GroupID: &deployTokenID,
+						Scopes:  []string{"scope2", "scope1"},
 					}),
 					withExternalName(sDeployTokenID),
 				),
@@ -223,6 +225,7 @@ func TestObserve(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						GroupID:   &deployTokenID,
+						Scopes:    []string{"scope2", "scope1"},
 						Username:  &username,
 						ExpiresAt: &metav1.Time{Time: expiresAt},
 					}),
@@ -246,6 +249,7 @@ func TestObserve(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						GroupID:   &deployTokenID,
+						Scopes:    []string{"scope2", "scope1"},
 						Username:  &username,
 						ExpiresAt: &metav1.Time{Time: expiresAt},
 					}),
@@ -256,6 +260,7 @@ func TestObserve(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						GroupID:   &deployTokenID,
+						Scopes:    []string{"scope2", "scope1"},
 						Username:  &username,
 						ExpiresAt: &metav1.Time{Time: expiresAt},
 					}),
@@ -273,7 +278,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.deployToken}
+			e := &external{recorder: event.NewNopRecorder(), kube: tc.kube, client: tc.deployToken}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -365,7 +370,7 @@ func TestCreate(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.deployToken}
+			e := &external{recorder: event.NewNopRecorder(), kube: tc.kube, client: tc.deployToken}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -403,7 +408,7 @@ func TestUpdate(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.deployToken}
+			e := &external{recorder: event.NewNopRecorder(), kube: tc.kube, client: tc.deployToken}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -513,7 +518,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.deployToken}
+			e := &external{recorder: event.NewNopRecorder(), client: tc.deployToken}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -39,9 +39,19 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/timeouts"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -68,7 +78,7 @@ func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewGroupClient}),
+		managed.WithExternalConnecter(timeouts.NewConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.GroupKubernetesGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.GroupKubernetesGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewGroupClient})))))))))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -80,14 +90,21 @@ func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.GroupKubernetesGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.Group{}).
-		Complete(r)
+		For(&v1alpha1.Group{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Group{}, &v1alpha1.GroupList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
@@ -128,7 +145,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errIDNotInt)
 	}
 
-	grp, res, err := e.client.GetGroup(groupID, nil)
+	grp, res, err := e.client.GetGroup(groupID, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -204,7 +221,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 				if sh.ExpiresAt != nil {
 					opt.ExpiresAt = (*gitlab.ISOTime)(&sh.ExpiresAt.Time) //nolint:gosec
 				}
-				_, _, err = e.client.ShareGroupWithGroup(grp.ID, &opt)
+				_, _, err = e.client.ShareGroupWithGroup(grp.ID, &opt, gitlab.WithContext(ctx))
 				if err != nil {
 					return managed.ExternalUpdate{}, errors.Wrapf(err, errShareFailed, *sh.GroupID)
 				}
@@ -219,7 +236,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 				return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 			}
 			if isNotUnshared {
-				_, err = e.client.UnshareGroupFromGroup(grp.ID, sh.GroupID)
+				_, err = e.client.UnshareGroupFromGroup(grp.ID, sh.GroupID, gitlab.WithContext(ctx))
 				if err != nil {
 					return managed.ExternalUpdate{}, errors.Wrapf(err, errUnshareFailed, sh.GroupID)
 				}
@@ -245,7 +262,7 @@ func isGroupUpToDate(p *v1alpha1.GroupParameters, g *gitlab.Group) (bool, error)
 	if p.Name != nil && !cmp.Equal(*p.Name, g.Name) {
 		return false, nil
 	}
-	if !cmp.Equal(p.Path, g.Path) {
+	if clients.NormalizePath(p.Path) != clients.NormalizePath(g.Path) {
 		return false, nil
 	}
 	if !cmp.Equal(p.Description, clients.StringToPtr(g.Description)) {
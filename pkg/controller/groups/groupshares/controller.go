@@ -0,0 +1,244 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupshares
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotGroupShare            = "managed resource is not a Gitlab group share custom resource"
+	errGroupIDMissing           = "GroupID is missing"
+	errSharedWithGroupIDMissing = "SharedWithGroupID is missing"
+	errSharedWithGroupIDNotInt  = "SharedWithGroupID is not an integer"
+	errExpiresAtInvalid         = "ExpiresAt is not a date in the format YEAR-MONTH-DAY"
+	errGetFailed                = "cannot get Gitlab group share"
+	errCreateFailed             = "cannot create Gitlab group share"
+	errUpdateFailed             = "cannot update Gitlab group share"
+	errDeleteFailed             = "cannot delete Gitlab group share"
+)
+
+// SetupGroupShare adds a controller that reconciles GroupShares.
+func SetupGroupShare(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.GroupShareKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.GroupShareGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.GroupShareGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewShareClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.GroupShareGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.GroupShare{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.GroupShare{}, &v1alpha1.GroupShareList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) groups.ShareClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return nil, errors.New(errNotGroupShare)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client groups.ShareClient
+}
+
+// ids returns the group that is shared and the group it is shared with.
+func ids(cr *v1alpha1.GroupShare) (string, int, error) {
+	if cr.Spec.ForProvider.GroupID == nil {
+		return "", 0, errors.New(errGroupIDMissing)
+	}
+	if cr.Spec.ForProvider.SharedWithGroupID == nil {
+		return "", 0, errors.New(errSharedWithGroupIDMissing)
+	}
+	groupID, err := strconv.Atoi(*cr.Spec.ForProvider.SharedWithGroupID)
+	if err != nil {
+		return "", 0, errors.Wrap(err, errSharedWithGroupIDNotInt)
+	}
+	return *cr.Spec.ForProvider.GroupID, groupID, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroupShare)
+	}
+
+	gid, groupID, err := ids(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	grp, res, err := e.client.GetGroup(gid, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	o := groups.GenerateGroupShareObservation(grp, groupID)
+	if o == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// The share is identified by the group it is shared with, which an
+	// existing share is adopted by as well.
+	adopted := false
+	if meta.GetExternalName(cr) != strconv.Itoa(groupID) {
+		meta.SetExternalName(cr, strconv.Itoa(groupID))
+		adopted = true
+	}
+
+	cr.Status.AtProvider = *o
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        groups.IsGroupShareUpToDate(&cr.Spec.ForProvider, o),
+		ResourceLateInitialized: adopted,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroupShare)
+	}
+
+	gid, groupID, err := ids(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	opt, err := groups.GenerateShareGroupWithGroupOptions(groupID, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errExpiresAtInvalid)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if _, _, err := e.client.ShareGroupWithGroup(gid, opt, gitlab.WithContext(ctx)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(groupID))
+	return managed.ExternalCreation{}, nil
+}
+
+// Update shares the group with the other group again, as Gitlab cannot
+// change the access level or the expiry of a share.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGroupShare)
+	}
+
+	gid, groupID, err := ids(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	opt, err := groups.GenerateShareGroupWithGroupOptions(groupID, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errExpiresAtInvalid)
+	}
+
+	if _, err := e.client.UnshareGroupFromGroup(gid, groupID, gitlab.WithContext(ctx)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	_, _, err = e.client.ShareGroupWithGroup(gid, opt, gitlab.WithContext(ctx))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return errors.New(errNotGroupShare)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	gid, groupID, err := ids(cr)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.client.UnshareGroupFromGroup(gid, groupID, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errDeleteFailed)
+}
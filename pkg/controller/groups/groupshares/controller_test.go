@@ -0,0 +1,360 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupshares
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
+)
+
+var (
+	errBoom           = errors.New("boom")
+	groupID           = "1234"
+	sharedWithGroupID = "5"
+	expiresAt         = gitlab.ISOTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+)
+
+type shareModifier func(*v1alpha1.GroupShare)
+
+func withConditions(c ...xpv1.Condition) shareModifier {
+	return func(r *v1alpha1.GroupShare) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withGroupID() shareModifier {
+	return func(r *v1alpha1.GroupShare) { r.Spec.ForProvider.GroupID = &groupID }
+}
+
+func withSharedWithGroupID(id string) shareModifier {
+	return func(r *v1alpha1.GroupShare) { r.Spec.ForProvider.SharedWithGroupID = &id }
+}
+
+func withGroupAccess(l int) shareModifier {
+	return func(r *v1alpha1.GroupShare) { r.Spec.ForProvider.GroupAccess = v1alpha1.AccessLevelValue(l) }
+}
+
+func withExpiresAt(d string) shareModifier {
+	return func(r *v1alpha1.GroupShare) { r.Spec.ForProvider.ExpiresAt = &d }
+}
+
+func withExternalName(n string) shareModifier {
+	return func(r *v1alpha1.GroupShare) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.GroupShareObservation) shareModifier {
+	return func(r *v1alpha1.GroupShare) { r.Status.AtProvider = s }
+}
+
+func share(m ...shareModifier) *v1alpha1.GroupShare {
+	cr := &v1alpha1.GroupShare{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func sharedGroup() *gitlab.Group {
+	grp := &gitlab.Group{}
+	grp.SharedWithGroups = append(grp.SharedWithGroups, struct {
+		GroupID          int             `json:"group_id"`
+		GroupName        string          `json:"group_name"`
+		GroupFullPath    string          `json:"group_full_path"`
+		GroupAccessLevel int             `json:"group_access_level"`
+		ExpiresAt        *gitlab.ISOTime `json:"expires_at"`
+	}{GroupID: 5, GroupName: "devs", GroupFullPath: "org/devs", GroupAccessLevel: 30, ExpiresAt: &expiresAt})
+	return grp
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GroupShare
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := v1alpha1.GroupShareObservation{
+		GroupName:        "devs",
+		GroupFullPath:    "org/devs",
+		GroupAccessLevel: 30,
+		ExpiresAt:        "2030-01-01",
+	}
+	get := func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+		return sharedGroup(), &gitlab.Response{}, nil
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.GroupShare
+		want
+	}{
+		"GroupIDMissing": {
+			cr: share(withSharedWithGroupID(sharedWithGroupID)),
+			want: want{
+				cr:  share(withSharedWithGroupID(sharedWithGroupID)),
+				err: errors.New(errGroupIDMissing),
+			},
+		},
+		"SharedWithGroupIDMissing": {
+			cr: share(withGroupID()),
+			want: want{
+				cr:  share(withGroupID()),
+				err: errors.New(errSharedWithGroupIDMissing),
+			},
+		},
+		"GroupNotFound": {
+			client: &fake.MockClient{
+				MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+				},
+			},
+			cr: share(withGroupID(), withSharedWithGroupID(sharedWithGroupID)),
+			want: want{
+				cr: share(withGroupID(), withSharedWithGroupID(sharedWithGroupID)),
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: share(withGroupID(), withSharedWithGroupID(sharedWithGroupID)),
+			want: want{
+				cr:  share(withGroupID(), withSharedWithGroupID(sharedWithGroupID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"NotShared": {
+			client: &fake.MockClient{MockGetGroup: get},
+			cr:     share(withGroupID(), withSharedWithGroupID("7")),
+			want: want{
+				cr: share(withGroupID(), withSharedWithGroupID("7")),
+			},
+		},
+		"Adopted": {
+			client: &fake.MockClient{MockGetGroup: get},
+			cr:     share(withGroupID(), withSharedWithGroupID(sharedWithGroupID), withGroupAccess(30), withExpiresAt("2030-01-01")),
+			want: want{
+				cr: share(
+					withGroupID(),
+					withSharedWithGroupID(sharedWithGroupID),
+					withGroupAccess(30),
+					withExpiresAt("2030-01-01"),
+					withExternalName(sharedWithGroupID),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ExpiryChanged": {
+			client: &fake.MockClient{MockGetGroup: get},
+			cr:     share(withGroupID(), withSharedWithGroupID(sharedWithGroupID), withGroupAccess(30), withExternalName(sharedWithGroupID)),
+			want: want{
+				cr: share(
+					withGroupID(),
+					withSharedWithGroupID(sharedWithGroupID),
+					withGroupAccess(30),
+					withExternalName(sharedWithGroupID),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GroupShare
+		shared bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.GroupShare
+		want
+	}{
+		"SuccessfulCreation": {
+			cr: share(withGroupID(), withSharedWithGroupID(sharedWithGroupID), withGroupAccess(30)),
+			want: want{
+				cr: share(
+					withGroupID(),
+					withSharedWithGroupID(sharedWithGroupID),
+					withGroupAccess(30),
+					withExternalName(sharedWithGroupID),
+					withConditions(xpv1.Creating()),
+				),
+				shared: true,
+			},
+		},
+		"InvalidExpiry": {
+			cr: share(withGroupID(), withSharedWithGroupID(sharedWithGroupID), withExpiresAt("next year")),
+			want: want{
+				cr:  share(withGroupID(), withSharedWithGroupID(sharedWithGroupID), withExpiresAt("next year")),
+				err: errors.Wrap(errors.New(`parsing time "next year" as "2006-01-02": cannot parse "next year" as "2006"`), errExpiresAtInvalid),
+			},
+		},
+		"FailedCreation": {
+			err: errBoom,
+			cr:  share(withGroupID(), withSharedWithGroupID(sharedWithGroupID)),
+			want: want{
+				cr:     share(withGroupID(), withSharedWithGroupID(sharedWithGroupID), withConditions(xpv1.Creating())),
+				shared: true,
+				err:    errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			shared := false
+			e := &external{client: &fake.MockClient{
+				MockShareGroupWithGroup: func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+					shared = true
+					return &gitlab.Group{}, &gitlab.Response{}, tc.err
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.shared, shared); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		unshareErr error
+		shareErr   error
+		want
+	}{
+		"SharedAgain": {
+			want: want{
+				calls: []string{"unshare", "share"},
+			},
+		},
+		"FailedUnshare": {
+			unshareErr: errBoom,
+			want: want{
+				calls: []string{"unshare"},
+				err:   errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"FailedShare": {
+			shareErr: errBoom,
+			want: want{
+				calls: []string{"unshare", "share"},
+				err:   errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &fake.MockClient{
+				MockUnshareGroupFromGroup: func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					calls = append(calls, "unshare")
+					return &gitlab.Response{}, tc.unshareErr
+				},
+				MockShareGroupWithGroup: func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+					calls = append(calls, "share")
+					return &gitlab.Group{}, &gitlab.Response{}, tc.shareErr
+				},
+			}}
+			_, err := e.Update(context.Background(), share(withGroupID(), withSharedWithGroupID(sharedWithGroupID), withGroupAccess(40), withExternalName(sharedWithGroupID)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	var id int
+	cr := share(withGroupID(), withSharedWithGroupID(sharedWithGroupID), withExternalName(sharedWithGroupID))
+	e := &external{client: &fake.MockClient{
+		MockUnshareGroupFromGroup: func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			id = groupID
+			return &gitlab.Response{}, nil
+		},
+	}}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(5, id); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(share(withGroupID(), withSharedWithGroupID(sharedWithGroupID), withExternalName(sharedWithGroupID), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grouptrees
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+const (
+	errGetTree       = "cannot get GroupTree"
+	errRender        = "cannot render GroupTree"
+	errDuplicatePath = "path %s is declared more than once"
+	errMissingParent = "parent %s of %s is not declared"
+	errApply         = "cannot apply Group %s"
+	errListRendered  = "cannot list Groups rendered from GroupTree"
+	errDeleteRemoved = "cannot delete Group %s removed from GroupTree"
+	errUpdateStatus  = "cannot update GroupTree status"
+
+	reasonRenderFailed event.Reason = "RenderFailed"
+)
+
+// SetupGroupTree adds a controller that renders GroupTrees into a Group
+// per subgroup.
+func SetupGroupTree(mgr ctrl.Manager, o controller.Options) error {
+	name := "grouptree/" + v1alpha1.GroupTreeGroupKind
+
+	r := &reconciler{
+		client: mgr.GetClient(),
+		apply:  resource.NewAPIPatchingApplicator(mgr.GetClient()),
+		log:    o.Logger.WithValues("controller", name),
+		record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.GroupTree{}).
+		Owns(&v1alpha1.Group{}).
+		Complete(r)
+}
+
+// reconciler applies the Groups rendered from a GroupTree, deletes the ones
+// removed from it, and reports whether all are ready. Deleting the tree
+// deletes its Groups by garbage collection.
+type reconciler struct {
+	client client.Client
+	apply  resource.Applicator
+	log    logging.Logger
+	record event.Recorder
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	gt := &v1alpha1.GroupTree{}
+	if err := r.client.Get(ctx, req.NamespacedName, gt); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetTree)
+	}
+	if gt.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	rendered, err := Render(gt)
+	if err != nil {
+		err = errors.Wrap(err, errRender)
+		r.record.Event(gt, event.Warning(reasonRenderFailed, err))
+		gt.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, gt), errUpdateStatus)
+	}
+
+	if err := r.sync(ctx, gt, rendered); err != nil {
+		log.Debug("Cannot sync GroupTree", "error", err)
+		gt.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, gt), errUpdateStatus)
+	}
+
+	gt.Status.Groups = make([]v1alpha1.GroupTreeGroup, 0, len(rendered))
+	ready := true
+	for i, g := range rendered {
+		ok := g.GetCondition(xpv1.TypeReady).Status == "True"
+		ready = ready && ok
+		gt.Status.Groups = append(gt.Status.Groups, v1alpha1.GroupTreeGroup{
+			Path:  gt.Spec.Groups[i].Path,
+			Name:  g.GetName(),
+			ID:    g.Status.AtProvider.ID,
+			Ready: ok,
+		})
+	}
+	gt.SetConditions(xpv1.ReconcileSuccess(), xpv1.Creating())
+	if ready {
+		gt.SetConditions(xpv1.Available())
+	}
+	return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, gt), errUpdateStatus)
+}
+
+// sync applies the rendered Groups and deletes the Groups of gt that are no
+// longer rendered.
+func (r *reconciler) sync(ctx context.Context, gt *v1alpha1.GroupTree, rendered []*v1alpha1.Group) error {
+	keep := map[string]bool{}
+	for _, g := range rendered {
+		keep[g.GetName()] = true
+		if err := r.apply.Apply(ctx, g, resource.MustBeControllableBy(gt.GetUID())); err != nil {
+			return errors.Wrapf(err, errApply, g.GetName())
+		}
+	}
+
+	l := &v1alpha1.GroupList{}
+	if err := r.client.List(ctx, l, client.MatchingLabels{v1alpha1.LabelGroupTree: gt.GetName()}); err != nil {
+		return errors.Wrap(err, errListRendered)
+	}
+	for i := range l.Items {
+		g := &l.Items[i]
+		if keep[g.GetName()] || !metav1.IsControlledBy(g, gt) {
+			continue
+		}
+		if err := r.client.Delete(ctx, g); resource.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(err, errDeleteRemoved, g.GetName())
+		}
+	}
+	return nil
+}
+
+// Render returns a Group for every subgroup of gt, in the order they are
+// declared. The Groups of the first level refer to the root group of gt,
+// the others to the Group of their parent, so that every Group is created
+// once its parent exists.
+func Render(gt *v1alpha1.GroupTree) ([]*v1alpha1.Group, error) {
+	declared := map[string]bool{}
+	for _, n := range gt.Spec.Groups {
+		if declared[n.Path] {
+			return nil, errors.Errorf(errDuplicatePath, n.Path)
+		}
+		declared[n.Path] = true
+	}
+
+	rs := xpv1.ResourceSpec{ProviderConfigReference: gt.Spec.ProviderConfigReference, DeletionPolicy: gt.Spec.DeletionPolicy}
+	out := make([]*v1alpha1.Group, 0, len(gt.Spec.Groups))
+	names := map[string]bool{}
+	for _, n := range gt.Spec.Groups {
+		p := v1alpha1.GroupParameters{
+			Path:        path.Base(n.Path),
+			Name:        n.Name,
+			Description: n.Description,
+			Visibility:  n.Visibility,
+		}
+		if gt.Spec.ConfirmDelete != nil {
+			p.ConfirmDelete = ptr.To(*gt.Spec.ConfirmDelete)
+		}
+		if p.Name == nil {
+			p.Name = ptr.To(p.Path)
+		}
+		switch parent := path.Dir(n.Path); {
+		case parent == ".":
+			p.ParentIDRef = gt.Spec.RootGroupRef.DeepCopy()
+			if gt.Spec.RootGroupID != nil {
+				p.ParentID = ptr.To(*gt.Spec.RootGroupID)
+			}
+		case declared[parent]:
+			p.ParentIDRef = &xpv1.Reference{Name: groupName(gt, parent)}
+		default:
+			return nil, errors.Errorf(errMissingParent, parent, n.Path)
+		}
+
+		g := &v1alpha1.Group{Spec: v1alpha1.GroupSpec{ResourceSpec: rs, ForProvider: p}}
+		setMeta(g, gt, groupName(gt, n.Path))
+		// Paths that only differ in case or punctuation share a name.
+		if names[g.GetName()] {
+			return nil, errors.Errorf(errDuplicatePath, n.Path)
+		}
+		names[g.GetName()] = true
+		out = append(out, g)
+	}
+	return out, nil
+}
+
+// groupName returns the name of the Group rendered for the subgroup at p.
+func groupName(gt *v1alpha1.GroupTree, p string) string {
+	return gt.GetName() + "-" + strings.NewReplacer("/", "-", "_", "-", ".", "-").Replace(strings.ToLower(p))
+}
+
+// setMeta names g and marks it as controlled by gt.
+func setMeta(g *v1alpha1.Group, gt *v1alpha1.GroupTree, name string) {
+	g.SetGroupVersionKind(v1alpha1.GroupKubernetesGroupVersionKind)
+	g.SetName(name)
+	g.SetLabels(map[string]string{v1alpha1.LabelGroupTree: gt.GetName()})
+	meta.AddOwnerReference(g, meta.AsController(meta.TypedReferenceTo(gt, v1alpha1.GroupTreeGroupVersionKind)))
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grouptrees
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+func tree(groups ...v1alpha1.GroupTreeNode) *v1alpha1.GroupTree {
+	return &v1alpha1.GroupTree{
+		ObjectMeta: metav1.ObjectMeta{Name: "gt", UID: types.UID("uid")},
+		Spec: v1alpha1.GroupTreeSpec{
+			ProviderConfigReference: &xpv1.Reference{Name: "default"},
+			RootGroupID:             ptr.To(7),
+			Groups:                  groups,
+		},
+	}
+}
+
+func TestRender(t *testing.T) {
+	type want struct {
+		params map[string]v1alpha1.GroupParameters
+		policy xpv1.DeletionPolicy
+		err    error
+	}
+
+	cases := map[string]struct {
+		tree *v1alpha1.GroupTree
+		want want
+	}{
+		"Nested": {
+			tree: tree(
+				v1alpha1.GroupTreeNode{Path: "platform/Infra_Team", Visibility: ptr.To(v1alpha1.PrivateVisibility)},
+				v1alpha1.GroupTreeNode{Path: "platform", Name: ptr.To("Platform"), Description: ptr.To("desc")},
+			),
+			want: want{
+				params: map[string]v1alpha1.GroupParameters{
+					"gt-platform-infra-team": {
+						Path:        "Infra_Team",
+						Name:        ptr.To("Infra_Team"),
+						Visibility:  ptr.To(v1alpha1.PrivateVisibility),
+						ParentIDRef: &xpv1.Reference{Name: "gt-platform"},
+					},
+					"gt-platform": {
+						Path:        "platform",
+						Name:        ptr.To("Platform"),
+						Description: ptr.To("desc"),
+						ParentID:    ptr.To(7),
+					},
+				},
+			},
+		},
+		"ConfirmedDelete": {
+			tree: func() *v1alpha1.GroupTree {
+				gt := tree(v1alpha1.GroupTreeNode{Path: "platform"}, v1alpha1.GroupTreeNode{Path: "platform/infra"})
+				gt.Spec.DeletionPolicy = xpv1.DeletionDelete
+				gt.Spec.ConfirmDelete = ptr.To(true)
+				return gt
+			}(),
+			want: want{
+				params: map[string]v1alpha1.GroupParameters{
+					"gt-platform": {
+						Path:          "platform",
+						Name:          ptr.To("platform"),
+						ParentID:      ptr.To(7),
+						ConfirmDelete: ptr.To(true),
+					},
+					"gt-platform-infra": {
+						Path:          "infra",
+						Name:          ptr.To("infra"),
+						ParentIDRef:   &xpv1.Reference{Name: "gt-platform"},
+						ConfirmDelete: ptr.To(true),
+					},
+				},
+				policy: xpv1.DeletionDelete,
+			},
+		},
+		"MissingParent": {
+			tree: tree(v1alpha1.GroupTreeNode{Path: "platform/infra"}),
+			want: want{err: errors.Errorf(errMissingParent, "platform", "platform/infra")},
+		},
+		"DuplicatePath": {
+			tree: tree(v1alpha1.GroupTreeNode{Path: "platform"}, v1alpha1.GroupTreeNode{Path: "platform"}),
+			want: want{err: errors.Errorf(errDuplicatePath, "platform")},
+		},
+		"DuplicateName": {
+			tree: tree(v1alpha1.GroupTreeNode{Path: "a-b"}, v1alpha1.GroupTreeNode{Path: "a.b"}),
+			want: want{err: errors.Errorf(errDuplicatePath, "a.b")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Render(tc.tree)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Render: error: -want, +got:\n%s", diff)
+			}
+			params := map[string]v1alpha1.GroupParameters{}
+			for _, g := range got {
+				params[g.GetName()] = g.Spec.ForProvider
+				if diff := cmp.Diff("gt", g.GetLabels()[v1alpha1.LabelGroupTree]); diff != "" {
+					t.Errorf("Render %s: label: -want, +got:\n%s", g.GetName(), diff)
+				}
+				if diff := cmp.Diff(types.UID("uid"), metav1.GetControllerOf(g).UID); diff != "" {
+					t.Errorf("Render %s: controller: -want, +got:\n%s", g.GetName(), diff)
+				}
+				if diff := cmp.Diff(tc.want.policy, g.GetDeletionPolicy()); diff != "" {
+					t.Errorf("Render %s: deletion policy: -want, +got:\n%s", g.GetName(), diff)
+				}
+			}
+			if tc.want.params == nil {
+				tc.want.params = map[string]v1alpha1.GroupParameters{}
+			}
+			if diff := cmp.Diff(tc.want.params, params); diff != "" {
+				t.Errorf("Render: params: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		result  reconcile.Result
+		err     error
+		status  *v1alpha1.GroupTreeStatus
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		tree  *v1alpha1.GroupTree
+		apply resource.ApplyFn
+		list  test.MockListFn
+		want  want
+	}{
+		"NotAllReady": {
+			tree: tree(v1alpha1.GroupTreeNode{Path: "platform"}, v1alpha1.GroupTreeNode{Path: "platform/infra"}),
+			apply: func(_ context.Context, o client.Object, _ ...resource.ApplyOption) error {
+				if g := o.(*v1alpha1.Group); g.GetName() == "gt-platform" {
+					g.Status.AtProvider.ID = ptr.To(42)
+					g.SetConditions(xpv1.Available())
+				}
+				return nil
+			},
+			list: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+				removed := v1alpha1.Group{ObjectMeta: metav1.ObjectMeta{Name: "gt-removed"}}
+				removed.SetOwnerReferences([]metav1.OwnerReference{{UID: "uid", Controller: ptr.To(true)}})
+				foreign := v1alpha1.Group{ObjectMeta: metav1.ObjectMeta{Name: "gt-foreign"}}
+				kept := v1alpha1.Group{ObjectMeta: metav1.ObjectMeta{Name: "gt-platform"}}
+				kept.SetOwnerReferences([]metav1.OwnerReference{{UID: "uid", Controller: ptr.To(true)}})
+				obj.(*v1alpha1.GroupList).Items = []v1alpha1.Group{removed, foreign, kept}
+				return nil
+			},
+			want: want{
+				status: func() *v1alpha1.GroupTreeStatus {
+					s := &v1alpha1.GroupTreeStatus{
+						Groups: []v1alpha1.GroupTreeGroup{
+							{Path: "platform", Name: "gt-platform", ID: ptr.To(42), Ready: true},
+							{Path: "platform/infra", Name: "gt-platform-infra"},
+						},
+					}
+					s.SetConditions(xpv1.ReconcileSuccess(), xpv1.Creating())
+					return s
+				}(),
+				deleted: []string{"gt-removed"},
+			},
+		},
+		"AllReady": {
+			tree: tree(v1alpha1.GroupTreeNode{Path: "platform"}),
+			apply: func(_ context.Context, o client.Object, _ ...resource.ApplyOption) error {
+				o.(resource.Managed).SetConditions(xpv1.Available())
+				return nil
+			},
+			list: test.NewMockListFn(nil),
+			want: want{
+				status: func() *v1alpha1.GroupTreeStatus {
+					s := &v1alpha1.GroupTreeStatus{
+						Groups: []v1alpha1.GroupTreeGroup{{Path: "platform", Name: "gt-platform", Ready: true}},
+					}
+					s.SetConditions(xpv1.ReconcileSuccess(), xpv1.Available())
+					return s
+				}(),
+			},
+		},
+		"RenderFailed": {
+			tree: tree(v1alpha1.GroupTreeNode{Path: "platform/infra"}),
+			want: want{
+				status: func() *v1alpha1.GroupTreeStatus {
+					s := &v1alpha1.GroupTreeStatus{}
+					s.SetConditions(xpv1.ReconcileError(errors.Wrap(errors.Errorf(errMissingParent, "platform", "platform/infra"), errRender)))
+					return s
+				}(),
+			},
+		},
+		"ApplyFailed": {
+			tree: tree(v1alpha1.GroupTreeNode{Path: "platform"}),
+			apply: func(_ context.Context, _ client.Object, _ ...resource.ApplyOption) error {
+				return errBoom
+			},
+			want: want{
+				status: func() *v1alpha1.GroupTreeStatus {
+					s := &v1alpha1.GroupTreeStatus{}
+					s.SetConditions(xpv1.ReconcileError(errors.Wrapf(errBoom, errApply, "gt-platform")))
+					return s
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var status *v1alpha1.GroupTreeStatus
+			deleted := []string{}
+			r := &reconciler{
+				client: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						*obj.(*v1alpha1.GroupTree) = *tc.tree
+						return nil
+					},
+					MockList: tc.list,
+					MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+						deleted = append(deleted, obj.GetName())
+						return nil
+					},
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						status = &obj.(*v1alpha1.GroupTree).Status
+						return nil
+					},
+				},
+				apply:  tc.apply,
+				log:    logging.NewNopLogger(),
+				record: event.NewNopRecorder(),
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "gt"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile: error: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Reconcile: result: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status, test.EquateConditions()); diff != "" {
+				t.Errorf("Reconcile: status: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(append([]string{}, tc.want.deleted...), deleted); diff != "" {
+				t.Errorf("Reconcile: deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
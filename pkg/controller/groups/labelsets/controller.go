@@ -0,0 +1,266 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package labelsets
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotLabelSet       = "managed resource is not a Gitlab group label set custom resource"
+	errGroupIDMissing    = "GroupID is missing"
+	errListFailed        = "cannot list Gitlab group labels"
+	errCreateFailed      = "cannot create Gitlab group label %q"
+	errUpdateFailed      = "cannot update Gitlab group label %q"
+	errDeleteFailed      = "cannot delete Gitlab group label %q"
+	errListProjectFailed = "cannot list labels of Gitlab project %q"
+	errPropagateFailed   = "cannot propagate label %q to Gitlab project %q"
+)
+
+// SetupLabelSet adds a controller that reconciles LabelSets.
+func SetupLabelSet(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.LabelSetKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.LabelSetGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.LabelSetGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewLabelClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.LabelSetGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LabelSet{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.LabelSet{}, &v1alpha1.LabelSetList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) groups.LabelClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return nil, errors.New(errNotLabelSet)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client groups.LabelClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLabelSet)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
+	}
+
+	labels, err := groups.ListGroupLabels(e.client, *cr.Spec.ForProvider.GroupID, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListFailed)
+	}
+
+	cr.Status.AtProvider = groups.GenerateLabelSetObservation(labels)
+	cr.Status.SetConditions(xpv1.Available())
+
+	upToDate := groups.DiffLabelSet(&cr.Spec.ForProvider, labels).IsEmpty()
+	for _, pid := range cr.Spec.ForProvider.PropagateToProjectIDs {
+		if !upToDate {
+			break
+		}
+		pl, err := groups.ListProjectLabels(e.client, pid, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrapf(err, errListProjectFailed, pid)
+		}
+		upToDate = groups.DiffPropagatedLabels(&cr.Spec.ForProvider, pl).IsEmpty()
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLabelSet)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalCreation{}, errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.GroupID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLabelSet)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errGroupIDMissing)
+	}
+
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return errors.New(errNotLabelSet)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	for _, l := range cr.Spec.ForProvider.Labels {
+		res, err := e.client.DeleteGroupLabel(*cr.Spec.ForProvider.GroupID, &gitlab.DeleteGroupLabelOptions{Name: gitlab.String(l.Name)}, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errDeleteFailed, l.Name)
+		}
+	}
+	return nil
+}
+
+// apply creates, updates and prunes group labels until they match the spec,
+// and propagates them to the projects of the spec.
+func (e *external) apply(ctx context.Context, cr *v1alpha1.LabelSet) error {
+	gid := *cr.Spec.ForProvider.GroupID
+
+	labels, err := groups.ListGroupLabels(e.client, gid, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errListFailed)
+	}
+
+	d := groups.DiffLabelSet(&cr.Spec.ForProvider, labels)
+	for i := range d.Create {
+		if _, _, err := e.client.CreateGroupLabel(gid, groups.GenerateCreateLabelOptions(&d.Create[i]), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errCreateFailed, d.Create[i].Name)
+		}
+	}
+	for i := range d.Update {
+		if _, _, err := e.client.UpdateGroupLabel(gid, groups.GenerateUpdateLabelOptions(&d.Update[i]), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errUpdateFailed, d.Update[i].Name)
+		}
+	}
+	for _, name := range d.Delete {
+		if _, err := e.client.DeleteGroupLabel(gid, &gitlab.DeleteGroupLabelOptions{Name: gitlab.String(name)}, gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errDeleteFailed, name)
+		}
+	}
+	for _, pid := range cr.Spec.ForProvider.PropagateToProjectIDs {
+		if err := e.propagate(ctx, cr, pid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// propagate creates and updates the project labels of project pid until
+// they match the labels of the spec. Other project labels are kept.
+func (e *external) propagate(ctx context.Context, cr *v1alpha1.LabelSet, pid string) error {
+	labels, err := groups.ListProjectLabels(e.client, pid, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, errListProjectFailed, pid)
+	}
+
+	d := groups.DiffPropagatedLabels(&cr.Spec.ForProvider, labels)
+	for i := range d.Create {
+		if _, _, err := e.client.CreateLabel(pid, groups.GenerateCreateProjectLabelOptions(&d.Create[i]), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errPropagateFailed, d.Create[i].Name, pid)
+		}
+	}
+	for i := range d.Update {
+		if _, _, err := e.client.UpdateLabel(pid, groups.GenerateUpdateProjectLabelOptions(&d.Update[i]), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errPropagateFailed, d.Update[i].Name, pid)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package labelsets
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	groupID   = "1234"
+	projectID = "5678"
+	prune     = true
+
+	bug     = v1alpha1.Label{Name: "bug", Color: "#ff0000"}
+	feature = v1alpha1.Label{Name: "feature", Color: "#00ff00"}
+)
+
+type args struct {
+	client groups.LabelClient
+	cr     *v1alpha1.LabelSet
+}
+
+type labelSetModifier func(*v1alpha1.LabelSet)
+
+func withConditions(c ...xpv1.Condition) labelSetModifier {
+	return func(r *v1alpha1.LabelSet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withGroupID() labelSetModifier {
+	return func(r *v1alpha1.LabelSet) { r.Spec.ForProvider.GroupID = &groupID }
+}
+
+func withLabels(l ...v1alpha1.Label) labelSetModifier {
+	return func(r *v1alpha1.LabelSet) { r.Spec.ForProvider.Labels = l }
+}
+
+func withPrune() labelSetModifier {
+	return func(r *v1alpha1.LabelSet) { r.Spec.ForProvider.Prune = &prune }
+}
+
+func withPropagation() labelSetModifier {
+	return func(r *v1alpha1.LabelSet) { r.Spec.ForProvider.PropagateToProjectIDs = []string{projectID} }
+}
+
+func withExternalName(n string) labelSetModifier {
+	return func(r *v1alpha1.LabelSet) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.LabelSetObservation) labelSetModifier {
+	return func(r *v1alpha1.LabelSet) { r.Status.AtProvider = s }
+}
+
+func labelSet(m ...labelSetModifier) *v1alpha1.LabelSet {
+	cr := &v1alpha1.LabelSet{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listLabels(l ...*gitlab.GroupLabel) func(pid interface{}, opt *gitlab.ListGroupLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupLabel, *gitlab.Response, error) {
+	return func(pid interface{}, opt *gitlab.ListGroupLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupLabel, *gitlab.Response, error) {
+		return l, &gitlab.Response{}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LabelSet
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: labelSet(withGroupID()),
+			},
+			want: want{
+				cr: labelSet(withGroupID()),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupLabels: listLabels(&gitlab.GroupLabel{ID: 1, Name: "bug", Color: "#FF0000"}),
+				},
+				cr: labelSet(withGroupID(), withExternalName(groupID), withLabels(bug)),
+			},
+			want: want{
+				cr: labelSet(
+					withGroupID(),
+					withExternalName(groupID),
+					withLabels(bug),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.LabelSetObservation{Labels: []v1alpha1.LabelObservation{{ID: 1, Name: "bug", Color: "#FF0000"}}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDateWhenPruning": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupLabels: listLabels(
+						&gitlab.GroupLabel{ID: 1, Name: "bug", Color: "#ff0000"},
+						&gitlab.GroupLabel{ID: 2, Name: "manual", Color: "#cccccc"},
+					),
+				},
+				cr: labelSet(withGroupID(), withExternalName(groupID), withLabels(bug), withPrune()),
+			},
+			want: want{
+				cr: labelSet(
+					withGroupID(),
+					withExternalName(groupID),
+					withLabels(bug),
+					withPrune(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.LabelSetObservation{Labels: []v1alpha1.LabelObservation{
+						{ID: 1, Name: "bug", Color: "#ff0000"},
+						{ID: 2, Name: "manual", Color: "#cccccc"},
+					}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotUpToDateWhenNotPropagated": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupLabels: listLabels(&gitlab.GroupLabel{ID: 1, Name: "bug", Color: "#ff0000"}),
+					MockListLabels: func(pid interface{}, opt *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+						return []*gitlab.Label{{ID: 3, Name: "bug", Color: "#ff0000"}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: labelSet(withGroupID(), withExternalName(groupID), withLabels(bug), withPropagation()),
+			},
+			want: want{
+				cr: labelSet(
+					withGroupID(),
+					withExternalName(groupID),
+					withLabels(bug),
+					withPropagation(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.LabelSetObservation{Labels: []v1alpha1.LabelObservation{{ID: 1, Name: "bug", Color: "#ff0000"}}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ErrList": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupLabels: func(pid interface{}, opt *gitlab.ListGroupLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupLabel, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: labelSet(withGroupID(), withExternalName(groupID)),
+			},
+			want: want{
+				cr:  labelSet(withGroupID(), withExternalName(groupID)),
+				err: errors.Wrap(errBoom, errListFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr      *v1alpha1.LabelSet
+		created []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupLabels: listLabels(&gitlab.GroupLabel{ID: 1, Name: "bug", Color: "#ff0000"}),
+				},
+				cr: labelSet(withGroupID(), withLabels(bug, feature)),
+			},
+			want: want{
+				cr: labelSet(
+					withGroupID(),
+					withLabels(bug, feature),
+					withExternalName(groupID),
+					withConditions(xpv1.Creating()),
+				),
+				created: []string{"feature"},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupLabels: listLabels(),
+				},
+				cr: labelSet(withGroupID(), withLabels(bug)),
+			},
+			want: want{
+				cr: labelSet(
+					withGroupID(),
+					withLabels(bug),
+					withConditions(xpv1.Creating()),
+				),
+				created: []string{"bug"},
+				err:     errors.Wrapf(errBoom, errCreateFailed, "bug"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created []string
+			mc := tc.client.(*fake.MockClient)
+			mc.MockCreateGroupLabel = func(pid interface{}, opt *gitlab.CreateGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupLabel, *gitlab.Response, error) {
+				created = append(created, *opt.Name)
+				if tc.want.err != nil {
+					return nil, &gitlab.Response{}, errBoom
+				}
+				return &gitlab.GroupLabel{}, &gitlab.Response{}, nil
+			}
+
+			e := &external{client: mc}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var updated, deleted []string
+	e := &external{client: &fake.MockClient{
+		MockListGroupLabels: listLabels(
+			&gitlab.GroupLabel{ID: 1, Name: "bug", Color: "#000000"},
+			&gitlab.GroupLabel{ID: 2, Name: "manual", Color: "#cccccc"},
+		),
+		MockUpdateGroupLabel: func(pid interface{}, opt *gitlab.UpdateGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupLabel, *gitlab.Response, error) {
+			updated = append(updated, *opt.Name)
+			return &gitlab.GroupLabel{}, &gitlab.Response{}, nil
+		},
+		MockDeleteGroupLabel: func(pid interface{}, opt *gitlab.DeleteGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			deleted = append(deleted, *opt.Name)
+			return &gitlab.Response{}, nil
+		},
+	}}
+
+	_, err := e.Update(context.Background(), labelSet(withGroupID(), withExternalName(groupID), withLabels(bug), withPrune()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"bug"}, updated); diff != "" {
+		t.Errorf("updated: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"manual"}, deleted); diff != "" {
+		t.Errorf("deleted: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdatePropagates(t *testing.T) {
+	var created, updated []string
+	e := &external{client: &fake.MockClient{
+		MockListGroupLabels: listLabels(
+			&gitlab.GroupLabel{ID: 1, Name: "bug", Color: "#ff0000"},
+			&gitlab.GroupLabel{ID: 2, Name: "feature", Color: "#00ff00"},
+		),
+		MockListLabels: func(pid interface{}, opt *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+			return []*gitlab.Label{
+				{ID: 3, Name: "bug", Color: "#000000", IsProjectLabel: true},
+				{ID: 4, Name: "legacy", Color: "#cccccc", IsProjectLabel: true},
+			}, &gitlab.Response{}, nil
+		},
+		MockCreateLabel: func(pid interface{}, opt *gitlab.CreateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error) {
+			created = append(created, *opt.Name)
+			return &gitlab.Label{}, &gitlab.Response{}, nil
+		},
+		MockUpdateLabel: func(pid interface{}, opt *gitlab.UpdateLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Label, *gitlab.Response, error) {
+			updated = append(updated, *opt.Name)
+			return &gitlab.Label{}, &gitlab.Response{}, nil
+		},
+	}}
+
+	_, err := e.Update(context.Background(), labelSet(withGroupID(), withExternalName(groupID), withLabels(bug, feature), withPrune(), withPropagation()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"feature"}, created); diff != "" {
+		t.Errorf("created: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"bug"}, updated); diff != "" {
+		t.Errorf("updated: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LabelSet
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGroupLabel: func(pid interface{}, opt *gitlab.DeleteGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: labelSet(withGroupID(), withLabels(bug)),
+			},
+			want: want{
+				cr: labelSet(withGroupID(), withLabels(bug), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGroupLabel: func(pid interface{}, opt *gitlab.DeleteGroupLabelOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: labelSet(withGroupID(), withLabels(bug)),
+			},
+			want: want{
+				cr:  labelSet(withGroupID(), withLabels(bug), withConditions(xpv1.Deleting())),
+				err: errors.Wrapf(errBoom, errDeleteFailed, "bug"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -15,15 +15,17 @@ package members
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/xanzy/go-gitlab"
 
-	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -32,10 +34,21 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/expiry"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/saas"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -48,6 +61,11 @@ const (
 	errMissingGroupID  = "Group ID not set"
 	errMissingUserInfo = "UserID or UserName not set"
 	errFetchFailed     = "can not fetch userID by userName"
+	errGetUserFailed   = "cannot get Gitlab user"
+	errNoSAMLIdentity  = "user has no SAML identity of provider %q"
+	errGetGroupFailed  = "cannot get Gitlab Group"
+	errListFailed      = "cannot list Gitlab Group Members"
+	errLastOwner       = "cannot delete the last owner of a Gitlab Group"
 )
 
 // SetupMember adds a controller that reconciles Group Members.
@@ -60,10 +78,10 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(protection.NewConnecter(saas.NewConnecter(mgr.GetClient(), maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.MemberKubernetesGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.MemberKubernetesGroupVersionKind, clients.NewBreakerConnecter(expiry.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), tracing.NewConnecter(name, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: groups.NewMemberClient,
-			newUserClientFn:   users.NewUserClient}),
+			newUserClientFn:   users.NewUserClient}))))))))))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -75,14 +93,21 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.MemberKubernetesGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.Member{}).
-		Complete(r)
+		For(&v1alpha1.Member{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Member{}, &v1alpha1.MemberList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
@@ -100,13 +125,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), userClient: c.newUserClientFn(*cfg)}, nil
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), userClient: c.newUserClientFn(*cfg), version: cfg.Version}, nil
 }
 
 type external struct {
 	kube       client.Client
 	client     groups.MemberClient
 	userClient users.UserClient
+	version    *clients.Version
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -134,6 +160,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	groupMember, res, err := e.client.GetGroupMember(
 		*cr.Spec.ForProvider.GroupID,
 		*cr.Spec.ForProvider.UserID,
+		gitlab.WithContext(ctx),
 	)
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
@@ -142,12 +169,23 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
+	extended := expiry.Extended(cr.Spec.ForProvider.ExpiresAt, cr.Status.AtProvider.ExtendedExpiresAt, cr.Spec.ForProvider.AutoExtendBy, time.Now())
 	cr.Status.AtProvider = groups.GenerateMemberObservation(groupMember)
-	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.ExtendedExpiresAt = extended
+	cr.Status.SetConditions(users.StateCondition(cr.Status.AtProvider.State))
+	if p := cr.Spec.ForProvider.RequireSAMLProvider; p != nil {
+		ok, err := e.hasSAMLIdentity(ctx, *cr.Spec.ForProvider.UserID, *p, groupMember)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !ok {
+			cr.Status.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errNoSAMLIdentity, *p)))
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isMemberUpToDate(&cr.Spec.ForProvider, groupMember),
+		ResourceUpToDate:        isMemberUpToDate(desired(cr), groupMember),
 		ResourceLateInitialized: false,
 	}, nil
 }
@@ -161,10 +199,25 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.GroupID == nil {
 		return managed.ExternalCreation{}, errors.New(errMissingGroupID)
 	}
+	if p := cr.Spec.ForProvider.RequireSAMLProvider; p != nil {
+		if cr.Spec.ForProvider.UserID == nil {
+			return managed.ExternalCreation{}, errors.New(errMissingUserInfo)
+		}
+		ok, err := e.hasSAMLIdentity(ctx, *cr.Spec.ForProvider.UserID, *p, nil)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		if !ok {
+			return managed.ExternalCreation{}, errors.Errorf(errNoSAMLIdentity, *p)
+		}
+	}
+	if err := e.validateAccessLevel(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 
 	_, _, err := e.client.AddGroupMember(
 		*cr.Spec.ForProvider.GroupID,
-		groups.GenerateAddMemberOptions(&cr.Spec.ForProvider),
+		groups.GenerateAddMemberOptions(desired(cr)),
 		gitlab.WithContext(ctx),
 	)
 	if err != nil {
@@ -188,10 +241,14 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errMissingUserInfo)
 	}
 
+	if err := e.validateAccessLevel(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	_, _, err := e.client.EditGroupMember(
 		*cr.Spec.ForProvider.GroupID,
 		*cr.Spec.ForProvider.UserID,
-		groups.GenerateEditMemberOptions(&cr.Spec.ForProvider),
+		groups.GenerateEditMemberOptions(desired(cr)),
 		gitlab.WithContext(ctx),
 	)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
@@ -211,15 +268,61 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errMissingUserInfo)
 	}
 
-	_, err := e.client.RemoveGroupMember(
+	last, err := groups.IsLastOwner(e.client, *cr.Spec.ForProvider.GroupID, *cr.Spec.ForProvider.UserID, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errListFailed)
+	}
+	if last {
+		cr.SetConditions(v1alpha1.LastOwner())
+		return errors.New(errLastOwner)
+	}
+
+	_, err = e.client.RemoveGroupMember(
 		*cr.Spec.ForProvider.GroupID,
 		*cr.Spec.ForProvider.UserID,
-		nil,
+		groups.GenerateRemoveMemberOptions(&cr.Spec.ForProvider),
 		gitlab.WithContext(ctx),
 	)
 	return errors.Wrap(err, errDeleteFailed)
 }
 
+// validateAccessLevel returns an error if the group of cr does not offer
+// the minimal access level cr requests.
+func (e *external) validateAccessLevel(ctx context.Context, cr *v1alpha1.Member) error {
+	if cr.Spec.ForProvider.AccessLevel != v1alpha1.MinimalAccessPermissions {
+		return nil
+	}
+	g, _, err := e.client.GetGroup(*cr.Spec.ForProvider.GroupID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errGetGroupFailed)
+	}
+	return groups.ValidateMinimalAccess(g, e.version)
+}
+
+// hasSAMLIdentity returns true if the user has an identity of provider,
+// either the group SAML identity Gitlab reports for the member, if any, or
+// one of the identities of the user.
+func (e *external) hasSAMLIdentity(ctx context.Context, userID int, provider string, member *gitlab.GroupMember) (bool, error) {
+	if member != nil && member.GroupSAMLIdentity != nil && member.GroupSAMLIdentity.Provider == provider {
+		return true, nil
+	}
+	u, _, err := e.userClient.GetUser(userID, gitlab.GetUsersOptions{}, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, errors.Wrap(err, errGetUserFailed)
+	}
+	return users.HasIdentity(u, provider), nil
+}
+
+// desired returns the parameters of cr with ExpiresAt set to the date
+// AutoExtendBy extended the membership to, if any.
+func desired(cr *v1alpha1.Member) *v1alpha1.MemberParameters {
+	p := cr.Spec.ForProvider.DeepCopy()
+	if at := cr.Status.AtProvider.ExtendedExpiresAt; at != nil {
+		p.ExpiresAt = at
+	}
+	return p
+}
+
 // isMemberUpToDate checks whether there is a change in any of the modifiable fields.
 func isMemberUpToDate(p *v1alpha1.MemberParameters, g *gitlab.GroupMember) bool {
 
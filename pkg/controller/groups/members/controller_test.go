@@ -15,6 +15,7 @@ package members
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -304,6 +306,57 @@ func TestObserve(t *testing.T) {
 				err:    errors.New(errMissingUserInfo),
 			},
 		},
+		"SAMLIdentityMissing": {
+			args: args{
+				groupMember: &fake.MockClient{
+					MockGetMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
+						return &gitlab.GroupMember{GroupSAMLIdentity: &gitlab.GroupMemberSAMLIdentity{Provider: "group_saml"}}, &gitlab.Response{}, nil
+					},
+				},
+				user: &fake.MockClient{
+					MockGetUser: func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return &gitlab.User{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{GroupID: &groupID, UserID: &userID, RequireSAMLProvider: ptr.To("saml")}),
+				),
+			},
+			want: want{
+				cr: groupMember(
+					withConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errNoSAMLIdentity, "saml"))),
+					withSpec(v1alpha1.MemberParameters{GroupID: &groupID, UserID: &userID, RequireSAMLProvider: ptr.To("saml")}),
+					withStatus(v1alpha1.MemberObservation{GroupSAMLIdentity: &v1alpha1.MemberSAMLIdentity{Provider: "group_saml"}}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"GroupSAMLIdentity": {
+			args: args{
+				groupMember: &fake.MockClient{
+					MockGetMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
+						return &gitlab.GroupMember{GroupSAMLIdentity: &gitlab.GroupMemberSAMLIdentity{Provider: "group_saml"}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{GroupID: &groupID, UserID: &userID, RequireSAMLProvider: ptr.To("group_saml")}),
+				),
+			},
+			want: want{
+				cr: groupMember(
+					withConditions(xpv1.Available()),
+					withSpec(v1alpha1.MemberParameters{GroupID: &groupID, UserID: &userID, RequireSAMLProvider: ptr.To("group_saml")}),
+					withStatus(v1alpha1.MemberObservation{GroupSAMLIdentity: &v1alpha1.MemberSAMLIdentity{Provider: "group_saml"}}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"NoUserIDSuccess": {
 			args: args{
 				groupMember: &fake.MockClient{
@@ -460,10 +513,69 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
+		"MinimalAccessInSubgroup": {
+			args: args{
+				groupMember: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{FullPath: "team/sub", ParentID: 1}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{GroupID: &ID, AccessLevel: v1alpha1.MinimalAccessPermissions}),
+				),
+			},
+			want: want{
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{GroupID: &ID, AccessLevel: v1alpha1.MinimalAccessPermissions}),
+				),
+				err: errors.New("the minimal access level is only available in top-level groups, team/sub is a subgroup"),
+			},
+		},
+		"SAMLIdentityMissing": {
+			args: args{
+				user: &fake.MockClient{
+					MockGetUser: func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return &gitlab.User{Identities: []*gitlab.UserIdentity{{Provider: "ldapmain"}}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{GroupID: &ID, UserID: &userID, RequireSAMLProvider: ptr.To("saml")}),
+				),
+			},
+			want: want{
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{GroupID: &ID, UserID: &userID, RequireSAMLProvider: ptr.To("saml")}),
+				),
+				err: errors.Errorf(errNoSAMLIdentity, "saml"),
+			},
+		},
+		"SAMLIdentity": {
+			args: args{
+				groupMember: &fake.MockClient{
+					MockAddMember: func(gid interface{}, opt *gitlab.AddGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
+						return &gitlab.GroupMember{}, &gitlab.Response{}, nil
+					},
+				},
+				user: &fake.MockClient{
+					MockGetUser: func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return &gitlab.User{Identities: []*gitlab.UserIdentity{{Provider: "saml"}}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{GroupID: &ID, UserID: &userID, RequireSAMLProvider: ptr.To("saml")}),
+				),
+			},
+			want: want{
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{GroupID: &ID, UserID: &userID, RequireSAMLProvider: ptr.To("saml")}),
+				),
+				result: managed.ExternalCreation{},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.groupMember}
+			e := &external{kube: tc.kube, client: tc.groupMember, userClient: tc.user}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -588,6 +700,12 @@ func TestDelete(t *testing.T) {
 		"SuccessfulDeletion": {
 			args: args{
 				groupMember: &fake.MockClient{
+					MockListMembers: func(gid interface{}, opt *gitlab.ListGroupMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error) {
+						return []*gitlab.GroupMember{
+							{ID: userID, AccessLevel: gitlab.OwnerPermissions},
+							{ID: 1, AccessLevel: gitlab.OwnerPermissions},
+						}, &gitlab.Response{}, nil
+					},
 					MockRemoveMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 						return &gitlab.Response{}, nil
 					},
@@ -617,6 +735,42 @@ func TestDelete(t *testing.T) {
 				err: errors.New(errMissingUserInfo),
 			},
 		},
+		"LastOwner": {
+			args: args{
+				groupMember: &fake.MockClient{
+					MockListMembers: func(gid interface{}, opt *gitlab.ListGroupMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error) {
+						return []*gitlab.GroupMember{
+							{ID: userID, AccessLevel: gitlab.OwnerPermissions},
+							{ID: 1, AccessLevel: gitlab.MaintainerPermissions},
+						}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{UserID: &userID, GroupID: &groupID})),
+			},
+			want: want{
+				cr: groupMember(
+					withConditions(v1alpha1.LastOwner()),
+					withSpec(v1alpha1.MemberParameters{UserID: &userID, GroupID: &groupID})),
+				err: errors.New(errLastOwner),
+			},
+		},
+		"ListFailed": {
+			args: args{
+				groupMember: &fake.MockClient{
+					MockListMembers: func(gid interface{}, opt *gitlab.ListGroupMembersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{UserID: &userID, GroupID: &groupID})),
+			},
+			want: want{
+				cr: groupMember(
+					withSpec(v1alpha1.MemberParameters{UserID: &userID, GroupID: &groupID})),
+				err: errors.Wrap(errBoom, errListFailed),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,250 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package milestones
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotMilestone     = "managed resource is not a Gitlab milestone custom resource"
+	errGroupIDMissing   = "GroupID is missing"
+	errIDNotInt         = "ID is not an integer"
+	errGetFailed        = "cannot get Gitlab milestone"
+	errKubeUpdateFailed = "cannot update Gitlab milestone custom resource"
+	errCreateFailed     = "cannot create Gitlab milestone"
+	errUpdateFailed     = "cannot update Gitlab milestone"
+	errRollOverFailed   = "cannot move the open issues of the Gitlab milestone to the next milestone"
+	errDeleteFailed     = "cannot delete Gitlab milestone"
+)
+
+// SetupMilestone adds a controller that reconciles Milestones.
+func SetupMilestone(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.MilestoneKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.MilestoneGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.MilestoneGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewMilestoneClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.MilestoneGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Milestone{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Milestone{}, &v1alpha1.MilestoneList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) groups.MilestoneClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Milestone)
+	if !ok {
+		return nil, errors.New(errNotMilestone)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), now: time.Now}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client groups.MilestoneClient
+	now    func() time.Time
+
+	// milestone is the milestone seen by Observe, which Update closes once
+	// it is due.
+	milestone *gitlab.GroupMilestone
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Milestone)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMilestone)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
+	}
+
+	m, res, err := e.client.GetGroupMilestone(*cr.Spec.ForProvider.GroupID, id, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+	e.milestone = m
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	groups.LateInitializeMilestone(&cr.Spec.ForProvider, m)
+
+	cr.Status.AtProvider = groups.GenerateMilestoneObservation(m)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        groups.IsMilestoneUpToDate(&cr.Spec.ForProvider, m) && !groups.IsMilestoneDue(&cr.Spec.ForProvider, m, e.now()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Milestone)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMilestone)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalCreation{}, errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	opt, err := groups.GenerateCreateMilestoneOptions(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+	m, _, err := e.client.CreateGroupMilestone(*cr.Spec.ForProvider.GroupID, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(m.ID))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
+// Update applies the spec to the milestone. If the milestone is due, it
+// moves its open issues to the next milestone as the auto close policy asks
+// before closing it.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Milestone)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMilestone)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errGroupIDMissing)
+	}
+
+	opt, err := groups.GenerateUpdateMilestoneOptions(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	if e.milestone != nil && groups.IsMilestoneDue(&cr.Spec.ForProvider, e.milestone, e.now()) {
+		if ptr.Deref(cr.Spec.ForProvider.AutoClosePolicy.RollOverOpenIssues, true) {
+			if _, err := groups.RollOverOpenIssues(e.client, *cr.Spec.ForProvider.GroupID, e.milestone, gitlab.WithContext(ctx)); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errRollOverFailed)
+			}
+		}
+		opt.StateEvent = ptr.To(groups.MilestoneStateEventClose)
+	}
+
+	_, _, err = e.client.UpdateGroupMilestone(*cr.Spec.ForProvider.GroupID, id, opt, gitlab.WithContext(ctx))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Milestone)
+	if !ok {
+		return errors.New(errNotMilestone)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errIDNotInt)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteGroupMilestone(*cr.Spec.ForProvider.GroupID, id, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	return nil
+}
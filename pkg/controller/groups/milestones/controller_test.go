@@ -0,0 +1,529 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package milestones
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	groupID     = "1234"
+	milestoneID = 1
+	title       = "v1.0"
+	dueDate     = "2024-06-30"
+	now         = time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC)
+)
+
+type args struct {
+	milestone groups.MilestoneClient
+	kube      client.Client
+	cr        *v1alpha1.Milestone
+	observed  *gitlab.GroupMilestone
+}
+
+type milestoneModifier func(*v1alpha1.Milestone)
+
+func withConditions(c ...xpv1.Condition) milestoneModifier {
+	return func(r *v1alpha1.Milestone) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withGroupID() milestoneModifier {
+	return func(r *v1alpha1.Milestone) { r.Spec.ForProvider.GroupID = &groupID }
+}
+
+func withTitle() milestoneModifier {
+	return func(r *v1alpha1.Milestone) { r.Spec.ForProvider.Title = title }
+}
+
+func withDueDate(d string) milestoneModifier {
+	return func(r *v1alpha1.Milestone) { r.Spec.ForProvider.DueDate = &d }
+}
+
+func withAutoClosePolicy(rollOver *bool) milestoneModifier {
+	return func(r *v1alpha1.Milestone) {
+		r.Spec.ForProvider.AutoClosePolicy = &v1alpha1.MilestoneAutoClosePolicy{RollOverOpenIssues: rollOver}
+	}
+}
+
+func withExternalName(n string) milestoneModifier {
+	return func(r *v1alpha1.Milestone) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.MilestoneObservation) milestoneModifier {
+	return func(r *v1alpha1.Milestone) { r.Status.AtProvider = s }
+}
+
+func milestone(m ...milestoneModifier) *v1alpha1.Milestone {
+	cr := &v1alpha1.Milestone{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func isoDate(d string) *gitlab.ISOTime {
+	t, _ := time.Parse(time.DateOnly, d)
+	date := gitlab.ISOTime(t)
+	return &date
+}
+
+func TestObserve(t *testing.T) {
+	active := &gitlab.GroupMilestone{
+		ID:      milestoneID,
+		Title:   title,
+		DueDate: isoDate(dueDate),
+		State:   groups.MilestoneStateActive,
+	}
+
+	type want struct {
+		cr     *v1alpha1.Milestone
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: milestone(withGroupID()),
+			},
+			want: want{
+				cr: milestone(withGroupID()),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: milestone(withGroupID(), withExternalName("v1")),
+			},
+			want: want{
+				cr:  milestone(withGroupID(), withExternalName("v1")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"GroupIDMissing": {
+			args: args{
+				cr: milestone(withExternalName("1")),
+			},
+			want: want{
+				cr:  milestone(withExternalName("1")),
+				err: errors.New(errGroupIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockGetGroupMilestone: func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: milestone(withGroupID(), withExternalName("1")),
+			},
+			want: want{
+				cr: milestone(withGroupID(), withExternalName("1")),
+			},
+		},
+		"ErrGet": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockGetGroupMilestone: func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: milestone(withGroupID(), withExternalName("1")),
+			},
+			want: want{
+				cr:  milestone(withGroupID(), withExternalName("1")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockGetGroupMilestone: func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+						return active, &gitlab.Response{}, nil
+					},
+				},
+				cr: milestone(withGroupID(), withExternalName("1"), withTitle(), withDueDate(dueDate)),
+			},
+			want: want{
+				cr: milestone(
+					withGroupID(),
+					withExternalName("1"),
+					withTitle(),
+					withDueDate(dueDate),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.MilestoneObservation{ID: milestoneID, State: groups.MilestoneStateActive}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitializedDueDate": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockGetGroupMilestone: func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+						return active, &gitlab.Response{}, nil
+					},
+				},
+				cr: milestone(withGroupID(), withExternalName("1"), withTitle()),
+			},
+			want: want{
+				cr: milestone(
+					withGroupID(),
+					withExternalName("1"),
+					withTitle(),
+					withDueDate(dueDate),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.MilestoneObservation{ID: milestoneID, State: groups.MilestoneStateActive}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"DueWithAutoClosePolicy": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockGetGroupMilestone: func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+						return active, &gitlab.Response{}, nil
+					},
+				},
+				cr: milestone(withGroupID(), withExternalName("1"), withTitle(), withDueDate(dueDate), withAutoClosePolicy(nil)),
+			},
+			want: want{
+				cr: milestone(
+					withGroupID(),
+					withExternalName("1"),
+					withTitle(),
+					withDueDate(dueDate),
+					withAutoClosePolicy(nil),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.MilestoneObservation{ID: milestoneID, State: groups.MilestoneStateActive}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.milestone, now: func() time.Time { return now }}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	_, errDate := time.Parse(time.DateOnly, "30.06.2024")
+
+	type want struct {
+		cr  *v1alpha1.Milestone
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				milestone: &fake.MockClient{
+					MockCreateGroupMilestone: func(pid interface{}, opt *gitlab.CreateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+						if opt.DueDate == nil || opt.DueDate.String() != dueDate {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.GroupMilestone{ID: milestoneID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: milestone(withGroupID(), withTitle(), withDueDate(dueDate)),
+			},
+			want: want{
+				cr: milestone(
+					withGroupID(),
+					withTitle(),
+					withDueDate(dueDate),
+					withExternalName("1"),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"GroupIDMissing": {
+			args: args{
+				cr: milestone(withTitle()),
+			},
+			want: want{
+				cr:  milestone(withTitle()),
+				err: errors.New(errGroupIDMissing),
+			},
+		},
+		"InvalidDueDate": {
+			args: args{
+				cr: milestone(withGroupID(), withTitle(), withDueDate("30.06.2024")),
+			},
+			want: want{
+				cr:  milestone(withGroupID(), withTitle(), withDueDate("30.06.2024"), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errDate, errCreateFailed),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockCreateGroupMilestone: func(pid interface{}, opt *gitlab.CreateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: milestone(withGroupID(), withTitle()),
+			},
+			want: want{
+				cr:  milestone(withGroupID(), withTitle(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.milestone, now: func() time.Time { return now }}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	due := &gitlab.GroupMilestone{ID: milestoneID, Title: title, DueDate: isoDate(dueDate), State: groups.MilestoneStateActive}
+	next := &gitlab.GroupMilestone{ID: 2, DueDate: isoDate("2024-07-31"), State: groups.MilestoneStateActive}
+
+	// client closes the milestone only with the given state event, and
+	// records the issues moved to the next milestone.
+	client := func(stateEvent *string, moved *[]int) *fake.MockClient {
+		return &fake.MockClient{
+			MockListGroupMilestones: func(pid interface{}, opt *gitlab.ListGroupMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMilestone, *gitlab.Response, error) {
+				return []*gitlab.GroupMilestone{due, next}, &gitlab.Response{}, nil
+			},
+			MockGetGroupMilestoneIssues: func(pid interface{}, milestone int, opt *gitlab.GetGroupMilestoneIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+				return []*gitlab.Issue{
+					{IID: 11, ProjectID: 1234, State: groups.IssueStateOpened},
+					{IID: 12, ProjectID: 1234, State: "closed"},
+				}, &gitlab.Response{}, nil
+			},
+			MockUpdateIssue: func(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+				if ptr.Deref(opt.MilestoneID, 0) != next.ID {
+					return nil, &gitlab.Response{}, errBoom
+				}
+				*moved = append(*moved, issue)
+				return &gitlab.Issue{}, &gitlab.Response{}, nil
+			},
+			MockUpdateGroupMilestone: func(pid interface{}, milestone int, opt *gitlab.UpdateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+				if diff := cmp.Diff(stateEvent, opt.StateEvent); diff != "" {
+					return nil, &gitlab.Response{}, errBoom
+				}
+				return &gitlab.GroupMilestone{}, &gitlab.Response{}, nil
+			},
+		}
+	}
+
+	type want struct {
+		moved []int
+		err   error
+	}
+
+	cases := map[string]struct {
+		args
+		stateEvent *string
+		want
+	}{
+		"SuccessfulUpdate": {
+			args: args{
+				cr:       milestone(withGroupID(), withExternalName("1"), withTitle(), withDueDate("2024-07-15")),
+				observed: &gitlab.GroupMilestone{ID: milestoneID, DueDate: isoDate("2024-07-15"), State: groups.MilestoneStateActive},
+			},
+		},
+		"DueNotClosedWithoutPolicy": {
+			args: args{
+				cr:       milestone(withGroupID(), withExternalName("1"), withTitle(), withDueDate(dueDate)),
+				observed: due,
+			},
+		},
+		"DueRolledOverAndClosed": {
+			args: args{
+				cr:       milestone(withGroupID(), withExternalName("1"), withTitle(), withDueDate(dueDate), withAutoClosePolicy(nil)),
+				observed: due,
+			},
+			stateEvent: ptr.To(groups.MilestoneStateEventClose),
+			want: want{
+				moved: []int{11},
+			},
+		},
+		"DueClosedWithoutRollOver": {
+			args: args{
+				cr:       milestone(withGroupID(), withExternalName("1"), withTitle(), withDueDate(dueDate), withAutoClosePolicy(ptr.To(false))),
+				observed: due,
+			},
+			stateEvent: ptr.To(groups.MilestoneStateEventClose),
+		},
+		"FailedRollOver": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockListGroupMilestones: func(pid interface{}, opt *gitlab.ListGroupMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMilestone, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr:       milestone(withGroupID(), withExternalName("1"), withTitle(), withDueDate(dueDate), withAutoClosePolicy(nil)),
+				observed: due,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errRollOverFailed),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockUpdateGroupMilestone: func(pid interface{}, milestone int, opt *gitlab.UpdateGroupMilestoneOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMilestone, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: milestone(withGroupID(), withExternalName("1"), withTitle()),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var moved []int
+			c := tc.milestone
+			if c == nil {
+				c = client(tc.stateEvent, &moved)
+			}
+			e := &external{kube: tc.kube, client: c, now: func() time.Time { return now }, milestone: tc.observed}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.moved, moved); diff != "" {
+				t.Errorf("moved: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Milestone
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockDeleteGroupMilestone: func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: milestone(withGroupID(), withExternalName("1")),
+			},
+			want: want{
+				cr: milestone(withGroupID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockDeleteGroupMilestone: func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: milestone(withGroupID(), withExternalName("1")),
+			},
+			want: want{
+				cr: milestone(withGroupID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				milestone: &fake.MockClient{
+					MockDeleteGroupMilestone: func(pid interface{}, milestone int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: milestone(withGroupID(), withExternalName("1")),
+			},
+			want: want{
+				cr:  milestone(withGroupID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.milestone, now: func() time.Time { return now }}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
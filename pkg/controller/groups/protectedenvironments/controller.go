@@ -0,0 +1,208 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protectedenvironments
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotProtectedEnvironment = "managed resource is not a Gitlab group protected environment custom resource"
+	errGetFailed               = "cannot get Gitlab group protected environment"
+	errCreateFailed            = "cannot create Gitlab group protected environment"
+	errUpdateFailed            = "cannot update Gitlab group protected environment"
+	errDeleteFailed            = "cannot delete Gitlab group protected environment"
+	errGroupIDMissing          = "GroupID is missing"
+)
+
+// SetupProtectedEnvironment adds a controller that reconciles group
+// ProtectedEnvironments.
+func SetupProtectedEnvironment(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProtectedEnvironmentKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.ProtectedEnvironmentGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.ProtectedEnvironmentGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewProtectedEnvironmentClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProtectedEnvironmentGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ProtectedEnvironment{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.ProtectedEnvironment{}, &v1alpha1.ProtectedEnvironmentList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) groups.ProtectedEnvironmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedEnvironment)
+	if !ok {
+		return nil, errors.New(errNotProtectedEnvironment)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client groups.ProtectedEnvironmentClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedEnvironment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProtectedEnvironment)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
+	}
+
+	pe, res, err := e.client.GetGroupProtectedEnvironment(
+		*cr.Spec.ForProvider.GroupID,
+		cr.Spec.ForProvider.DeploymentTier,
+		gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	cr.Status.AtProvider = groups.GenerateProtectedEnvironmentObservation(pe)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: groups.IsProtectedEnvironmentUpToDate(&cr.Spec.ForProvider, pe),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedEnvironment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProtectedEnvironment)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalCreation{}, errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	_, _, err := e.client.ProtectGroupEnvironment(
+		*cr.Spec.ForProvider.GroupID,
+		groups.GenerateProtectEnvironmentOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedEnvironment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProtectedEnvironment)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errGroupIDMissing)
+	}
+
+	// The IDs of the accesses and approval rules to remove are only known
+	// from the current protected environment.
+	pe, _, err := e.client.GetGroupProtectedEnvironment(
+		*cr.Spec.ForProvider.GroupID,
+		cr.Spec.ForProvider.DeploymentTier,
+		gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFailed)
+	}
+
+	_, _, err = e.client.UpdateGroupProtectedEnvironment(
+		*cr.Spec.ForProvider.GroupID,
+		cr.Spec.ForProvider.DeploymentTier,
+		groups.GenerateUpdateProtectedEnvironmentOptions(&cr.Spec.ForProvider, pe),
+		gitlab.WithContext(ctx))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProtectedEnvironment)
+	if !ok {
+		return errors.New(errNotProtectedEnvironment)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return errors.New(errGroupIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.client.UnprotectGroupEnvironment(
+		*cr.Spec.ForProvider.GroupID,
+		cr.Spec.ForProvider.DeploymentTier,
+		gitlab.WithContext(ctx))
+	return errors.Wrap(err, errDeleteFailed)
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protectedenvironments
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
+)
+
+var (
+	errBoom    = errors.New("boom")
+	groupID    = 1234
+	tier       = "production"
+	maintainer = v1alpha1.AccessLevelValue(40)
+
+	maintainers = &gitlab.EnvironmentAccessDescription{ID: 1, AccessLevel: 40, AccessLevelDescription: "Maintainers"}
+)
+
+type args struct {
+	client groups.ProtectedEnvironmentClient
+	cr     *v1alpha1.ProtectedEnvironment
+}
+
+type protectedEnvironmentModifier func(*v1alpha1.ProtectedEnvironment)
+
+func withConditions(c ...xpv1.Condition) protectedEnvironmentModifier {
+	return func(r *v1alpha1.ProtectedEnvironment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withGroupID() protectedEnvironmentModifier {
+	return func(r *v1alpha1.ProtectedEnvironment) { r.Spec.ForProvider.GroupID = &groupID }
+}
+
+func withDeployAccessLevels(a ...v1alpha1.EnvironmentAccess) protectedEnvironmentModifier {
+	return func(r *v1alpha1.ProtectedEnvironment) { r.Spec.ForProvider.DeployAccessLevels = a }
+}
+
+func withStatus(s v1alpha1.ProtectedEnvironmentObservation) protectedEnvironmentModifier {
+	return func(r *v1alpha1.ProtectedEnvironment) { r.Status.AtProvider = s }
+}
+
+func protectedEnvironment(m ...protectedEnvironmentModifier) *v1alpha1.ProtectedEnvironment {
+	cr := &v1alpha1.ProtectedEnvironment{Spec: v1alpha1.ProtectedEnvironmentSpec{ForProvider: v1alpha1.ProtectedEnvironmentParameters{DeploymentTier: tier}}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getProtectedEnvironment(pe *gitlab.ProtectedEnvironment) func(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+	return func(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+		return pe, &gitlab.Response{}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProtectedEnvironment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"GroupIDMissing": {
+			args: args{
+				cr: protectedEnvironment(),
+			},
+			want: want{
+				cr:  protectedEnvironment(),
+				err: errors.New(errGroupIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupProtectedEnvironment: func(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: protectedEnvironment(withGroupID()),
+			},
+			want: want{
+				cr: protectedEnvironment(withGroupID()),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupProtectedEnvironment: func(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedEnvironment(withGroupID()),
+			},
+			want: want{
+				cr:  protectedEnvironment(withGroupID()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupProtectedEnvironment: getProtectedEnvironment(&gitlab.ProtectedEnvironment{Name: tier, DeployAccessLevels: []*gitlab.EnvironmentAccessDescription{maintainers}}),
+				},
+				cr: protectedEnvironment(withGroupID(), withDeployAccessLevels(v1alpha1.EnvironmentAccess{AccessLevel: &maintainer})),
+			},
+			want: want{
+				cr: protectedEnvironment(
+					withGroupID(),
+					withDeployAccessLevels(v1alpha1.EnvironmentAccess{AccessLevel: &maintainer}),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProtectedEnvironmentObservation{DeployAccessLevels: []v1alpha1.EnvironmentAccessObservation{{ID: 1, AccessLevel: 40, AccessLevelDescription: "Maintainers"}}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"AccessChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupProtectedEnvironment: getProtectedEnvironment(&gitlab.ProtectedEnvironment{Name: tier, DeployAccessLevels: []*gitlab.EnvironmentAccessDescription{maintainers}}),
+				},
+				cr: protectedEnvironment(withGroupID(), withDeployAccessLevels(v1alpha1.EnvironmentAccess{UserID: ptr.To(7)})),
+			},
+			want: want{
+				cr: protectedEnvironment(
+					withGroupID(),
+					withDeployAccessLevels(v1alpha1.EnvironmentAccess{UserID: ptr.To(7)}),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProtectedEnvironmentObservation{DeployAccessLevels: []v1alpha1.EnvironmentAccessObservation{{ID: 1, AccessLevel: 40, AccessLevelDescription: "Maintainers"}}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var got *groups.UpdateProtectedEnvironmentOptions
+
+	cases := map[string]struct {
+		args
+		want    *groups.UpdateProtectedEnvironmentOptions
+		wantErr error
+	}{
+		"ReplacesAccess": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupProtectedEnvironment: getProtectedEnvironment(&gitlab.ProtectedEnvironment{Name: tier, DeployAccessLevels: []*gitlab.EnvironmentAccessDescription{maintainers}}),
+					MockUpdateGroupProtectedEnvironment: func(gid interface{}, environment string, opt *groups.UpdateProtectedEnvironmentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+						got = opt
+						return &gitlab.ProtectedEnvironment{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedEnvironment(withGroupID(), withDeployAccessLevels(v1alpha1.EnvironmentAccess{UserID: ptr.To(7)})),
+			},
+			want: &groups.UpdateProtectedEnvironmentOptions{
+				DeployAccessLevels: &[]*groups.UpdateEnvironmentAccessOptions{
+					{ID: ptr.To(1), Destroy: ptr.To(true)},
+					{UserID: ptr.To(7)},
+				},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetGroupProtectedEnvironment: func(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedEnvironment, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedEnvironment(withGroupID()),
+			},
+			wantErr: errors.Wrap(errBoom, errGetFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.args.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockUnprotectGroupEnvironment: func(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedEnvironment(withGroupID()),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockUnprotectGroupEnvironment: func(gid interface{}, environment string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedEnvironment(withGroupID()),
+			},
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,7 +24,12 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/accesstokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/deploytokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/groupshares"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/grouptrees"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/labelsets"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/members"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/milestones"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/protectedenvironments"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/variables"
 )
 
@@ -36,6 +41,11 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		accesstokens.SetupAccessToken,
 		deploytokens.SetupDeployToken,
 		variables.SetupVariable,
+		labelsets.SetupLabelSet,
+		protectedenvironments.SetupProtectedEnvironment,
+		groupshares.SetupGroupShare,
+		grouptrees.SetupGroupTree,
+		milestones.SetupMilestone,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
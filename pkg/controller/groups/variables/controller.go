@@ -23,6 +23,7 @@ import (
 	"github.com/xanzy/go-gitlab"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,9 +37,20 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretversion"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/sensitive"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -52,6 +64,28 @@ const (
 	errGroupIDMissing    = "GroupID is missing"
 )
 
+// valueField is the plaintext Value of a Variable, moved into a Secret if
+// secret migration is enabled. Masked and Raw default to true for values
+// read from a secret, so they are pinned to keep the variable as it is.
+var valueField = sensitive.Field{
+	Path: "spec.forProvider.value",
+	Key:  "value",
+	Get: func(mg resource.Managed) *string {
+		p := &mg.(*v1alpha1.Variable).Spec.ForProvider
+		if p.ValueSecretRef != nil {
+			return nil
+		}
+		return p.Value
+	},
+	Set: func(mg resource.Managed, ref *xpv1.SecretKeySelector) {
+		p := &mg.(*v1alpha1.Variable).Spec.ForProvider
+		p.Value = nil
+		p.ValueSecretRef = ref
+		p.Masked = ptr.To(ptr.Deref(p.Masked, false))
+		p.Raw = ptr.To(ptr.Deref(p.Raw, false))
+	},
+}
+
 // SetupVariable adds a controller that reconciles Variables.
 func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.VariableKind)
@@ -62,7 +96,7 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewVariableClient}),
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.VariableGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.VariableGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, secretversion.NewConnecter(mgr.GetClient(), &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewVariableClient})))))))))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -74,14 +108,25 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	if o.Features.Enabled(features.EnableSecretMigration) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithInitializers(sensitive.NewInitializer(mgr.GetClient(), v1alpha1.VariableGroupVersionKind, valueField)))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.VariableGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.Variable{}).
-		Complete(r)
+		For(&v1alpha1.Variable{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Variable{}, &v1alpha1.VariableList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
@@ -118,6 +163,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	variable, res, err := e.client.GetVariable(
 		*cr.Spec.ForProvider.GroupID,
 		cr.Spec.ForProvider.Key,
+		groups.GenerateGetVariableOptions(&cr.Spec.ForProvider),
 		gitlab.WithContext(ctx))
 
 	if err != nil {
@@ -127,20 +173,20 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
-	if cr.Spec.ForProvider.ValueSecretRef != nil {
-		if err = e.updateVariableFromSecret(ctx, cr.Spec.ForProvider.ValueSecretRef, &cr.Spec.ForProvider); err != nil {
-			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateFailed)
-		}
+	params, err := e.resolveSecretValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateFailed)
 	}
 
 	current := cr.Spec.ForProvider.DeepCopy()
 	groups.LateInitializeVariable(&cr.Spec.ForProvider, variable)
+	groups.LateInitializeVariable(params, variable)
 
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        groups.IsVariableUpToDate(&cr.Spec.ForProvider, variable),
+		ResourceUpToDate:        groups.IsVariableUpToDate(params, variable),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -151,19 +197,18 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotVariable)
 	}
 
-	if cr.Spec.ForProvider.ValueSecretRef != nil {
-		if err := e.updateVariableFromSecret(ctx, cr.Spec.ForProvider.ValueSecretRef, &cr.Spec.ForProvider); err != nil {
-			return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
-		}
+	params, err := e.resolveSecretValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 	if cr.Spec.ForProvider.GroupID == nil {
 		return managed.ExternalCreation{}, errors.New(errGroupIDMissing)
 	}
 
 	cr.Status.SetConditions(xpv1.Creating())
-	_, _, err := e.client.CreateVariable(
-		*cr.Spec.ForProvider.GroupID,
-		groups.GenerateCreateVariableOptions(&cr.Spec.ForProvider),
+	_, _, err = e.client.CreateVariable(
+		*params.GroupID,
+		groups.GenerateCreateVariableOptions(params),
 		gitlab.WithContext(ctx))
 
 	if err != nil {
@@ -178,19 +223,18 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotVariable)
 	}
 
-	if cr.Spec.ForProvider.ValueSecretRef != nil {
-		if err := e.updateVariableFromSecret(ctx, cr.Spec.ForProvider.ValueSecretRef, &cr.Spec.ForProvider); err != nil {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
-		}
+	params, err := e.resolveSecretValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 	}
 	if cr.Spec.ForProvider.GroupID == nil {
 		return managed.ExternalUpdate{}, errors.New(errGroupIDMissing)
 	}
 
-	_, _, err := e.client.UpdateVariable(
-		*cr.Spec.ForProvider.GroupID,
-		cr.Spec.ForProvider.Key,
-		groups.GenerateUpdateVariableOptions(&cr.Spec.ForProvider),
+	_, _, err = e.client.UpdateVariable(
+		*params.GroupID,
+		params.Key,
+		groups.GenerateUpdateVariableOptions(params),
 		gitlab.WithContext(ctx),
 	)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
@@ -210,13 +254,23 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	_, err := e.client.RemoveVariable(
 		*cr.Spec.ForProvider.GroupID,
 		cr.Spec.ForProvider.Key,
+		groups.GenerateRemoveVariableOptions(&cr.Spec.ForProvider),
 		gitlab.WithContext(ctx),
 	)
 	return errors.Wrap(err, errDeleteFailed)
 }
 
-func (e *external) updateVariableFromSecret(ctx context.Context, selector *xpv1.SecretKeySelector, params *v1alpha1.VariableParameters) error {
+// resolveSecretValue returns the parameters to send to Gitlab. A value read
+// from ValueSecretRef is only set on a copy of params, so it is never written
+// back into the spec. Masked and Raw default to true for such a value, and
+// these defaults are set on params itself.
+func (e *external) resolveSecretValue(ctx context.Context, params *v1alpha1.VariableParameters) (*v1alpha1.VariableParameters, error) {
+	if params.ValueSecretRef == nil {
+		return params, nil
+	}
+
 	// Fetch the Kubernetes secret.
+	selector := params.ValueSecretRef
 	secret := &corev1.Secret{}
 	nn := types.NamespacedName{
 		Namespace: selector.Namespace,
@@ -225,13 +279,13 @@ func (e *external) updateVariableFromSecret(ctx context.Context, selector *xpv1.
 
 	err := e.kube.Get(ctx, nn, secret)
 	if err != nil {
-		return errors.Wrap(err, errGetSecretFailed)
+		return nil, errors.Wrap(err, errGetSecretFailed)
 	}
 
 	// Obtain the data from the secret.
 	raw, ok := secret.Data[selector.Key]
 	if raw == nil || !ok {
-		return errors.New(errSecretKeyNotFound)
+		return nil, errors.New(errSecretKeyNotFound)
 	}
 
 	// Mask variable if it hasn't already been explicitly configured.
@@ -244,8 +298,9 @@ func (e *external) updateVariableFromSecret(ctx context.Context, selector *xpv1.
 		params.Raw = gitlab.Bool(true)
 	}
 
+	resolved := params.DeepCopy()
 	value := string(raw)
-	params.Value = &value
+	resolved.Value = &value
 
-	return nil
+	return resolved, nil
 }
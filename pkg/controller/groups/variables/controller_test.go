@@ -47,7 +47,7 @@ var (
 )
 
 var (
-	pv = gitlab.GroupVariable{
+	pv = groups.Variable{GroupVariable: gitlab.GroupVariable{
 		Value:            variableValue,
 		Key:              variableKey,
 		EnvironmentScope: variableEnvScope,
@@ -55,7 +55,7 @@ var (
 		Protected:        f,
 		Masked:           f,
 		Raw:              f,
-	}
+	}}
 )
 
 type args struct {
@@ -97,6 +97,12 @@ func withValue(value string) variableModifier {
 	}
 }
 
+func withoutValue() variableModifier {
+	return func(r *v1alpha1.Variable) {
+		r.Spec.ForProvider.Value = nil
+	}
+}
+
 func withValueSecretRef(selector *xpv1.SecretKeySelector) variableModifier {
 	return func(r *v1alpha1.Variable) {
 		r.Spec.ForProvider.ValueSecretRef = selector
@@ -155,7 +161,7 @@ func TestObserve(t *testing.T) {
 		"SuccessfulAvailable": {
 			args: args{
 				variable: &fake.MockClient{
-					MockGetGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+					MockGetGroupVariable: func(gid interface{}, key string, opt *groups.GetVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
 						return &pv, &gitlab.Response{}, nil
 					},
 				},
@@ -175,7 +181,7 @@ func TestObserve(t *testing.T) {
 		"NotUpToDate": {
 			args: args{
 				variable: &fake.MockClient{
-					MockGetGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+					MockGetGroupVariable: func(gid interface{}, key string, opt *groups.GetVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
 						rv := pv
 						rv.Value = "not-up-to-date"
 						return &rv, &gitlab.Response{}, nil
@@ -201,7 +207,7 @@ func TestObserve(t *testing.T) {
 		"LateInitSuccess": {
 			args: args{
 				variable: &fake.MockClient{
-					MockGetGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+					MockGetGroupVariable: func(gid interface{}, key string, opt *groups.GetVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
 						rv := pv
 						rv.Masked = true
 						rv.VariableType = gitlab.FileVariableType
@@ -239,8 +245,8 @@ func TestObserve(t *testing.T) {
 		"GetError": {
 			args: args{
 				variable: &fake.MockClient{
-					MockGetGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					MockGetGroupVariable: func(gid interface{}, key string, opt *groups.GetVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{}, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
 					},
 				},
 				cr: variable(
@@ -260,8 +266,8 @@ func TestObserve(t *testing.T) {
 		"ErrGet404": {
 			args: args{
 				variable: &fake.MockClient{
-					MockGetGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					MockGetGroupVariable: func(gid interface{}, key string, opt *groups.GetVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{}, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
 					},
 				},
 				cr: variable(
@@ -295,8 +301,8 @@ func TestObserve(t *testing.T) {
 					},
 				},
 				variable: &fake.MockClient{
-					MockGetGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{}, nil
+					MockGetGroupVariable: func(gid interface{}, key string, opt *groups.GetVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{}, &gitlab.Response{}, nil
 					},
 				},
 				cr: variable(
@@ -315,6 +321,7 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: variable(
 					withDefaultValues(),
+					withoutValue(),
 					withValueSecretRef(&xpv1.SecretKeySelector{
 						SecretReference: xpv1.SecretReference{},
 						Key:             "blah",
@@ -347,8 +354,8 @@ func TestObserve(t *testing.T) {
 					},
 				},
 				variable: &fake.MockClient{
-					MockGetGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errors.New(errSecretKeyNotFound)
+					MockGetGroupVariable: func(gid interface{}, key string, opt *groups.GetVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{}, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errors.New(errSecretKeyNotFound)
 					},
 				},
 				cr: variable(
@@ -409,8 +416,8 @@ func TestCreate(t *testing.T) {
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
 				variable: &fake.MockClient{
-					MockCreateGroupVariable: func(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{Key: variableKey}, &gitlab.Response{}, nil
+					MockCreateGroupVariable: func(gid interface{}, opt *groups.CreateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{GroupVariable: gitlab.GroupVariable{Key: variableKey}}, &gitlab.Response{}, nil
 					},
 				},
 				cr: variable(
@@ -428,8 +435,8 @@ func TestCreate(t *testing.T) {
 		"FailedCreation": {
 			args: args{
 				variable: &fake.MockClient{
-					MockCreateGroupVariable: func(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{}, errBoom
+					MockCreateGroupVariable: func(gid interface{}, opt *groups.CreateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{}, &gitlab.Response{}, errBoom
 					},
 				},
 				cr: variable(
@@ -457,8 +464,11 @@ func TestCreate(t *testing.T) {
 					},
 				},
 				variable: &fake.MockClient{
-					MockCreateGroupVariable: func(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{}, nil
+					MockCreateGroupVariable: func(gid interface{}, opt *groups.CreateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						if opt.Value == nil || *opt.Value != variableValue {
+							return nil, nil, errBoom
+						}
+						return &groups.Variable{}, &gitlab.Response{}, nil
 					},
 				},
 				cr: variable(
@@ -479,7 +489,6 @@ func TestCreate(t *testing.T) {
 						SecretReference: xpv1.SecretReference{},
 						Key:             "blah",
 					}),
-					withValue(variableValue),
 					withMasked(true),
 					withRaw(true),
 				),
@@ -498,8 +507,8 @@ func TestCreate(t *testing.T) {
 					},
 				},
 				variable: &fake.MockClient{
-					MockCreateGroupVariable: func(gid interface{}, opt *gitlab.CreateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{}, errors.New(errSecretKeyNotFound)
+					MockCreateGroupVariable: func(gid interface{}, opt *groups.CreateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{}, &gitlab.Response{}, errors.New(errSecretKeyNotFound)
 					},
 				},
 				cr: variable(
@@ -555,8 +564,8 @@ func TestUpdate(t *testing.T) {
 		"SuccessfulEditGroup": {
 			args: args{
 				variable: &fake.MockClient{
-					MockUpdateGroupVariable: func(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{}, nil
+					MockUpdateGroupVariable: func(gid interface{}, key string, opt *groups.UpdateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{}, &gitlab.Response{}, nil
 					},
 				},
 				cr: variable(
@@ -574,8 +583,8 @@ func TestUpdate(t *testing.T) {
 		"FailedEdit": {
 			args: args{
 				variable: &fake.MockClient{
-					MockUpdateGroupVariable: func(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{}, errBoom
+					MockUpdateGroupVariable: func(gid interface{}, key string, opt *groups.UpdateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{}, &gitlab.Response{}, errBoom
 					},
 				},
 				cr: variable(
@@ -608,8 +617,11 @@ func TestUpdate(t *testing.T) {
 					},
 				},
 				variable: &fake.MockClient{
-					MockUpdateGroupVariable: func(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{}, nil
+					MockUpdateGroupVariable: func(gid interface{}, key string, opt *groups.UpdateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						if opt.Value == nil || *opt.Value != variableValue {
+							return nil, nil, errBoom
+						}
+						return &groups.Variable{}, &gitlab.Response{}, nil
 					},
 				},
 				cr: variable(
@@ -629,7 +641,6 @@ func TestUpdate(t *testing.T) {
 						SecretReference: xpv1.SecretReference{},
 						Key:             "blah",
 					}),
-					withValue(variableValue),
 					withMasked(true),
 					withRaw(true),
 				),
@@ -652,8 +663,8 @@ func TestUpdate(t *testing.T) {
 					},
 				},
 				variable: &fake.MockClient{
-					MockUpdateGroupVariable: func(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
-						return &gitlab.GroupVariable{}, &gitlab.Response{}, errors.New(errSecretKeyNotFound)
+					MockUpdateGroupVariable: func(gid interface{}, key string, opt *groups.UpdateVariableOptions, options ...gitlab.RequestOptionFunc) (*groups.Variable, *gitlab.Response, error) {
+						return &groups.Variable{}, &gitlab.Response{}, errors.New(errSecretKeyNotFound)
 					},
 				},
 				cr: variable(
@@ -708,7 +719,7 @@ func TestDelete(t *testing.T) {
 		"SuccessfulDeletion": {
 			args: args{
 				variable: &fake.MockClient{
-					MockRemoveGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					MockRemoveGroupVariable: func(gid interface{}, key string, opt *groups.RemoveVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 						return &gitlab.Response{}, nil
 					},
 				},
@@ -727,7 +738,7 @@ func TestDelete(t *testing.T) {
 		"FailedDeletion": {
 			args: args{
 				variable: &fake.MockClient{
-					MockRemoveGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					MockRemoveGroupVariable: func(gid interface{}, key string, opt *groups.RemoveVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 						return &gitlab.Response{}, errBoom
 					},
 				},
@@ -747,7 +758,7 @@ func TestDelete(t *testing.T) {
 		"InvalidVariableID": {
 			args: args{
 				variable: &fake.MockClient{
-					MockRemoveGroupVariable: func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					MockRemoveGroupVariable: func(gid interface{}, key string, opt *groups.RemoveVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 						return &gitlab.Response{}, nil
 					},
 				},
@@ -0,0 +1,309 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesstokenrotations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	groupsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/rotation"
+)
+
+const (
+	errGetRotation     = "cannot get AccessTokenRotation"
+	errTokenSelector   = "cannot parse token selector"
+	errListTokens      = "cannot list %s"
+	errRequestRotation = "cannot request rotation of %s %s"
+	errUpdateStatus    = "cannot update AccessTokenRotation status"
+	errTokenDeleted    = "access token was deleted before it was rotated"
+	errSuperseded      = "rotation of access token was superseded by request %q"
+	errTokensFailed    = "%d of %d access tokens failed to rotate"
+
+	reasonRotationStarted   event.Reason = "RotationStarted"
+	reasonRotationCompleted event.Reason = "RotationCompleted"
+	reasonRotationFailed    event.Reason = "RotationFailed"
+)
+
+// defaultMaxParallel is the number of access tokens rotating at the same
+// time if an AccessTokenRotation does not say.
+const defaultMaxParallel = 5
+
+// SetupAccessTokenRotation adds a controller that rotates the access tokens
+// selected by AccessTokenRotations.
+func SetupAccessTokenRotation(mgr ctrl.Manager, o controller.Options) error {
+	name := "accesstokenrotation/" + v1alpha1.AccessTokenRotationGroupKind
+
+	r := &reconciler{
+		client:       mgr.GetClient(),
+		log:          o.Logger.WithValues("controller", name),
+		record:       event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		pollInterval: o.PollInterval,
+		now:          time.Now,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.AccessTokenRotation{}).
+		Complete(r)
+}
+
+// reconciler requests the access tokens of an AccessTokenRotation to rotate
+// and reports their progress. The access token controllers perform the
+// rotations; their progress does not cause events here, so it is checked
+// every poll interval.
+type reconciler struct {
+	client       client.Client
+	log          logging.Logger
+	record       event.Recorder
+	pollInterval time.Duration
+	now          func() time.Time
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	rot := &v1alpha1.AccessTokenRotation{}
+	if err := r.client.Get(ctx, req.NamespacedName, rot); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetRotation)
+	}
+	if rot.GetDeletionTimestamp() != nil || completed(rot) {
+		return reconcile.Result{}, nil
+	}
+
+	tokens, err := r.listTokens(ctx, rot)
+	if err != nil {
+		log.Debug("Cannot list access tokens", "error", err)
+		rot.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, rot), errUpdateStatus)
+	}
+
+	// The tokens are recorded once, so tokens created during the rotation
+	// are not rotated.
+	if rot.Status.Tokens == nil {
+		rot.Status.Tokens = Plan(tokens)
+	}
+
+	start, wait := Advance(rot, tokens, r.now())
+	rot.SetConditions(xpv1.ReconcileSuccess())
+	for _, i := range start {
+		t := &rot.Status.Tokens[i]
+		mg := tokens[key(t.Kind, t.Name)]
+		rotation.Request(mg, string(rot.GetUID()))
+		if err := r.client.Update(ctx, mg); err != nil {
+			err = errors.Wrapf(err, errRequestRotation, t.Kind, t.Name)
+			log.Debug("Cannot request rotation", "error", err)
+			t.Phase, t.StartedAt, t.Message = v1alpha1.RotationPending, nil, err.Error()
+			rot.SetConditions(xpv1.ReconcileError(err))
+			continue
+		}
+		r.record.Event(rot, event.Normal(reasonRotationStarted, fmt.Sprintf("Requested rotation of %s %s", t.Kind, t.Name)))
+	}
+	count(rot)
+
+	if !Finished(rot) {
+		rot.SetConditions(xpv1.Creating())
+		if wait <= 0 || wait > r.pollInterval {
+			wait = r.pollInterval
+		}
+		return reconcile.Result{RequeueAfter: wait}, errors.Wrap(r.client.Status().Update(ctx, rot), errUpdateStatus)
+	}
+
+	if rot.Status.Failed > 0 {
+		msg := fmt.Sprintf(errTokensFailed, rot.Status.Failed, rot.Status.Total)
+		rot.SetConditions(xpv1.Unavailable().WithMessage(msg))
+		r.record.Event(rot, event.Warning(reasonRotationFailed, errors.New(msg)))
+	} else {
+		rot.SetConditions(xpv1.Available())
+		r.record.Event(rot, event.Normal(reasonRotationCompleted, fmt.Sprintf("Rotated %d access tokens", rot.Status.Rotated)))
+	}
+	return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, rot), errUpdateStatus)
+}
+
+// listTokens returns the project and group access tokens selected by rot by
+// their kind and name.
+func (r *reconciler) listTokens(ctx context.Context, rot *v1alpha1.AccessTokenRotation) (map[string]resource.Managed, error) {
+	opts := []client.ListOption{}
+	if rot.Spec.TokenSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(rot.Spec.TokenSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, errTokenSelector)
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: sel})
+	}
+
+	out := map[string]resource.Managed{}
+	pl := &v1alpha1.AccessTokenList{}
+	if err := r.client.List(ctx, pl, opts...); err != nil {
+		return nil, errors.Wrapf(err, errListTokens, v1alpha1.AccessTokenGroupKind)
+	}
+	for i := range pl.Items {
+		out[key(v1alpha1.AccessTokenGroupKind, pl.Items[i].GetName())] = &pl.Items[i]
+	}
+	gl := &groupsv1alpha1.AccessTokenList{}
+	if err := r.client.List(ctx, gl, opts...); err != nil {
+		return nil, errors.Wrapf(err, errListTokens, groupsv1alpha1.AccessTokenGroupKind)
+	}
+	for i := range gl.Items {
+		out[key(groupsv1alpha1.AccessTokenGroupKind, gl.Items[i].GetName())] = &gl.Items[i]
+	}
+	return out, nil
+}
+
+// revokePending reports whether the token replaced by the rotation of mg is
+// not revoked yet.
+func revokePending(mg resource.Managed) bool {
+	switch t := mg.(type) {
+	case *v1alpha1.AccessToken:
+		return t.Status.AtProvider.RevokePendingID != nil
+	case *groupsv1alpha1.AccessToken:
+		return t.Status.AtProvider.RevokePendingID != nil
+	}
+	return false
+}
+
+func key(kind, name string) string {
+	return kind + "/" + name
+}
+
+// Plan returns a pending target for each of the tokens, ordered by kind and
+// name.
+func Plan(tokens map[string]resource.Managed) []v1alpha1.AccessTokenRotationTarget {
+	keys := make([]string, 0, len(tokens))
+	for k := range tokens {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]v1alpha1.AccessTokenRotationTarget, 0, len(tokens))
+	for _, k := range keys {
+		kind, name, _ := strings.Cut(k, "/")
+		out = append(out, v1alpha1.AccessTokenRotationTarget{Kind: kind, Name: name, Phase: v1alpha1.RotationPending})
+	}
+	return out
+}
+
+// Advance updates the targets of rot from the current state of the tokens
+// and starts as many pending targets as MaxParallel and Interval allow. It
+// returns the indexes of the started targets, whose tokens must be requested
+// to rotate, and how long to wait until the next target may start.
+func Advance(rot *v1alpha1.AccessTokenRotation, tokens map[string]resource.Managed, now time.Time) ([]int, time.Duration) {
+	id := string(rot.GetUID())
+	at := metav1.NewTime(now)
+
+	rotating := 0
+	for i := range rot.Status.Tokens {
+		t := &rot.Status.Tokens[i]
+		mg, ok := tokens[key(t.Kind, t.Name)]
+		switch {
+		case t.Phase != v1alpha1.RotationPending && t.Phase != v1alpha1.RotationRotating:
+			continue
+		case !ok:
+			t.Phase, t.FinishedAt, t.Message = v1alpha1.RotationFailed, &at, errTokenDeleted
+		case t.Phase == v1alpha1.RotationPending:
+			continue
+		case rotation.IsCompleted(mg, id) && !revokePending(mg):
+			t.Phase, t.FinishedAt, t.Message = v1alpha1.RotationRotated, &at, ""
+		case mg.GetAnnotations()[rotation.AnnotationKeyRotate] != id:
+			t.Phase, t.FinishedAt, t.Message = v1alpha1.RotationFailed, &at, fmt.Sprintf(errSuperseded, mg.GetAnnotations()[rotation.AnnotationKeyRotate])
+		default:
+			rotating++
+			t.Message = ""
+			if c := mg.GetCondition(xpv1.TypeSynced); c.Status == corev1.ConditionFalse {
+				t.Message = c.Message
+			}
+		}
+	}
+
+	maxParallel := ptr.Deref(rot.Spec.MaxParallel, defaultMaxParallel)
+	var interval time.Duration
+	if rot.Spec.Interval != nil {
+		interval = rot.Spec.Interval.Duration
+	}
+
+	start := []int{}
+	for i := range rot.Status.Tokens {
+		t := &rot.Status.Tokens[i]
+		if t.Phase != v1alpha1.RotationPending {
+			continue
+		}
+		if rotating >= maxParallel {
+			return start, 0
+		}
+		if last := rot.Status.LastStartedAt; last != nil && interval > 0 && now.Before(last.Add(interval)) {
+			return start, last.Add(interval).Sub(now)
+		}
+		t.Phase, t.StartedAt, t.Message = v1alpha1.RotationRotating, &at, ""
+		rot.Status.LastStartedAt = &at
+		rotating++
+		start = append(start, i)
+	}
+	return start, 0
+}
+
+// Finished returns true if every token of rot was rotated or failed to
+// rotate.
+func Finished(rot *v1alpha1.AccessTokenRotation) bool {
+	if rot.Status.Tokens == nil {
+		return false
+	}
+	for _, t := range rot.Status.Tokens {
+		if t.Phase == v1alpha1.RotationPending || t.Phase == v1alpha1.RotationRotating {
+			return false
+		}
+	}
+	return true
+}
+
+// completed returns true if rot reported its outcome, so its tokens are not
+// listed again.
+func completed(rot *v1alpha1.AccessTokenRotation) bool {
+	r := rot.GetCondition(xpv1.TypeReady).Reason
+	return r == xpv1.ReasonAvailable || r == xpv1.ReasonUnavailable
+}
+
+// count updates the totals of the status of rot.
+func count(rot *v1alpha1.AccessTokenRotation) {
+	rot.Status.Total, rot.Status.Rotated, rot.Status.Failed = len(rot.Status.Tokens), 0, 0
+	for _, t := range rot.Status.Tokens {
+		if t.Phase == v1alpha1.RotationRotated {
+			rot.Status.Rotated++
+		}
+		if t.Phase == v1alpha1.RotationFailed {
+			rot.Status.Failed++
+		}
+	}
+}
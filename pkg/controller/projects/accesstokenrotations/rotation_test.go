@@ -0,0 +1,236 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesstokenrotations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	groupsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/rotation"
+)
+
+var now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func projectToken(name string, annotations map[string]string) *v1alpha1.AccessToken {
+	return &v1alpha1.AccessToken{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+}
+
+func revoking(at *v1alpha1.AccessToken) *v1alpha1.AccessToken {
+	at.Status.AtProvider.RevokePendingID = ptr.To(1)
+	return at
+}
+
+func tokens(mgs ...*v1alpha1.AccessToken) map[string]resource.Managed {
+	out := map[string]resource.Managed{}
+	for _, mg := range mgs {
+		out[key(v1alpha1.AccessTokenGroupKind, mg.GetName())] = mg
+	}
+	return out
+}
+
+func target(name string, phase v1alpha1.RotationPhase) v1alpha1.AccessTokenRotationTarget {
+	return v1alpha1.AccessTokenRotationTarget{Kind: v1alpha1.AccessTokenGroupKind, Name: name, Phase: phase}
+}
+
+func accessTokenRotation(maxParallel int, interval time.Duration, ts ...v1alpha1.AccessTokenRotationTarget) *v1alpha1.AccessTokenRotation {
+	return &v1alpha1.AccessTokenRotation{
+		ObjectMeta: metav1.ObjectMeta{Name: "incident", UID: types.UID("uid")},
+		Spec: v1alpha1.AccessTokenRotationSpec{
+			MaxParallel: ptr.To(maxParallel),
+			Interval:    &metav1.Duration{Duration: interval},
+		},
+		Status: v1alpha1.AccessTokenRotationStatus{Tokens: ts},
+	}
+}
+
+func TestPlan(t *testing.T) {
+	got := Plan(map[string]resource.Managed{
+		key(v1alpha1.AccessTokenGroupKind, "b"):       projectToken("b", nil),
+		key(groupsv1alpha1.AccessTokenGroupKind, "a"): &groupsv1alpha1.AccessToken{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+		key(v1alpha1.AccessTokenGroupKind, "a"):       projectToken("a", nil),
+	})
+	want := []v1alpha1.AccessTokenRotationTarget{
+		{Kind: groupsv1alpha1.AccessTokenGroupKind, Name: "a", Phase: v1alpha1.RotationPending},
+		target("a", v1alpha1.RotationPending),
+		target("b", v1alpha1.RotationPending),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Plan: -want, +got:\n%s", diff)
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	requested := map[string]string{rotation.AnnotationKeyRotate: "uid"}
+	rotated := map[string]string{rotation.AnnotationKeyRotate: "uid", rotation.AnnotationKeyRotated: "uid"}
+
+	type want struct {
+		start  []int
+		wait   time.Duration
+		phases []v1alpha1.RotationPhase
+	}
+
+	cases := map[string]struct {
+		rot    *v1alpha1.AccessTokenRotation
+		tokens map[string]resource.Managed
+		want   want
+	}{
+		"StartUpToMaxParallel": {
+			rot: accessTokenRotation(2, 0,
+				target("a", v1alpha1.RotationPending), target("b", v1alpha1.RotationPending), target("c", v1alpha1.RotationPending)),
+			tokens: tokens(projectToken("a", nil), projectToken("b", nil), projectToken("c", nil)),
+			want: want{
+				start:  []int{0, 1},
+				phases: []v1alpha1.RotationPhase{v1alpha1.RotationRotating, v1alpha1.RotationRotating, v1alpha1.RotationPending},
+			},
+		},
+		"StartWhenRotated": {
+			rot: accessTokenRotation(1, 0,
+				target("a", v1alpha1.RotationRotating), target("b", v1alpha1.RotationPending)),
+			tokens: tokens(projectToken("a", rotated), projectToken("b", nil)),
+			want: want{
+				start:  []int{1},
+				phases: []v1alpha1.RotationPhase{v1alpha1.RotationRotated, v1alpha1.RotationRotating},
+			},
+		},
+		"WaitWhileRevoking": {
+			rot: accessTokenRotation(1, 0,
+				target("a", v1alpha1.RotationRotating), target("b", v1alpha1.RotationPending)),
+			tokens: tokens(revoking(projectToken("a", rotated)), projectToken("b", nil)),
+			want: want{
+				start:  []int{},
+				phases: []v1alpha1.RotationPhase{v1alpha1.RotationRotating, v1alpha1.RotationPending},
+			},
+		},
+		"WaitWhileRotating": {
+			rot: accessTokenRotation(1, 0,
+				target("a", v1alpha1.RotationRotating), target("b", v1alpha1.RotationPending)),
+			tokens: tokens(projectToken("a", requested), projectToken("b", nil)),
+			want: want{
+				start:  []int{},
+				phases: []v1alpha1.RotationPhase{v1alpha1.RotationRotating, v1alpha1.RotationPending},
+			},
+		},
+		"StaggerByInterval": {
+			rot: accessTokenRotation(5, time.Minute,
+				target("a", v1alpha1.RotationPending), target("b", v1alpha1.RotationPending)),
+			tokens: tokens(projectToken("a", nil), projectToken("b", nil)),
+			want: want{
+				start:  []int{0},
+				wait:   time.Minute,
+				phases: []v1alpha1.RotationPhase{v1alpha1.RotationRotating, v1alpha1.RotationPending},
+			},
+		},
+		"DeletedAndSuperseded": {
+			rot: accessTokenRotation(5, 0,
+				target("a", v1alpha1.RotationPending), target("b", v1alpha1.RotationRotating)),
+			tokens: tokens(projectToken("b", map[string]string{rotation.AnnotationKeyRotate: "other"})),
+			want: want{
+				start:  []int{},
+				phases: []v1alpha1.RotationPhase{v1alpha1.RotationFailed, v1alpha1.RotationFailed},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			start, wait := Advance(tc.rot, tc.tokens, now)
+			if diff := cmp.Diff(tc.want.start, start); diff != "" {
+				t.Errorf("start: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.wait, wait); diff != "" {
+				t.Errorf("wait: -want, +got:\n%s", diff)
+			}
+			phases := []v1alpha1.RotationPhase{}
+			for _, tgt := range tc.rot.Status.Tokens {
+				phases = append(phases, tgt.Phase)
+			}
+			if diff := cmp.Diff(tc.want.phases, phases); diff != "" {
+				t.Errorf("phases: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	rot := accessTokenRotation(5, 0)
+	rot.Status.Tokens = nil
+
+	var updated []string
+	var status *v1alpha1.AccessTokenRotation
+	kube := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			rot.DeepCopyInto(obj.(*v1alpha1.AccessTokenRotation))
+			return nil
+		}),
+		MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+			if l, ok := obj.(*v1alpha1.AccessTokenList); ok {
+				l.Items = []v1alpha1.AccessToken{*projectToken("a", nil)}
+			}
+			return nil
+		}),
+		MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+			if rotation.Requested(obj.(resource.Managed)) {
+				updated = append(updated, obj.GetName())
+			}
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+			status = obj.(*v1alpha1.AccessTokenRotation)
+			return nil
+		}),
+	}
+	r := &reconciler{
+		client:       kube,
+		log:          logging.NewNopLogger(),
+		record:       event.NewNopRecorder(),
+		pollInterval: time.Minute,
+		now:          func() time.Time { return now },
+	}
+
+	got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "incident"}})
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if diff := cmp.Diff(reconcile.Result{RequeueAfter: time.Minute}, got); diff != "" {
+		t.Errorf("Reconcile: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"a"}, updated); diff != "" {
+		t.Errorf("requested: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(1, status.Status.Total); diff != "" {
+		t.Errorf("total: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(xpv1.Creating(), status.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("ready: -want, +got:\n%s", diff)
+	}
+}
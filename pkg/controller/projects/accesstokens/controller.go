@@ -26,6 +26,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,9 +40,21 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/expiry"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/rotation"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -52,7 +65,12 @@ const (
 	errCreateFailed         = "cannot create Gitlab accesstoken"
 	errDeleteFailed         = "cannot delete Gitlab accesstoken"
 	errAccessTokentNotFound = "cannot find Gitlab accesstoken"
-	errMissingProjectID     = "missing Spec.ForProvider.ProjectID"
+	errKubeUpdateFailed     = "cannot update Gitlab accesstoken custom resource"
+	errMissingProjectID     = "missing Spec.ForProvider.ProjectID or Spec.ForProvider.GroupID"
+
+	reasonRevoked       event.Reason = "RevokedExternalResource"
+	reasonRevokeSkipped event.Reason = "SkippedRevokingExternalResource"
+	reasonRotated       event.Reason = "RotatedExternalResource"
 )
 
 // SetupAccessToken adds a controller that reconciles ProjectAccessTokens.
@@ -64,12 +82,15 @@ func SetupAccessToken(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewAccessTokenClient}),
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.AccessTokenGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.AccessTokenGroupVersionKind, clients.NewBreakerConnecter(expiry.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), recorder: recorder, newGitlabClientFn: projects.NewAccessTokenClient, newGroupClientFn: projects.NewGroupAccessTokenClient})))))))))),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -77,19 +98,28 @@ func SetupAccessToken(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.AccessTokenGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.AccessToken{}).
-		Complete(r)
+		For(&v1alpha1.AccessToken{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.AccessToken{}, &v1alpha1.AccessTokenList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
 	kube              client.Client
+	recorder          event.Recorder
 	newGitlabClientFn func(cfg clients.Config) projects.AccessTokenClient
+	newGroupClientFn  func(cfg clients.Config) projects.AccessTokenClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -101,12 +131,16 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	if cr.Spec.ForProvider.GroupID != nil {
+		return &external{kube: c.kube, recorder: c.recorder, client: c.newGroupClientFn(*cfg)}, nil
+	}
+	return &external{kube: c.kube, recorder: c.recorder, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client projects.AccessTokenClient
+	kube     client.Client
+	recorder event.Recorder
+	client   projects.AccessTokenClient
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -120,16 +154,23 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, nil
 	}
 
+	// Report a token kept valid on deletion as gone, so that the managed
+	// resource is finalized without calling Delete.
+	if meta.WasDeleted(cr) && ptr.Deref(cr.Spec.ForProvider.SkipRevokeOnDelete, false) {
+		e.recorder.Event(cr, event.Normal(reasonRevokeSkipped, "Not revoking "+clients.DescribeToken(tokenKind(&cr.Spec.ForProvider), externalName, cr.Spec.ForProvider.Name, cr.Spec.ForProvider.Scopes, cr.Spec.ForProvider.ExpiresAt)))
+		return managed.ExternalObservation{}, nil
+	}
+
 	accessTokenID, err := strconv.Atoi(externalName)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errFailedParseID)
 	}
 
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.AccessTokenScopeID(&cr.Spec.ForProvider) == nil {
 		return managed.ExternalObservation{}, errors.New(errMissingProjectID)
 	}
 
-	at, res, err := e.client.GetProjectAccessToken(*cr.Spec.ForProvider.ProjectID, accessTokenID)
+	at, res, err := e.client.GetProjectAccessToken(*projects.AccessTokenScopeID(&cr.Spec.ForProvider), accessTokenID, gitlab.WithContext(ctx))
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -144,7 +185,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        true,
+		ResourceUpToDate:        cr.Status.AtProvider.RevokePendingID == nil && !rotation.Requested(cr) && (!ptr.Deref(cr.Spec.ForProvider.RecreateOnDrift, false) || projects.IsAccessTokenUpToDate(&cr.Spec.ForProvider, at)),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -155,12 +196,16 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotAccessToken)
 	}
 
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.AccessTokenScopeID(&cr.Spec.ForProvider) == nil {
 		return managed.ExternalCreation{}, errors.New(errMissingProjectID)
 	}
 
+	if err := e.checkProviderToken(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	at, _, err := e.client.CreateProjectAccessToken(
-		*cr.Spec.ForProvider.ProjectID,
+		*projects.AccessTokenScopeID(&cr.Spec.ForProvider),
 		projects.GenerateCreateProjectAccessTokenOptions(cr.Name, &cr.Spec.ForProvider),
 		gitlab.WithContext(ctx),
 	)
@@ -178,8 +223,72 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// it's not possible to update a ProjectAccessToken
-	return managed.ExternalUpdate{}, nil
+	cr, ok := mg.(*v1alpha1.AccessToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccessToken)
+	}
+
+	if cr.Status.AtProvider.RevokePendingID != nil {
+		if projects.AccessTokenScopeID(&cr.Spec.ForProvider) == nil {
+			return managed.ExternalUpdate{}, errors.New(errMissingProjectID)
+		}
+		return managed.ExternalUpdate{}, e.revokeReplaced(ctx, cr)
+	}
+
+	// it's not possible to update a ProjectAccessToken, it can only be
+	// recreated when it drifted or was requested to rotate.
+	rotate := rotation.Requested(cr)
+	if !rotate && !ptr.Deref(cr.Spec.ForProvider.RecreateOnDrift, false) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	accessTokenID, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errExternalNameNotInt)
+	}
+
+	if projects.AccessTokenScopeID(&cr.Spec.ForProvider) == nil {
+		return managed.ExternalUpdate{}, errors.New(errMissingProjectID)
+	}
+
+	if err := e.checkProviderToken(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	at, res, err := e.client.GetProjectAccessToken(*projects.AccessTokenScopeID(&cr.Spec.ForProvider), accessTokenID, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFailed)
+	}
+	replaced := err == nil && !at.Revoked
+
+	at, _, err = e.client.CreateProjectAccessToken(
+		*projects.AccessTokenScopeID(&cr.Spec.ForProvider),
+		projects.GenerateCreateProjectAccessTokenOptions(cr.Name, &cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(at.ID))
+	rotation.Completed(cr)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+	// The replaced token stays valid until the next reconcile, so that its
+	// consumers keep working until the new token is published.
+	if replaced {
+		cr.Status.AtProvider.RevokePendingID = &accessTokenID
+	}
+	if rotate {
+		e.recorder.Event(cr, event.Normal(reasonRotated, "Rotated "+clients.DescribeToken(tokenKind(&cr.Spec.ForProvider), meta.GetExternalName(cr), cr.Spec.ForProvider.Name, cr.Spec.ForProvider.Scopes, cr.Spec.ForProvider.ExpiresAt)))
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			"token": []byte(at.Token),
+		},
+	}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -194,16 +303,56 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errExternalNameNotInt)
 	}
 
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.AccessTokenScopeID(&cr.Spec.ForProvider) == nil {
 		return errors.New(errMissingProjectID)
 	}
+	if err := e.revokeReplaced(ctx, cr); err != nil {
+		return err
+	}
 	_, err = e.client.RevokeProjectAccessToken(
-		*cr.Spec.ForProvider.ProjectID,
+		*projects.AccessTokenScopeID(&cr.Spec.ForProvider),
 		accessTokenID,
 		gitlab.WithContext(ctx),
 	)
+	if err != nil {
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	e.recorder.Event(cr, event.Normal(reasonRevoked, "Revoked "+clients.DescribeToken(tokenKind(&cr.Spec.ForProvider), meta.GetExternalName(cr), cr.Spec.ForProvider.Name, cr.Spec.ForProvider.Scopes, cr.Spec.ForProvider.ExpiresAt)))
+	return nil
+}
+
+// revokeReplaced revokes the token replaced by the last recreation of cr,
+// if any. A token that is already gone counts as revoked.
+func (e *external) revokeReplaced(ctx context.Context, cr *v1alpha1.AccessToken) error {
+	id := cr.Status.AtProvider.RevokePendingID
+	if id == nil {
+		return nil
+	}
+	res, err := e.client.RevokeProjectAccessToken(*projects.AccessTokenScopeID(&cr.Spec.ForProvider), *id, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	cr.Status.AtProvider.RevokePendingID = nil
+	return nil
+}
 
-	return errors.Wrap(err, errDeleteFailed)
+// checkProviderToken reports in the ProviderToken condition of cr whether
+// the provider token may create the access token, so that it fails with an
+// actionable condition instead of being denied by Gitlab.
+func (e *external) checkProviderToken(ctx context.Context, cr *v1alpha1.AccessToken) error {
+	reason, err := projects.InsufficientProviderToken(e.client, &cr.Spec.ForProvider, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	return clients.SetProviderTokenCondition(cr, reason)
+}
+
+// tokenKind describes the kind of access token p is in events.
+func tokenKind(p *v1alpha1.AccessTokenParameters) string {
+	if p.GroupID != nil {
+		return "group access token"
+	}
+	return "project access token"
 }
 
 // lateInitializeProjectAccessToken fills the empty fields in the access token spec with the
@@ -29,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -37,22 +38,25 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	commonv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/rotation"
 )
 
 var (
-	errBoom        = errors.New("boom")
-	projectID      = ""
-	wrongIDstr     = "fr"
-	accessTokenID  = 1234
-	sAccessTokenID = strconv.Itoa(accessTokenID)
-	invalidInput   resource.Managed
-	expiresAt      = time.Now().AddDate(0, 6, 0)
-	accessLevel    = 40
-	name           = "Access Token Name"
-	token          = "Token"
-	accessTokenObj = gitlab.ProjectAccessToken{
+	errBoom         = errors.New("boom")
+	projectID       = ""
+	wrongIDstr      = "fr"
+	accessTokenID   = 1234
+	sAccessTokenID  = strconv.Itoa(accessTokenID)
+	invalidInput    resource.Managed
+	expiresAt       = time.Now().AddDate(0, 6, 0)
+	accessLevel     = 40
+	name            = "Access Token Name"
+	token           = "Token"
+	recreateOnDrift = true
+	accessTokenObj  = gitlab.ProjectAccessToken{
 		ID:          accessTokenID,
 		Name:        name,
 		ExpiresAt:   (*gitlab.ISOTime)(&expiresAt),
@@ -84,10 +88,33 @@ func withExternalName(accessTokenID string) accessTokenModifier {
 	return func(r *v1alpha1.AccessToken) { meta.SetExternalName(r, accessTokenID) }
 }
 
+func withRevokePending(id int) accessTokenModifier {
+	return func(r *v1alpha1.AccessToken) { r.Status.AtProvider.RevokePendingID = &id }
+}
+
+func withDeletionTimestamp() accessTokenModifier {
+	return func(r *v1alpha1.AccessToken) { r.DeletionTimestamp = &v1.Time{Time: time.Unix(1, 0)} }
+}
+
 func withAnnotations(a map[string]string) accessTokenModifier {
 	return func(p *v1alpha1.AccessToken) { meta.AddAnnotations(p, a) }
 }
 
+// withProviderToken lets c get a provider token with the api scope, whose
+// user has access level l.
+func withProviderToken(c *fake.MockClient, l gitlab.AccessLevelValue) *fake.MockClient {
+	c.MockGetSinglePersonalAccessToken = func(options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+		return &gitlab.PersonalAccessToken{Name: "provider", Scopes: []string{"api"}}, &gitlab.Response{}, nil
+	}
+	c.MockCurrentUser = func(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+		return &gitlab.User{ID: 1, Username: "provider"}, &gitlab.Response{}, nil
+	}
+	c.MockGetInheritedMember = func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+		return &gitlab.ProjectMember{ID: user, AccessLevel: l}, &gitlab.Response{}, nil
+	}
+	return c
+}
+
 func accessToken(m ...accessTokenModifier) *v1alpha1.AccessToken {
 	cr := &v1alpha1.AccessToken{}
 	for _, f := range m {
@@ -97,6 +124,8 @@ func accessToken(m ...accessTokenModifier) *v1alpha1.AccessToken {
 }
 
 func TestObserve(t *testing.T) {
+	skipRevokeOnDelete := true
+
 	type want struct {
 		cr     resource.Managed
 		result managed.ExternalObservation
@@ -116,6 +145,22 @@ func TestObserve(t *testing.T) {
 				err: errors.New(errNotAccessToken),
 			},
 		},
+		"SkipRevokeOnDelete": {
+			args: args{
+				cr: accessToken(
+					withExternalName("1234"),
+					withDeletionTimestamp(),
+					withSpec(v1alpha1.AccessTokenParameters{SkipRevokeOnDelete: &skipRevokeOnDelete}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("1234"),
+					withDeletionTimestamp(),
+					withSpec(v1alpha1.AccessTokenParameters{SkipRevokeOnDelete: &skipRevokeOnDelete}),
+				),
+			},
+		},
 		"NoExternalName": {
 			args: args{
 				cr: accessToken(),
@@ -286,6 +331,78 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ScopesDriftRecreateOnDrift": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:       &projectID,
+						AccessLevel:     (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:       &v1.Time{Time: expiresAt},
+						Scopes:          []string{"scope1"},
+						RecreateOnDrift: &recreateOnDrift,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withConditions(xpv1.Available()),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:       &projectID,
+						AccessLevel:     (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:       &v1.Time{Time: expiresAt},
+						Scopes:          []string{"scope1"},
+						RecreateOnDrift: &recreateOnDrift,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
+		"ScopesDriftIgnored": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:   &projectID,
+						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:   &v1.Time{Time: expiresAt},
+						Scopes:      []string{"scope1"},
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withConditions(xpv1.Available()),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:   &projectID,
+						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:   &v1.Time{Time: expiresAt},
+						Scopes:      []string{"scope1"},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
 		"TokenUpToDate": {
 			args: args{
 				accessTokenClient: &fake.MockClient{
@@ -319,11 +436,79 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationRequested": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withAnnotations(map[string]string{rotation.AnnotationKeyRotate: "incident-1"}),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:   &projectID,
+						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:   &v1.Time{Time: expiresAt},
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withAnnotations(map[string]string{rotation.AnnotationKeyRotate: "incident-1"}),
+					withConditions(xpv1.Available()),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:   &projectID,
+						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:   &v1.Time{Time: expiresAt},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RevokePending": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName("4321"),
+					withRevokePending(accessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:   &projectID,
+						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:   &v1.Time{Time: expiresAt},
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("4321"),
+					withRevokePending(accessTokenID),
+					withConditions(xpv1.Available()),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:   &projectID,
+						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:   &v1.Time{Time: expiresAt},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.accessTokenClient}
+			e := &external{recorder: event.NewNopRecorder(), kube: tc.kube, client: tc.accessTokenClient}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -346,6 +531,9 @@ func TestCreate(t *testing.T) {
 		err    error
 	}
 
+	insufficient := "user provider of the provider token has access level 30, creating access tokens requires at least 40"
+	groupInsufficient := "user provider of the provider token has access level 40, creating access tokens requires at least 50"
+
 	cases := map[string]struct {
 		args
 		want
@@ -372,11 +560,11 @@ func TestCreate(t *testing.T) {
 		},
 		"CreationFailedErr": {
 			args: args{
-				accessTokenClient: &fake.MockClient{
+				accessTokenClient: withProviderToken(&fake.MockClient{
 					MockCreateProjectAccessToken: func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
 						return nil, nil, errBoom
 					},
-				},
+				}, gitlab.MaintainerPermissions),
 				cr: accessToken(
 					withExternalName(sAccessTokenID),
 					withSpec(v1alpha1.AccessTokenParameters{
@@ -397,11 +585,11 @@ func TestCreate(t *testing.T) {
 		},
 		"NoExternalName": {
 			args: args{
-				accessTokenClient: &fake.MockClient{
+				accessTokenClient: withProviderToken(&fake.MockClient{
 					MockCreateProjectAccessToken: func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
 						return &gitlab.ProjectAccessToken{}, &gitlab.Response{}, errBoom
 					},
-				},
+				}, gitlab.MaintainerPermissions),
 				cr: accessToken(
 					withSpec(v1alpha1.AccessTokenParameters{
 						ProjectID: &projectID,
@@ -419,16 +607,54 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
+		"ProviderTokenInsufficient": {
+			args: args{
+				accessTokenClient: withProviderToken(&fake.MockClient{}, gitlab.DeveloperPermissions),
+				cr: accessToken(
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID: &projectID,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID: &projectID,
+					}),
+					withConditions(commonv1alpha1.ProviderTokenInsufficient(insufficient)),
+				),
+				err: errors.New(insufficient),
+			},
+		},
+		"GroupProviderTokenInsufficient": {
+			args: args{
+				accessTokenClient: withProviderToken(&fake.MockClient{}, gitlab.MaintainerPermissions),
+				cr: accessToken(
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID: &projectID,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID: &projectID,
+					}),
+					withConditions(commonv1alpha1.ProviderTokenInsufficient(groupInsufficient)),
+				),
+				err: errors.New(groupInsufficient),
+			},
+		},
 		"CreationSuccessful": {
 			args: args{
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
-				accessTokenClient: &fake.MockClient{
+				accessTokenClient: withProviderToken(&fake.MockClient{
 					MockCreateProjectAccessToken: func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
 						return &accessTokenObj, &gitlab.Response{}, nil
 					},
-				},
+				}, gitlab.MaintainerPermissions),
 				cr: accessToken(
 					withSpec(v1alpha1.AccessTokenParameters{
 						ProjectID: &projectID,
@@ -451,7 +677,7 @@ func TestCreate(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.accessTokenClient}
+			e := &external{recorder: event.NewNopRecorder(), kube: tc.kube, client: tc.accessTokenClient}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -487,10 +713,158 @@ func TestUpdate(t *testing.T) {
 				result: managed.ExternalUpdate{},
 			},
 		},
+		"RecreateOnDrift": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				accessTokenClient: withProviderToken(&fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+					MockCreateProjectAccessToken: func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{ID: 4321, Token: token}, &gitlab.Response{}, nil
+					},
+				}, gitlab.MaintainerPermissions),
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID, RecreateOnDrift: &recreateOnDrift}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("4321"),
+					withRevokePending(accessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID, RecreateOnDrift: &recreateOnDrift}),
+				),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte(token)},
+				},
+			},
+		},
+		"RotationRequested": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				accessTokenClient: withProviderToken(&fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+					MockCreateProjectAccessToken: func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{ID: 4321, Token: token}, &gitlab.Response{}, nil
+					},
+				}, gitlab.MaintainerPermissions),
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withAnnotations(map[string]string{rotation.AnnotationKeyRotate: "incident-1"}),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("4321"),
+					withRevokePending(accessTokenID),
+					withAnnotations(map[string]string{rotation.AnnotationKeyRotate: "incident-1", rotation.AnnotationKeyRotated: "incident-1"}),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID}),
+				),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte(token)},
+				},
+			},
+		},
+		"CreateFailedKeepsToken": {
+			args: args{
+				accessTokenClient: withProviderToken(&fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+					MockCreateProjectAccessToken: func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				}, gitlab.MaintainerPermissions),
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID, RecreateOnDrift: &recreateOnDrift}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID, RecreateOnDrift: &recreateOnDrift}),
+				),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"RevokeReplaced": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockRevokeProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if id != accessTokenID {
+							return &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName("4321"),
+					withRevokePending(accessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID, RecreateOnDrift: &recreateOnDrift}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("4321"),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID, RecreateOnDrift: &recreateOnDrift}),
+				),
+			},
+		},
+		"RevokeReplacedGone": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockRevokeProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: accessToken(
+					withExternalName("4321"),
+					withRevokePending(accessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("4321"),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID}),
+				),
+			},
+		},
+		"RevokeReplacedFailed": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockRevokeProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: accessToken(
+					withExternalName("4321"),
+					withRevokePending(accessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("4321"),
+					withRevokePending(accessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{ProjectID: &projectID}),
+				),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.accessTokenClient}
+			e := &external{recorder: event.NewNopRecorder(), kube: tc.kube, client: tc.accessTokenClient}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -598,7 +972,7 @@ func TestDelete(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.accessTokenClient}
+			e := &external{recorder: event.NewNopRecorder(), client: tc.accessTokenClient}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
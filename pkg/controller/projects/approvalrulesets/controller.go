@@ -0,0 +1,243 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalrulesets
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotApprovalRuleSet = "managed resource is not a Gitlab project approval rule set custom resource"
+	errProjectIDMissing   = "ProjectID is missing"
+	errListFailed         = "cannot list Gitlab project approval rules"
+	errCreateFailed       = "cannot create Gitlab project approval rule %q"
+	errUpdateFailed       = "cannot update Gitlab project approval rule %q"
+	errDeleteFailed       = "cannot delete Gitlab project approval rule %d"
+)
+
+// SetupApprovalRuleSet adds a controller that reconciles ApprovalRuleSets.
+func SetupApprovalRuleSet(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ApprovalRuleSetKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.ApprovalRuleSetGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.ApprovalRuleSetGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewApprovalRuleClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ApprovalRuleSetGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ApprovalRuleSet{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.ApprovalRuleSet{}, &v1alpha1.ApprovalRuleSetList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ApprovalRuleClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalRuleSet)
+	if !ok {
+		return nil, errors.New(errNotApprovalRuleSet)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ApprovalRuleClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalRuleSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApprovalRuleSet)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	rules, err := projects.ListProjectApprovalRules(e.client, *cr.Spec.ForProvider.ProjectID, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateApprovalRuleSetObservation(rules)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.DiffApprovalRuleSet(&cr.Spec.ForProvider, rules).IsEmpty(),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalRuleSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApprovalRuleSet)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.ProjectID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalRuleSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotApprovalRuleSet)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ApprovalRuleSet)
+	if !ok {
+		return errors.New(errNotApprovalRuleSet)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	pid := *cr.Spec.ForProvider.ProjectID
+	rules, err := projects.ListProjectApprovalRules(e.client, pid, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errListFailed)
+	}
+
+	// Only the rules of the set are deleted; rules pruned while the set
+	// existed stay deleted, others are left alone.
+	inSet := make(map[string]bool, len(cr.Spec.ForProvider.Rules))
+	for _, r := range cr.Spec.ForProvider.Rules {
+		inSet[r.Name] = true
+	}
+	for _, r := range rules {
+		if !inSet[r.Name] {
+			continue
+		}
+		res, err := e.client.DeleteProjectApprovalRule(pid, r.ID, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errDeleteFailed, r.ID)
+		}
+	}
+	return nil
+}
+
+// apply deletes, updates and creates project approval rules until they match
+// the spec. Deletions come first so rules whose type changed can be created
+// again under the same name.
+func (e *external) apply(ctx context.Context, cr *v1alpha1.ApprovalRuleSet) error {
+	pid := *cr.Spec.ForProvider.ProjectID
+
+	rules, err := projects.ListProjectApprovalRules(e.client, pid, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errListFailed)
+	}
+
+	d := projects.DiffApprovalRuleSet(&cr.Spec.ForProvider, rules)
+	for _, id := range d.Delete {
+		if res, err := e.client.DeleteProjectApprovalRule(pid, id, gitlab.WithContext(ctx)); err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errDeleteFailed, id)
+		}
+	}
+	for i := range d.Update {
+		if _, _, err := e.client.UpdateProjectApprovalRule(pid, d.Update[i].ID, projects.GenerateUpdateApprovalRuleOptions(&d.Update[i].Rule), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errUpdateFailed, d.Update[i].Rule.Name)
+		}
+	}
+	for i := range d.Create {
+		if _, _, err := e.client.CreateProjectApprovalRule(pid, projects.GenerateCreateApprovalRuleOptions(&d.Create[i]), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errCreateFailed, d.Create[i].Name)
+		}
+	}
+	return nil
+}
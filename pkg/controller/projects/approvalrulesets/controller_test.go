@@ -0,0 +1,328 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalrulesets
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+
+	security = v1alpha1.ApprovalRule{Name: "security", ApprovalsRequired: 2, GroupIDs: []int{7}}
+	anyone   = v1alpha1.ApprovalRule{Name: "anyone", ApprovalsRequired: 1, RuleType: ptr.To("any_approver")}
+)
+
+type args struct {
+	client projects.ApprovalRuleClient
+	cr     *v1alpha1.ApprovalRuleSet
+}
+
+type approvalRuleSetModifier func(*v1alpha1.ApprovalRuleSet)
+
+func withConditions(c ...xpv1.Condition) approvalRuleSetModifier {
+	return func(r *v1alpha1.ApprovalRuleSet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() approvalRuleSetModifier {
+	return func(r *v1alpha1.ApprovalRuleSet) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withRules(rules ...v1alpha1.ApprovalRule) approvalRuleSetModifier {
+	return func(r *v1alpha1.ApprovalRuleSet) { r.Spec.ForProvider.Rules = rules }
+}
+
+func withoutPrune() approvalRuleSetModifier {
+	return func(r *v1alpha1.ApprovalRuleSet) { r.Spec.ForProvider.Prune = ptr.To(false) }
+}
+
+func withExternalName(n string) approvalRuleSetModifier {
+	return func(r *v1alpha1.ApprovalRuleSet) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.ApprovalRuleSetObservation) approvalRuleSetModifier {
+	return func(r *v1alpha1.ApprovalRuleSet) { r.Status.AtProvider = s }
+}
+
+func approvalRuleSet(m ...approvalRuleSetModifier) *v1alpha1.ApprovalRuleSet {
+	cr := &v1alpha1.ApprovalRuleSet{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listRules(r ...*gitlab.ProjectApprovalRule) func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	return func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+		return r, &gitlab.Response{}, nil
+	}
+}
+
+var (
+	securityRule = &gitlab.ProjectApprovalRule{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2, Groups: []*gitlab.Group{{ID: 7}}}
+	manualRule   = &gitlab.ProjectApprovalRule{ID: 2, Name: "manual", RuleType: "regular", ApprovalsRequired: 0}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApprovalRuleSet
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: approvalRuleSet(withProjectID()),
+			},
+			want: want{
+				cr: approvalRuleSet(withProjectID()),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRules: listRules(securityRule),
+				},
+				cr: approvalRuleSet(withProjectID(), withExternalName(projectID), withRules(security)),
+			},
+			want: want{
+				cr: approvalRuleSet(
+					withProjectID(),
+					withExternalName(projectID),
+					withRules(security),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ApprovalRuleSetObservation{Rules: []v1alpha1.ApprovalRuleObservation{{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2, GroupIDs: []int{7}}}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDateWithManualRule": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRules: listRules(securityRule, manualRule),
+				},
+				cr: approvalRuleSet(withProjectID(), withExternalName(projectID), withRules(security)),
+			},
+			want: want{
+				cr: approvalRuleSet(
+					withProjectID(),
+					withExternalName(projectID),
+					withRules(security),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ApprovalRuleSetObservation{Rules: []v1alpha1.ApprovalRuleObservation{
+						{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2, GroupIDs: []int{7}},
+						{ID: 2, Name: "manual", RuleType: "regular"},
+					}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"UpToDateWithManualRuleWithoutPrune": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRules: listRules(securityRule, manualRule),
+				},
+				cr: approvalRuleSet(withProjectID(), withExternalName(projectID), withRules(security), withoutPrune()),
+			},
+			want: want{
+				cr: approvalRuleSet(
+					withProjectID(),
+					withExternalName(projectID),
+					withRules(security),
+					withoutPrune(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ApprovalRuleSetObservation{Rules: []v1alpha1.ApprovalRuleObservation{
+						{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2, GroupIDs: []int{7}},
+						{ID: 2, Name: "manual", RuleType: "regular"},
+					}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ErrList": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRules: func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: approvalRuleSet(withProjectID(), withExternalName(projectID)),
+			},
+			want: want{
+				cr:  approvalRuleSet(withProjectID(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errListFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var created []string
+	e := &external{client: &fake.MockClient{
+		MockGetProjectApprovalRules: listRules(securityRule),
+		MockCreateProjectApprovalRule: func(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			created = append(created, *opt.Name)
+			return &gitlab.ProjectApprovalRule{}, &gitlab.Response{}, nil
+		},
+	}}
+
+	cr := approvalRuleSet(withProjectID(), withRules(security, anyone))
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := approvalRuleSet(withProjectID(), withRules(security, anyone), withExternalName(projectID), withConditions(xpv1.Creating()))
+	if diff := cmp.Diff(want, cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"anyone"}, created); diff != "" {
+		t.Errorf("created: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var calls []string
+	e := &external{client: &fake.MockClient{
+		MockGetProjectApprovalRules: listRules(
+			&gitlab.ProjectApprovalRule{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 1},
+			&gitlab.ProjectApprovalRule{ID: 3, Name: "anyone", RuleType: "regular", ApprovalsRequired: 1},
+			manualRule,
+		),
+		MockUpdateProjectApprovalRule: func(pid interface{}, approvalRule int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			calls = append(calls, "update "+*opt.Name)
+			return &gitlab.ProjectApprovalRule{}, &gitlab.Response{}, nil
+		},
+		MockCreateProjectApprovalRule: func(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			calls = append(calls, "create "+*opt.Name)
+			return &gitlab.ProjectApprovalRule{}, &gitlab.Response{}, nil
+		},
+		MockDeleteProjectApprovalRule: func(pid interface{}, approvalRule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			calls = append(calls, "delete "+map[int]string{2: "manual", 3: "anyone"}[approvalRule])
+			return &gitlab.Response{}, nil
+		},
+	}}
+
+	_, err := e.Update(context.Background(), approvalRuleSet(withProjectID(), withExternalName(projectID), withRules(security, anyone)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"delete anyone", "delete manual", "update security", "create anyone"}
+	if diff := cmp.Diff(want, calls); diff != "" {
+		t.Errorf("calls: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr      *v1alpha1.ApprovalRuleSet
+		deleted []int
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRules: listRules(securityRule, manualRule),
+				},
+				cr: approvalRuleSet(withProjectID(), withRules(security)),
+			},
+			want: want{
+				cr:      approvalRuleSet(withProjectID(), withRules(security), withConditions(xpv1.Deleting())),
+				deleted: []int{1},
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRules: listRules(securityRule),
+				},
+				cr: approvalRuleSet(withProjectID(), withRules(security)),
+			},
+			want: want{
+				cr:      approvalRuleSet(withProjectID(), withRules(security), withConditions(xpv1.Deleting())),
+				deleted: []int{1},
+				err:     errors.Wrapf(errBoom, errDeleteFailed, 1),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []int
+			mc := tc.client.(*fake.MockClient)
+			mc.MockDeleteProjectApprovalRule = func(pid interface{}, approvalRule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				deleted = append(deleted, approvalRule)
+				if tc.want.err != nil {
+					return &gitlab.Response{}, errBoom
+				}
+				return &gitlab.Response{}, nil
+			}
+
+			e := &external{client: mc}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvals
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotApproval      = "managed resource is not a Gitlab merge request approval custom resource"
+	errProjectIDMissing = "ProjectID is missing"
+	errGetFailed        = "cannot get Gitlab merge request"
+	errGetApprovals     = "cannot get Gitlab merge request approvals"
+	errApproveFailed    = "cannot approve Gitlab merge request"
+	errUnapproveFailed  = "cannot unapprove Gitlab merge request"
+)
+
+// SetupApproval adds a controller that reconciles Approvals.
+func SetupApproval(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ApprovalKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.ApprovalGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.ApprovalGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewApprovalClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ApprovalGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Approval{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Approval{}, &v1alpha1.ApprovalList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ApprovalClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Approval)
+	if !ok {
+		return nil, errors.New(errNotApproval)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ApprovalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Approval)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApproval)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	// The merge request is only approved by the provider once the
+	// Approval was created, so an approval given by hand beforehand is
+	// adopted on creation.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	mr, a, res, err := e.get(ctx, cr)
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = projects.GenerateApprovalObservation(&cr.Spec.ForProvider, mr, a)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.IsApprovalUpToDate(&cr.Spec.ForProvider, mr, a),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Approval)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApproval)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.sync(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(cr.Spec.ForProvider.MergeRequestIID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Approval)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotApproval)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	return managed.ExternalUpdate{}, e.sync(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Approval)
+	if !ok {
+		return errors.New(errNotApproval)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	mr, a, res, err := e.get(ctx, cr)
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return nil
+		}
+		return err
+	}
+	if mr.State != projects.MergeRequestStateOpened || !a.UserHasApproved {
+		return nil
+	}
+
+	res, err = e.client.UnapproveMergeRequest(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.MergeRequestIID, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errUnapproveFailed)
+	}
+	return nil
+}
+
+// get returns the merge request of cr and its approvals.
+func (e *external) get(ctx context.Context, cr *v1alpha1.Approval) (*gitlab.MergeRequest, *gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+	p := &cr.Spec.ForProvider
+	mr, res, err := e.client.GetMergeRequest(*p.ProjectID, p.MergeRequestIID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, nil, res, errors.Wrap(err, errGetFailed)
+	}
+	a, res, err := e.client.GetConfiguration(*p.ProjectID, p.MergeRequestIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, nil, res, errors.Wrap(err, errGetApprovals)
+	}
+	return mr, a, res, nil
+}
+
+// sync approves the merge request of cr if it meets the conditions of cr,
+// and revokes the approval otherwise. The approval is bound to the head
+// commit that was observed, so that Gitlab rejects it if commits were
+// pushed in between.
+func (e *external) sync(ctx context.Context, cr *v1alpha1.Approval) error {
+	mr, a, _, err := e.get(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if projects.IsApprovalUpToDate(&cr.Spec.ForProvider, mr, a) {
+		return nil
+	}
+
+	p := &cr.Spec.ForProvider
+	if a.UserHasApproved {
+		_, err := e.client.UnapproveMergeRequest(*p.ProjectID, p.MergeRequestIID, gitlab.WithContext(ctx))
+		return errors.Wrap(err, errUnapproveFailed)
+	}
+	_, _, err = e.client.ApproveMergeRequest(*p.ProjectID, p.MergeRequestIID, &gitlab.ApproveMergeRequestOptions{SHA: &mr.SHA}, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errApproveFailed)
+}
@@ -0,0 +1,345 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvals
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	mrIID     = 42
+	sha       = "0b4bc9a4"
+)
+
+type approvalModifier func(*v1alpha1.Approval)
+
+func withConditions(c ...xpv1.Condition) approvalModifier {
+	return func(r *v1alpha1.Approval) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() approvalModifier {
+	return func(r *v1alpha1.Approval) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withExternalName(n string) approvalModifier {
+	return func(r *v1alpha1.Approval) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.ApprovalObservation) approvalModifier {
+	return func(r *v1alpha1.Approval) { r.Status.AtProvider = s }
+}
+
+func approval(m ...approvalModifier) *v1alpha1.Approval {
+	cr := &v1alpha1.Approval{Spec: v1alpha1.ApprovalSpec{ForProvider: v1alpha1.ApprovalParameters{
+		MergeRequestIID: mrIID,
+		RequiredLabels:  []string{"automerge"},
+	}}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func mergeRequest(state string, labels ...string) func(pid interface{}, mergeRequest int, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	return func(pid interface{}, mergeRequest int, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+		return &gitlab.MergeRequest{IID: mergeRequest, State: state, SHA: sha, Labels: labels}, &gitlab.Response{}, nil
+	}
+}
+
+func approved(a bool) func(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+	return func(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+		return &gitlab.MergeRequestApprovals{UserHasApproved: a}, &gitlab.Response{}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Approval
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Approval
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: approval(),
+			want: want{
+				cr:  approval(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotCreated": {
+			cr: approval(withProjectID()),
+			want: want{
+				cr: approval(withProjectID()),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetMergeRequest: func(pid interface{}, mergeRequest int, opt *gitlab.GetMergeRequestsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+				},
+			},
+			cr: approval(withProjectID(), withExternalName("42")),
+			want: want{
+				cr: approval(withProjectID(), withExternalName("42")),
+			},
+		},
+		"FailedGetApprovals": {
+			client: &fake.MockClient{
+				MockGetMergeRequest: mergeRequest("opened", "automerge"),
+				MockGetConfiguration: func(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: approval(withProjectID(), withExternalName("42")),
+			want: want{
+				cr:  approval(withProjectID(), withExternalName("42")),
+				err: errors.Wrap(errBoom, errGetApprovals),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockGetMergeRequest:  mergeRequest("opened", "automerge"),
+				MockGetConfiguration: approved(true),
+			},
+			cr: approval(withProjectID(), withExternalName("42")),
+			want: want{
+				cr: approval(
+					withProjectID(),
+					withExternalName("42"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ApprovalObservation{Approved: true, State: "opened", SHA: sha}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LabelRemoved": {
+			client: &fake.MockClient{
+				MockGetMergeRequest:  mergeRequest("opened"),
+				MockGetConfiguration: approved(true),
+			},
+			cr: approval(withProjectID(), withExternalName("42")),
+			want: want{
+				cr: approval(
+					withProjectID(),
+					withExternalName("42"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ApprovalObservation{
+						Approved:        true,
+						UnmetConditions: []string{`label "automerge" is missing`},
+						State:           "opened",
+						SHA:             sha,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr       *v1alpha1.Approval
+		approved *string
+		err      error
+	}
+
+	cases := map[string]struct {
+		labels []string
+		err    error
+		want
+	}{
+		"Approved": {
+			labels: []string{"automerge"},
+			want: want{
+				cr:       approval(withProjectID(), withExternalName("42"), withConditions(xpv1.Creating())),
+				approved: &sha,
+			},
+		},
+		"ConditionsUnmet": {
+			want: want{
+				cr: approval(withProjectID(), withExternalName("42"), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedApprove": {
+			labels: []string{"automerge"},
+			err:    errBoom,
+			want: want{
+				cr:       approval(withProjectID(), withConditions(xpv1.Creating())),
+				approved: &sha,
+				err:      errors.Wrap(errBoom, errApproveFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var s *string
+			client := &fake.MockClient{
+				MockGetMergeRequest:  mergeRequest("opened", tc.labels...),
+				MockGetConfiguration: approved(false),
+				MockApproveMergeRequest: func(pid interface{}, mr int, opt *gitlab.ApproveMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+					s = opt.SHA
+					return &gitlab.MergeRequestApprovals{UserHasApproved: true}, &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{client: client}
+			cr := approval(withProjectID())
+			_, err := e.Create(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.approved, s); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"SuccessfulUnapprove": {},
+		"FailedUnapprove": {
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errUnapproveFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			client := &fake.MockClient{
+				MockGetMergeRequest:  mergeRequest("opened"),
+				MockGetConfiguration: approved(true),
+				MockUnapproveMergeRequest: func(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					called = true
+					return &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{client: client}
+			_, err := e.Update(context.Background(), approval(withProjectID(), withExternalName("42")))
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if !called {
+				t.Errorf("UnapproveMergeRequest was not called")
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		state    string
+		approved bool
+		err      error
+		want     error
+	}{
+		"SuccessfulDeletion": {
+			state:    "opened",
+			approved: true,
+		},
+		"NotApproved": {
+			state: "opened",
+		},
+		"Merged": {
+			state:    "merged",
+			approved: true,
+		},
+		"FailedDeletion": {
+			state:    "opened",
+			approved: true,
+			err:      errBoom,
+			want:     errors.Wrap(errBoom, errUnapproveFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &fake.MockClient{
+				MockGetMergeRequest:  mergeRequest(tc.state),
+				MockGetConfiguration: approved(tc.approved),
+				MockUnapproveMergeRequest: func(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					if !tc.approved || tc.state != "opened" {
+						t.Errorf("UnapproveMergeRequest was called")
+					}
+					return &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{client: client}
+			cr := approval(withProjectID(), withExternalName("42"))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(approval(withProjectID(), withExternalName("42"), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalsettings
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+)
+
+type settingsModifier func(*v1alpha1.ApprovalSettings)
+
+func withConditions(c ...xpv1.Condition) settingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() settingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withExternalName(n string) settingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) { meta.SetExternalName(r, n) }
+}
+
+func withResetApprovalsOnPush(b bool) settingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) { r.Spec.ForProvider.ResetApprovalsOnPush = &b }
+}
+
+func withLateInitialized() settingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) {
+		p := &r.Spec.ForProvider
+		p.DisableOverridingApproversPerMergeRequest = ptr.To(false)
+		p.MergeRequestsAuthorApproval = ptr.To(true)
+		p.RequirePasswordToApprove = ptr.To(false)
+		p.SelectiveCodeOwnerRemovals = ptr.To(false)
+	}
+}
+
+func withStatus(s v1alpha1.ApprovalSettingsObservation) settingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) { r.Status.AtProvider = s }
+}
+
+func settings(m ...settingsModifier) *v1alpha1.ApprovalSettings {
+	cr := &v1alpha1.ApprovalSettings{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func configuration(_ interface{}, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+	return &gitlab.ProjectApprovals{
+		ResetApprovalsOnPush:        true,
+		MergeRequestsAuthorApproval: true,
+	}, &gitlab.Response{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApprovalSettings
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := v1alpha1.ApprovalSettingsObservation{ResetApprovalsOnPush: true, MergeRequestsAuthorApproval: true}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ApprovalSettings
+		want
+	}{
+		"NoExternalName": {
+			cr: settings(withProjectID()),
+			want: want{
+				cr: settings(withProjectID()),
+			},
+		},
+		"ProjectIDMissing": {
+			cr: settings(withExternalName(projectID)),
+			want: want{
+				cr:  settings(withExternalName(projectID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockGetApprovalConfiguration: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: settings(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr:  settings(withProjectID(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockGetApprovalConfiguration: configuration},
+			cr:     settings(withProjectID(), withExternalName(projectID), withResetApprovalsOnPush(true)),
+			want: want{
+				cr: settings(
+					withProjectID(),
+					withExternalName(projectID),
+					withResetApprovalsOnPush(true),
+					withLateInitialized(),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			client: &fake.MockClient{MockGetApprovalConfiguration: configuration},
+			cr:     settings(withProjectID(), withExternalName(projectID), withResetApprovalsOnPush(false), withLateInitialized()),
+			want: want{
+				cr: settings(
+					withProjectID(),
+					withExternalName(projectID),
+					withResetApprovalsOnPush(false),
+					withLateInitialized(),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ApprovalSettings
+		opt *gitlab.ChangeApprovalConfigurationOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.ApprovalSettings
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: settings(),
+			want: want{
+				cr:  settings(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			cr: settings(withProjectID(), withResetApprovalsOnPush(false)),
+			want: want{
+				cr: settings(
+					withProjectID(),
+					withResetApprovalsOnPush(false),
+					withExternalName(projectID),
+					withConditions(xpv1.Creating()),
+				),
+				opt: &gitlab.ChangeApprovalConfigurationOptions{ResetApprovalsOnPush: ptr.To(false)},
+			},
+		},
+		"FailedCreation": {
+			err: errBoom,
+			cr:  settings(withProjectID(), withResetApprovalsOnPush(false)),
+			want: want{
+				cr: settings(
+					withProjectID(),
+					withResetApprovalsOnPush(false),
+					withConditions(xpv1.Creating()),
+				),
+				opt: &gitlab.ChangeApprovalConfigurationOptions{ResetApprovalsOnPush: ptr.To(false)},
+				err: errors.Wrap(errBoom, errChangeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opt *gitlab.ChangeApprovalConfigurationOptions
+			client := &fake.MockClient{
+				MockChangeApprovalConfiguration: func(pid interface{}, o *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+					opt = o
+					return &gitlab.ProjectApprovals{}, &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{client: client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.opt, opt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cr := settings(withProjectID(), withExternalName(projectID))
+	e := &external{client: &fake.MockClient{}}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(settings(withProjectID(), withExternalName(projectID), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package badges
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotBadge         = "managed resource is not a Gitlab project badge custom resource"
+	errProjectIDMissing = "ProjectID or GroupID is missing"
+	errIDNotInt         = "ID is not an integer"
+	errInvalidURL       = "invalid Gitlab project badge URL"
+	errGetFailed        = "cannot get Gitlab project badge"
+	errKubeUpdateFailed = "cannot update Gitlab project badge custom resource"
+	errCreateFailed     = "cannot create Gitlab project badge"
+	errUpdateFailed     = "cannot update Gitlab project badge"
+	errDeleteFailed     = "cannot delete Gitlab project badge"
+)
+
+// SetupBadge adds a controller that reconciles Badges.
+func SetupBadge(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BadgeKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.BadgeGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.BadgeGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewBadgeClient, newGroupClientFn: projects.NewGroupBadgeClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BadgeGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Badge{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Badge{}, &v1alpha1.BadgeList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.BadgeClient
+	newGroupClientFn  func(cfg clients.Config) projects.BadgeClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Badge)
+	if !ok {
+		return nil, errors.New(errNotBadge)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	if cr.Spec.ForProvider.GroupID != nil {
+		return &external{kube: c.kube, client: c.newGroupClientFn(*cfg)}, nil
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.BadgeClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Badge)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBadge)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	badgeID, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+	if projects.BadgeScopeID(&cr.Spec.ForProvider) == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	badge, res, err := e.client.GetProjectBadge(*projects.BadgeScopeID(&cr.Spec.ForProvider), badgeID, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeBadge(&cr.Spec.ForProvider, badge)
+
+	cr.Status.AtProvider = projects.GenerateBadgeObservation(badge)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsBadgeUpToDate(&cr.Spec.ForProvider, badge),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Badge)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBadge)
+	}
+	if projects.BadgeScopeID(&cr.Spec.ForProvider) == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+	if err := validateBadge(&cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	badge, _, err := e.client.AddProjectBadge(*projects.BadgeScopeID(&cr.Spec.ForProvider), projects.GenerateAddBadgeOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(badge.ID))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Badge)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBadge)
+	}
+
+	badgeID, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+	if projects.BadgeScopeID(&cr.Spec.ForProvider) == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+	if err := validateBadge(&cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, _, err = e.client.EditProjectBadge(*projects.BadgeScopeID(&cr.Spec.ForProvider), badgeID, projects.GenerateEditBadgeOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Badge)
+	if !ok {
+		return errors.New(errNotBadge)
+	}
+
+	badgeID, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errIDNotInt)
+	}
+	if projects.BadgeScopeID(&cr.Spec.ForProvider) == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteProjectBadge(*projects.BadgeScopeID(&cr.Spec.ForProvider), badgeID, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	return nil
+}
+
+// validateBadge rejects badge URLs with placeholders Gitlab does not render.
+func validateBadge(p *v1alpha1.BadgeParameters) error {
+	for _, u := range []string{p.LinkURL, p.ImageURL} {
+		if err := projects.ValidateBadgeURL(u); err != nil {
+			return errors.Wrap(err, errInvalidURL)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package badges
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	badgeID   = 1
+	name      = "pipeline"
+	linkURL   = "https://example.com/%{project_path}"
+	imageURL  = "https://example.com/%{project_path}/badge.svg"
+)
+
+type args struct {
+	badge projects.BadgeClient
+	kube  client.Client
+	cr    *v1alpha1.Badge
+}
+
+type badgeModifier func(*v1alpha1.Badge)
+
+func withConditions(c ...xpv1.Condition) badgeModifier {
+	return func(r *v1alpha1.Badge) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() badgeModifier {
+	return func(r *v1alpha1.Badge) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withURLs(link, image string) badgeModifier {
+	return func(r *v1alpha1.Badge) {
+		r.Spec.ForProvider.LinkURL = link
+		r.Spec.ForProvider.ImageURL = image
+	}
+}
+
+func withName() badgeModifier {
+	return func(r *v1alpha1.Badge) { r.Spec.ForProvider.Name = &name }
+}
+
+func withExternalName(n string) badgeModifier {
+	return func(r *v1alpha1.Badge) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.BadgeObservation) badgeModifier {
+	return func(r *v1alpha1.Badge) { r.Status.AtProvider = s }
+}
+
+func badge(m ...badgeModifier) *v1alpha1.Badge {
+	cr := &v1alpha1.Badge{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	stored := &gitlab.ProjectBadge{
+		ID:               badgeID,
+		Name:             name,
+		LinkURL:          linkURL,
+		ImageURL:         imageURL,
+		RenderedLinkURL:  "https://example.com/group/project",
+		RenderedImageURL: "https://example.com/group/project/badge.svg",
+		Kind:             "project",
+	}
+	observation := v1alpha1.BadgeObservation{
+		ID:               badgeID,
+		RenderedLinkURL:  "https://example.com/group/project",
+		RenderedImageURL: "https://example.com/group/project/badge.svg",
+		Kind:             "project",
+	}
+
+	type want struct {
+		cr     *v1alpha1.Badge
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: badge(withProjectID()),
+			},
+			want: want{
+				cr: badge(withProjectID()),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: badge(withProjectID(), withExternalName("fr")),
+			},
+			want: want{
+				cr:  badge(withProjectID(), withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"NotFound": {
+			args: args{
+				badge: &fake.MockClient{
+					MockGetBadge: func(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: badge(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr: badge(withProjectID(), withExternalName("1")),
+			},
+		},
+		"ErrGet": {
+			args: args{
+				badge: &fake.MockClient{
+					MockGetBadge: func(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: badge(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr:  badge(withProjectID(), withExternalName("1")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"RenderedPlaceholdersUpToDate": {
+			args: args{
+				badge: &fake.MockClient{
+					MockGetBadge: func(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+						return stored, &gitlab.Response{}, nil
+					},
+				},
+				cr: badge(withProjectID(), withExternalName("1"), withName(), withURLs(linkURL, imageURL)),
+			},
+			want: want{
+				cr: badge(
+					withProjectID(),
+					withExternalName("1"),
+					withName(),
+					withURLs(linkURL, imageURL),
+					withConditions(xpv1.Available()),
+					withStatus(observation),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitializedName": {
+			args: args{
+				badge: &fake.MockClient{
+					MockGetBadge: func(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+						return stored, &gitlab.Response{}, nil
+					},
+				},
+				cr: badge(withProjectID(), withExternalName("1"), withURLs(linkURL, "https://example.com/other.svg")),
+			},
+			want: want{
+				cr: badge(
+					withProjectID(),
+					withExternalName("1"),
+					withName(),
+					withURLs(linkURL, "https://example.com/other.svg"),
+					withConditions(xpv1.Available()),
+					withStatus(observation),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.badge}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Badge
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				badge: &fake.MockClient{
+					MockAddBadge: func(pid interface{}, opt *gitlab.AddProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+						return &gitlab.ProjectBadge{ID: badgeID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: badge(withProjectID(), withURLs(linkURL, imageURL)),
+			},
+			want: want{
+				cr: badge(
+					withProjectID(),
+					withURLs(linkURL, imageURL),
+					withExternalName("1"),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"UnknownPlaceholder": {
+			args: args{
+				cr: badge(withProjectID(), withURLs("https://example.com/%{project_slug}", imageURL)),
+			},
+			want: want{
+				cr:  badge(withProjectID(), withURLs("https://example.com/%{project_slug}", imageURL)),
+				err: errors.Wrap(errors.Errorf("unknown badge placeholder %q", "%{project_slug}"), errInvalidURL),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				badge: &fake.MockClient{
+					MockAddBadge: func(pid interface{}, opt *gitlab.AddProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: badge(withProjectID(), withURLs(linkURL, imageURL)),
+			},
+			want: want{
+				cr:  badge(withProjectID(), withURLs(linkURL, imageURL), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.badge}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulUpdate": {
+			args: args{
+				badge: &fake.MockClient{
+					MockEditBadge: func(pid interface{}, badge int, opt *gitlab.EditProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+						return &gitlab.ProjectBadge{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: badge(withProjectID(), withExternalName("1"), withURLs(linkURL, imageURL)),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				badge: &fake.MockClient{
+					MockEditBadge: func(pid interface{}, badge int, opt *gitlab.EditProjectBadgeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectBadge, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: badge(withProjectID(), withExternalName("1"), withURLs(linkURL, imageURL)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.badge}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Badge
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				badge: &fake.MockClient{
+					MockDeleteBadge: func(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: badge(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr: badge(withProjectID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				badge: &fake.MockClient{
+					MockDeleteBadge: func(pid interface{}, badge int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: badge(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr:  badge(withProjectID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.badge}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
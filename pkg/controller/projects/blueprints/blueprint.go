@@ -0,0 +1,256 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blueprints
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+const (
+	errGetBlueprint  = "cannot get ProjectBlueprint"
+	errRender        = "cannot render ProjectBlueprint"
+	errApply         = "cannot apply %s %s"
+	errListRendered  = "cannot list resources rendered from ProjectBlueprint"
+	errDeleteRemoved = "cannot delete %s %s removed from ProjectBlueprint"
+	errUpdateStatus  = "cannot update ProjectBlueprint status"
+
+	reasonRenderFailed event.Reason = "RenderFailed"
+)
+
+// SetupProjectBlueprint adds a controller that renders ProjectBlueprints
+// into a Project and its resources.
+func SetupProjectBlueprint(mgr ctrl.Manager, o controller.Options) error {
+	name := "blueprint/" + v1alpha1.ProjectBlueprintGroupKind
+
+	r := &reconciler{
+		client: mgr.GetClient(),
+		apply:  resource.NewAPIPatchingApplicator(mgr.GetClient()),
+		log:    o.Logger.WithValues("controller", name),
+		record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ProjectBlueprint{}).
+		Owns(&v1alpha1.Project{}).
+		Owns(&v1alpha1.Hook{}).
+		Owns(&v1alpha1.Variable{}).
+		Owns(&v1alpha1.Badge{}).
+		Complete(r)
+}
+
+// reconciler applies the resources rendered from a ProjectBlueprint,
+// deletes the ones removed from it, and reports whether all are ready.
+// Deleting the blueprint deletes its resources by garbage collection.
+type reconciler struct {
+	client client.Client
+	apply  resource.Applicator
+	log    logging.Logger
+	record event.Recorder
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	bp := &v1alpha1.ProjectBlueprint{}
+	if err := r.client.Get(ctx, req.NamespacedName, bp); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetBlueprint)
+	}
+	if bp.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	rendered, err := Render(bp)
+	if err != nil {
+		err = errors.Wrap(err, errRender)
+		r.record.Event(bp, event.Warning(reasonRenderFailed, err))
+		bp.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, bp), errUpdateStatus)
+	}
+
+	if err := r.sync(ctx, bp, rendered); err != nil {
+		log.Debug("Cannot sync ProjectBlueprint", "error", err)
+		bp.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, bp), errUpdateStatus)
+	}
+
+	bp.Status.Resources = make([]v1alpha1.BlueprintResource, 0, len(rendered))
+	ready := true
+	for _, mg := range rendered {
+		ok := mg.GetCondition(xpv1.TypeReady).Status == "True"
+		ready = ready && ok
+		bp.Status.Resources = append(bp.Status.Resources, v1alpha1.BlueprintResource{
+			Kind:  mg.GetObjectKind().GroupVersionKind().Kind,
+			Name:  mg.GetName(),
+			Ready: ok,
+		})
+		if p, isProject := mg.(*v1alpha1.Project); isProject && p.Status.AtProvider.ID != 0 {
+			bp.Status.ProjectID = &p.Status.AtProvider.ID
+		}
+	}
+	bp.SetConditions(xpv1.ReconcileSuccess(), xpv1.Creating())
+	if ready {
+		bp.SetConditions(xpv1.Available())
+	}
+	return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, bp), errUpdateStatus)
+}
+
+// sync applies the rendered resources and deletes the resources of bp that
+// are no longer rendered.
+func (r *reconciler) sync(ctx context.Context, bp *v1alpha1.ProjectBlueprint, rendered []resource.Managed) error {
+	keep := map[string]bool{}
+	for _, mg := range rendered {
+		kind := mg.GetObjectKind().GroupVersionKind().Kind
+		keep[kind+"/"+mg.GetName()] = true
+		if err := r.apply.Apply(ctx, mg, resource.MustBeControllableBy(bp.GetUID())); err != nil {
+			return errors.Wrapf(err, errApply, kind, mg.GetName())
+		}
+		// The applied object is decoded without its type meta.
+		mg.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(kind))
+	}
+
+	for kind, l := range map[string]client.ObjectList{
+		v1alpha1.ProjectKind:  &v1alpha1.ProjectList{},
+		v1alpha1.HookKind:     &v1alpha1.HookList{},
+		v1alpha1.VariableKind: &v1alpha1.VariableList{},
+		v1alpha1.BadgeKind:    &v1alpha1.BadgeList{},
+	} {
+		if err := r.client.List(ctx, l, client.MatchingLabels{v1alpha1.LabelBlueprint: bp.GetName()}); err != nil {
+			return errors.Wrap(err, errListRendered)
+		}
+		for _, o := range listItems(l) {
+			if keep[kind+"/"+o.GetName()] || !metav1.IsControlledBy(o, bp) {
+				continue
+			}
+			if err := r.client.Delete(ctx, o); resource.IgnoreNotFound(err) != nil {
+				return errors.Wrapf(err, errDeleteRemoved, kind, o.GetName())
+			}
+		}
+	}
+	return nil
+}
+
+// listItems returns the items of a list of resources a blueprint renders.
+func listItems(l client.ObjectList) []client.Object {
+	var out []client.Object
+	switch l := l.(type) {
+	case *v1alpha1.ProjectList:
+		for i := range l.Items {
+			out = append(out, &l.Items[i])
+		}
+	case *v1alpha1.HookList:
+		for i := range l.Items {
+			out = append(out, &l.Items[i])
+		}
+	case *v1alpha1.VariableList:
+		for i := range l.Items {
+			out = append(out, &l.Items[i])
+		}
+	case *v1alpha1.BadgeList:
+		for i := range l.Items {
+			out = append(out, &l.Items[i])
+		}
+	}
+	return out
+}
+
+// Render returns the Project and the resources of bp, with the parameters
+// of bp substituted. The Project is named after bp and the resources refer
+// to it.
+func Render(bp *v1alpha1.ProjectBlueprint) ([]resource.Managed, error) {
+	spec := bp.Spec.DeepCopy()
+	spec.Parameters = nil
+	if err := substitute(spec, bp.Spec.Parameters); err != nil {
+		return nil, err
+	}
+
+	// The resources wait for the Project, which is created alongside them.
+	projectRef := &xpv1.Reference{Name: bp.GetName()}
+	requires := []v1alpha1.Requirement{v1alpha1.RequireProjectReady}
+	rs := xpv1.ResourceSpec{ProviderConfigReference: spec.ProviderConfigReference}
+
+	p := &v1alpha1.Project{Spec: v1alpha1.ProjectSpec{ResourceSpec: rs, ForProvider: spec.Project}}
+	setMeta(p, bp, v1alpha1.ProjectKind, bp.GetName())
+	out := []resource.Managed{p}
+
+	for _, h := range spec.Hooks {
+		h.ForProvider.ProjectID, h.ForProvider.ProjectIDRef, h.ForProvider.ProjectIDSelector = nil, projectRef, nil
+		o := &v1alpha1.Hook{Spec: v1alpha1.HookSpec{ResourceSpec: rs, ForProvider: h.ForProvider, Requires: requires}}
+		setMeta(o, bp, v1alpha1.HookKind, bp.GetName()+"-"+h.Name)
+		out = append(out, o)
+	}
+	for _, v := range spec.Variables {
+		v.ForProvider.ProjectID, v.ForProvider.ProjectIDRef, v.ForProvider.ProjectIDSelector = nil, projectRef, nil
+		o := &v1alpha1.Variable{Spec: v1alpha1.VariableSpec{ResourceSpec: rs, ForProvider: v.ForProvider, Requires: requires}}
+		setMeta(o, bp, v1alpha1.VariableKind, bp.GetName()+"-"+v.Name)
+		out = append(out, o)
+	}
+	for _, b := range spec.Badges {
+		b.ForProvider.ProjectID, b.ForProvider.ProjectIDRef, b.ForProvider.ProjectIDSelector = nil, projectRef, nil
+		o := &v1alpha1.Badge{Spec: v1alpha1.BadgeSpec{ResourceSpec: rs, ForProvider: b.ForProvider}}
+		setMeta(o, bp, v1alpha1.BadgeKind, bp.GetName()+"-"+b.Name)
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+// setMeta names mg and marks it as controlled by bp.
+func setMeta(mg resource.Managed, bp *v1alpha1.ProjectBlueprint, kind, name string) {
+	mg.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(kind))
+	mg.SetName(name)
+	mg.SetLabels(map[string]string{v1alpha1.LabelBlueprint: bp.GetName()})
+	meta.AddOwnerReference(mg, meta.AsController(meta.TypedReferenceTo(bp, v1alpha1.ProjectBlueprintGroupVersionKind)))
+}
+
+// substitute replaces the placeholders $(name) in the string values of o
+// with the parameters.
+func substitute(o any, params map[string]string) error {
+	if len(params) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+	s := string(b)
+	for k, v := range params {
+		escaped, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		s = strings.ReplaceAll(s, "$("+k+")", string(escaped[1:len(escaped)-1]))
+	}
+	return json.Unmarshal([]byte(s), o)
+}
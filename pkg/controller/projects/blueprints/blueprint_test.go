@@ -0,0 +1,216 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blueprints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func blueprint() *v1alpha1.ProjectBlueprint {
+	return &v1alpha1.ProjectBlueprint{
+		ObjectMeta: metav1.ObjectMeta{Name: "bp", UID: types.UID("uid")},
+		Spec: v1alpha1.ProjectBlueprintSpec{
+			ProviderConfigReference: &xpv1.Reference{Name: "default"},
+			Parameters:              map[string]string{"service": `svc "a"`},
+			Project:                 v1alpha1.ProjectParameters{Name: ptr.To("$(service)")},
+			Hooks: []v1alpha1.BlueprintHook{{
+				Name:        "ci",
+				ForProvider: v1alpha1.HookParameters{URL: ptr.To("https://ci/$(service)"), ProjectID: ptr.To(7)},
+			}},
+			Variables: []v1alpha1.BlueprintVariable{{
+				Name:        "team",
+				ForProvider: v1alpha1.VariableParameters{Key: "TEAM", Value: ptr.To("$(unknown)")},
+			}},
+		},
+	}
+}
+
+func TestRender(t *testing.T) {
+	got, err := Render(blueprint())
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	names := []string{}
+	for _, mg := range got {
+		names = append(names, mg.GetObjectKind().GroupVersionKind().Kind+"/"+mg.GetName())
+		if diff := cmp.Diff("bp", mg.GetLabels()[v1alpha1.LabelBlueprint]); diff != "" {
+			t.Errorf("Render %s: label: -want, +got:\n%s", mg.GetName(), diff)
+		}
+		if diff := cmp.Diff(types.UID("uid"), metav1.GetControllerOf(mg).UID); diff != "" {
+			t.Errorf("Render %s: controller: -want, +got:\n%s", mg.GetName(), diff)
+		}
+	}
+	if diff := cmp.Diff([]string{"Project/bp", "Hook/bp-ci", "Variable/bp-team"}, names); diff != "" {
+		t.Errorf("Render: names: -want, +got:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(ptr.To(`svc "a"`), got[0].(*v1alpha1.Project).Spec.ForProvider.Name); diff != "" {
+		t.Errorf("Render: project name: -want, +got:\n%s", diff)
+	}
+	hook := got[1].(*v1alpha1.Hook).Spec.ForProvider
+	want := v1alpha1.HookParameters{URL: ptr.To(`https://ci/svc "a"`), ProjectIDRef: &xpv1.Reference{Name: "bp"}}
+	if diff := cmp.Diff(want, hook); diff != "" {
+		t.Errorf("Render: hook: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(ptr.To("$(unknown)"), got[2].(*v1alpha1.Variable).Spec.ForProvider.Value); diff != "" {
+		t.Errorf("Render: unknown parameter: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		result  reconcile.Result
+		err     error
+		status  *v1alpha1.ProjectBlueprintStatus
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		apply  resource.ApplyFn
+		list   test.MockListFn
+		status *v1alpha1.ProjectBlueprintStatus
+		want   want
+	}{
+		"NotAllReady": {
+			apply: func(_ context.Context, o client.Object, _ ...resource.ApplyOption) error {
+				if p, ok := o.(*v1alpha1.Project); ok {
+					p.Status.AtProvider.ID = 42
+					p.SetConditions(xpv1.Available())
+				}
+				return nil
+			},
+			list: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+				if l, ok := obj.(*v1alpha1.HookList); ok {
+					removed := v1alpha1.Hook{ObjectMeta: metav1.ObjectMeta{Name: "bp-removed"}}
+					removed.SetOwnerReferences([]metav1.OwnerReference{{UID: "uid", Controller: ptr.To(true)}})
+					foreign := v1alpha1.Hook{ObjectMeta: metav1.ObjectMeta{Name: "bp-foreign"}}
+					kept := v1alpha1.Hook{ObjectMeta: metav1.ObjectMeta{Name: "bp-ci"}}
+					kept.SetOwnerReferences([]metav1.OwnerReference{{UID: "uid", Controller: ptr.To(true)}})
+					l.Items = []v1alpha1.Hook{removed, foreign, kept}
+				}
+				return nil
+			},
+			want: want{
+				status: func() *v1alpha1.ProjectBlueprintStatus {
+					s := &v1alpha1.ProjectBlueprintStatus{
+						ProjectID: ptr.To(42),
+						Resources: []v1alpha1.BlueprintResource{
+							{Kind: "Project", Name: "bp", Ready: true},
+							{Kind: "Hook", Name: "bp-ci"},
+							{Kind: "Variable", Name: "bp-team"},
+						},
+					}
+					s.SetConditions(xpv1.ReconcileSuccess(), xpv1.Creating())
+					return s
+				}(),
+				deleted: []string{"bp-removed"},
+			},
+		},
+		"AllReady": {
+			apply: func(_ context.Context, o client.Object, _ ...resource.ApplyOption) error {
+				o.(resource.Managed).SetConditions(xpv1.Available())
+				return nil
+			},
+			list: test.NewMockListFn(nil),
+			want: want{
+				status: func() *v1alpha1.ProjectBlueprintStatus {
+					s := &v1alpha1.ProjectBlueprintStatus{
+						Resources: []v1alpha1.BlueprintResource{
+							{Kind: "Project", Name: "bp", Ready: true},
+							{Kind: "Hook", Name: "bp-ci", Ready: true},
+							{Kind: "Variable", Name: "bp-team", Ready: true},
+						},
+					}
+					s.SetConditions(xpv1.ReconcileSuccess(), xpv1.Available())
+					return s
+				}(),
+			},
+		},
+		"ApplyFailed": {
+			apply: func(_ context.Context, _ client.Object, _ ...resource.ApplyOption) error {
+				return errBoom
+			},
+			want: want{
+				status: func() *v1alpha1.ProjectBlueprintStatus {
+					s := &v1alpha1.ProjectBlueprintStatus{}
+					s.SetConditions(xpv1.ReconcileError(errors.Wrapf(errBoom, errApply, "Project", "bp")))
+					return s
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var status *v1alpha1.ProjectBlueprintStatus
+			deleted := []string{}
+			r := &reconciler{
+				client: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						*obj.(*v1alpha1.ProjectBlueprint) = *blueprint()
+						return nil
+					},
+					MockList: tc.list,
+					MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+						deleted = append(deleted, obj.GetName())
+						return nil
+					},
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						status = &obj.(*v1alpha1.ProjectBlueprint).Status
+						return nil
+					},
+				},
+				apply:  tc.apply,
+				log:    logging.NewNopLogger(),
+				record: event.NewNopRecorder(),
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "bp"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile: error: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Reconcile: result: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status, test.EquateConditions()); diff != "" {
+				t.Errorf("Reconcile: status: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(append([]string{}, tc.want.deleted...), deleted); diff != "" {
+				t.Errorf("Reconcile: deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteragents
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotClusterAgent  = "managed resource is not a Gitlab cluster agent custom resource"
+	errProjectIDMissing = "ProjectID is missing"
+	errAdoptFailed      = "cannot look up Gitlab cluster agent by name"
+	errGetFailed        = "cannot get Gitlab cluster agent"
+	errCreateFailed     = "cannot register Gitlab cluster agent"
+	errDeleteFailed     = "cannot delete Gitlab cluster agent"
+	errIDNotAnInt       = "external-name is not an int"
+)
+
+// SetupClusterAgent adds a controller that reconciles ClusterAgents.
+func SetupClusterAgent(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ClusterAgentKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.ClusterAgentGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.ClusterAgentGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewClusterAgentClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ClusterAgentGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ClusterAgent{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.ClusterAgent{}, &v1alpha1.ClusterAgentList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ClusterAgentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAgent)
+	if !ok {
+		return nil, errors.New(errNotClusterAgent)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client projects.ClusterAgentClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAgent)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClusterAgent)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+	pid := *cr.Spec.ForProvider.ProjectID
+
+	var agent *gitlab.Agent
+	adopted := false
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		// The agent is not known yet. Adopt an existing agent with the
+		// same name, as names are unique within a project.
+		agent, err = projects.FindAgentByName(e.client, pid, cr.Spec.ForProvider.Name, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errAdoptFailed)
+		}
+		if agent == nil {
+			return managed.ExternalObservation{}, nil
+		}
+		meta.SetExternalName(cr, strconv.Itoa(agent.ID))
+		adopted = true
+	} else {
+		var res *gitlab.Response
+		agent, res, err = e.client.GetAgent(pid, id, gitlab.WithContext(ctx))
+		if err != nil {
+			if clients.IsResponseNotFound(res) {
+				return managed.ExternalObservation{}, nil
+			}
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+		}
+	}
+
+	cr.Status.AtProvider = projects.GenerateClusterAgentObservation(agent)
+	cr.Status.SetConditions(xpv1.Available())
+
+	// An agent cannot be changed once registered, so it is always up to
+	// date.
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: adopted,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAgent)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClusterAgent)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	agent, _, err := e.client.RegisterAgent(*cr.Spec.ForProvider.ProjectID, projects.GenerateRegisterAgentOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(agent.ID))
+	return managed.ExternalCreation{}, nil
+}
+
+// Update does nothing, as Gitlab cannot change a registered agent.
+func (e *external) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.ClusterAgent); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClusterAgent)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterAgent)
+	if !ok {
+		return errors.New(errNotClusterAgent)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errIDNotAnInt)
+	}
+
+	_, err = e.client.DeleteAgent(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errDeleteFailed)
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteragents
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	createdAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type agentModifier func(*v1alpha1.ClusterAgent)
+
+func withConditions(c ...xpv1.Condition) agentModifier {
+	return func(r *v1alpha1.ClusterAgent) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() agentModifier {
+	return func(r *v1alpha1.ClusterAgent) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withName(n string) agentModifier {
+	return func(r *v1alpha1.ClusterAgent) { r.Spec.ForProvider.Name = n }
+}
+
+func withExternalName(n string) agentModifier {
+	return func(r *v1alpha1.ClusterAgent) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.ClusterAgentObservation) agentModifier {
+	return func(r *v1alpha1.ClusterAgent) { r.Status.AtProvider = s }
+}
+
+func agent(m ...agentModifier) *v1alpha1.ClusterAgent {
+	cr := &v1alpha1.ClusterAgent{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func gitlabAgent() *gitlab.Agent {
+	return &gitlab.Agent{
+		ID:              7,
+		Name:            "production",
+		CreatedAt:       &createdAt,
+		CreatedByUserID: 3,
+		ConfigProject:   gitlab.ConfigProject{ID: 1234, PathWithNamespace: "org/agents"},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClusterAgent
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := v1alpha1.ClusterAgentObservation{
+		ID:                             7,
+		CreatedAt:                      &metav1.Time{Time: createdAt},
+		CreatedByUserID:                3,
+		ConfigProjectID:                1234,
+		ConfigProjectPathWithNamespace: "org/agents",
+	}
+	list := func(pid interface{}, opt *gitlab.ListAgentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Agent, *gitlab.Response, error) {
+		return []*gitlab.Agent{{ID: 6, Name: "staging"}, gitlabAgent()}, &gitlab.Response{}, nil
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ClusterAgent
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: agent(withName("production")),
+			want: want{
+				cr:  agent(withName("production")),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotRegistered": {
+			client: &fake.MockClient{MockListAgents: list},
+			cr:     agent(withProjectID(), withName("development")),
+			want: want{
+				cr: agent(withProjectID(), withName("development")),
+			},
+		},
+		"FailedAdoption": {
+			client: &fake.MockClient{
+				MockListAgents: func(pid interface{}, opt *gitlab.ListAgentsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Agent, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: agent(withProjectID(), withName("production")),
+			want: want{
+				cr:  agent(withProjectID(), withName("production")),
+				err: errors.Wrap(errBoom, errAdoptFailed),
+			},
+		},
+		"Adopted": {
+			client: &fake.MockClient{MockListAgents: list},
+			cr:     agent(withProjectID(), withName("production")),
+			want: want{
+				cr: agent(
+					withProjectID(),
+					withName("production"),
+					withExternalName("7"),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetAgent: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+				},
+			},
+			cr: agent(withProjectID(), withName("production"), withExternalName("7")),
+			want: want{
+				cr: agent(withProjectID(), withName("production"), withExternalName("7")),
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockGetAgent: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: agent(withProjectID(), withName("production"), withExternalName("7")),
+			want: want{
+				cr:  agent(withProjectID(), withName("production"), withExternalName("7")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"Available": {
+			client: &fake.MockClient{
+				MockGetAgent: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+					return gitlabAgent(), &gitlab.Response{}, nil
+				},
+			},
+			cr: agent(withProjectID(), withName("production"), withExternalName("7")),
+			want: want{
+				cr: agent(
+					withProjectID(),
+					withName("production"),
+					withExternalName("7"),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ClusterAgent
+		opt *gitlab.RegisterAgentOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.ClusterAgent
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: agent(withName("production")),
+			want: want{
+				cr:  agent(withName("production")),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			cr: agent(withProjectID(), withName("production")),
+			want: want{
+				cr: agent(
+					withProjectID(),
+					withName("production"),
+					withExternalName("7"),
+					withConditions(xpv1.Creating()),
+				),
+				opt: &gitlab.RegisterAgentOptions{Name: ptr.To("production")},
+			},
+		},
+		"FailedCreation": {
+			err: errBoom,
+			cr:  agent(withProjectID(), withName("production")),
+			want: want{
+				cr:  agent(withProjectID(), withName("production"), withConditions(xpv1.Creating())),
+				opt: &gitlab.RegisterAgentOptions{Name: ptr.To("production")},
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opt *gitlab.RegisterAgentOptions
+			e := &external{client: &fake.MockClient{
+				MockRegisterAgent: func(pid interface{}, o *gitlab.RegisterAgentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+					opt = o
+					if tc.err != nil {
+						return nil, &gitlab.Response{}, tc.err
+					}
+					return gitlabAgent(), &gitlab.Response{}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.opt, opt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		id  int
+		err error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.ClusterAgent
+		want
+	}{
+		"IDNotAnInt": {
+			cr: agent(withProjectID(), withName("production")),
+			want: want{
+				err: errors.New(errIDNotAnInt),
+			},
+		},
+		"SuccessfulDeletion": {
+			cr: agent(withProjectID(), withName("production"), withExternalName("7")),
+			want: want{
+				id: 7,
+			},
+		},
+		"FailedDeletion": {
+			err: errBoom,
+			cr:  agent(withProjectID(), withName("production"), withExternalName("7")),
+			want: want{
+				id:  7,
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var id int
+			e := &external{client: &fake.MockClient{
+				MockDeleteAgent: func(pid interface{}, aid int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					id = aid
+					return &gitlab.Response{}, tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
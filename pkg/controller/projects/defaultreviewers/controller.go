@@ -0,0 +1,299 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultreviewers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotDefaultReviewers = "managed resource is not a Gitlab project default reviewers custom resource"
+	errProjectIDMissing    = "ProjectID is missing"
+	errGetFileFailed       = "cannot get Gitlab project CODEOWNERS file"
+	errDecodeFailed        = "cannot decode Gitlab project CODEOWNERS file"
+	errCreateFileFailed    = "cannot create Gitlab project CODEOWNERS file"
+	errUpdateFileFailed    = "cannot update Gitlab project CODEOWNERS file"
+	errDeleteFileFailed    = "cannot delete Gitlab project CODEOWNERS file"
+	errListFailed          = "cannot list Gitlab project approval rules"
+	errCreateFailed        = "cannot create Gitlab project approval rule %q"
+	errUpdateFailed        = "cannot update Gitlab project approval rule %q"
+	errDeleteFailed        = "cannot delete Gitlab project approval rule %d"
+)
+
+// SetupDefaultReviewers adds a controller that reconciles DefaultReviewers.
+func SetupDefaultReviewers(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.DefaultReviewersKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.DefaultReviewersGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.DefaultReviewersGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewDefaultReviewersClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DefaultReviewersGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DefaultReviewers{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.DefaultReviewers{}, &v1alpha1.DefaultReviewersList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.DefaultReviewersClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DefaultReviewers)
+	if !ok {
+		return nil, errors.New(errNotDefaultReviewers)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.DefaultReviewersClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DefaultReviewers)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDefaultReviewers)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	f, err := e.getCodeOwners(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if f == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	content, err := projects.FileContent(f)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDecodeFailed)
+	}
+
+	rules, err := projects.ListProjectApprovalRules(e.client, *cr.Spec.ForProvider.ProjectID, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateDefaultReviewersObservation(&cr.Spec.ForProvider, f, rules)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: content == projects.RenderCodeOwners(cr.Spec.ForProvider.Sections) && projects.DiffDefaultReviewersRules(&cr.Spec.ForProvider, rules).IsEmpty(),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DefaultReviewers)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDefaultReviewers)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.ProjectID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DefaultReviewers)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDefaultReviewers)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DefaultReviewers)
+	if !ok {
+		return errors.New(errNotDefaultReviewers)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	pid := *cr.Spec.ForProvider.ProjectID
+	res, err := e.client.DeleteFile(pid, projects.CodeOwnersPath(&cr.Spec.ForProvider), projects.GenerateDeleteCodeOwnersOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errDeleteFileFailed)
+	}
+
+	rules, err := projects.ListProjectApprovalRules(e.client, pid, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errListFailed)
+	}
+	inSet := make(map[string]bool, len(cr.Spec.ForProvider.Rules))
+	for _, r := range cr.Spec.ForProvider.Rules {
+		inSet[r.Name] = true
+	}
+	for _, r := range rules {
+		if !inSet[r.Name] {
+			continue
+		}
+		res, err := e.client.DeleteProjectApprovalRule(pid, r.ID, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errDeleteFailed, r.ID)
+		}
+	}
+	return nil
+}
+
+// getCodeOwners returns the CODEOWNERS file, or nil if it does not exist.
+func (e *external) getCodeOwners(ctx context.Context, cr *v1alpha1.DefaultReviewers) (*gitlab.File, error) {
+	p := &cr.Spec.ForProvider
+	f, res, err := e.client.GetFile(*p.ProjectID, projects.CodeOwnersPath(p), &gitlab.GetFileOptions{Ref: ptr.To(projects.CodeOwnersBranch(p))}, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errGetFileFailed)
+	}
+	return f, nil
+}
+
+// apply commits the CODEOWNERS file if it differs from the spec, then
+// deletes, updates and creates the approval rules of the spec until they
+// match it.
+func (e *external) apply(ctx context.Context, cr *v1alpha1.DefaultReviewers) error {
+	p := &cr.Spec.ForProvider
+	pid := *p.ProjectID
+
+	f, err := e.getCodeOwners(ctx, cr)
+	if err != nil {
+		return err
+	}
+	switch {
+	case f == nil:
+		if _, _, err := e.client.CreateFile(pid, projects.CodeOwnersPath(p), projects.GenerateCreateCodeOwnersOptions(p), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrap(err, errCreateFileFailed)
+		}
+	default:
+		content, err := projects.FileContent(f)
+		if err != nil {
+			return errors.Wrap(err, errDecodeFailed)
+		}
+		if content == projects.RenderCodeOwners(p.Sections) {
+			break
+		}
+		if _, _, err := e.client.UpdateFile(pid, projects.CodeOwnersPath(p), projects.GenerateUpdateCodeOwnersOptions(p, f.LastCommitID), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrap(err, errUpdateFileFailed)
+		}
+	}
+
+	rules, err := projects.ListProjectApprovalRules(e.client, pid, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errListFailed)
+	}
+
+	d := projects.DiffDefaultReviewersRules(p, rules)
+	for _, id := range d.Delete {
+		if res, err := e.client.DeleteProjectApprovalRule(pid, id, gitlab.WithContext(ctx)); err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errDeleteFailed, id)
+		}
+	}
+	for i := range d.Update {
+		if _, _, err := e.client.UpdateProjectApprovalRule(pid, d.Update[i].ID, projects.GenerateUpdateApprovalRuleOptions(&d.Update[i].Rule), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errUpdateFailed, d.Update[i].Rule.Name)
+		}
+	}
+	for i := range d.Create {
+		if _, _, err := e.client.CreateProjectApprovalRule(pid, projects.GenerateCreateApprovalRuleOptions(&d.Create[i]), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errCreateFailed, d.Create[i].Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultreviewers
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+
+	backend  = v1alpha1.CodeOwnersSection{Name: "Backend", Entries: []v1alpha1.CodeOwnersEntry{{Pattern: "*.go", Owners: []string{"@backend"}}}}
+	security = v1alpha1.ApprovalRule{Name: "security", ApprovalsRequired: 2, GroupIDs: []int{7}}
+
+	securityRule = &gitlab.ProjectApprovalRule{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2, Groups: []*gitlab.Group{{ID: 7}}}
+	manualRule   = &gitlab.ProjectApprovalRule{ID: 2, Name: "manual", RuleType: "regular", ApprovalsRequired: 0}
+)
+
+type args struct {
+	client projects.DefaultReviewersClient
+	cr     *v1alpha1.DefaultReviewers
+}
+
+type defaultReviewersModifier func(*v1alpha1.DefaultReviewers)
+
+func withConditions(c ...xpv1.Condition) defaultReviewersModifier {
+	return func(r *v1alpha1.DefaultReviewers) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() defaultReviewersModifier {
+	return func(r *v1alpha1.DefaultReviewers) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withSections(s ...v1alpha1.CodeOwnersSection) defaultReviewersModifier {
+	return func(r *v1alpha1.DefaultReviewers) { r.Spec.ForProvider.Sections = s }
+}
+
+func withRules(rules ...v1alpha1.ApprovalRule) defaultReviewersModifier {
+	return func(r *v1alpha1.DefaultReviewers) { r.Spec.ForProvider.Rules = rules }
+}
+
+func withExternalName(n string) defaultReviewersModifier {
+	return func(r *v1alpha1.DefaultReviewers) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.DefaultReviewersObservation) defaultReviewersModifier {
+	return func(r *v1alpha1.DefaultReviewers) { r.Status.AtProvider = s }
+}
+
+func defaultReviewers(m ...defaultReviewersModifier) *v1alpha1.DefaultReviewers {
+	cr := &v1alpha1.DefaultReviewers{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getFile(content string) func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+	return func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+		return &gitlab.File{Encoding: "base64", Content: base64.StdEncoding.EncodeToString([]byte(content)), LastCommitID: "abc"}, &gitlab.Response{}, nil
+	}
+}
+
+func fileNotFound(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+	return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+}
+
+func listRules(r ...*gitlab.ProjectApprovalRule) func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	return func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+		return r, &gitlab.Response{}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	securityObservation := v1alpha1.ApprovalRuleObservation{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2, GroupIDs: []int{7}}
+
+	type want struct {
+		cr     *v1alpha1.DefaultReviewers
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: defaultReviewers(withProjectID()),
+			},
+			want: want{
+				cr: defaultReviewers(withProjectID()),
+			},
+		},
+		"FileNotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetFile: fileNotFound,
+				},
+				cr: defaultReviewers(withProjectID(), withExternalName(projectID)),
+			},
+			want: want{
+				cr: defaultReviewers(withProjectID(), withExternalName(projectID)),
+			},
+		},
+		"UpToDateWithManualRule": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetFile:                 getFile(projects.RenderCodeOwners([]v1alpha1.CodeOwnersSection{backend})),
+					MockGetProjectApprovalRules: listRules(securityRule, manualRule),
+				},
+				cr: defaultReviewers(withProjectID(), withExternalName(projectID), withSections(backend), withRules(security)),
+			},
+			want: want{
+				cr: defaultReviewers(
+					withProjectID(),
+					withExternalName(projectID),
+					withSections(backend),
+					withRules(security),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.DefaultReviewersObservation{LastCommitID: "abc", Rules: []v1alpha1.ApprovalRuleObservation{securityObservation}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FileChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetFile:                 getFile("* @someone\n"),
+					MockGetProjectApprovalRules: listRules(securityRule),
+				},
+				cr: defaultReviewers(withProjectID(), withExternalName(projectID), withSections(backend), withRules(security)),
+			},
+			want: want{
+				cr: defaultReviewers(
+					withProjectID(),
+					withExternalName(projectID),
+					withSections(backend),
+					withRules(security),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.DefaultReviewersObservation{LastCommitID: "abc", Rules: []v1alpha1.ApprovalRuleObservation{securityObservation}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RuleMissing": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetFile:                 getFile(projects.RenderCodeOwners([]v1alpha1.CodeOwnersSection{backend})),
+					MockGetProjectApprovalRules: listRules(manualRule),
+				},
+				cr: defaultReviewers(withProjectID(), withExternalName(projectID), withSections(backend), withRules(security)),
+			},
+			want: want{
+				cr: defaultReviewers(
+					withProjectID(),
+					withExternalName(projectID),
+					withSections(backend),
+					withRules(security),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.DefaultReviewersObservation{LastCommitID: "abc"}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ErrGetFile": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetFile: func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: defaultReviewers(withProjectID(), withExternalName(projectID)),
+			},
+			want: want{
+				cr:  defaultReviewers(withProjectID(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errGetFileFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var calls []string
+	e := &external{client: &fake.MockClient{
+		MockGetFile: fileNotFound,
+		MockCreateFile: func(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+			calls = append(calls, "create "+fileName+" on "+*opt.Branch)
+			return &gitlab.FileInfo{}, &gitlab.Response{}, nil
+		},
+		MockGetProjectApprovalRules: listRules(manualRule),
+		MockCreateProjectApprovalRule: func(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			calls = append(calls, "create "+*opt.Name)
+			return &gitlab.ProjectApprovalRule{}, &gitlab.Response{}, nil
+		},
+	}}
+
+	cr := defaultReviewers(withProjectID(), withSections(backend), withRules(security))
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := defaultReviewers(withProjectID(), withSections(backend), withRules(security), withExternalName(projectID), withConditions(xpv1.Creating()))
+	if diff := cmp.Diff(want, cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"create .gitlab/CODEOWNERS on main", "create security"}, calls); diff != "" {
+		t.Errorf("calls: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var calls []string
+	e := &external{client: &fake.MockClient{
+		MockGetFile: getFile("* @someone\n"),
+		MockUpdateFile: func(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+			calls = append(calls, "update "+fileName+" after "+*opt.LastCommitID)
+			return &gitlab.FileInfo{}, &gitlab.Response{}, nil
+		},
+		MockGetProjectApprovalRules: listRules(
+			&gitlab.ProjectApprovalRule{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 1},
+			manualRule,
+		),
+		MockUpdateProjectApprovalRule: func(pid interface{}, approvalRule int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			calls = append(calls, "update "+*opt.Name)
+			return &gitlab.ProjectApprovalRule{}, &gitlab.Response{}, nil
+		},
+	}}
+
+	_, err := e.Update(context.Background(), defaultReviewers(withProjectID(), withExternalName(projectID), withSections(backend), withRules(security)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"update .gitlab/CODEOWNERS after abc", "update security"}
+	if diff := cmp.Diff(want, calls); diff != "" {
+		t.Errorf("calls: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr      *v1alpha1.DefaultReviewers
+		deleted []int
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteFile: func(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+					MockGetProjectApprovalRules: listRules(securityRule, manualRule),
+				},
+				cr: defaultReviewers(withProjectID(), withRules(security)),
+			},
+			want: want{
+				cr:      defaultReviewers(withProjectID(), withRules(security), withConditions(xpv1.Deleting())),
+				deleted: []int{1},
+			},
+		},
+		"FileAlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteFile: func(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+					MockGetProjectApprovalRules: listRules(manualRule),
+				},
+				cr: defaultReviewers(withProjectID(), withRules(security)),
+			},
+			want: want{
+				cr: defaultReviewers(withProjectID(), withRules(security), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedFileDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteFile: func(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: defaultReviewers(withProjectID(), withRules(security)),
+			},
+			want: want{
+				cr:  defaultReviewers(withProjectID(), withRules(security), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFileFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []int
+			mc := tc.client.(*fake.MockClient)
+			mc.MockDeleteProjectApprovalRule = func(pid interface{}, approvalRule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+				deleted = append(deleted, approvalRule)
+				return &gitlab.Response{}, nil
+			}
+
+			e := &external{client: mc}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploykeyenablements
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotDeployKeyEnablement = "managed resource is not a Gitlab deploy key enablement custom resource"
+	errSelectionMissing       = "GroupID, GroupIDRef, GroupIDSelector or ProjectSelector is missing"
+	errListGroupProjects      = "cannot list projects of group"
+	errProjectSelector        = "cannot parse ProjectSelector"
+	errListProjects           = "cannot list selected Projects"
+	errGetFailed              = "cannot get Gitlab deploy key of project %d"
+	errEnableFailed           = "cannot enable Gitlab deploy key for project %d"
+	errRemoveFailed           = "cannot remove Gitlab deploy key from project %d"
+)
+
+// SetupDeployKeyEnablement adds a controller that reconciles
+// DeployKeyEnablements.
+func SetupDeployKeyEnablement(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.DeployKeyEnablementKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.DeployKeyEnablementGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.DeployKeyEnablementGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewDeployKeyEnablementClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DeployKeyEnablementGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DeployKeyEnablement{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.DeployKeyEnablement{}, &v1alpha1.DeployKeyEnablementList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.DeployKeyEnablementClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DeployKeyEnablement)
+	if !ok {
+		return nil, errors.New(errNotDeployKeyEnablement)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.DeployKeyEnablementClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DeployKeyEnablement)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDeployKeyEnablement)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// The projects the key is enabled for are all that is needed to delete
+	// it, even if the projects can no longer be selected.
+	var desired []int
+	if !meta.WasDeleted(cr) {
+		var err error
+		if desired, err = e.selectProjects(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	enabled, err := e.enabledProjects(ctx, cr.Spec.ForProvider.KeyID, append(desired, cr.Status.AtProvider.ProjectIDs...))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider.ProjectIDs = enabled
+	cr.Status.SetConditions(xpv1.Available())
+
+	enable, remove := projects.DiffDeployKeyProjects(desired, enabled)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(enable) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DeployKeyEnablement)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDeployKeyEnablement)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.sync(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(cr.Spec.ForProvider.KeyID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DeployKeyEnablement)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDeployKeyEnablement)
+	}
+
+	return managed.ExternalUpdate{}, e.sync(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DeployKeyEnablement)
+	if !ok {
+		return errors.New(errNotDeployKeyEnablement)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	for _, pid := range cr.Status.AtProvider.ProjectIDs {
+		if err := e.remove(ctx, cr.Spec.ForProvider.KeyID, pid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectProjects returns the sorted IDs of the projects of the group of cr
+// and of the Projects selected by cr.
+func (e *external) selectProjects(ctx context.Context, cr *v1alpha1.DeployKeyEnablement) ([]int, error) {
+	p := &cr.Spec.ForProvider
+	if p.GroupID == nil && p.ProjectSelector == nil {
+		return nil, errors.New(errSelectionMissing)
+	}
+
+	ids := map[int]bool{}
+	if p.GroupID != nil {
+		prjs, err := projects.ListAllGroupProjects(e.client, *p.GroupID, ptr.Deref(p.IncludeSubgroups, false), gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, errors.Wrap(err, errListGroupProjects)
+		}
+		for _, prj := range prjs {
+			ids[prj.ID] = true
+		}
+	}
+	if p.ProjectSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(p.ProjectSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, errProjectSelector)
+		}
+		l := &v1alpha1.ProjectList{}
+		if err := e.kube.List(ctx, l, client.MatchingLabelsSelector{Selector: sel}); err != nil {
+			return nil, errors.Wrap(err, errListProjects)
+		}
+		for i := range l.Items {
+			// Projects that are not created yet have no ID.
+			id, err := strconv.Atoi(meta.GetExternalName(&l.Items[i]))
+			if err != nil {
+				continue
+			}
+			ids[id] = true
+		}
+	}
+	return sortedIDs(ids), nil
+}
+
+// enabledProjects returns the sorted IDs of the projects among pids the
+// deploy key is enabled for.
+func (e *external) enabledProjects(ctx context.Context, keyID int, pids []int) ([]int, error) {
+	ids := map[int]bool{}
+	for _, pid := range pids {
+		if ids[pid] {
+			continue
+		}
+		_, res, err := e.client.GetDeployKey(pid, keyID, gitlab.WithContext(ctx))
+		if err != nil {
+			if clients.IsResponseNotFound(res) {
+				continue
+			}
+			return nil, errors.Wrapf(err, errGetFailed, pid)
+		}
+		ids[pid] = true
+	}
+	return sortedIDs(ids), nil
+}
+
+// sync enables the deploy key for the selected projects and removes it from
+// the projects that are no longer selected.
+func (e *external) sync(ctx context.Context, cr *v1alpha1.DeployKeyEnablement) error {
+	desired, err := e.selectProjects(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	keyID := cr.Spec.ForProvider.KeyID
+	enable, remove := projects.DiffDeployKeyProjects(desired, cr.Status.AtProvider.ProjectIDs)
+	for _, pid := range enable {
+		if _, _, err := e.client.EnableDeployKey(pid, keyID, gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errEnableFailed, pid)
+		}
+	}
+	for _, pid := range remove {
+		if err := e.remove(ctx, keyID, pid); err != nil {
+			return err
+		}
+	}
+
+	cr.Status.AtProvider.ProjectIDs = desired
+	return nil
+}
+
+// remove removes the deploy key from a project.
+func (e *external) remove(ctx context.Context, keyID, pid int) error {
+	res, err := e.client.DeleteDeployKey(pid, keyID, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrapf(err, errRemoveFailed, pid)
+	}
+	return nil
+}
+
+func sortedIDs(ids map[int]bool) []int {
+	var out []int
+	for id := range ids {
+		out = append(out, id)
+	}
+	sort.Ints(out)
+	return out
+}
@@ -0,0 +1,432 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploykeyenablements
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom  = errors.New("boom")
+	groupID  = "42"
+	keyID    = 7
+	notFound = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	now      = metav1.Now()
+)
+
+type args struct {
+	kube   client.Client
+	client projects.DeployKeyEnablementClient
+	cr     *v1alpha1.DeployKeyEnablement
+}
+
+type enablementModifier func(*v1alpha1.DeployKeyEnablement)
+
+func withConditions(c ...xpv1.Condition) enablementModifier {
+	return func(r *v1alpha1.DeployKeyEnablement) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withGroupID() enablementModifier {
+	return func(r *v1alpha1.DeployKeyEnablement) { r.Spec.ForProvider.GroupID = &groupID }
+}
+
+func withProjectSelector(l map[string]string) enablementModifier {
+	return func(r *v1alpha1.DeployKeyEnablement) {
+		r.Spec.ForProvider.ProjectSelector = &metav1.LabelSelector{MatchLabels: l}
+	}
+}
+
+func withExternalName(n string) enablementModifier {
+	return func(r *v1alpha1.DeployKeyEnablement) { meta.SetExternalName(r, n) }
+}
+
+func withDeletionTimestamp() enablementModifier {
+	return func(r *v1alpha1.DeployKeyEnablement) { r.SetDeletionTimestamp(&now) }
+}
+
+func withProjectIDs(ids ...int) enablementModifier {
+	return func(r *v1alpha1.DeployKeyEnablement) { r.Status.AtProvider.ProjectIDs = ids }
+}
+
+func enablement(m ...enablementModifier) *v1alpha1.DeployKeyEnablement {
+	cr := &v1alpha1.DeployKeyEnablement{Spec: v1alpha1.DeployKeyEnablementSpec{ForProvider: v1alpha1.DeployKeyEnablementParameters{KeyID: keyID}}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// groupProjects returns a MockListGroupProjects that lists projects with the
+// given IDs.
+func groupProjects(ids ...int) func(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+	return func(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+		prjs := make([]*gitlab.Project, 0, len(ids))
+		for _, id := range ids {
+			prjs = append(prjs, &gitlab.Project{ID: id})
+		}
+		return prjs, &gitlab.Response{}, nil
+	}
+}
+
+// enabledFor returns a MockGetDeployKey that finds the deploy key in the
+// projects with the given IDs only.
+func enabledFor(ids ...int) func(pid interface{}, deployKey int, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+	return func(pid interface{}, deployKey int, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+		for _, id := range ids {
+			if pid == id {
+				return &gitlab.ProjectDeployKey{ID: deployKey}, &gitlab.Response{}, nil
+			}
+		}
+		return nil, notFound, errBoom
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DeployKeyEnablement
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: enablement(withGroupID()),
+			},
+			want: want{
+				cr: enablement(withGroupID()),
+			},
+		},
+		"SelectionMissing": {
+			args: args{
+				cr: enablement(withExternalName("7")),
+			},
+			want: want{
+				cr:  enablement(withExternalName("7")),
+				err: errors.New(errSelectionMissing),
+			},
+		},
+		"ListGroupProjectsFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupProjects: func(gid interface{}, opt *gitlab.ListGroupProjectsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: enablement(withGroupID(), withExternalName("7")),
+			},
+			want: want{
+				cr:  enablement(withGroupID(), withExternalName("7")),
+				err: errors.Wrap(errBoom, errListGroupProjects),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupProjects: groupProjects(1),
+					MockGetDeployKey: func(pid interface{}, deployKey int, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: enablement(withGroupID(), withExternalName("7")),
+			},
+			want: want{
+				cr:  enablement(withGroupID(), withExternalName("7")),
+				err: errors.Wrapf(errBoom, errGetFailed, 1),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupProjects: groupProjects(2, 1),
+					MockGetDeployKey:      enabledFor(1, 2),
+				},
+				cr: enablement(withGroupID(), withExternalName("7")),
+			},
+			want: want{
+				cr:     enablement(withGroupID(), withExternalName("7"), withProjectIDs(1, 2), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ProjectsJoinedAndLeft": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupProjects: groupProjects(1, 2),
+					MockGetDeployKey:      enabledFor(1, 3),
+				},
+				cr: enablement(withGroupID(), withExternalName("7"), withProjectIDs(1, 3)),
+			},
+			want: want{
+				cr:     enablement(withGroupID(), withExternalName("7"), withProjectIDs(1, 3), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"SelectedProjects": {
+			args: args{
+				kube: &test.MockClient{
+					MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+						l := obj.(*v1alpha1.ProjectList)
+						l.Items = []v1alpha1.Project{{}, {}}
+						meta.SetExternalName(&l.Items[0], "5")
+						return nil
+					},
+				},
+				client: &fake.MockClient{
+					MockGetDeployKey: enabledFor(5),
+				},
+				cr: enablement(withProjectSelector(map[string]string{"team": "a"}), withExternalName("7")),
+			},
+			want: want{
+				cr:     enablement(withProjectSelector(map[string]string{"team": "a"}), withExternalName("7"), withProjectIDs(5), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetDeployKey: enabledFor(1),
+				},
+				cr: enablement(withGroupID(), withExternalName("7"), withDeletionTimestamp(), withProjectIDs(1, 3)),
+			},
+			want: want{
+				cr:     enablement(withGroupID(), withExternalName("7"), withDeletionTimestamp(), withProjectIDs(1), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.args.kube, client: tc.args.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var enabled []int
+
+	type want struct {
+		cr      *v1alpha1.DeployKeyEnablement
+		enabled []int
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupProjects: groupProjects(2, 1),
+					MockEnableDeployKey: func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						enabled = append(enabled, pid.(int))
+						return &gitlab.ProjectDeployKey{ID: deployKey}, &gitlab.Response{}, nil
+					},
+				},
+				cr: enablement(withGroupID()),
+			},
+			want: want{
+				cr:      enablement(withGroupID(), withExternalName("7"), withProjectIDs(1, 2), withConditions(xpv1.Creating())),
+				enabled: []int{1, 2},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupProjects: groupProjects(1),
+					MockEnableDeployKey: func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: enablement(withGroupID()),
+			},
+			want: want{
+				cr:  enablement(withGroupID(), withConditions(xpv1.Creating())),
+				err: errors.Wrapf(errBoom, errEnableFailed, 1),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			enabled = nil
+			e := &external{kube: tc.args.kube, client: tc.args.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.enabled, enabled); diff != "" {
+				t.Errorf("enabled: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var enabled, removed []int
+
+	type want struct {
+		cr      *v1alpha1.DeployKeyEnablement
+		enabled []int
+		removed []int
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupProjects: groupProjects(1, 2),
+					MockEnableDeployKey: func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error) {
+						enabled = append(enabled, pid.(int))
+						return &gitlab.ProjectDeployKey{ID: deployKey}, &gitlab.Response{}, nil
+					},
+					MockDeleteDeployKey: func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						removed = append(removed, pid.(int))
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: enablement(withGroupID(), withExternalName("7"), withProjectIDs(1, 3)),
+			},
+			want: want{
+				cr:      enablement(withGroupID(), withExternalName("7"), withProjectIDs(1, 2)),
+				enabled: []int{2},
+				removed: []int{3},
+			},
+		},
+		"RemoveFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockListGroupProjects: groupProjects(1),
+					MockDeleteDeployKey: func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: enablement(withGroupID(), withExternalName("7"), withProjectIDs(1, 3)),
+			},
+			want: want{
+				cr:  enablement(withGroupID(), withExternalName("7"), withProjectIDs(1, 3)),
+				err: errors.Wrapf(errBoom, errRemoveFailed, 3),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			enabled, removed = nil, nil
+			e := &external{kube: tc.args.kube, client: tc.args.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.enabled, enabled); diff != "" {
+				t.Errorf("enabled: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("removed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteDeployKey: func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: enablement(withGroupID(), withExternalName("7"), withProjectIDs(1, 2)),
+			},
+		},
+		"AlreadyRemoved": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteDeployKey: func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return notFound, errBoom
+					},
+				},
+				cr: enablement(withGroupID(), withExternalName("7"), withProjectIDs(1)),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteDeployKey: func(pid interface{}, deployKey int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: enablement(withGroupID(), withExternalName("7"), withProjectIDs(1)),
+			},
+			want: errors.Wrapf(errBoom, errRemoveFailed, 1),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.args.kube, client: tc.args.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,9 +23,21 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/expiry"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretversion"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -38,6 +50,7 @@ const (
 	errKeyMissing       = "missing key ref value"
 	errIDNotAnInt       = "external-name is not an int"
 	errProjectIDMissing = "missing project ID"
+	errKubeUpdateFail   = "cannot update Gitlab deploy key custom resource"
 )
 
 type external struct {
@@ -60,8 +73,9 @@ func SetupDeployKey(mgr ctrl.Manager, o crpc.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: newDeployKeyClient}),
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.DeployKeyGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.DeployKeyGroupVersionKind, clients.NewBreakerConnecter(expiry.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), tracing.NewConnecter(name, secretversion.NewConnecter(mgr.GetClient(), &connector{kube: mgr.GetClient(), newGitlabClientFn: newDeployKeyClient}))))))))))),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -72,14 +86,21 @@ func SetupDeployKey(mgr ctrl.Manager, o crpc.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.DeployKeyGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.DeployKey{}).
-		Complete(r)
+		For(&v1alpha1.DeployKey{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.DeployKey{}, &v1alpha1.DeployKeyList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 func (c *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
@@ -118,6 +139,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	dk, res, err := e.client.GetDeployKey(
 		*cr.Spec.ForProvider.ProjectID,
 		id,
+		gitlab.WithContext(ctx),
 	)
 
 	if err != nil {
@@ -206,10 +228,21 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Wrap(err, errIDNotAnInt)
 	}
 
+	// The key of a deploy key cannot be edited, so the deploy key is
+	// replaced if its key was rotated.
+	rotated, err := secretversion.Changed(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKeyMissing)
+	}
+	if rotated {
+		return managed.ExternalUpdate{}, e.replace(ctx, cr, id)
+	}
+
 	_, _, er := e.client.UpdateDeployKey(
 		cr.Spec.ForProvider.ProjectID,
 		id,
 		generateUpdateOptions(cr),
+		gitlab.WithContext(ctx),
 	)
 
 	return managed.ExternalUpdate{}, errors.Wrap(er, errUpdateFail)
@@ -236,6 +269,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	_, err = e.client.DeleteDeployKey(
 		*cr.Spec.ForProvider.ProjectID,
 		keyID,
+		gitlab.WithContext(ctx),
 	)
 
 	return errors.Wrap(err, errDeleteFail)
@@ -276,3 +310,30 @@ func isUpToDate(cr *v1alpha1.DeployKey, dk *gitlab.ProjectDeployKey) bool {
 
 	return isCanPushUpToDate && isTitleUpToDate
 }
+
+// replace adds the rotated key of cr to its project before deleting deploy
+// key id, so access is not interrupted.
+func (e *external) replace(ctx context.Context, cr *v1alpha1.DeployKey, id int) error {
+	ref := cr.Spec.ForProvider.KeySecretRef
+	secret := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return errors.Wrap(err, errKeyMissing)
+	}
+
+	keyResponse, _, err := e.client.AddDeployKey(
+		*cr.Spec.ForProvider.ProjectID,
+		generateCreateOptions(string(secret.Data[ref.Key]), &cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return errors.Wrap(err, errCreateFail)
+	}
+
+	res, err := e.client.DeleteDeployKey(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errDeleteFail)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(keyResponse.ID))
+	return errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFail)
+}
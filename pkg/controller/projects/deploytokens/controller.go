@@ -25,6 +25,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,18 +39,34 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/expiry"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
 	errNotDeployToken   = "managed resource is not a Gitlab deploytoken custom resource"
+	errKubeUpdateFailed = "cannot update Gitlab deploytoken custom resource"
 	errIDnotInt         = "ID is not an integer"
 	errGetFailed        = "cannot get Gitlab deploytoken"
 	errCreateFailed     = "cannot create Gitlab deploytoken"
 	errDeleteFailed     = "cannot delete Gitlab deploytoken"
-	errProjectIDMissing = "projectID missing"
+	errScopesImmutable  = "scopes of a deploy token cannot be changed, create a new deploy token instead"
+	errProjectIDMissing = "projectID or groupID missing"
+
+	reasonRevoked       event.Reason = "RevokedExternalResource"
+	reasonRevokeSkipped event.Reason = "SkippedRevokingExternalResource"
 )
 
 // SetupDeployToken adds a controller that reconciles ProjectDeployTokens.
@@ -61,12 +78,15 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewDeployTokenClient}),
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.DeployTokenGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.DeployTokenGroupVersionKind, clients.NewBreakerConnecter(expiry.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), recorder: recorder, newGitlabClientFn: projects.NewDeployTokenClient, newGroupClientFn: projects.NewGroupDeployTokenClient})))))))))),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -74,19 +94,28 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.DeployTokenGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.DeployToken{}).
-		Complete(r)
+		For(&v1alpha1.DeployToken{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.DeployToken{}, &v1alpha1.DeployTokenList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
 	kube              client.Client
+	recorder          event.Recorder
 	newGitlabClientFn func(cfg clients.Config) projects.DeployTokenClient
+	newGroupClientFn  func(cfg clients.Config) projects.DeployTokenClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -98,12 +127,27 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	if cr.Spec.ForProvider.GroupID != nil {
+		return &external{kube: c.kube, recorder: c.recorder, client: c.newGroupClientFn(*cfg)}, nil
+	}
+	cl := c.newGitlabClientFn(*cfg)
+	if cr.Spec.ForProvider.ProjectID == nil && cr.Spec.ForProvider.ProjectPath != nil {
+		if cr.Spec.ForProvider.ProjectID, err = projects.ResolveProjectID(ctx, cl, *cr.Spec.ForProvider.ProjectPath); err != nil {
+			return nil, err
+		}
+		// Persist the ID like a resolved reference, so the path is only
+		// resolved once.
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return nil, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+	return &external{kube: c.kube, recorder: c.recorder, client: cl}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client projects.DeployTokenClient
+	kube     client.Client
+	recorder event.Recorder
+	client   projects.DeployTokenClient
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -117,16 +161,23 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
+	// Report a token kept valid on deletion as gone, so that the managed
+	// resource is finalized without calling Delete.
+	if meta.WasDeleted(cr) && ptr.Deref(cr.Spec.ForProvider.SkipRevokeOnDelete, false) {
+		e.recorder.Event(cr, event.Normal(reasonRevokeSkipped, "Not revoking "+clients.DescribeToken(tokenKind(&cr.Spec.ForProvider), externalName, cr.Name, cr.Spec.ForProvider.Scopes, cr.Spec.ForProvider.ExpiresAt)))
+		return managed.ExternalObservation{}, nil
+	}
+
 	id, err := strconv.Atoi(externalName)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.New(errIDnotInt)
 	}
 
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.DeployTokenScopeID(&cr.Spec.ForProvider) == nil {
 		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
 	}
 
-	dt, res, err := e.client.GetProjectDeployToken(*cr.Spec.ForProvider.ProjectID, id)
+	dt, res, err := e.client.GetProjectDeployToken(*projects.DeployTokenScopeID(&cr.Spec.ForProvider), id, gitlab.WithContext(ctx))
 
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
@@ -143,7 +194,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        true,
+		ResourceUpToDate:        projects.IsDeployTokenUpToDate(&cr.Spec.ForProvider, dt),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -153,12 +204,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotDeployToken)
 	}
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.DeployTokenScopeID(&cr.Spec.ForProvider) == nil {
 		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
 	}
 
 	dt, _, err := e.client.CreateProjectDeployToken(
-		*cr.Spec.ForProvider.ProjectID,
+		*projects.DeployTokenScopeID(&cr.Spec.ForProvider),
 		projects.GenerateCreateProjectDeployTokenOptions(cr.Name, &cr.Spec.ForProvider),
 		gitlab.WithContext(ctx),
 	)
@@ -190,16 +241,27 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotDeployToken)
 	}
 
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.DeployTokenScopeID(&cr.Spec.ForProvider) == nil {
 		return errors.New(errProjectIDMissing)
 	}
 	_, deleteError := e.client.DeleteProjectDeployToken(
-		*cr.Spec.ForProvider.ProjectID,
+		*projects.DeployTokenScopeID(&cr.Spec.ForProvider),
 		deployTokenID,
 		gitlab.WithContext(ctx),
 	)
+	if deleteError != nil {
+		return errors.Wrap(deleteError, errDeleteFailed)
+	}
+	e.recorder.Event(cr, event.Normal(reasonRevoked, "Revoked "+clients.DescribeToken(tokenKind(&cr.Spec.ForProvider), meta.GetExternalName(cr), cr.Name, cr.Spec.ForProvider.Scopes, cr.Spec.ForProvider.ExpiresAt)))
+	return nil
+}
 
-	return errors.Wrap(deleteError, errDeleteFailed)
+// tokenKind describes the kind of deploy token p is in events.
+func tokenKind(p *v1alpha1.DeployTokenParameters) string {
+	if p.GroupID != nil {
+		return "group deploy token"
+	}
+	return "project deploy token"
 }
 
 // lateInitializeProjectDeployToken fills the empty fields in the deploy token spec with the
@@ -29,6 +29,7 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -83,6 +84,10 @@ func withExternalName(deployTokenID string) deployTokenModifier {
 	return func(r *v1alpha1.DeployToken) { meta.SetExternalName(r, deployTokenID) }
 }
 
+func withDeletionTimestamp() deployTokenModifier {
+	return func(r *v1alpha1.DeployToken) { r.DeletionTimestamp = &metav1.Time{Time: time.Unix(1, 0)} }
+}
+
 func withAnnotations(a map[string]string) deployTokenModifier {
 	return func(p *v1alpha1.DeployToken) { meta.AddAnnotations(p, a) }
 }
@@ -96,6 +101,8 @@ func deployToken(m ...deployTokenModifier) *v1alpha1.DeployToken {
 }
 
 func TestObserve(t *testing.T) {
+	skipRevokeOnDelete := true
+
 	type want struct {
 		cr     resource.Managed
 		result managed.ExternalObservation
@@ -115,6 +122,22 @@ func TestObserve(t *testing.T) {
 				err: errors.New(errNotDeployToken),
 			},
 		},
+		"SkipRevokeOnDelete": {
+			args: args{
+				cr: deployToken(
+					withExternalName("1234"),
+					withDeletionTimestamp(),
+					withSpec(v1alpha1.DeployTokenParameters{SkipRevokeOnDelete: &skipRevokeOnDelete}),
+				),
+			},
+			want: want{
+				cr: deployToken(
+					withExternalName("1234"),
+					withDeletionTimestamp(),
+					withSpec(v1alpha1.DeployTokenParameters{SkipRevokeOnDelete: &skipRevokeOnDelete}),
+				),
+			},
+		},
 		"NoExternalName": {
 			args: args{
 				cr: deployToken(),
@@ -204,6 +227,7 @@ func TestObserve(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						ProjectID: &deployTokenID,
+						Scopes:    []string{"scope2", "scope1"},
 					}),
 					withExternalName(sDeployTokenID),
 				),
@@ -212,6 +236,7 @@ func TestObserve(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						ProjectID: &deployTokenID,
+						Scopes:    []string{"scope2", "scope1"},
 						Username:  &username,
 						ExpiresAt: &metav1.Time{Time: expiresAt},
 					}),
@@ -235,6 +260,7 @@ func TestObserve(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						ProjectID: &deployTokenID,
+						Scopes:    []string{"scope2", "scope1"},
 						Username:  &username,
 						ExpiresAt: &metav1.Time{Time: expiresAt},
 					}),
@@ -245,6 +271,7 @@ func TestObserve(t *testing.T) {
 				cr: deployToken(
 					withSpec(v1alpha1.DeployTokenParameters{
 						ProjectID: &deployTokenID,
+						Scopes:    []string{"scope2", "scope1"},
 						Username:  &username,
 						ExpiresAt: &metav1.Time{Time: expiresAt},
 					}),
@@ -262,7 +289,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.deployToken}
+			e := &external{recorder: event.NewNopRecorder(), kube: tc.kube, client: tc.deployToken}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -354,7 +381,7 @@ func TestCreate(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.deployToken}
+			e := &external{recorder: event.NewNopRecorder(), kube: tc.kube, client: tc.deployToken}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -392,7 +419,7 @@ func TestUpdate(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.deployToken}
+			e := &external{recorder: event.NewNopRecorder(), kube: tc.kube, client: tc.deployToken}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -502,7 +529,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.deployToken}
+			e := &external{recorder: event.NewNopRecorder(), client: tc.deployToken}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,286 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalstatuschecks
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretversion"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotExternalStatusCheck = "managed resource is not a Gitlab external status check custom resource"
+	errProjectIDMissing       = "ProjectID is missing"
+	errIDNotInt               = "ID is not an integer"
+	errGetFailed              = "cannot get Gitlab external status check"
+	errKubeUpdateFailed       = "cannot update Gitlab external status check custom resource"
+	errCreateFailed           = "cannot create Gitlab external status check"
+	errUpdateFailed           = "cannot update Gitlab external status check"
+	errDeleteFailed           = "cannot delete Gitlab external status check"
+	errBranchesFailed         = "cannot resolve protected branches of Gitlab external status check"
+	errGetSecretFailed        = "cannot get secret for Gitlab external status check shared secret"
+	errSecretKeyNotFound      = "cannot find key in secret for Gitlab external status check shared secret"
+)
+
+// SetupExternalStatusCheck adds a controller that reconciles
+// ExternalStatusChecks.
+func SetupExternalStatusCheck(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ExternalStatusCheckKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.ExternalStatusCheckGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.ExternalStatusCheckGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, secretversion.NewConnecter(mgr.GetClient(), &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewExternalStatusCheckClient})))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ExternalStatusCheckGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ExternalStatusCheck{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.ExternalStatusCheck{}, &v1alpha1.ExternalStatusCheckList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ExternalStatusCheckClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ExternalStatusCheck)
+	if !ok {
+		return nil, errors.New(errNotExternalStatusCheck)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ExternalStatusCheckClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ExternalStatusCheck)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotExternalStatusCheck)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	check, err := projects.GetExternalStatusCheck(e.client, *cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+	if check == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	secret, err := e.sharedSecret(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	var secretHash string
+	if secret != "" {
+		secretHash = projects.HashSharedSecret(secret)
+	}
+
+	cr.Status.AtProvider.ID = check.ID
+	cr.Status.AtProvider.HMAC = check.HMAC
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.IsExternalStatusCheckUpToDate(&cr.Spec.ForProvider, check, cr.Status.AtProvider, secretHash),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ExternalStatusCheck)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotExternalStatusCheck)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	secret, err := e.sharedSecret(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	branchIDs, err := projects.ProtectedBranchIDs(e.client, *cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.ProtectedBranches, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errBranchesFailed)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	check, _, err := e.client.CreateExternalStatusCheck(*cr.Spec.ForProvider.ProjectID, projects.GenerateExternalStatusCheckOptions(&cr.Spec.ForProvider, branchIDs, secret), gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(check.ID))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ExternalStatusCheck)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotExternalStatusCheck)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	secret, err := e.sharedSecret(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	branchIDs, err := projects.ProtectedBranchIDs(e.client, *cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.ProtectedBranches, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBranchesFailed)
+	}
+
+	_, _, err = e.client.UpdateExternalStatusCheck(*cr.Spec.ForProvider.ProjectID, id, projects.GenerateExternalStatusCheckOptions(&cr.Spec.ForProvider, branchIDs, secret), gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	// Gitlab does not return the shared secret, so the hash of the one sent
+	// is kept to detect rotations.
+	if secret != "" {
+		cr.Status.AtProvider.SharedSecretHash = projects.HashSharedSecret(secret)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ExternalStatusCheck)
+	if !ok {
+		return errors.New(errNotExternalStatusCheck)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errIDNotInt)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteExternalStatusCheck(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	return nil
+}
+
+// sharedSecret returns the shared secret the spec selects, or an empty
+// string if it selects none.
+func (e *external) sharedSecret(ctx context.Context, p *v1alpha1.ExternalStatusCheckParameters) (string, error) {
+	selector := p.SharedSecretSecretRef
+	if selector == nil {
+		return "", nil
+	}
+
+	secret := &corev1.Secret{}
+	nn := types.NamespacedName{
+		Namespace: selector.Namespace,
+		Name:      selector.Name,
+	}
+	if err := e.kube.Get(ctx, nn, secret); err != nil {
+		return "", errors.Wrap(err, errGetSecretFailed)
+	}
+
+	raw, ok := secret.Data[selector.Key]
+	if raw == nil || !ok {
+		return "", errors.New(errSecretKeyNotFound)
+	}
+	return string(raw), nil
+}
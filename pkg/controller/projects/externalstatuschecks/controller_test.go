@@ -0,0 +1,460 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalstatuschecks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	checkID      = 1
+	checkName    = "compliance"
+	externalURL  = "https://example.com/check"
+	sharedSecret = "s3cret"
+)
+
+type args struct {
+	check projects.ExternalStatusCheckClient
+	kube  client.Client
+	cr    *v1alpha1.ExternalStatusCheck
+}
+
+type checkModifier func(*v1alpha1.ExternalStatusCheck)
+
+func withConditions(c ...xpv1.Condition) checkModifier {
+	return func(r *v1alpha1.ExternalStatusCheck) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() checkModifier {
+	return func(r *v1alpha1.ExternalStatusCheck) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withCheck() checkModifier {
+	return func(r *v1alpha1.ExternalStatusCheck) {
+		r.Spec.ForProvider.Name = checkName
+		r.Spec.ForProvider.ExternalURL = externalURL
+	}
+}
+
+func withProtectedBranches(b ...string) checkModifier {
+	return func(r *v1alpha1.ExternalStatusCheck) { r.Spec.ForProvider.ProtectedBranches = b }
+}
+
+func withSharedSecretRef() checkModifier {
+	return func(r *v1alpha1.ExternalStatusCheck) {
+		r.Spec.ForProvider.SharedSecretSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "check", Namespace: "default"},
+			Key:             "secret",
+		}
+	}
+}
+
+func withExternalName(n string) checkModifier {
+	return func(r *v1alpha1.ExternalStatusCheck) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.ExternalStatusCheckObservation) checkModifier {
+	return func(r *v1alpha1.ExternalStatusCheck) { r.Status.AtProvider = s }
+}
+
+func externalStatusCheck(m ...checkModifier) *v1alpha1.ExternalStatusCheck {
+	cr := &v1alpha1.ExternalStatusCheck{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func secretGetter(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return errors.Wrapf(errBoom, "unexpected object type %T, expected %T", obj, secret)
+	}
+	secret.Data = map[string][]byte{"secret": []byte(sharedSecret)}
+	return nil
+}
+
+func TestObserve(t *testing.T) {
+	stored := &projects.ExternalStatusCheck{
+		ID:                checkID,
+		Name:              checkName,
+		ExternalURL:       externalURL,
+		HMAC:              true,
+		ProtectedBranches: []gitlab.StatusCheckProtectedBranch{{ID: 1, Name: "main"}},
+	}
+	hash := projects.HashSharedSecret(sharedSecret)
+
+	type want struct {
+		cr     *v1alpha1.ExternalStatusCheck
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: externalStatusCheck(withProjectID()),
+			},
+			want: want{
+				cr: externalStatusCheck(withProjectID()),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: externalStatusCheck(withProjectID(), withExternalName("fr")),
+			},
+			want: want{
+				cr:  externalStatusCheck(withProjectID(), withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"NotFound": {
+			args: args{
+				check: &fake.MockClient{
+					MockListProjectStatusChecks: func(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*projects.ExternalStatusCheck, *gitlab.Response, error) {
+						return []*projects.ExternalStatusCheck{{ID: 2}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: externalStatusCheck(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr: externalStatusCheck(withProjectID(), withExternalName("1")),
+			},
+		},
+		"ErrGet": {
+			args: args{
+				check: &fake.MockClient{
+					MockListProjectStatusChecks: func(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*projects.ExternalStatusCheck, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: externalStatusCheck(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr:  externalStatusCheck(withProjectID(), withExternalName("1")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				kube: &test.MockClient{MockGet: secretGetter},
+				check: &fake.MockClient{
+					MockListProjectStatusChecks: func(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*projects.ExternalStatusCheck, *gitlab.Response, error) {
+						return []*projects.ExternalStatusCheck{stored}, &gitlab.Response{}, nil
+					},
+				},
+				cr: externalStatusCheck(
+					withProjectID(),
+					withExternalName("1"),
+					withCheck(),
+					withProtectedBranches("main"),
+					withSharedSecretRef(),
+					withStatus(v1alpha1.ExternalStatusCheckObservation{SharedSecretHash: hash}),
+				),
+			},
+			want: want{
+				cr: externalStatusCheck(
+					withProjectID(),
+					withExternalName("1"),
+					withCheck(),
+					withProtectedBranches("main"),
+					withSharedSecretRef(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ExternalStatusCheckObservation{ID: checkID, HMAC: true, SharedSecretHash: hash}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SecretRotated": {
+			args: args{
+				kube: &test.MockClient{MockGet: secretGetter},
+				check: &fake.MockClient{
+					MockListProjectStatusChecks: func(pid interface{}, opt *gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*projects.ExternalStatusCheck, *gitlab.Response, error) {
+						return []*projects.ExternalStatusCheck{stored}, &gitlab.Response{}, nil
+					},
+				},
+				cr: externalStatusCheck(
+					withProjectID(),
+					withExternalName("1"),
+					withCheck(),
+					withProtectedBranches("main"),
+					withSharedSecretRef(),
+					withStatus(v1alpha1.ExternalStatusCheckObservation{SharedSecretHash: "old"}),
+				),
+			},
+			want: want{
+				cr: externalStatusCheck(
+					withProjectID(),
+					withExternalName("1"),
+					withCheck(),
+					withProtectedBranches("main"),
+					withSharedSecretRef(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ExternalStatusCheckObservation{ID: checkID, HMAC: true, SharedSecretHash: "old"}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.check}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ExternalStatusCheck
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    secretGetter,
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				check: &fake.MockClient{
+					MockListProtectedBranches: func(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error) {
+						return []*gitlab.ProtectedBranch{{ID: 7, Name: "main"}}, &gitlab.Response{}, nil
+					},
+					MockCreateExternalStatusCheck: func(pid interface{}, opt *projects.ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*projects.ExternalStatusCheck, *gitlab.Response, error) {
+						if !cmp.Equal(*opt.ProtectedBranchIDs, []int{7}) || *opt.SharedSecret != sharedSecret {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &projects.ExternalStatusCheck{ID: checkID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: externalStatusCheck(withProjectID(), withCheck(), withProtectedBranches("main"), withSharedSecretRef()),
+			},
+			want: want{
+				cr: externalStatusCheck(
+					withProjectID(),
+					withCheck(),
+					withProtectedBranches("main"),
+					withSharedSecretRef(),
+					withExternalName("1"),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"SecretNotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   externalStatusCheck(withProjectID(), withCheck(), withSharedSecretRef()),
+			},
+			want: want{
+				cr:  externalStatusCheck(withProjectID(), withCheck(), withSharedSecretRef()),
+				err: errors.Wrap(errBoom, errGetSecretFailed),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				check: &fake.MockClient{
+					MockCreateExternalStatusCheck: func(pid interface{}, opt *projects.ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*projects.ExternalStatusCheck, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: externalStatusCheck(withProjectID(), withCheck()),
+			},
+			want: want{
+				cr:  externalStatusCheck(withProjectID(), withCheck(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.check}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ExternalStatusCheck
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulUpdate": {
+			args: args{
+				kube: &test.MockClient{MockGet: secretGetter},
+				check: &fake.MockClient{
+					MockUpdateExternalStatusCheck: func(pid interface{}, check int, opt *projects.ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*projects.ExternalStatusCheck, *gitlab.Response, error) {
+						return &projects.ExternalStatusCheck{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: externalStatusCheck(withProjectID(), withExternalName("1"), withCheck(), withSharedSecretRef()),
+			},
+			want: want{
+				cr: externalStatusCheck(
+					withProjectID(),
+					withExternalName("1"),
+					withCheck(),
+					withSharedSecretRef(),
+					withStatus(v1alpha1.ExternalStatusCheckObservation{SharedSecretHash: projects.HashSharedSecret(sharedSecret)}),
+				),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				check: &fake.MockClient{
+					MockUpdateExternalStatusCheck: func(pid interface{}, check int, opt *projects.ExternalStatusCheckOptions, options ...gitlab.RequestOptionFunc) (*projects.ExternalStatusCheck, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: externalStatusCheck(withProjectID(), withExternalName("1"), withCheck()),
+			},
+			want: want{
+				cr:  externalStatusCheck(withProjectID(), withExternalName("1"), withCheck()),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.check}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ExternalStatusCheck
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				check: &fake.MockClient{
+					MockDeleteExternalStatusCheck: func(pid interface{}, check int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: externalStatusCheck(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr: externalStatusCheck(withProjectID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				check: &fake.MockClient{
+					MockDeleteExternalStatusCheck: func(pid interface{}, check int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: externalStatusCheck(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr: externalStatusCheck(withProjectID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				check: &fake.MockClient{
+					MockDeleteExternalStatusCheck: func(pid interface{}, check int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: externalStatusCheck(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr:  externalStatusCheck(withProjectID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.check}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forkrelationships
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	forkedFromID = "10"
+	notFound     = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+)
+
+type relationshipModifier func(*v1alpha1.ForkRelationship)
+
+func withConditions(c ...xpv1.Condition) relationshipModifier {
+	return func(r *v1alpha1.ForkRelationship) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() relationshipModifier {
+	return func(r *v1alpha1.ForkRelationship) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withForkedFromProjectID(id string) relationshipModifier {
+	return func(r *v1alpha1.ForkRelationship) { r.Spec.ForProvider.ForkedFromProjectID = &id }
+}
+
+func withExternalName(n string) relationshipModifier {
+	return func(r *v1alpha1.ForkRelationship) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.ForkRelationshipObservation) relationshipModifier {
+	return func(r *v1alpha1.ForkRelationship) { r.Status.AtProvider = s }
+}
+
+func relationship(m ...relationshipModifier) *v1alpha1.ForkRelationship {
+	cr := &v1alpha1.ForkRelationship{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// forkedFrom returns a client whose project is forked from the project
+// with id, or not forked if id is 0.
+func forkedFrom(id int) *fake.MockClient {
+	return &fake.MockClient{
+		MockGetProject: func(_ interface{}, _ *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+			p := &gitlab.Project{ID: 1234}
+			if id != 0 {
+				p.ForkedFromProject = &gitlab.ForkParent{ID: id, PathWithNamespace: "upstream/project"}
+			}
+			return p, &gitlab.Response{}, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ForkRelationship
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := v1alpha1.ForkRelationshipObservation{ForkedFromProjectID: 10, ForkedFromProjectPath: "upstream/project"}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ForkRelationship
+		want
+	}{
+		"NoExternalName": {
+			cr: relationship(withProjectID()),
+			want: want{
+				cr: relationship(withProjectID()),
+			},
+		},
+		"ProjectIDMissing": {
+			cr: relationship(withExternalName(projectID)),
+			want: want{
+				cr:  relationship(withExternalName(projectID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockGetProject: func(_ interface{}, _ *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: relationship(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr:  relationship(withProjectID(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ProjectNotFound": {
+			client: &fake.MockClient{
+				MockGetProject: func(_ interface{}, _ *gitlab.GetProjectOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+					return nil, notFound, errBoom
+				},
+			},
+			cr: relationship(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr: relationship(withProjectID(), withExternalName(projectID)),
+			},
+		},
+		"NotForked": {
+			client: forkedFrom(0),
+			cr:     relationship(withProjectID(), withForkedFromProjectID(forkedFromID), withExternalName(projectID)),
+			want: want{
+				cr: relationship(withProjectID(), withForkedFromProjectID(forkedFromID), withExternalName(projectID)),
+			},
+		},
+		"UpToDate": {
+			client: forkedFrom(10),
+			cr:     relationship(withProjectID(), withForkedFromProjectID(forkedFromID), withExternalName(projectID)),
+			want: want{
+				cr: relationship(
+					withProjectID(),
+					withForkedFromProjectID(forkedFromID),
+					withExternalName(projectID),
+					withStatus(observed),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ForkedFromAnotherProject": {
+			client: forkedFrom(10),
+			cr:     relationship(withProjectID(), withForkedFromProjectID("11"), withExternalName(projectID)),
+			want: want{
+				cr: relationship(
+					withProjectID(),
+					withForkedFromProjectID("11"),
+					withExternalName(projectID),
+					withStatus(observed),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr   *v1alpha1.ForkRelationship
+		fork int
+		err  error
+	}
+
+	cases := map[string]struct {
+		cr  *v1alpha1.ForkRelationship
+		err error
+		want
+	}{
+		"Successful": {
+			cr: relationship(withProjectID(), withForkedFromProjectID(forkedFromID)),
+			want: want{
+				cr:   relationship(withProjectID(), withForkedFromProjectID(forkedFromID), withExternalName(projectID), withConditions(xpv1.Creating())),
+				fork: 10,
+			},
+		},
+		"ForkedFromProjectIDNotInt": {
+			cr: relationship(withProjectID(), withForkedFromProjectID("upstream/project")),
+			want: want{
+				cr:  relationship(withProjectID(), withForkedFromProjectID("upstream/project"), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.New(`strconv.Atoi: parsing "upstream/project": invalid syntax`), "ForkedFromProjectID is not the ID of a project"),
+			},
+		},
+		"FailedCreate": {
+			cr:  relationship(withProjectID(), withForkedFromProjectID(forkedFromID)),
+			err: errBoom,
+			want: want{
+				cr:   relationship(withProjectID(), withForkedFromProjectID(forkedFromID), withConditions(xpv1.Creating())),
+				fork: 10,
+				err:  errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var fork int
+			e := &external{client: &fake.MockClient{
+				MockCreateProjectForkRelation: func(_ interface{}, f int, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectForkRelation, *gitlab.Response, error) {
+					fork = f
+					return &gitlab.ProjectForkRelation{}, &gitlab.Response{}, tc.err
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.fork, fork); diff != "" {
+				t.Errorf("fork: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var calls []string
+	e := &external{client: &fake.MockClient{
+		MockDeleteProjectForkRelation: func(_ interface{}, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			calls = append(calls, "delete")
+			return &gitlab.Response{}, nil
+		},
+		MockCreateProjectForkRelation: func(_ interface{}, f int, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectForkRelation, *gitlab.Response, error) {
+			calls = append(calls, "create")
+			return &gitlab.ProjectForkRelation{}, &gitlab.Response{}, nil
+		},
+	}}
+
+	if _, err := e.Update(context.Background(), relationship(withProjectID(), withForkedFromProjectID("11"), withExternalName(projectID))); err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+	if diff := cmp.Diff([]string{"delete", "create"}, calls); diff != "" {
+		t.Errorf("calls: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		res  *gitlab.Response
+		err  error
+		want error
+	}{
+		"Successful": {
+			res: &gitlab.Response{},
+		},
+		"AlreadyDeleted": {
+			res: notFound,
+			err: errBoom,
+		},
+		"Failed": {
+			res:  &gitlab.Response{},
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockClient{
+				MockDeleteProjectForkRelation: func(_ interface{}, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					return tc.res, tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), relationship(withProjectID(), withExternalName(projectID)))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,405 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package freezeperiods
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	freezeID     = 1
+	freezeStart  = "0 23 * * 5"
+	freezeEnd    = "0 7 * * 1"
+	cronTimezone = "UTC"
+)
+
+type args struct {
+	freezePeriod projects.FreezePeriodClient
+	kube         client.Client
+	cr           *v1alpha1.FreezePeriod
+}
+
+type freezePeriodModifier func(*v1alpha1.FreezePeriod)
+
+func withConditions(c ...xpv1.Condition) freezePeriodModifier {
+	return func(r *v1alpha1.FreezePeriod) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() freezePeriodModifier {
+	return func(r *v1alpha1.FreezePeriod) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withSchedule(start, end string) freezePeriodModifier {
+	return func(r *v1alpha1.FreezePeriod) {
+		r.Spec.ForProvider.FreezeStart = start
+		r.Spec.ForProvider.FreezeEnd = end
+	}
+}
+
+func withCronTimezone() freezePeriodModifier {
+	return func(r *v1alpha1.FreezePeriod) { r.Spec.ForProvider.CronTimezone = &cronTimezone }
+}
+
+func withExternalName(n string) freezePeriodModifier {
+	return func(r *v1alpha1.FreezePeriod) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.FreezePeriodObservation) freezePeriodModifier {
+	return func(r *v1alpha1.FreezePeriod) { r.Status.AtProvider = s }
+}
+
+func freezePeriod(m ...freezePeriodModifier) *v1alpha1.FreezePeriod {
+	cr := &v1alpha1.FreezePeriod{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	stored := &gitlab.FreezePeriod{
+		ID:           freezeID,
+		FreezeStart:  freezeStart,
+		FreezeEnd:    freezeEnd,
+		CronTimezone: cronTimezone,
+	}
+
+	type want struct {
+		cr     *v1alpha1.FreezePeriod
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: freezePeriod(withProjectID()),
+			},
+			want: want{
+				cr: freezePeriod(withProjectID()),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: freezePeriod(withProjectID(), withExternalName("fr")),
+			},
+			want: want{
+				cr:  freezePeriod(withProjectID(), withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: freezePeriod(withExternalName("1")),
+			},
+			want: want{
+				cr:  freezePeriod(withExternalName("1")),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockGetFreezePeriod: func(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: freezePeriod(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr: freezePeriod(withProjectID(), withExternalName("1")),
+			},
+		},
+		"ErrGet": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockGetFreezePeriod: func(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: freezePeriod(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr:  freezePeriod(withProjectID(), withExternalName("1")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockGetFreezePeriod: func(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+						return stored, &gitlab.Response{}, nil
+					},
+				},
+				cr: freezePeriod(withProjectID(), withExternalName("1"), withSchedule(freezeStart, freezeEnd), withCronTimezone()),
+			},
+			want: want{
+				cr: freezePeriod(
+					withProjectID(),
+					withExternalName("1"),
+					withSchedule(freezeStart, freezeEnd),
+					withCronTimezone(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.FreezePeriodObservation{ID: freezeID}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitializedCronTimezone": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockGetFreezePeriod: func(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+						return stored, &gitlab.Response{}, nil
+					},
+				},
+				cr: freezePeriod(withProjectID(), withExternalName("1"), withSchedule(freezeStart, "0 8 * * 1")),
+			},
+			want: want{
+				cr: freezePeriod(
+					withProjectID(),
+					withExternalName("1"),
+					withSchedule(freezeStart, "0 8 * * 1"),
+					withCronTimezone(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.FreezePeriodObservation{ID: freezeID}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.freezePeriod}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.FreezePeriod
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				freezePeriod: &fake.MockClient{
+					MockCreateFreezePeriod: func(pid interface{}, opt *gitlab.CreateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+						return &gitlab.FreezePeriod{ID: freezeID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: freezePeriod(withProjectID(), withSchedule(freezeStart, freezeEnd)),
+			},
+			want: want{
+				cr: freezePeriod(
+					withProjectID(),
+					withSchedule(freezeStart, freezeEnd),
+					withExternalName("1"),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: freezePeriod(withSchedule(freezeStart, freezeEnd)),
+			},
+			want: want{
+				cr:  freezePeriod(withSchedule(freezeStart, freezeEnd)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockCreateFreezePeriod: func(pid interface{}, opt *gitlab.CreateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: freezePeriod(withProjectID(), withSchedule(freezeStart, freezeEnd)),
+			},
+			want: want{
+				cr:  freezePeriod(withProjectID(), withSchedule(freezeStart, freezeEnd), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.freezePeriod}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulUpdate": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockUpdateFreezePeriod: func(pid interface{}, freezePeriod int, opt *gitlab.UpdateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+						return &gitlab.FreezePeriod{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: freezePeriod(withProjectID(), withExternalName("1"), withSchedule(freezeStart, freezeEnd)),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockUpdateFreezePeriod: func(pid interface{}, freezePeriod int, opt *gitlab.UpdateFreezePeriodOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FreezePeriod, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: freezePeriod(withProjectID(), withExternalName("1"), withSchedule(freezeStart, freezeEnd)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.freezePeriod}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.FreezePeriod
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockDeleteFreezePeriod: func(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: freezePeriod(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr: freezePeriod(withProjectID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockDeleteFreezePeriod: func(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: freezePeriod(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr: freezePeriod(withProjectID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				freezePeriod: &fake.MockClient{
+					MockDeleteFreezePeriod: func(pid interface{}, freezePeriod int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: freezePeriod(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr:  freezePeriod(withProjectID(), withExternalName("1"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.freezePeriod}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
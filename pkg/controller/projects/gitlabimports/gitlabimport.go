@@ -0,0 +1,387 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlabimports
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	groupsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+)
+
+const (
+	errGetImport         = "cannot get GitLabImport"
+	errGroupIDMissing    = "GroupID or GroupIDRef is missing"
+	errGetGroup          = "cannot get referenced Group"
+	errGroupNotCreated   = "referenced Group is not created yet"
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errListProjects      = "cannot list projects of group"
+	errListMembers       = "cannot list members of project %d"
+	errListVariables     = "cannot list variables of project %d"
+	errListManaged       = "cannot list managed %s resources"
+	errCreate            = "cannot create %s %s"
+	errUpdateStatus      = "cannot update GitLabImport status"
+
+	reasonImportFailed event.Reason = "ImportFailed"
+	reasonImported     event.Reason = "ImportedProjects"
+
+	defaultBatchSize     = 10
+	defaultBatchInterval = 30 * time.Second
+)
+
+// SetupGitLabImport adds a controller that imports the projects of a group
+// as observe-only managed resources. The controller is only set up if
+// management policies are enabled, as the resources it creates would not be
+// reconciled otherwise.
+func SetupGitLabImport(mgr ctrl.Manager, o controller.Options) error {
+	if !o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		return nil
+	}
+	name := "gitlabimport/" + v1alpha1.GitLabImportGroupKind
+
+	r := &reconciler{
+		client:            mgr.GetClient(),
+		newGitlabClientFn: projects.NewGitLabImportClient,
+		log:               o.Logger.WithValues("controller", name),
+		record:            event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		pollInterval:      o.PollInterval,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.GitLabImport{}).
+		Complete(r)
+}
+
+// reconciler imports the projects of the group of a GitLabImport in batches
+// of increasing project IDs, remembering the ID of the last imported project
+// in the status. Once all projects are imported, the group is listed again
+// every poll interval to import new projects, which have higher IDs.
+// Created resources are not owned by the GitLabImport, so they are kept
+// when it is deleted. The reconciler only reads from Gitlab, so like the
+// GroupPolicy reconciler it is exempt from the protection, maintenance, dry
+// run and audit wrappers of managed resource controllers.
+type reconciler struct {
+	client            client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.GitLabImportClient
+	log               logging.Logger
+	record            event.Recorder
+	pollInterval      time.Duration
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	gi := &v1alpha1.GitLabImport{}
+	if err := r.client.Get(ctx, req.NamespacedName, gi); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetImport)
+	}
+	if gi.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	pending, err := r.importBatch(ctx, gi)
+	if err != nil {
+		log.Debug("Cannot import group", "error", err)
+		r.record.Event(gi, event.Warning(reasonImportFailed, err))
+		gi.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, gi), errUpdateStatus)
+	}
+
+	gi.SetConditions(xpv1.ReconcileSuccess())
+	if pending {
+		gi.SetConditions(xpv1.Creating())
+		interval := defaultBatchInterval
+		if gi.Spec.BatchInterval != nil {
+			interval = gi.Spec.BatchInterval.Duration
+		}
+		return reconcile.Result{RequeueAfter: interval}, errors.Wrap(r.client.Status().Update(ctx, gi), errUpdateStatus)
+	}
+	gi.SetConditions(xpv1.Available())
+	return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.client.Status().Update(ctx, gi), errUpdateStatus)
+}
+
+// importBatch imports the next batch of projects of the group of gi and
+// reports whether projects are left to import. The status of gi records
+// the progress even if the batch fails part way.
+func (r *reconciler) importBatch(ctx context.Context, gi *v1alpha1.GitLabImport) (bool, error) { // nolint:gocyclo
+	gid, err := r.groupID(ctx, gi)
+	if err != nil {
+		return false, err
+	}
+	gi.Status.GroupID = &gid
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: gi.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return false, errors.Wrap(err, errGetProviderConfig)
+	}
+	cfg, err := clients.ConfigFromProviderConfig(ctx, r.client, pc)
+	if err != nil {
+		return false, err
+	}
+	gl := r.newGitlabClientFn(*cfg)
+	prjs, err := projects.ListAllGroupProjects(gl, gid, ptr.Deref(gi.Spec.IncludeSubgroups, false), gitlab.WithContext(ctx))
+	if err != nil {
+		return false, errors.Wrap(err, errListProjects)
+	}
+	sort.Slice(prjs, func(i, j int) bool { return prjs[i].ID < prjs[j].ID })
+
+	var next []*gitlab.Project
+	for _, p := range prjs {
+		if p.ID > gi.Status.LastProjectID {
+			next = append(next, p)
+		}
+	}
+	gi.Status.Projects = len(prjs)
+	gi.Status.ImportedProjects = len(prjs) - len(next)
+	if len(next) == 0 {
+		return false, nil
+	}
+
+	batch := defaultBatchSize
+	if gi.Spec.BatchSize != nil {
+		batch = *gi.Spec.BatchSize
+	}
+	if len(next) > batch {
+		next = next[:batch]
+	}
+
+	managed, err := r.managedKeys(ctx, gi)
+	if err != nil {
+		return false, err
+	}
+	created := 0
+	defer func() {
+		if created > 0 {
+			r.record.Event(gi, event.Normal(reasonImported, fmt.Sprintf("Created %d resources", created)))
+		}
+	}()
+	for _, p := range next {
+		var members []*gitlab.ProjectMember
+		if imports(gi, v1alpha1.ImportedKindMember) {
+			if members, err = projects.ListAllProjectMembers(gl, p.ID, gitlab.WithContext(ctx)); err != nil {
+				return false, errors.Wrapf(err, errListMembers, p.ID)
+			}
+		}
+		var variables []*gitlab.ProjectVariable
+		if imports(gi, v1alpha1.ImportedKindVariable) {
+			if variables, err = projects.ListAllProjectVariables(gl, p.ID, gitlab.WithContext(ctx)); err != nil {
+				return false, errors.Wrapf(err, errListVariables, p.ID)
+			}
+		}
+		for _, mg := range Render(gi, p, members, variables) {
+			if managed[Key(mg)] {
+				continue
+			}
+			err := r.client.Create(ctx, mg)
+			if kerrors.IsAlreadyExists(err) {
+				continue
+			}
+			if err != nil {
+				return false, errors.Wrapf(err, errCreate, mg.GetObjectKind().GroupVersionKind().Kind, mg.GetName())
+			}
+			gi.Status.Resources++
+			created++
+		}
+		gi.Status.LastProjectID = p.ID
+		gi.Status.ImportedProjects++
+	}
+	return gi.Status.ImportedProjects < gi.Status.Projects, nil
+}
+
+// managedKeys returns the Keys of the managed resources of the kinds gi
+// imports, so resources that are already managed are not imported again.
+func (r *reconciler) managedKeys(ctx context.Context, gi *v1alpha1.GitLabImport) (map[string]bool, error) {
+	keys := map[string]bool{}
+	if imports(gi, v1alpha1.ImportedKindProject) {
+		l := &v1alpha1.ProjectList{}
+		if err := r.client.List(ctx, l); err != nil {
+			return nil, errors.Wrapf(err, errListManaged, v1alpha1.ProjectKind)
+		}
+		for i := range l.Items {
+			keys[Key(&l.Items[i])] = true
+		}
+	}
+	if imports(gi, v1alpha1.ImportedKindMember) {
+		l := &v1alpha1.MemberList{}
+		if err := r.client.List(ctx, l); err != nil {
+			return nil, errors.Wrapf(err, errListManaged, v1alpha1.MemberKind)
+		}
+		for i := range l.Items {
+			keys[Key(&l.Items[i])] = true
+		}
+	}
+	if imports(gi, v1alpha1.ImportedKindVariable) {
+		l := &v1alpha1.VariableList{}
+		if err := r.client.List(ctx, l); err != nil {
+			return nil, errors.Wrapf(err, errListManaged, v1alpha1.VariableKind)
+		}
+		for i := range l.Items {
+			keys[Key(&l.Items[i])] = true
+		}
+	}
+	return keys, nil
+}
+
+// groupID returns the ID of the group of gi, read from the external name of
+// the referenced Group if no ID is given.
+func (r *reconciler) groupID(ctx context.Context, gi *v1alpha1.GitLabImport) (int, error) {
+	if gi.Spec.GroupID != nil {
+		return *gi.Spec.GroupID, nil
+	}
+	if gi.Spec.GroupIDRef == nil {
+		return 0, errors.New(errGroupIDMissing)
+	}
+	g := &groupsv1alpha1.Group{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: gi.Spec.GroupIDRef.Name}, g); err != nil {
+		return 0, errors.Wrap(err, errGetGroup)
+	}
+	gid, err := strconv.Atoi(meta.GetExternalName(g))
+	if err != nil {
+		return 0, errors.New(errGroupNotCreated)
+	}
+	return gid, nil
+}
+
+// imports reports whether gi imports resources of kind k. All kinds are
+// imported if none are given.
+func imports(gi *v1alpha1.GitLabImport, k v1alpha1.ImportedKind) bool {
+	if len(gi.Spec.Kinds) == 0 {
+		return true
+	}
+	for _, kind := range gi.Spec.Kinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+// Key identifies the Gitlab resource of a managed Project, Member or
+// Variable independently of the name of the managed resource.
+func Key(mg resource.Managed) string {
+	switch cr := mg.(type) {
+	case *v1alpha1.Project:
+		return "Project/" + meta.GetExternalName(cr)
+	case *v1alpha1.Member:
+		p := cr.Spec.ForProvider
+		return fmt.Sprintf("Member/%d/%d", ptr.Deref(p.ProjectID, 0), ptr.Deref(p.UserID, 0))
+	case *v1alpha1.Variable:
+		p := cr.Spec.ForProvider
+		return fmt.Sprintf("Variable/%d/%s/%s", ptr.Deref(p.ProjectID, 0), p.Key, ptr.Deref(p.EnvironmentScope, "*"))
+	}
+	return ""
+}
+
+// Render returns the observe-only managed resources of the kinds gi imports
+// for project p with its members and variables. Variable values are not
+// rendered.
+func Render(gi *v1alpha1.GitLabImport, p *gitlab.Project, members []*gitlab.ProjectMember, variables []*gitlab.ProjectVariable) []resource.Managed {
+	rs := xpv1.ResourceSpec{
+		ProviderConfigReference: gi.Spec.ProviderConfigReference,
+		ManagementPolicies:      xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+		DeletionPolicy:          xpv1.DeletionOrphan,
+	}
+	pid := strconv.Itoa(p.ID)
+
+	out := []resource.Managed{}
+	if imports(gi, v1alpha1.ImportedKindProject) {
+		o := &v1alpha1.Project{Spec: v1alpha1.ProjectSpec{ResourceSpec: rs, ForProvider: v1alpha1.ProjectParameters{
+			Name: ptr.To(p.Name),
+			Path: ptr.To(p.Path),
+		}}}
+		if p.Namespace != nil {
+			o.Spec.ForProvider.NamespaceID = ptr.To(p.Namespace.ID)
+		}
+		setMeta(o, gi, v1alpha1.ProjectKind, gi.GetName()+"-"+pid)
+		meta.SetExternalName(o, pid)
+		out = append(out, o)
+	}
+	for _, m := range members {
+		o := &v1alpha1.Member{Spec: v1alpha1.MemberSpec{ResourceSpec: rs, ForProvider: v1alpha1.MemberParameters{
+			ProjectID:   ptr.To(p.ID),
+			UserID:      ptr.To(m.ID),
+			AccessLevel: v1alpha1.AccessLevelValue(m.AccessLevel),
+		}}}
+		if m.ExpiresAt != nil {
+			o.Spec.ForProvider.ExpiresAt = ptr.To(m.ExpiresAt.String())
+		}
+		setMeta(o, gi, v1alpha1.MemberKind, fmt.Sprintf("%s-%s-member-%d", gi.GetName(), pid, m.ID))
+		out = append(out, o)
+	}
+	for _, v := range variables {
+		o := &v1alpha1.Variable{Spec: v1alpha1.VariableSpec{ResourceSpec: rs, ForProvider: v1alpha1.VariableParameters{
+			ProjectID:        ptr.To(p.ID),
+			Key:              v.Key,
+			Masked:           ptr.To(v.Masked),
+			Protected:        ptr.To(v.Protected),
+			Raw:              ptr.To(v.Raw),
+			VariableType:     ptr.To(v1alpha1.VariableType(v.VariableType)),
+			EnvironmentScope: ptr.To(v.EnvironmentScope),
+		}}}
+		setMeta(o, gi, v1alpha1.VariableKind, gi.GetName()+"-"+pid+"-variable-"+variableName(v))
+		out = append(out, o)
+	}
+	return out
+}
+
+// variableName returns a name for variable v that is unique within its
+// project. Keys may only differ in case, and the same key may exist for
+// several environment scopes, so the name ends with a hash of both.
+func variableName(v *gitlab.ProjectVariable) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(v.Key + "/" + v.EnvironmentScope))
+	key := strings.ReplaceAll(strings.ToLower(v.Key), "_", "-")
+	if len(key) > 40 {
+		key = key[:40]
+	}
+	return fmt.Sprintf("%s-%08x", strings.Trim(key, "-"), h.Sum32())
+}
+
+// setMeta names mg and labels it as imported by gi.
+func setMeta(mg resource.Managed, gi *v1alpha1.GitLabImport, kind, name string) {
+	mg.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(kind))
+	mg.SetName(name)
+	mg.SetLabels(map[string]string{v1alpha1.LabelGitLabImport: gi.GetName()})
+}
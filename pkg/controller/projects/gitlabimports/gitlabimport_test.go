@@ -0,0 +1,277 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlabimports
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	groupsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+func gitLabImport(m ...func(*v1alpha1.GitLabImport)) *v1alpha1.GitLabImport {
+	gi := &v1alpha1.GitLabImport{
+		ObjectMeta: metav1.ObjectMeta{Name: "gi"},
+		Spec: v1alpha1.GitLabImportSpec{
+			ProviderConfigReference: &xpv1.Reference{Name: "default"},
+			GroupIDRef:              &xpv1.Reference{Name: "team"},
+			BatchSize:               ptr.To(2),
+		},
+	}
+	for _, f := range m {
+		f(gi)
+	}
+	return gi
+}
+
+func withKinds(k ...v1alpha1.ImportedKind) func(*v1alpha1.GitLabImport) {
+	return func(gi *v1alpha1.GitLabImport) { gi.Spec.Kinds = k }
+}
+
+func withLastProjectID(id int) func(*v1alpha1.GitLabImport) {
+	return func(gi *v1alpha1.GitLabImport) { gi.Status.LastProjectID = id }
+}
+
+func TestRender(t *testing.T) {
+	p := &gitlab.Project{ID: 1, Name: "Web", Path: "web", Namespace: &gitlab.ProjectNamespace{ID: 7}}
+	members := []*gitlab.ProjectMember{{ID: 5, AccessLevel: gitlab.DeveloperPermissions}}
+	variables := []*gitlab.ProjectVariable{{Key: "API_TOKEN", Value: "secret", EnvironmentScope: "*", VariableType: gitlab.EnvVariableType, Masked: true}}
+	got := Render(gitLabImport(), p, members, variables)
+
+	names := []string{}
+	for _, mg := range got {
+		names = append(names, mg.GetObjectKind().GroupVersionKind().Kind+"/"+mg.GetName())
+		if diff := cmp.Diff("gi", mg.GetLabels()[v1alpha1.LabelGitLabImport]); diff != "" {
+			t.Errorf("Render %s: label: -want, +got:\n%s", mg.GetName(), diff)
+		}
+		if diff := cmp.Diff(xpv1.ManagementPolicies{xpv1.ManagementActionObserve}, mg.GetManagementPolicies()); diff != "" {
+			t.Errorf("Render %s: management policies: -want, +got:\n%s", mg.GetName(), diff)
+		}
+		if metav1.GetControllerOf(mg) != nil {
+			t.Errorf("Render %s: want no controller", mg.GetName())
+		}
+	}
+	want := []string{"Project/gi-1", "Member/gi-1-member-5", "Variable/gi-1-variable-" + variableName(variables[0])}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Errorf("Render: names: -want, +got:\n%s", diff)
+	}
+
+	if diff := cmp.Diff("1", meta.GetExternalName(got[0])); diff != "" {
+		t.Errorf("Render: project external name: -want, +got:\n%s", diff)
+	}
+	wantVariable := v1alpha1.VariableParameters{
+		ProjectID:        ptr.To(1),
+		Key:              "API_TOKEN",
+		Masked:           ptr.To(true),
+		Protected:        ptr.To(false),
+		Raw:              ptr.To(false),
+		VariableType:     ptr.To(v1alpha1.VariableTypeEnvVar),
+		EnvironmentScope: ptr.To("*"),
+	}
+	if diff := cmp.Diff(wantVariable, got[2].(*v1alpha1.Variable).Spec.ForProvider); diff != "" {
+		t.Errorf("Render: variable: -want, +got:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(1, len(Render(gitLabImport(withKinds(v1alpha1.ImportedKindMember)), p, members, nil))); diff != "" {
+		t.Errorf("Render: members only: -want, +got:\n%s", diff)
+	}
+}
+
+func TestVariableName(t *testing.T) {
+	a := variableName(&gitlab.ProjectVariable{Key: "API_TOKEN", EnvironmentScope: "*"})
+	b := variableName(&gitlab.ProjectVariable{Key: "API_TOKEN", EnvironmentScope: "production"})
+	if a == b {
+		t.Errorf("variableName: want different names for different scopes, got %s", a)
+	}
+	if len(variableName(&gitlab.ProjectVariable{Key: string(make([]byte, 255))})) > 63 {
+		t.Errorf("variableName: want long keys to be truncated")
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		result  reconcile.Result
+		err     error
+		status  *v1alpha1.GitLabImportStatus
+		created []string
+	}
+
+	cases := map[string]struct {
+		gi         *v1alpha1.GitLabImport
+		groupName  string
+		membersErr error
+		want       want
+	}{
+		"FirstBatch": {
+			gi:        gitLabImport(withKinds(v1alpha1.ImportedKindProject, v1alpha1.ImportedKindMember)),
+			groupName: "7",
+			want: want{
+				result: reconcile.Result{RequeueAfter: defaultBatchInterval},
+				status: func() *v1alpha1.GitLabImportStatus {
+					s := &v1alpha1.GitLabImportStatus{GroupID: ptr.To(7), Projects: 3, ImportedProjects: 2, LastProjectID: 2, Resources: 2}
+					s.SetConditions(xpv1.ReconcileSuccess(), xpv1.Creating())
+					return s
+				}(),
+				// Project 1 is managed already, member 5 of project 2 exists.
+				created: []string{"Member/gi-1-member-5", "Project/gi-2"},
+			},
+		},
+		"LastBatch": {
+			gi:        gitLabImport(withKinds(v1alpha1.ImportedKindProject), withLastProjectID(2)),
+			groupName: "7",
+			want: want{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				status: func() *v1alpha1.GitLabImportStatus {
+					s := &v1alpha1.GitLabImportStatus{GroupID: ptr.To(7), Projects: 3, ImportedProjects: 3, LastProjectID: 3, Resources: 1}
+					s.SetConditions(xpv1.ReconcileSuccess(), xpv1.Available())
+					return s
+				}(),
+				created: []string{"Project/gi-3"},
+			},
+		},
+		"Imported": {
+			gi:        gitLabImport(withLastProjectID(3)),
+			groupName: "7",
+			want: want{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				status: func() *v1alpha1.GitLabImportStatus {
+					s := &v1alpha1.GitLabImportStatus{GroupID: ptr.To(7), Projects: 3, ImportedProjects: 3, LastProjectID: 3}
+					s.SetConditions(xpv1.ReconcileSuccess(), xpv1.Available())
+					return s
+				}(),
+			},
+		},
+		"ListMembersFailed": {
+			gi:         gitLabImport(withKinds(v1alpha1.ImportedKindMember)),
+			groupName:  "7",
+			membersErr: errBoom,
+			want: want{
+				status: func() *v1alpha1.GitLabImportStatus {
+					s := &v1alpha1.GitLabImportStatus{GroupID: ptr.To(7), Projects: 3}
+					s.SetConditions(xpv1.ReconcileError(errors.Wrapf(errBoom, errListMembers, 1)))
+					return s
+				}(),
+			},
+		},
+		"GroupNotCreated": {
+			gi: gitLabImport(),
+			want: want{
+				status: func() *v1alpha1.GitLabImportStatus {
+					s := &v1alpha1.GitLabImportStatus{}
+					s.SetConditions(xpv1.ReconcileError(errors.New(errGroupNotCreated)))
+					return s
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var status *v1alpha1.GitLabImportStatus
+			created := []string{}
+			r := &reconciler{
+				client: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						switch o := obj.(type) {
+						case *v1alpha1.GitLabImport:
+							*o = *tc.gi
+						case *groupsv1alpha1.Group:
+							meta.SetExternalName(o, tc.groupName)
+						case *v1beta1.ProviderConfig:
+							o.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+							o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{Key: "token"}
+						case *corev1.Secret:
+							o.Data = map[string][]byte{"token": []byte("t")}
+						}
+						return nil
+					},
+					MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+						if l, ok := obj.(*v1alpha1.ProjectList); ok {
+							p := v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "web"}}
+							meta.SetExternalName(&p, "1")
+							l.Items = []v1alpha1.Project{p}
+						}
+						return nil
+					},
+					MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						if obj.GetName() == "gi-2-member-5" {
+							return kerrors.NewAlreadyExists(schema.GroupResource{}, obj.GetName())
+						}
+						created = append(created, obj.GetObjectKind().GroupVersionKind().Kind+"/"+obj.GetName())
+						return nil
+					},
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						status = &obj.(*v1alpha1.GitLabImport).Status
+						return nil
+					},
+				},
+				newGitlabClientFn: func(_ clients.Config) projects.GitLabImportClient {
+					return &fake.MockClient{
+						MockListGroupProjects: func(_ interface{}, _ *gitlab.ListGroupProjectsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+							return []*gitlab.Project{{ID: 3}, {ID: 1}, {ID: 2}}, &gitlab.Response{}, nil
+						},
+						MockListProjectMembers: func(_ interface{}, _ *gitlab.ListProjectMembersOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error) {
+							return []*gitlab.ProjectMember{{ID: 5}}, &gitlab.Response{}, tc.membersErr
+						},
+					}
+				},
+				log:          logging.NewNopLogger(),
+				record:       event.NewNopRecorder(),
+				pollInterval: time.Minute,
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "gi"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile: error: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Reconcile: result: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status, test.EquateConditions()); diff != "" {
+				t.Errorf("Reconcile: status: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(append([]string{}, tc.want.created...), created); diff != "" {
+				t.Errorf("Reconcile: created: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
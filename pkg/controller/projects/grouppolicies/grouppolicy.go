@@ -0,0 +1,291 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grouppolicies
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	groupsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+)
+
+const (
+	errGetPolicy         = "cannot get GroupPolicy"
+	errGroupIDMissing    = "GroupID or GroupIDRef is missing"
+	errGetGroup          = "cannot get referenced Group"
+	errGroupNotCreated   = "referenced Group is not created yet"
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errListProjects      = "cannot list projects of group"
+	errApply             = "cannot apply %s %s"
+	errListRendered      = "cannot list resources rendered from GroupPolicy"
+	errDeleteRemoved     = "cannot delete %s %s no longer rendered from GroupPolicy"
+	errUpdateStatus      = "cannot update GroupPolicy status"
+
+	reasonListFailed event.Reason = "ListProjectsFailed"
+)
+
+// SetupGroupPolicy adds a controller that renders GroupPolicies into the
+// protections of the projects of a group.
+func SetupGroupPolicy(mgr ctrl.Manager, o controller.Options) error {
+	name := "grouppolicy/" + v1alpha1.GroupPolicyGroupKind
+
+	r := &reconciler{
+		client:            mgr.GetClient(),
+		apply:             resource.NewAPIPatchingApplicator(mgr.GetClient()),
+		newGitlabClientFn: projects.NewProjectClient,
+		log:               o.Logger.WithValues("controller", name),
+		record:            event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		pollInterval:      o.PollInterval,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.GroupPolicy{}).
+		Owns(&v1alpha1.ProtectedBranchSet{}).
+		Owns(&v1alpha1.ApprovalRuleSet{}).
+		Owns(&v1alpha1.ApprovalSettings{}).
+		Complete(r)
+}
+
+// reconciler lists the projects of the group of a GroupPolicy, applies the
+// resources rendered for them and deletes the ones of projects that left the
+// group. New projects do not cause events, so the group is listed again
+// every poll interval. Deleting the policy deletes its resources by garbage
+// collection. The reconciler only reads from Gitlab, so it is exempt from the
+// protection, maintenance, dry run and audit wrappers of managed resource
+// controllers, which guard changes. The rendered resources are reconciled
+// through them.
+type reconciler struct {
+	client            client.Client
+	apply             resource.Applicator
+	newGitlabClientFn func(cfg clients.Config) projects.Client
+	log               logging.Logger
+	record            event.Recorder
+	pollInterval      time.Duration
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	gp := &v1alpha1.GroupPolicy{}
+	if err := r.client.Get(ctx, req.NamespacedName, gp); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPolicy)
+	}
+	if gp.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	prjs, err := r.listProjects(ctx, gp)
+	if err != nil {
+		log.Debug("Cannot list projects of group", "error", err)
+		r.record.Event(gp, event.Warning(reasonListFailed, err))
+		gp.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, gp), errUpdateStatus)
+	}
+
+	rendered := Render(gp, prjs)
+	if err := r.sync(ctx, gp, rendered); err != nil {
+		log.Debug("Cannot sync GroupPolicy", "error", err)
+		gp.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, gp), errUpdateStatus)
+	}
+
+	gp.Status.Projects = len(prjs)
+	gp.Status.Resources = make([]v1alpha1.BlueprintResource, 0, len(rendered))
+	ready := true
+	for _, mg := range rendered {
+		ok := mg.GetCondition(xpv1.TypeReady).Status == "True"
+		ready = ready && ok
+		gp.Status.Resources = append(gp.Status.Resources, v1alpha1.BlueprintResource{
+			Kind:  mg.GetObjectKind().GroupVersionKind().Kind,
+			Name:  mg.GetName(),
+			Ready: ok,
+		})
+	}
+	gp.SetConditions(xpv1.ReconcileSuccess(), xpv1.Creating())
+	if ready {
+		gp.SetConditions(xpv1.Available())
+	}
+	return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.client.Status().Update(ctx, gp), errUpdateStatus)
+}
+
+// listProjects resolves the group of gp and lists its projects.
+func (r *reconciler) listProjects(ctx context.Context, gp *v1alpha1.GroupPolicy) ([]*gitlab.Project, error) {
+	gid, err := r.groupID(ctx, gp)
+	if err != nil {
+		return nil, err
+	}
+	gp.Status.GroupID = &gid
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: gp.Spec.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	cfg, err := clients.ConfigFromProviderConfig(ctx, r.client, pc)
+	if err != nil {
+		return nil, err
+	}
+	prjs, err := projects.ListAllGroupProjects(r.newGitlabClientFn(*cfg), gid, ptr.Deref(gp.Spec.IncludeSubgroups, false), gitlab.WithContext(ctx))
+	return prjs, errors.Wrap(err, errListProjects)
+}
+
+// groupID returns the ID of the group of gp, read from the external name of
+// the referenced Group if no ID is given.
+func (r *reconciler) groupID(ctx context.Context, gp *v1alpha1.GroupPolicy) (int, error) {
+	if gp.Spec.GroupID != nil {
+		return *gp.Spec.GroupID, nil
+	}
+	if gp.Spec.GroupIDRef == nil {
+		return 0, errors.New(errGroupIDMissing)
+	}
+	g := &groupsv1alpha1.Group{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: gp.Spec.GroupIDRef.Name}, g); err != nil {
+		return 0, errors.Wrap(err, errGetGroup)
+	}
+	gid, err := strconv.Atoi(meta.GetExternalName(g))
+	if err != nil {
+		return 0, errors.New(errGroupNotCreated)
+	}
+	return gid, nil
+}
+
+// sync applies the rendered resources and deletes the resources of gp that
+// are no longer rendered.
+func (r *reconciler) sync(ctx context.Context, gp *v1alpha1.GroupPolicy, rendered []resource.Managed) error {
+	keep := map[string]bool{}
+	for _, mg := range rendered {
+		kind := mg.GetObjectKind().GroupVersionKind().Kind
+		keep[kind+"/"+mg.GetName()] = true
+		if err := r.apply.Apply(ctx, mg, resource.MustBeControllableBy(gp.GetUID())); err != nil {
+			return errors.Wrapf(err, errApply, kind, mg.GetName())
+		}
+		// The applied object is decoded without its type meta.
+		mg.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(kind))
+	}
+
+	for kind, l := range map[string]client.ObjectList{
+		v1alpha1.ProtectedBranchSetKind: &v1alpha1.ProtectedBranchSetList{},
+		v1alpha1.ApprovalRuleSetKind:    &v1alpha1.ApprovalRuleSetList{},
+		v1alpha1.ApprovalSettingsKind:   &v1alpha1.ApprovalSettingsList{},
+	} {
+		if err := r.client.List(ctx, l, client.MatchingLabels{v1alpha1.LabelGroupPolicy: gp.GetName()}); err != nil {
+			return errors.Wrap(err, errListRendered)
+		}
+		for _, o := range listItems(l) {
+			if keep[kind+"/"+o.GetName()] || !metav1.IsControlledBy(o, gp) {
+				continue
+			}
+			if err := r.client.Delete(ctx, o); resource.IgnoreNotFound(err) != nil {
+				return errors.Wrapf(err, errDeleteRemoved, kind, o.GetName())
+			}
+		}
+	}
+	return nil
+}
+
+// listItems returns the items of a list of resources a policy renders.
+func listItems(l client.ObjectList) []client.Object {
+	var out []client.Object
+	switch l := l.(type) {
+	case *v1alpha1.ProtectedBranchSetList:
+		for i := range l.Items {
+			out = append(out, &l.Items[i])
+		}
+	case *v1alpha1.ApprovalRuleSetList:
+		for i := range l.Items {
+			out = append(out, &l.Items[i])
+		}
+	case *v1alpha1.ApprovalSettingsList:
+		for i := range l.Items {
+			out = append(out, &l.Items[i])
+		}
+	}
+	return out
+}
+
+// Render returns a ProtectedBranchSet, an ApprovalRuleSet and an
+// ApprovalSettings for each of the projects, named after gp and the project
+// ID. Resources the policy leaves empty are not rendered.
+func Render(gp *v1alpha1.GroupPolicy, prjs []*gitlab.Project) []resource.Managed {
+	rs := xpv1.ResourceSpec{ProviderConfigReference: gp.Spec.ProviderConfigReference}
+
+	out := []resource.Managed{}
+	for _, p := range prjs {
+		pid := strconv.Itoa(p.ID)
+		if len(gp.Spec.Protections) > 0 {
+			o := &v1alpha1.ProtectedBranchSet{Spec: v1alpha1.ProtectedBranchSetSpec{ResourceSpec: rs, ForProvider: v1alpha1.ProtectedBranchSetParameters{
+				ProjectID:   &pid,
+				Protections: append([]v1alpha1.BranchProtection{}, gp.Spec.Protections...),
+				Prune:       gp.Spec.Prune,
+			}}}
+			setMeta(o, gp, v1alpha1.ProtectedBranchSetKind, gp.GetName()+"-"+pid+"-branches")
+			out = append(out, o)
+		}
+		if len(gp.Spec.ApprovalRules) > 0 {
+			o := &v1alpha1.ApprovalRuleSet{Spec: v1alpha1.ApprovalRuleSetSpec{ResourceSpec: rs, ForProvider: v1alpha1.ApprovalRuleSetParameters{
+				ProjectID: &pid,
+				Rules:     append([]v1alpha1.ApprovalRule{}, gp.Spec.ApprovalRules...),
+				Prune:     gp.Spec.Prune,
+			}}}
+			setMeta(o, gp, v1alpha1.ApprovalRuleSetKind, gp.GetName()+"-"+pid+"-approvals")
+			out = append(out, o)
+		}
+		if as := gp.Spec.ApprovalSettings; as != nil {
+			o := &v1alpha1.ApprovalSettings{Spec: v1alpha1.ApprovalSettingsSpec{ResourceSpec: rs, ForProvider: v1alpha1.ApprovalSettingsParameters{
+				ProjectID:            &pid,
+				ResetApprovalsOnPush: as.ResetApprovalsOnPush,
+				DisableOverridingApproversPerMergeRequest: as.DisableOverridingApproversPerMergeRequest,
+				MergeRequestsAuthorApproval:               as.MergeRequestsAuthorApproval,
+				RequirePasswordToApprove:                  as.RequirePasswordToApprove,
+				SelectiveCodeOwnerRemovals:                as.SelectiveCodeOwnerRemovals,
+			}}}
+			setMeta(o, gp, v1alpha1.ApprovalSettingsKind, gp.GetName()+"-"+pid+"-approval-settings")
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// setMeta names mg and marks it as controlled by gp.
+func setMeta(mg resource.Managed, gp *v1alpha1.GroupPolicy, kind, name string) {
+	mg.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(kind))
+	mg.SetName(name)
+	mg.SetLabels(map[string]string{v1alpha1.LabelGroupPolicy: gp.GetName()})
+	meta.AddOwnerReference(mg, meta.AsController(meta.TypedReferenceTo(gp, v1alpha1.GroupPolicyGroupVersionKind)))
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grouppolicies
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	groupsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+func policy() *v1alpha1.GroupPolicy {
+	return &v1alpha1.GroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gp", UID: types.UID("uid")},
+		Spec: v1alpha1.GroupPolicySpec{
+			ProviderConfigReference: &xpv1.Reference{Name: "default"},
+			GroupIDRef:              &xpv1.Reference{Name: "team"},
+			Protections:             []v1alpha1.BranchProtection{{Name: "main"}},
+			ApprovalRules:           []v1alpha1.ApprovalRule{{Name: "security", ApprovalsRequired: 2}},
+			Prune:                   ptr.To(true),
+		},
+	}
+}
+
+func TestRender(t *testing.T) {
+	got := Render(policy(), []*gitlab.Project{{ID: 1}, {ID: 2}})
+
+	names := []string{}
+	for _, mg := range got {
+		names = append(names, mg.GetObjectKind().GroupVersionKind().Kind+"/"+mg.GetName())
+		if diff := cmp.Diff("gp", mg.GetLabels()[v1alpha1.LabelGroupPolicy]); diff != "" {
+			t.Errorf("Render %s: label: -want, +got:\n%s", mg.GetName(), diff)
+		}
+		if diff := cmp.Diff(types.UID("uid"), metav1.GetControllerOf(mg).UID); diff != "" {
+			t.Errorf("Render %s: controller: -want, +got:\n%s", mg.GetName(), diff)
+		}
+	}
+	want := []string{
+		"ProtectedBranchSet/gp-1-branches", "ApprovalRuleSet/gp-1-approvals",
+		"ProtectedBranchSet/gp-2-branches", "ApprovalRuleSet/gp-2-approvals",
+	}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Errorf("Render: names: -want, +got:\n%s", diff)
+	}
+
+	wantParams := v1alpha1.ProtectedBranchSetParameters{
+		ProjectID:   ptr.To("2"),
+		Protections: []v1alpha1.BranchProtection{{Name: "main"}},
+		Prune:       ptr.To(true),
+	}
+	if diff := cmp.Diff(wantParams, got[2].(*v1alpha1.ProtectedBranchSet).Spec.ForProvider); diff != "" {
+		t.Errorf("Render: protected branches: -want, +got:\n%s", diff)
+	}
+
+	gp := policy()
+	gp.Spec.ApprovalRules = nil
+	if diff := cmp.Diff(2, len(Render(gp, []*gitlab.Project{{ID: 1}, {ID: 2}}))); diff != "" {
+		t.Errorf("Render: without approval rules: -want, +got:\n%s", diff)
+	}
+
+	gp = policy()
+	gp.Spec.ApprovalSettings = &v1alpha1.PolicyApprovalSettings{ResetApprovalsOnPush: ptr.To(true)}
+	got = Render(gp, []*gitlab.Project{{ID: 1}})
+	if diff := cmp.Diff(3, len(got)); diff != "" {
+		t.Fatalf("Render: with approval settings: -want, +got:\n%s", diff)
+	}
+	as, ok := got[2].(*v1alpha1.ApprovalSettings)
+	if !ok {
+		t.Fatalf("Render: want ApprovalSettings, got %T", got[2])
+	}
+	if diff := cmp.Diff("gp-1-approval-settings", as.GetName()); diff != "" {
+		t.Errorf("Render: approval settings name: -want, +got:\n%s", diff)
+	}
+	wantSettings := v1alpha1.ApprovalSettingsParameters{
+		ProjectID:            ptr.To("1"),
+		ResetApprovalsOnPush: ptr.To(true),
+	}
+	if diff := cmp.Diff(wantSettings, as.Spec.ForProvider); diff != "" {
+		t.Errorf("Render: approval settings: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		result  reconcile.Result
+		err     error
+		status  *v1alpha1.GroupPolicyStatus
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		groupName string
+		projects  []*gitlab.Project
+		listErr   error
+		apply     resource.ApplyFn
+		list      test.MockListFn
+		want      want
+	}{
+		"ProjectLeftGroup": {
+			groupName: "7",
+			projects:  []*gitlab.Project{{ID: 1}, {ID: 2, MarkedForDeletionAt: &gitlab.ISOTime{}}},
+			apply: func(_ context.Context, o client.Object, _ ...resource.ApplyOption) error {
+				if _, ok := o.(*v1alpha1.ProtectedBranchSet); ok {
+					o.(resource.Managed).SetConditions(xpv1.Available())
+				}
+				return nil
+			},
+			list: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+				if l, ok := obj.(*v1alpha1.ApprovalRuleSetList); ok {
+					left := v1alpha1.ApprovalRuleSet{ObjectMeta: metav1.ObjectMeta{Name: "gp-3-approvals"}}
+					left.SetOwnerReferences([]metav1.OwnerReference{{UID: "uid", Controller: ptr.To(true)}})
+					foreign := v1alpha1.ApprovalRuleSet{ObjectMeta: metav1.ObjectMeta{Name: "gp-4-approvals"}}
+					l.Items = []v1alpha1.ApprovalRuleSet{left, foreign}
+				}
+				return nil
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				status: func() *v1alpha1.GroupPolicyStatus {
+					s := &v1alpha1.GroupPolicyStatus{
+						GroupID:  ptr.To(7),
+						Projects: 1,
+						Resources: []v1alpha1.BlueprintResource{
+							{Kind: "ProtectedBranchSet", Name: "gp-1-branches", Ready: true},
+							{Kind: "ApprovalRuleSet", Name: "gp-1-approvals"},
+						},
+					}
+					s.SetConditions(xpv1.ReconcileSuccess(), xpv1.Creating())
+					return s
+				}(),
+				deleted: []string{"gp-3-approvals"},
+			},
+		},
+		"GroupNotCreated": {
+			want: want{
+				status: func() *v1alpha1.GroupPolicyStatus {
+					s := &v1alpha1.GroupPolicyStatus{}
+					s.SetConditions(xpv1.ReconcileError(errors.New(errGroupNotCreated)))
+					return s
+				}(),
+			},
+		},
+		"ListFailed": {
+			groupName: "7",
+			listErr:   errBoom,
+			want: want{
+				status: func() *v1alpha1.GroupPolicyStatus {
+					s := &v1alpha1.GroupPolicyStatus{GroupID: ptr.To(7)}
+					s.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errListProjects)))
+					return s
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var status *v1alpha1.GroupPolicyStatus
+			deleted := []string{}
+			r := &reconciler{
+				client: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						switch o := obj.(type) {
+						case *v1alpha1.GroupPolicy:
+							*o = *policy()
+						case *groupsv1alpha1.Group:
+							meta.SetExternalName(o, tc.groupName)
+						case *v1beta1.ProviderConfig:
+							o.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+							o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{Key: "token"}
+						case *corev1.Secret:
+							o.Data = map[string][]byte{"token": []byte("t")}
+						}
+						return nil
+					},
+					MockList: tc.list,
+					MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+						deleted = append(deleted, obj.GetName())
+						return nil
+					},
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						status = &obj.(*v1alpha1.GroupPolicy).Status
+						return nil
+					},
+				},
+				apply: tc.apply,
+				newGitlabClientFn: func(_ clients.Config) projects.Client {
+					return &fake.MockClient{
+						MockListGroupProjects: func(_ interface{}, _ *gitlab.ListGroupProjectsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+							return tc.projects, &gitlab.Response{}, tc.listErr
+						},
+					}
+				},
+				log:          logging.NewNopLogger(),
+				record:       event.NewNopRecorder(),
+				pollInterval: time.Minute,
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "gp"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile: error: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Reconcile: result: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status, test.EquateConditions()); diff != "" {
+				t.Errorf("Reconcile: status: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(append([]string{}, tc.want.deleted...), deleted); diff != "" {
+				t.Errorf("Reconcile: deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,6 +24,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -37,21 +40,58 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretversion"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/sensitive"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
 	errNotHook          = "managed resource is not a Gitlab project hook custom resource"
-	errProjectIDMissing = "ProjectID is missing"
+	errProjectIDMissing = "ProjectID or GroupID is missing"
 	errGetFailed        = "cannot get Gitlab project hook"
 	errKubeUpdateFailed = "cannot update Gitlab project hook custom resource"
 	errCreateFailed     = "cannot create Gitlab project hook"
 	errUpdateFailed     = "cannot update Gitlab project hook"
 	errDeleteFailed     = "cannot delete Gitlab project hook"
+	errReEnableFailed   = "cannot re-enable Gitlab project hook"
+	errAdoptFailed      = "cannot look up existing Gitlab project hooks"
+	errGetTokenFailed   = "cannot get token of Gitlab project hook"
 )
 
+// hookTestTrigger is the event sent to re-enable a disabled hook.
+const hookTestTrigger = "push_events"
+
+// tokenField is the plaintext Token of a Hook, moved into a Secret if
+// secret migration is enabled.
+var tokenField = sensitive.Field{
+	Path: "spec.forProvider.token",
+	Key:  "token",
+	Get: func(mg resource.Managed) *string {
+		p := &mg.(*v1alpha1.Hook).Spec.ForProvider
+		if p.TokenSecretRef != nil {
+			return nil
+		}
+		return p.Token
+	},
+	Set: func(mg resource.Managed, ref *xpv1.SecretKeySelector) {
+		p := &mg.(*v1alpha1.Hook).Spec.ForProvider
+		p.Token = nil
+		p.TokenSecretRef = ref
+	},
+}
+
 // SetupHook adds a controller that reconciles Hooks.
 func SetupHook(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.HookKind)
@@ -62,8 +102,9 @@ func SetupHook(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewHookClient}),
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.HookGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.HookGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, secretversion.NewConnecter(mgr.GetClient(), &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewHookClient, newGroupClientFn: projects.NewGroupHookClient})))))))))),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -74,19 +115,31 @@ func SetupHook(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	if o.Features.Enabled(features.EnableSecretMigration) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithInitializers(sensitive.NewInitializer(mgr.GetClient(), v1alpha1.HookGroupVersionKind, tokenField)))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.HookGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.Hook{}).
-		Complete(r)
+		For(&v1alpha1.Hook{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Hook{}, &v1alpha1.HookList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg clients.Config) projects.HookClient
+	newGroupClientFn  func(cfg clients.Config) projects.HookClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -98,7 +151,21 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	if cr.Spec.ForProvider.GroupID != nil {
+		return &external{kube: c.kube, client: c.newGroupClientFn(*cfg)}, nil
+	}
+	cl := c.newGitlabClientFn(*cfg)
+	if cr.Spec.ForProvider.ProjectID == nil && cr.Spec.ForProvider.ProjectPath != nil {
+		if cr.Spec.ForProvider.ProjectID, err = projects.ResolveProjectID(ctx, cl, *cr.Spec.ForProvider.ProjectPath); err != nil {
+			return nil, err
+		}
+		// Persist the ID like a resolved reference, so the path is only
+		// resolved once.
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return nil, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+	return &external{kube: c.kube, client: cl}, nil
 }
 
 type external struct {
@@ -112,21 +179,40 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotHook)
 	}
 
-	if meta.GetExternalName(cr) == "" {
-		return managed.ExternalObservation{
-			ResourceExists: false,
-		}, nil
+	if projects.HookScopeID(&cr.Spec.ForProvider) == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	if !meta.WasDeleted(cr) {
+		waiting, err := projects.UnmetRequirement(ctx, e.kube, cr.Spec.Requires, cr.Spec.ForProvider.ProjectIDRef)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if waiting != "" {
+			// Report the resource as it is rather than creating or
+			// changing it before its Project is ready.
+			cr.Status.SetConditions(v1alpha1.WaitingForProject(waiting))
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
 	}
 
 	hookid, err := strconv.Atoi(meta.GetExternalName(cr))
+	adopted := false
 	if err != nil {
-		return managed.ExternalObservation{}, errors.New(errNotHook)
-	}
-	if cr.Spec.ForProvider.ProjectID == nil {
-		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+		// The hook is not known yet. Adopt an existing hook with the same
+		// URL instead of creating another one.
+		hook, err := projects.FindHookByURL(e.client, *projects.HookScopeID(&cr.Spec.ForProvider), ptr.Deref(cr.Spec.ForProvider.URL, ""), gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errAdoptFailed)
+		}
+		if hook == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		meta.SetExternalName(cr, strconv.Itoa(hook.ID))
+		hookid, adopted = hook.ID, true
 	}
 
-	projecthook, res, err := e.client.GetProjectHook(*cr.Spec.ForProvider.ProjectID, hookid)
+	projecthook, res, err := e.client.GetProjectHookStatus(*projects.HookScopeID(&cr.Spec.ForProvider), hookid, gitlab.WithContext(ctx))
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -135,15 +221,23 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	current := cr.Spec.ForProvider.DeepCopy()
-	projects.LateInitializeHook(&cr.Spec.ForProvider, projecthook)
+	projects.LateInitializeHook(&cr.Spec.ForProvider, &projecthook.ProjectHook)
 
-	cr.Status.AtProvider = projects.GenerateHookObservation(projecthook)
+	cr.Status.AtProvider = projects.GenerateHookStatusObservation(projecthook)
 	cr.Status.SetConditions(xpv1.Available())
 
+	disabled := projects.IsHookDisabled(projecthook.AlertStatus)
+	switch {
+	case disabled:
+		cr.Status.SetConditions(v1alpha1.HookDisabled(projecthook.AlertStatus))
+	case projecthook.AlertStatus != "":
+		cr.Status.SetConditions(v1alpha1.HookExecutable())
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        projects.IsHookUpToDate(&cr.Spec.ForProvider, projecthook),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceUpToDate:        projects.IsHookUpToDate(&cr.Spec.ForProvider, &projecthook.ProjectHook) && !(disabled && ptr.Deref(cr.Spec.ForProvider.AutoReEnable, false)),
+		ResourceLateInitialized: adopted || !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
 
@@ -153,8 +247,15 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotHook)
 	}
 
+	opt := projects.GenerateCreateHookOptions(&cr.Spec.ForProvider)
+	token, err := e.token(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	opt.Token = token
+
 	cr.Status.SetConditions(xpv1.Creating())
-	hook, _, err := e.client.AddProjectHook(*cr.Spec.ForProvider.ProjectID, projects.GenerateCreateHookOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	hook, _, err := e.client.AddProjectHook(*projects.HookScopeID(&cr.Spec.ForProvider), opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
@@ -172,15 +273,26 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.New(errNotHook)
 	}
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.HookScopeID(&cr.Spec.ForProvider) == nil {
 		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
 	}
 
-	_, _, err = e.client.EditProjectHook(*cr.Spec.ForProvider.ProjectID, hookid, projects.GenerateEditHookOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	opt := projects.GenerateEditHookOptions(&cr.Spec.ForProvider)
+	if opt.Token, err = e.token(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, _, err = e.client.EditProjectHook(*projects.HookScopeID(&cr.Spec.ForProvider), hookid, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 	}
 
+	if ptr.Deref(cr.Spec.ForProvider.AutoReEnable, false) && projects.IsHookDisabled(cr.Status.AtProvider.AlertStatus) {
+		if _, err := e.client.TestProjectHook(*projects.HookScopeID(&cr.Spec.ForProvider), hookid, hookTestTrigger, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errReEnableFailed)
+		}
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -192,10 +304,10 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.HookScopeID(&cr.Spec.ForProvider) == nil {
 		return errors.New(errProjectIDMissing)
 	}
-	_, err := e.client.DeleteProjectHook(*cr.Spec.ForProvider.ProjectID, cr.Status.AtProvider.ID, gitlab.WithContext(ctx))
+	_, err := e.client.DeleteProjectHook(*projects.HookScopeID(&cr.Spec.ForProvider), cr.Status.AtProvider.ID, gitlab.WithContext(ctx))
 	return errors.Wrap(err, errDeleteFailed)
 }
 
@@ -203,3 +315,17 @@ func (e *external) updateExternalName(ctx context.Context, cr *v1alpha1.Hook, pr
 	meta.SetExternalName(cr, strconv.Itoa(projecthook.ID))
 	return e.kube.Update(ctx, cr)
 }
+
+// token returns the token of cr, read from its TokenSecretRef if set.
+func (e *external) token(ctx context.Context, cr *v1alpha1.Hook) (*string, error) {
+	ref := cr.Spec.ForProvider.TokenSecretRef
+	if ref == nil {
+		return cr.Spec.ForProvider.Token, nil
+	}
+	secret := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, errors.Wrap(err, errGetTokenFailed)
+	}
+	token := string(secret.Data[ref.Key])
+	return &token, nil
+}
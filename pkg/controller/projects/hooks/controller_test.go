@@ -31,6 +31,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -87,10 +88,29 @@ func withProjectID(pid int) projectHookModifier {
 	}
 }
 
+func withAutoReEnable(b bool) projectHookModifier {
+	return func(r *v1alpha1.Hook) { r.Spec.ForProvider.AutoReEnable = &b }
+}
+
 func withStatus(s v1alpha1.HookObservation) projectHookModifier {
 	return func(r *v1alpha1.Hook) { r.Status.AtProvider = s }
 }
 
+func withURL(url string) projectHookModifier {
+	return func(r *v1alpha1.Hook) { r.Spec.ForProvider.URL = &url }
+}
+
+func withRequiredProject(name string) projectHookModifier {
+	return func(r *v1alpha1.Hook) {
+		r.Spec.Requires = []v1alpha1.Requirement{v1alpha1.RequireProjectReady}
+		r.Spec.ForProvider.ProjectIDRef = &xpv1.Reference{Name: name}
+	}
+}
+
+func withTokenSecretRef(ref *xpv1.SecretKeySelector) projectHookModifier {
+	return func(r *v1alpha1.Hook) { r.Spec.ForProvider.TokenSecretRef = ref }
+}
+
 func withExternalName(projectHookID int) projectHookModifier {
 	return func(r *v1alpha1.Hook) { meta.SetExternalName(r, fmt.Sprint(projectHookID)) }
 }
@@ -117,8 +137,8 @@ func TestObserve(t *testing.T) {
 		"SuccessfulAvailable": {
 			args: args{
 				projecthook: &fake.MockClient{
-					MockGetHook: func(pid interface{}, projectHookID int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
-						return &gitlab.ProjectHook{}, &gitlab.Response{}, nil
+					MockGetHookStatus: func(pid interface{}, projectHookID int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error) {
+						return &projects.ProjectHookStatus{}, &gitlab.Response{}, nil
 					},
 				},
 				cr: projecthook(
@@ -145,9 +165,11 @@ func TestObserve(t *testing.T) {
 		"NotUpToDate": {
 			args: args{
 				projecthook: &fake.MockClient{
-					MockGetHook: func(pid interface{}, projectHookID int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
-						return &gitlab.ProjectHook{
-							MergeRequestsEvents: true,
+					MockGetHookStatus: func(pid interface{}, projectHookID int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error) {
+						return &projects.ProjectHookStatus{
+							ProjectHook: gitlab.ProjectHook{
+								MergeRequestsEvents: true,
+							},
 						}, &gitlab.Response{}, nil
 					},
 				},
@@ -175,8 +197,8 @@ func TestObserve(t *testing.T) {
 		"LateInitSuccess": {
 			args: args{
 				projecthook: &fake.MockClient{
-					MockGetHook: func(pid interface{}, projectHookID int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
-						return &gitlab.ProjectHook{}, &gitlab.Response{}, nil
+					MockGetHookStatus: func(pid interface{}, projectHookID int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error) {
+						return &projects.ProjectHookStatus{}, &gitlab.Response{}, nil
 					},
 				},
 				cr: projecthook(
@@ -201,10 +223,162 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"DisabledHookDegraded": {
+			args: args{
+				projecthook: &fake.MockClient{
+					MockGetHookStatus: func(pid interface{}, projectHookID int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error) {
+						return &projects.ProjectHookStatus{AlertStatus: v1alpha1.HookAlertStatusDisabled}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withDefaultValues(),
+					withExternalName(projectHookID),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withDefaultValues(),
+					withExternalName(projectHookID),
+					withStatus(v1alpha1.HookObservation{AlertStatus: v1alpha1.HookAlertStatusDisabled}),
+					withConditions(xpv1.Available(), v1alpha1.HookDisabled(v1alpha1.HookAlertStatusDisabled)),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DisabledHookAutoReEnable": {
+			args: args{
+				projecthook: &fake.MockClient{
+					MockGetHookStatus: func(pid interface{}, projectHookID int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error) {
+						return &projects.ProjectHookStatus{AlertStatus: v1alpha1.HookAlertStatusTemporarilyDisabled}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withDefaultValues(),
+					withAutoReEnable(true),
+					withExternalName(projectHookID),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withDefaultValues(),
+					withAutoReEnable(true),
+					withExternalName(projectHookID),
+					withStatus(v1alpha1.HookObservation{AlertStatus: v1alpha1.HookAlertStatusTemporarilyDisabled}),
+					withConditions(xpv1.Available(), v1alpha1.HookDisabled(v1alpha1.HookAlertStatusTemporarilyDisabled)),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ExecutableHook": {
+			args: args{
+				projecthook: &fake.MockClient{
+					MockGetHookStatus: func(pid interface{}, projectHookID int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error) {
+						return &projects.ProjectHookStatus{AlertStatus: v1alpha1.HookAlertStatusExecutable}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withDefaultValues(),
+					withAutoReEnable(true),
+					withExternalName(projectHookID),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withDefaultValues(),
+					withAutoReEnable(true),
+					withExternalName(projectHookID),
+					withStatus(v1alpha1.HookObservation{AlertStatus: v1alpha1.HookAlertStatusExecutable}),
+					withConditions(xpv1.Available(), v1alpha1.HookExecutable()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AdoptByURL": {
+			args: args{
+				projecthook: &fake.MockClient{
+					MockListHooks: func(pid interface{}, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error) {
+						return []*gitlab.ProjectHook{{ID: 1, URL: "https://other.example.com"}, {ID: projectHookID, URL: "https://example.com"}}, &gitlab.Response{}, nil
+					},
+					MockGetHookStatus: func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error) {
+						if hook != projectHookID {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+						}
+						return &projects.ProjectHookStatus{ProjectHook: gitlab.ProjectHook{ID: projectHookID, URL: "https://example.com"}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withDefaultValues(),
+					withURL("https://example.com"),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withDefaultValues(),
+					withURL("https://example.com"),
+					withExternalName(projectHookID),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NothingToAdopt": {
+			args: args{
+				projecthook: &fake.MockClient{
+					MockListHooks: func(pid interface{}, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error) {
+						return []*gitlab.ProjectHook{{ID: 1, URL: "https://other.example.com"}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withProjectID(projectID),
+					withURL("https://example.com"),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withProjectID(projectID),
+					withURL("https://example.com"),
+				),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"FailedAdopt": {
+			args: args{
+				projecthook: &fake.MockClient{
+					MockListHooks: func(pid interface{}, opt *gitlab.ListProjectHooksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: projecthook(
+					withProjectID(projectID),
+					withURL("https://example.com"),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withProjectID(projectID),
+					withURL("https://example.com"),
+				),
+				err: errors.Wrap(errBoom, errAdoptFailed),
+			},
+		},
 		"ErrGet404": {
 			args: args{
 				projecthook: &fake.MockClient{
-					MockGetHook: func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+					MockGetHookStatus: func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*projects.ProjectHookStatus, *gitlab.Response, error) {
 						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
 					},
 				},
@@ -222,6 +396,33 @@ func TestObserve(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"WaitingForProjectImport": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						p := obj.(*v1alpha1.Project)
+						p.SetConditions(xpv1.Available())
+						p.Status.AtProvider.ImportStatus = "started"
+						return nil
+					},
+				},
+				cr: projecthook(
+					withDefaultValues(),
+					withRequiredProject("example"),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withDefaultValues(),
+					withRequiredProject("example"),
+					withConditions(v1alpha1.WaitingForProject("Project example import is started")),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -276,6 +477,54 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"TokenFromSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte("s3cr3t")}
+						return nil
+					},
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				projecthook: &fake.MockClient{
+					MockAddHook: func(pid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+						if *opt.Token != "s3cr3t" {
+							return nil, nil, errBoom
+						}
+						return &gitlab.ProjectHook{ID: projectHookID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projecthook(
+					withDefaultValues(),
+					withTokenSecretRef(&xpv1.SecretKeySelector{Key: "token"}),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withDefaultValues(),
+					withTokenSecretRef(&xpv1.SecretKeySelector{Key: "token"}),
+					withConditions(xpv1.Creating()),
+					withExternalName(projectHookID),
+				),
+				result: managed.ExternalCreation{},
+			},
+		},
+		"TokenSecretMissing": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr: projecthook(
+					withDefaultValues(),
+					withTokenSecretRef(&xpv1.SecretKeySelector{Key: "token"}),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withDefaultValues(),
+					withTokenSecretRef(&xpv1.SecretKeySelector{Key: "token"}),
+				),
+				err: errors.Wrap(errBoom, errGetTokenFailed),
+			},
+		},
 		"FailedCreation": {
 			args: args{
 				projecthook: &fake.MockClient{
@@ -368,6 +617,33 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errUpdateFailed),
 			},
 		},
+		"ReEnableDisabledHook": {
+			args: args{
+				projecthook: &fake.MockClient{
+					MockEditHook: func(pid interface{}, hook int, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
+						return &gitlab.ProjectHook{}, &gitlab.Response{}, nil
+					},
+					MockTestHook: func(pid interface{}, hook int, trigger string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: projecthook(
+					withExternalName(projectHookID),
+					withProjectID(projectID),
+					withAutoReEnable(true),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID, AlertStatus: v1alpha1.HookAlertStatusDisabled}),
+				),
+			},
+			want: want{
+				cr: projecthook(
+					withExternalName(projectHookID),
+					withProjectID(projectID),
+					withAutoReEnable(true),
+					withStatus(v1alpha1.HookObservation{ID: projectHookID, AlertStatus: v1alpha1.HookAlertStatusDisabled}),
+				),
+				err: errors.Wrap(errBoom, errReEnableFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,355 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuelinks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom        = errors.New("boom")
+	projectID      = "1234"
+	issueIID       = 1
+	targetIssueID  = 42
+	targetIssueIID = 2
+	linkID         = 7
+	linkType       = "blocks"
+	webURL         = "https://gitlab.example.com/group/project/-/issues/2"
+)
+
+type args struct {
+	link projects.IssueLinkClient
+	kube client.Client
+	cr   *v1alpha1.IssueLink
+}
+
+type issueLinkModifier func(*v1alpha1.IssueLink)
+
+func withConditions(c ...xpv1.Condition) issueLinkModifier {
+	return func(r *v1alpha1.IssueLink) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() issueLinkModifier {
+	return func(r *v1alpha1.IssueLink) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withIssues() issueLinkModifier {
+	return func(r *v1alpha1.IssueLink) {
+		r.Spec.ForProvider.IssueIID = issueIID
+		r.Spec.ForProvider.TargetIssueIID = targetIssueIID
+		r.Spec.ForProvider.LinkType = &linkType
+	}
+}
+
+func withExternalName(n string) issueLinkModifier {
+	return func(r *v1alpha1.IssueLink) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.IssueLinkObservation) issueLinkModifier {
+	return func(r *v1alpha1.IssueLink) { r.Status.AtProvider = s }
+}
+
+func issueLink(m ...issueLinkModifier) *v1alpha1.IssueLink {
+	cr := &v1alpha1.IssueLink{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func relations(_ interface{}, _ int, _ ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error) {
+	return []*gitlab.IssueRelation{
+		{ID: 99, IssueLinkID: 3, LinkType: "relates_to"},
+		{ID: targetIssueID, IssueLinkID: linkID, LinkType: linkType, WebURL: webURL},
+	}, &gitlab.Response{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.IssueLink
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: issueLink(withProjectID()),
+			},
+			want: want{
+				cr: issueLink(withProjectID()),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: issueLink(withProjectID(), withExternalName("fr")),
+			},
+			want: want{
+				cr:  issueLink(withProjectID(), withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: issueLink(withExternalName("7")),
+			},
+			want: want{
+				cr:  issueLink(withExternalName("7")),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedListRelations": {
+			args: args{
+				link: &fake.MockClient{
+					MockListIssueRelations: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}}, errBoom
+					},
+				},
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("7")),
+			},
+			want: want{
+				cr:  issueLink(withProjectID(), withIssues(), withExternalName("7")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"IssueNotFound": {
+			args: args{
+				link: &fake.MockClient{
+					MockListIssueRelations: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("7")),
+			},
+			want: want{
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("7")),
+			},
+		},
+		"LinkNotFound": {
+			args: args{
+				link: &fake.MockClient{MockListIssueRelations: relations},
+				cr:   issueLink(withProjectID(), withIssues(), withExternalName("8")),
+			},
+			want: want{
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("8")),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				link: &fake.MockClient{MockListIssueRelations: relations},
+				cr:   issueLink(withProjectID(), withIssues(), withExternalName("7")),
+			},
+			want: want{
+				cr: issueLink(
+					withProjectID(),
+					withIssues(),
+					withExternalName("7"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.IssueLinkObservation{ID: linkID, LinkType: linkType, TargetIssueWebURL: webURL}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.link}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	created := func(pid interface{}, issue int, opt *gitlab.CreateIssueLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+		return &gitlab.IssueLink{TargetIssue: &gitlab.Issue{ID: targetIssueID, IID: targetIssueIID}}, &gitlab.Response{}, nil
+	}
+
+	type want struct {
+		cr  *v1alpha1.IssueLink
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ProjectIDMissing": {
+			args: args{
+				cr: issueLink(withIssues()),
+			},
+			want: want{
+				cr:  issueLink(withIssues()),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				link: &fake.MockClient{
+					MockCreateIssueLink:    created,
+					MockListIssueRelations: relations,
+				},
+				cr: issueLink(withProjectID(), withIssues()),
+			},
+			want: want{
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("7"), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				link: &fake.MockClient{
+					MockCreateIssueLink: func(pid interface{}, issue int, opt *gitlab.CreateIssueLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: issueLink(withProjectID(), withIssues()),
+			},
+			want: want{
+				cr:  issueLink(withProjectID(), withIssues(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"CreatedLinkNotFound": {
+			args: args{
+				link: &fake.MockClient{
+					MockCreateIssueLink: created,
+					MockListIssueRelations: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) ([]*gitlab.IssueRelation, *gitlab.Response, error) {
+						return []*gitlab.IssueRelation{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: issueLink(withProjectID(), withIssues()),
+			},
+			want: want{
+				cr:  issueLink(withProjectID(), withIssues(), withConditions(xpv1.Creating())),
+				err: errors.New(errLinkNotFound),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.link}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.IssueLink
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				link: &fake.MockClient{
+					MockDeleteIssueLink: func(pid interface{}, issue, issueLink int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+						return &gitlab.IssueLink{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("7")),
+			},
+			want: want{
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("7"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				link: &fake.MockClient{
+					MockDeleteIssueLink: func(pid interface{}, issue, issueLink int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("7")),
+			},
+			want: want{
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("7"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				link: &fake.MockClient{
+					MockDeleteIssueLink: func(pid interface{}, issue, issueLink int, options ...gitlab.RequestOptionFunc) (*gitlab.IssueLink, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}}, errBoom
+					},
+				},
+				cr: issueLink(withProjectID(), withIssues(), withExternalName("7")),
+			},
+			want: want{
+				cr:  issueLink(withProjectID(), withIssues(), withExternalName("7"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.link}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
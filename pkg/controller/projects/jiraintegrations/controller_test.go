@@ -0,0 +1,356 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jiraintegrations
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	jiraURL   = "https://jira.example.com"
+)
+
+type integrationModifier func(*v1alpha1.JiraIntegration)
+
+func withConditions(c ...xpv1.Condition) integrationModifier {
+	return func(r *v1alpha1.JiraIntegration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() integrationModifier {
+	return func(r *v1alpha1.JiraIntegration) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withExternalName(n string) integrationModifier {
+	return func(r *v1alpha1.JiraIntegration) { meta.SetExternalName(r, n) }
+}
+
+func withCommitEvents(b bool) integrationModifier {
+	return func(r *v1alpha1.JiraIntegration) { r.Spec.ForProvider.CommitEvents = &b }
+}
+
+func withUsernameSecretRef() integrationModifier {
+	return func(r *v1alpha1.JiraIntegration) {
+		r.Spec.ForProvider.UsernameSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "jira", Namespace: "default"},
+			Key:             "username",
+		}
+	}
+}
+
+func withLateInitialized() integrationModifier {
+	return func(r *v1alpha1.JiraIntegration) {
+		p := &r.Spec.ForProvider
+		p.ProjectKey = ptr.To("PRJ")
+		p.MergeRequestsEvents = ptr.To(true)
+		p.CommentOnEventEnabled = ptr.To(false)
+	}
+}
+
+func withStatus(s v1alpha1.JiraIntegrationObservation) integrationModifier {
+	return func(r *v1alpha1.JiraIntegration) { r.Status.AtProvider = s }
+}
+
+func integration(m ...integrationModifier) *v1alpha1.JiraIntegration {
+	cr := &v1alpha1.JiraIntegration{}
+	cr.Spec.ForProvider.URL = jiraURL
+	cr.Spec.ForProvider.PasswordSecretRef = xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "jira", Namespace: "default"},
+		Key:             "token",
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func get(active bool) func(interface{}, ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error) {
+	return func(_ interface{}, _ ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error) {
+		return &gitlab.JiraService{
+			Service: gitlab.Service{
+				Active:              active,
+				CommitEvents:        true,
+				MergeRequestsEvents: true,
+			},
+			Properties: &gitlab.JiraServiceProperties{
+				URL:        jiraURL,
+				ProjectKey: "PRJ",
+			},
+		}, &gitlab.Response{}, nil
+	}
+}
+
+func secrets() client.Client {
+	return &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{"username": []byte("bot"), "token": []byte("s3cr3t")}
+		return nil
+	})}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.JiraIntegration
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.JiraIntegration
+		want
+	}{
+		"NoExternalName": {
+			cr: integration(withProjectID()),
+			want: want{
+				cr: integration(withProjectID()),
+			},
+		},
+		"ProjectIDMissing": {
+			cr: integration(withExternalName(projectID)),
+			want: want{
+				cr:  integration(withExternalName(projectID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetJiraService: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+				},
+			},
+			cr: integration(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr: integration(withProjectID(), withExternalName(projectID)),
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockGetJiraService: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.JiraService, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: integration(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr:  integration(withProjectID(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"Inactive": {
+			client: &fake.MockClient{MockGetJiraService: get(false)},
+			cr:     integration(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr: integration(withProjectID(), withExternalName(projectID)),
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockGetJiraService: get(true)},
+			cr:     integration(withProjectID(), withExternalName(projectID), withCommitEvents(true)),
+			want: want{
+				cr: integration(
+					withProjectID(),
+					withExternalName(projectID),
+					withCommitEvents(true),
+					withLateInitialized(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.JiraIntegrationObservation{Active: true}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			client: &fake.MockClient{MockGetJiraService: get(true)},
+			cr:     integration(withProjectID(), withExternalName(projectID), withCommitEvents(false), withLateInitialized()),
+			want: want{
+				cr: integration(
+					withProjectID(),
+					withExternalName(projectID),
+					withCommitEvents(false),
+					withLateInitialized(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.JiraIntegrationObservation{Active: true}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.JiraIntegration
+		opt *gitlab.SetJiraServiceOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		err  error
+		cr   *v1alpha1.JiraIntegration
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: integration(),
+			want: want{
+				cr:  integration(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			kube: secrets(),
+			cr:   integration(withProjectID(), withUsernameSecretRef(), withCommitEvents(true)),
+			want: want{
+				cr: integration(
+					withProjectID(),
+					withUsernameSecretRef(),
+					withCommitEvents(true),
+					withExternalName(projectID),
+					withConditions(xpv1.Creating()),
+				),
+				opt: &gitlab.SetJiraServiceOptions{
+					URL:          &jiraURL,
+					Username:     ptr.To("bot"),
+					Password:     ptr.To("s3cr3t"),
+					CommitEvents: ptr.To(true),
+				},
+			},
+		},
+		"PasswordMissing": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			cr:   integration(withProjectID()),
+			want: want{
+				cr:  integration(withProjectID(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errPasswordMissing),
+			},
+		},
+		"FailedCreation": {
+			kube: secrets(),
+			err:  errBoom,
+			cr:   integration(withProjectID()),
+			want: want{
+				cr:  integration(withProjectID(), withConditions(xpv1.Creating())),
+				opt: &gitlab.SetJiraServiceOptions{URL: &jiraURL, Password: ptr.To("s3cr3t")},
+				err: errors.Wrap(errBoom, errSetFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opt *gitlab.SetJiraServiceOptions
+			client := &fake.MockClient{
+				MockSetJiraService: func(pid interface{}, o *gitlab.SetJiraServiceOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					opt = o
+					return &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{kube: tc.kube, client: client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.opt, opt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		res  *gitlab.Response
+		err  error
+		want error
+	}{
+		"SuccessfulDeletion": {
+			res: &gitlab.Response{},
+		},
+		"NotFound": {
+			res: &gitlab.Response{Response: &http.Response{StatusCode: 404}},
+			err: errBoom,
+		},
+		"FailedDeletion": {
+			res:  &gitlab.Response{},
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &fake.MockClient{
+				MockDeleteJiraService: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					return tc.res, tc.err
+				},
+			}
+			e := &external{client: client}
+			cr := integration(withProjectID(), withExternalName(projectID))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(integration(withProjectID(), withExternalName(projectID), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobtokenscopes
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotJobTokenScope = "managed resource is not a Gitlab job token scope custom resource"
+	errProjectIDMissing = "ProjectID is missing"
+	errGetFailed        = "cannot get Gitlab project job token access settings"
+	errPatchFailed      = "cannot change Gitlab project job token access settings"
+	errListFailed       = "cannot list Gitlab project job token allowlist"
+	errAddProjectFailed = "cannot add project %d to Gitlab project job token allowlist"
+	errRemoveProject    = "cannot remove project %d from Gitlab project job token allowlist"
+	errAddGroupFailed   = "cannot add group %d to Gitlab project job token allowlist"
+	errRemoveGroup      = "cannot remove group %d from Gitlab project job token allowlist"
+)
+
+// SetupJobTokenScope adds a controller that reconciles JobTokenScopes.
+func SetupJobTokenScope(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.JobTokenScopeKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.JobTokenScopeGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.JobTokenScopeGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewJobTokenScopeClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.JobTokenScopeGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.JobTokenScope{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.JobTokenScope{}, &v1alpha1.JobTokenScopeList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.JobTokenScopeClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.JobTokenScope)
+	if !ok {
+		return nil, errors.New(errNotJobTokenScope)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.JobTokenScopeClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.JobTokenScope)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotJobTokenScope)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+	pid := *cr.Spec.ForProvider.ProjectID
+
+	s, _, err := e.client.GetJobTokenAccessSettings(pid, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+	l, err := projects.GetJobTokenAllowlist(e.client, pid, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListFailed)
+	}
+	d, err := projects.DiffJobTokenScope(&cr.Spec.ForProvider, l)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeJobTokenScope(&cr.Spec.ForProvider, s)
+
+	cr.Status.AtProvider = projects.GenerateJobTokenScopeObservation(s, l)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsJobTokenScopeEnabledUpToDate(&cr.Spec.ForProvider, s) && d.IsEmpty(),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.JobTokenScope)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotJobTokenScope)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.ProjectID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.JobTokenScope)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotJobTokenScope)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.JobTokenScope)
+	if !ok {
+		return errors.New(errNotJobTokenScope)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+	pid := *cr.Spec.ForProvider.ProjectID
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// Every project has a job token scope, so only the listed entries are
+	// removed and the scope is left enabled as it is.
+	projectIDs, groupIDs, err := projects.AllowedIDs(&cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	for _, id := range projectIDs {
+		if res, err := e.client.RemoveProjectFromJobTokenInboundAllowList(pid, id, gitlab.WithContext(ctx)); err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errRemoveProject, id)
+		}
+	}
+	for _, id := range groupIDs {
+		if res, err := e.client.RemoveGroupFromJobTokenAllowlist(pid, id, gitlab.WithContext(ctx)); err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errRemoveGroup, id)
+		}
+	}
+	return nil
+}
+
+// apply adds and removes allowlist entries until they match the spec, then
+// enables or disables the scope. Entries are added before the scope is
+// enabled, so listed projects and groups keep their access throughout.
+func (e *external) apply(ctx context.Context, cr *v1alpha1.JobTokenScope) error {
+	pid := *cr.Spec.ForProvider.ProjectID
+
+	l, err := projects.GetJobTokenAllowlist(e.client, pid, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errListFailed)
+	}
+	d, err := projects.DiffJobTokenScope(&cr.Spec.ForProvider, l)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range d.AddProjects {
+		if _, err := e.client.AddProjectToJobTokenInboundAllowList(pid, id, gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errAddProjectFailed, id)
+		}
+	}
+	for _, id := range d.AddGroups {
+		if _, err := e.client.AddGroupToJobTokenAllowlist(pid, id, gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errAddGroupFailed, id)
+		}
+	}
+	for _, id := range d.RemoveProjects {
+		if res, err := e.client.RemoveProjectFromJobTokenInboundAllowList(pid, id, gitlab.WithContext(ctx)); err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errRemoveProject, id)
+		}
+	}
+	for _, id := range d.RemoveGroups {
+		if res, err := e.client.RemoveGroupFromJobTokenAllowlist(pid, id, gitlab.WithContext(ctx)); err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errRemoveGroup, id)
+		}
+	}
+
+	if cr.Spec.ForProvider.Enabled == nil {
+		return nil
+	}
+	_, err = e.client.PatchJobTokenAccessSettings(pid, &projects.PatchJobTokenAccessSettingsOptions{Enabled: *cr.Spec.ForProvider.Enabled}, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errPatchFailed)
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobtokenscopes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+)
+
+type scopeModifier func(*v1alpha1.JobTokenScope)
+
+func withConditions(c ...xpv1.Condition) scopeModifier {
+	return func(r *v1alpha1.JobTokenScope) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() scopeModifier {
+	return func(r *v1alpha1.JobTokenScope) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withExternalName(n string) scopeModifier {
+	return func(r *v1alpha1.JobTokenScope) { meta.SetExternalName(r, n) }
+}
+
+func withEnabled(b bool) scopeModifier {
+	return func(r *v1alpha1.JobTokenScope) { r.Spec.ForProvider.Enabled = &b }
+}
+
+func withAllowedProjectIDs(ids ...string) scopeModifier {
+	return func(r *v1alpha1.JobTokenScope) { r.Spec.ForProvider.AllowedProjectIDs = ids }
+}
+
+func withAllowedGroupIDs(ids ...string) scopeModifier {
+	return func(r *v1alpha1.JobTokenScope) { r.Spec.ForProvider.AllowedGroupIDs = ids }
+}
+
+func withStatus(s v1alpha1.JobTokenScopeObservation) scopeModifier {
+	return func(r *v1alpha1.JobTokenScope) { r.Status.AtProvider = s }
+}
+
+func scope(m ...scopeModifier) *v1alpha1.JobTokenScope {
+	cr := &v1alpha1.JobTokenScope{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// allowlist returns a client whose project is enabled and allows itself,
+// project 10 and group 20.
+func allowlist() *fake.MockClient {
+	return &fake.MockClient{
+		MockGetJobTokenAccessSettings: func(_ interface{}, _ ...gitlab.RequestOptionFunc) (*projects.JobTokenAccessSettings, *gitlab.Response, error) {
+			return &projects.JobTokenAccessSettings{InboundEnabled: true}, &gitlab.Response{}, nil
+		},
+		MockListJobTokenInboundAllowList: func(_ interface{}, _ *gitlab.ListOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+			return []*gitlab.Project{{ID: 1234}, {ID: 10}}, &gitlab.Response{}, nil
+		},
+		MockListJobTokenAllowlistGroups: func(_ interface{}, _ *gitlab.ListOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Group, *gitlab.Response, error) {
+			return []*gitlab.Group{{ID: 20}}, &gitlab.Response{}, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.JobTokenScope
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := v1alpha1.JobTokenScopeObservation{Enabled: true, AllowedProjectIDs: []int{10, 1234}, AllowedGroupIDs: []int{20}}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.JobTokenScope
+		want
+	}{
+		"NoExternalName": {
+			cr: scope(withProjectID()),
+			want: want{
+				cr: scope(withProjectID()),
+			},
+		},
+		"ProjectIDMissing": {
+			cr: scope(withExternalName(projectID)),
+			want: want{
+				cr:  scope(withExternalName(projectID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockGetJobTokenAccessSettings: func(_ interface{}, _ ...gitlab.RequestOptionFunc) (*projects.JobTokenAccessSettings, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: scope(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr:  scope(withProjectID(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitialized": {
+			client: allowlist(),
+			cr:     scope(withProjectID(), withExternalName(projectID), withAllowedProjectIDs("10"), withAllowedGroupIDs("20")),
+			want: want{
+				cr: scope(
+					withProjectID(),
+					withExternalName(projectID),
+					withAllowedProjectIDs("10"),
+					withAllowedGroupIDs("20"),
+					withEnabled(true),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			client: allowlist(),
+			cr:     scope(withProjectID(), withExternalName(projectID), withEnabled(true), withAllowedProjectIDs("10", "11")),
+			want: want{
+				cr: scope(
+					withProjectID(),
+					withExternalName(projectID),
+					withEnabled(true),
+					withAllowedProjectIDs("10", "11"),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var calls []string
+	c := allowlist()
+	c.MockAddProjectToJobTokenInboundAllowList = func(_ interface{}, target int, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+		calls = append(calls, fmt.Sprintf("add project %d", target))
+		return &gitlab.Response{}, nil
+	}
+	c.MockRemoveProjectFromJobTokenInboundAllowList = func(_ interface{}, target int, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+		calls = append(calls, fmt.Sprintf("remove project %d", target))
+		return &gitlab.Response{}, nil
+	}
+	c.MockAddGroupToJobTokenAllowlist = func(_ interface{}, target int, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+		calls = append(calls, fmt.Sprintf("add group %d", target))
+		return &gitlab.Response{}, nil
+	}
+	c.MockRemoveGroupFromJobTokenAllowlist = func(_ interface{}, target int, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+		calls = append(calls, fmt.Sprintf("remove group %d", target))
+		return &gitlab.Response{}, nil
+	}
+	c.MockPatchJobTokenAccessSettings = func(_ interface{}, opt *projects.PatchJobTokenAccessSettingsOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+		calls = append(calls, fmt.Sprintf("enabled %t", opt.Enabled))
+		return &gitlab.Response{}, nil
+	}
+
+	e := &external{client: c}
+	_, err := e.Update(context.Background(), scope(withProjectID(), withExternalName(projectID), withEnabled(true), withAllowedProjectIDs("11"), withAllowedGroupIDs("20", "21")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"add project 11", "add group 21", "remove project 10", "enabled true"}
+	if diff := cmp.Diff(want, calls); diff != "" {
+		t.Errorf("calls: -want, +got:\n%s", diff)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.JobTokenScope
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.JobTokenScope
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: scope(),
+			want: want{
+				cr:  scope(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"InvalidAllowedID": {
+			client: allowlist(),
+			cr:     scope(withProjectID(), withAllowedProjectIDs("group/project")),
+			want: want{
+				cr:  scope(withProjectID(), withAllowedProjectIDs("group/project"), withConditions(xpv1.Creating())),
+				err: errors.New(`allowlist entry "group/project" is not an ID`),
+			},
+		},
+		"FailedAdd": {
+			client: func() *fake.MockClient {
+				c := allowlist()
+				c.MockAddProjectToJobTokenInboundAllowList = func(_ interface{}, _ int, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					return &gitlab.Response{}, errBoom
+				}
+				return c
+			}(),
+			cr: scope(withProjectID(), withAllowedProjectIDs("10", "11")),
+			want: want{
+				cr:  scope(withProjectID(), withAllowedProjectIDs("10", "11"), withConditions(xpv1.Creating())),
+				err: errors.Wrapf(errBoom, errAddProjectFailed, 11),
+			},
+		},
+		"SuccessfulCreation": {
+			client: func() *fake.MockClient {
+				c := allowlist()
+				c.MockRemoveGroupFromJobTokenAllowlist = func(_ interface{}, _ int, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					return &gitlab.Response{}, nil
+				}
+				c.MockPatchJobTokenAccessSettings = func(_ interface{}, _ *projects.PatchJobTokenAccessSettingsOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					return &gitlab.Response{}, nil
+				}
+				return c
+			}(),
+			cr: scope(withProjectID(), withEnabled(true), withAllowedProjectIDs("10")),
+			want: want{
+				cr: scope(
+					withProjectID(),
+					withEnabled(true),
+					withAllowedProjectIDs("10"),
+					withExternalName(projectID),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr      *v1alpha1.JobTokenScope
+		removed []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.JobTokenScope
+		want
+	}{
+		"SuccessfulDeletion": {
+			cr: scope(withProjectID(), withEnabled(true), withAllowedProjectIDs("10"), withAllowedGroupIDs("20")),
+			want: want{
+				cr:      scope(withProjectID(), withEnabled(true), withAllowedProjectIDs("10"), withAllowedGroupIDs("20"), withConditions(xpv1.Deleting())),
+				removed: []string{"project 10", "group 20"},
+			},
+		},
+		"FailedDeletion": {
+			err: errBoom,
+			cr:  scope(withProjectID(), withAllowedProjectIDs("10")),
+			want: want{
+				cr:      scope(withProjectID(), withAllowedProjectIDs("10"), withConditions(xpv1.Deleting())),
+				removed: []string{"project 10"},
+				err:     errors.Wrapf(errBoom, errRemoveProject, 10),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var removed []string
+			e := &external{client: &fake.MockClient{
+				MockRemoveProjectFromJobTokenInboundAllowList: func(_ interface{}, target int, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					removed = append(removed, fmt.Sprintf("project %d", target))
+					return &gitlab.Response{}, tc.err
+				},
+				MockRemoveGroupFromJobTokenAllowlist: func(_ interface{}, target int, _ ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					removed = append(removed, fmt.Sprintf("group %d", target))
+					return &gitlab.Response{}, tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("removed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package labelsets
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotLabelSet      = "managed resource is not a Gitlab project label set custom resource"
+	errProjectIDMissing = "ProjectID or GroupID is missing"
+	errListFailed       = "cannot list Gitlab project labels"
+	errCreateFailed     = "cannot create Gitlab project label %q"
+	errUpdateFailed     = "cannot update Gitlab project label %q"
+	errDeleteFailed     = "cannot delete Gitlab project label %q"
+)
+
+// SetupLabelSet adds a controller that reconciles LabelSets.
+func SetupLabelSet(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.LabelSetKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.LabelSetGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.LabelSetGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewLabelClient, newGroupClientFn: projects.NewGroupLabelClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.LabelSetGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LabelSet{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.LabelSet{}, &v1alpha1.LabelSetList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.LabelClient
+	newGroupClientFn  func(cfg clients.Config) projects.LabelClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return nil, errors.New(errNotLabelSet)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	if cr.Spec.ForProvider.GroupID != nil {
+		return &external{kube: c.kube, client: c.newGroupClientFn(*cfg)}, nil
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.LabelClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLabelSet)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	if projects.LabelSetScopeID(&cr.Spec.ForProvider) == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	labels, err := e.list(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateLabelSetObservation(labels)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.DiffLabelSet(&cr.Spec.ForProvider, labels).IsEmpty(),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLabelSet)
+	}
+	if projects.LabelSetScopeID(&cr.Spec.ForProvider) == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, *projects.LabelSetScopeID(&cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLabelSet)
+	}
+	if projects.LabelSetScopeID(&cr.Spec.ForProvider) == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LabelSet)
+	if !ok {
+		return errors.New(errNotLabelSet)
+	}
+	if projects.LabelSetScopeID(&cr.Spec.ForProvider) == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	for _, l := range cr.Spec.ForProvider.Labels {
+		res, err := e.client.DeleteLabel(*projects.LabelSetScopeID(&cr.Spec.ForProvider), &gitlab.DeleteLabelOptions{Name: gitlab.String(l.Name)}, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errDeleteFailed, l.Name)
+		}
+	}
+	return nil
+}
+
+// list returns the labels defined on the project or group of cr itself.
+func (e *external) list(ctx context.Context, cr *v1alpha1.LabelSet) ([]*gitlab.Label, error) {
+	if cr.Spec.ForProvider.GroupID != nil {
+		return projects.ListGroupLabels(e.client, *cr.Spec.ForProvider.GroupID, gitlab.WithContext(ctx))
+	}
+	return projects.ListProjectLabels(e.client, *cr.Spec.ForProvider.ProjectID, gitlab.WithContext(ctx))
+}
+
+// apply creates, updates and prunes project or group labels until they match
+// the spec.
+func (e *external) apply(ctx context.Context, cr *v1alpha1.LabelSet) error {
+	pid := *projects.LabelSetScopeID(&cr.Spec.ForProvider)
+
+	labels, err := e.list(ctx, cr)
+	if err != nil {
+		return errors.Wrap(err, errListFailed)
+	}
+
+	d := projects.DiffLabelSet(&cr.Spec.ForProvider, labels)
+	for i := range d.Create {
+		if _, _, err := e.client.CreateLabel(pid, projects.GenerateCreateLabelOptions(&d.Create[i]), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errCreateFailed, d.Create[i].Name)
+		}
+	}
+	for i := range d.Update {
+		if _, _, err := e.client.UpdateLabel(pid, projects.GenerateUpdateLabelOptions(&d.Update[i]), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errUpdateFailed, d.Update[i].Name)
+		}
+	}
+	for _, name := range d.Delete {
+		if _, err := e.client.DeleteLabel(pid, &gitlab.DeleteLabelOptions{Name: gitlab.String(name)}, gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errDeleteFailed, name)
+		}
+	}
+	return nil
+}
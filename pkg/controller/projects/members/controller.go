@@ -15,10 +15,10 @@ package members
 
 import (
 	"context"
+	"time"
 
 	"github.com/xanzy/go-gitlab"
 
-	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -27,19 +27,32 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/expiry"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
 	errNotMember        = "managed resource is not a Gitlab Project Member custom resource"
+	errKubeUpdateFailed = "cannot update Gitlab Project Member custom resource"
 	errCreateFailed     = "cannot create Gitlab Project Member"
 	errUpdateFailed     = "cannot update Gitlab Project Member"
 	errDeleteFailed     = "cannot delete Gitlab Project Member"
@@ -59,12 +72,13 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.MemberGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.MemberGroupVersionKind, clients.NewBreakerConnecter(expiry.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), tracing.NewConnecter(name, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: projects.NewMemberClient,
 			newUserClientFn:   users.NewUserClient,
-		}),
+		})))))))))),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -75,14 +89,21 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.MemberGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.Member{}).
-		Complete(r)
+		For(&v1alpha1.Member{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Member{}, &v1alpha1.MemberList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
@@ -100,7 +121,18 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), userClient: c.newUserClientFn(*cfg)}, nil
+	cl := c.newGitlabClientFn(*cfg)
+	if cr.Spec.ForProvider.ProjectID == nil && cr.Spec.ForProvider.ProjectPath != nil {
+		if cr.Spec.ForProvider.ProjectID, err = projects.ResolveProjectID(ctx, cl, *cr.Spec.ForProvider.ProjectPath); err != nil {
+			return nil, err
+		}
+		// Persist the ID like a resolved reference, so the path is only
+		// resolved once.
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return nil, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+	return &external{kube: c.kube, client: cl, userClient: c.newUserClientFn(*cfg)}, nil
 }
 
 type external struct {
@@ -133,25 +165,62 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	projectMember, res, err := e.client.GetProjectMember(
 		*cr.Spec.ForProvider.ProjectID,
 		*cr.Spec.ForProvider.UserID,
+		gitlab.WithContext(ctx),
 	)
 
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
-			return managed.ExternalObservation{}, nil
+			return e.observeInherited(ctx, cr)
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errObserveFailed)
 	}
 
+	extended := expiry.Extended(cr.Spec.ForProvider.ExpiresAt, cr.Status.AtProvider.ExtendedExpiresAt, cr.Spec.ForProvider.AutoExtendBy, time.Now())
 	cr.Status.AtProvider = projects.GenerateMemberObservation(projectMember)
-	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.ExtendedExpiresAt = extended
+	cr.Status.SetConditions(users.StateCondition(cr.Status.AtProvider.State))
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isMemberUpToDate(&cr.Spec.ForProvider, projectMember),
+		ResourceUpToDate:        isMemberUpToDate(desired(cr), projectMember),
 		ResourceLateInitialized: false,
 	}, nil
 }
 
+// observeInherited reports an inherited membership with a sufficient access
+// level as existing and up to date if the Member allows inherited ones. It is
+// reported as absent once the Member is deleted, because inherited
+// memberships are left alone.
+func (e *external) observeInherited(ctx context.Context, cr *v1alpha1.Member) (managed.ExternalObservation, error) {
+	if cr.Spec.ForProvider.AllowInherited == nil || !*cr.Spec.ForProvider.AllowInherited || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	projectMember, res, err := e.client.GetInheritedProjectMember(
+		*cr.Spec.ForProvider.ProjectID,
+		*cr.Spec.ForProvider.UserID,
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveFailed)
+	}
+	if int(projectMember.AccessLevel) < int(cr.Spec.ForProvider.AccessLevel) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = projects.GenerateMemberObservation(projectMember)
+	cr.Status.AtProvider.Inherited = true
+	cr.Status.SetConditions(users.StateCondition(cr.Status.AtProvider.State))
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.Member)
 	if !ok {
@@ -163,7 +232,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	_, _, err := e.client.AddProjectMember(
 		*cr.Spec.ForProvider.ProjectID,
-		projects.GenerateAddMemberOptions(&cr.Spec.ForProvider),
+		projects.GenerateAddMemberOptions(desired(cr)),
 		gitlab.WithContext(ctx),
 	)
 	if err != nil {
@@ -188,7 +257,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	_, _, err := e.client.EditProjectMember(
 		*cr.Spec.ForProvider.ProjectID,
 		*cr.Spec.ForProvider.UserID,
-		projects.GenerateEditMemberOptions(&cr.Spec.ForProvider),
+		projects.GenerateEditMemberOptions(desired(cr)),
 		gitlab.WithContext(ctx),
 	)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
@@ -214,6 +283,16 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return errors.Wrap(err, errDeleteFailed)
 }
 
+// desired returns the parameters of cr with ExpiresAt set to the date
+// AutoExtendBy extended the membership to, if any.
+func desired(cr *v1alpha1.Member) *v1alpha1.MemberParameters {
+	p := cr.Spec.ForProvider.DeepCopy()
+	if at := cr.Status.AtProvider.ExtendedExpiresAt; at != nil {
+		p.ExpiresAt = at
+	}
+	return p
+}
+
 // isMemberUpToDate checks whether there is a change in any of the modifiable fields.
 func isMemberUpToDate(p *v1alpha1.MemberParameters, g *gitlab.ProjectMember) bool { // nolint:gocyclo
 	if !cmp.Equal(int(p.AccessLevel), int(g.AccessLevel)) {
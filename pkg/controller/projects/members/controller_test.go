@@ -22,6 +22,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -271,6 +273,167 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"BlockedUser": {
+			args: args{
+				projectMember: &fake.MockClient{
+					MockGetMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+						return &gitlab.ProjectMember{State: "blocked"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projectMember(
+					withProjectID(),
+					withSpec(v1alpha1.MemberParameters{
+						UserID:    &userID,
+						ProjectID: &projectID,
+					}),
+				),
+			},
+			want: want{
+				cr: projectMember(
+					withConditions(xpv1.Unavailable().WithMessage("user is blocked")),
+					withProjectID(),
+					withSpec(v1alpha1.MemberParameters{
+						UserID:    &userID,
+						ProjectID: &projectID,
+					}),
+					withStatus(v1alpha1.MemberObservation{State: "blocked"}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
+		"InheritedMember": {
+			args: args{
+				projectMember: &fake.MockClient{
+					MockGetMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+					MockGetInheritedMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+						return &gitlab.ProjectMember{AccessLevel: 40}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projectMember(
+					withSpec(v1alpha1.MemberParameters{
+						UserID:         &userID,
+						ProjectID:      &projectID,
+						AccessLevel:    30,
+						AllowInherited: gitlab.Bool(true),
+					}),
+				),
+			},
+			want: want{
+				cr: projectMember(
+					withConditions(xpv1.Available()),
+					withSpec(v1alpha1.MemberParameters{
+						UserID:         &userID,
+						ProjectID:      &projectID,
+						AccessLevel:    30,
+						AllowInherited: gitlab.Bool(true),
+					}),
+					withStatus(v1alpha1.MemberObservation{Inherited: true}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InheritedMemberInsufficientAccess": {
+			args: args{
+				projectMember: &fake.MockClient{
+					MockGetMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+					MockGetInheritedMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+						return &gitlab.ProjectMember{AccessLevel: 20}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projectMember(
+					withSpec(v1alpha1.MemberParameters{
+						UserID:         &userID,
+						ProjectID:      &projectID,
+						AccessLevel:    30,
+						AllowInherited: gitlab.Bool(true),
+					}),
+				),
+			},
+			want: want{
+				cr: projectMember(
+					withSpec(v1alpha1.MemberParameters{
+						UserID:         &userID,
+						ProjectID:      &projectID,
+						AccessLevel:    30,
+						AllowInherited: gitlab.Bool(true),
+					}),
+				),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"InheritedMemberNotAllowed": {
+			args: args{
+				projectMember: &fake.MockClient{
+					MockGetMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: projectMember(
+					withSpec(v1alpha1.MemberParameters{
+						UserID:      &userID,
+						ProjectID:   &projectID,
+						AccessLevel: 30,
+					}),
+				),
+			},
+			want: want{
+				cr: projectMember(
+					withSpec(v1alpha1.MemberParameters{
+						UserID:      &userID,
+						ProjectID:   &projectID,
+						AccessLevel: 30,
+					}),
+				),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"AutoExtended": {
+			args: args{
+				projectMember: &fake.MockClient{
+					MockGetMember: func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+						return &gitlab.ProjectMember{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projectMember(
+					withProjectID(),
+					withSpec(v1alpha1.MemberParameters{
+						UserID:       &userID,
+						ProjectID:    &projectID,
+						AutoExtendBy: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+					}),
+					withExpiresAt(time.Now().Add(24*time.Hour).UTC().Format(time.DateOnly)),
+				),
+			},
+			want: want{
+				cr: projectMember(
+					withConditions(xpv1.Available()),
+					withProjectID(),
+					withSpec(v1alpha1.MemberParameters{
+						UserID:       &userID,
+						ProjectID:    &projectID,
+						AutoExtendBy: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+					}),
+					withExpiresAt(time.Now().Add(24*time.Hour).UTC().Format(time.DateOnly)),
+					withStatus(v1alpha1.MemberObservation{ExtendedExpiresAt: ptr.To(time.Now().Add(30 * 24 * time.Hour).UTC().Format(time.DateOnly))}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
 		"IsGroupUpToDateAccessLevel": {
 			args: args{
 				projectMember: &fake.MockClient{
@@ -574,6 +737,38 @@ func TestUpdate(t *testing.T) {
 				),
 			},
 		},
+		"UpdateExtended": {
+			args: args{
+				projectMember: &fake.MockClient{
+					MockEditMember: func(gid interface{}, user int, opt *gitlab.EditProjectMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error) {
+						if opt.ExpiresAt == nil || *opt.ExpiresAt != "2024-06-01" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.ProjectMember{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projectMember(
+					withProjectID(),
+					withSpec(v1alpha1.MemberParameters{
+						UserID:    &userID,
+						ProjectID: &projectID,
+					}),
+					withExpiresAt("2024-05-01"),
+					withStatus(v1alpha1.MemberObservation{ExtendedExpiresAt: ptr.To("2024-06-01")}),
+				),
+			},
+			want: want{
+				cr: projectMember(
+					withProjectID(),
+					withSpec(v1alpha1.MemberParameters{
+						UserID:    &userID,
+						ProjectID: &projectID,
+					}),
+					withExpiresAt("2024-05-01"),
+					withStatus(v1alpha1.MemberObservation{ExtendedExpiresAt: ptr.To("2024-06-01")}),
+				),
+			},
+		},
 		"FailedUpdate": {
 			args: args{
 				projectMember: &fake.MockClient{
@@ -0,0 +1,355 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	noteID    = 7
+	issueIID  = 3
+	body      = "Provisioned.\n\n<!-- crossplane/status -->"
+)
+
+type noteModifier func(*v1alpha1.Note)
+
+func withConditions(c ...xpv1.Condition) noteModifier {
+	return func(r *v1alpha1.Note) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() noteModifier {
+	return func(r *v1alpha1.Note) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withExternalName(n string) noteModifier {
+	return func(r *v1alpha1.Note) { meta.SetExternalName(r, n) }
+}
+
+func withBody(b string) noteModifier {
+	return func(r *v1alpha1.Note) { r.Spec.ForProvider.Body = b }
+}
+
+func withMergeRequest() noteModifier {
+	return func(r *v1alpha1.Note) { r.Spec.ForProvider.NoteableType = "MergeRequest" }
+}
+
+func withStatus(s v1alpha1.NoteObservation) noteModifier {
+	return func(r *v1alpha1.Note) { r.Status.AtProvider = s }
+}
+
+func note(m ...noteModifier) *v1alpha1.Note {
+	cr := &v1alpha1.Note{Spec: v1alpha1.NoteSpec{ForProvider: v1alpha1.NoteParameters{
+		NoteableType: "Issue",
+		NoteableIID:  issueIID,
+		Body:         "Provisioned.",
+		Marker:       ptr.To("crossplane/status"),
+	}}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func gitlabNote(id int, b string) *gitlab.Note {
+	return &gitlab.Note{ID: id, Body: b}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Note
+		result managed.ExternalObservation
+		err    error
+	}
+
+	get := func(pid interface{}, issue, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+		return gitlabNote(id, body), &gitlab.Response{}, nil
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Note
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: note(),
+			want: want{
+				cr:  note(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NoNote": {
+			client: &fake.MockClient{
+				MockListIssueNotes: func(pid interface{}, issue int, opt *gitlab.ListIssueNotesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error) {
+					return []*gitlab.Note{gitlabNote(5, "LGTM")}, &gitlab.Response{}, nil
+				},
+			},
+			cr: note(withProjectID()),
+			want: want{
+				cr: note(withProjectID()),
+			},
+		},
+		"FailedAdopt": {
+			client: &fake.MockClient{
+				MockListIssueNotes: func(pid interface{}, issue int, opt *gitlab.ListIssueNotesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: note(withProjectID()),
+			want: want{
+				cr:  note(withProjectID()),
+				err: errors.Wrap(errBoom, errAdoptFailed),
+			},
+		},
+		"Adopted": {
+			client: &fake.MockClient{
+				MockListIssueNotes: func(pid interface{}, issue int, opt *gitlab.ListIssueNotesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Note, *gitlab.Response, error) {
+					if opt.Page == 0 {
+						return []*gitlab.Note{gitlabNote(5, "LGTM")}, &gitlab.Response{NextPage: 2}, nil
+					}
+					return []*gitlab.Note{gitlabNote(noteID, "Provisioning.\n\n<!-- crossplane/status -->")}, &gitlab.Response{}, nil
+				},
+				MockGetIssueNote: get,
+			},
+			cr: note(withProjectID()),
+			want: want{
+				cr: note(
+					withProjectID(),
+					withExternalName("7"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.NoteObservation{ID: noteID}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetIssueNote: func(pid interface{}, issue, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+				},
+			},
+			cr: note(withProjectID(), withExternalName("7")),
+			want: want{
+				cr: note(withProjectID(), withExternalName("7")),
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockGetIssueNote: func(pid interface{}, issue, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: note(withProjectID(), withExternalName("7")),
+			want: want{
+				cr:  note(withProjectID(), withExternalName("7")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"NotUpToDate": {
+			client: &fake.MockClient{
+				MockGetMergeRequestNote: get,
+			},
+			cr: note(withProjectID(), withExternalName("7"), withMergeRequest(), withBody("Failed.")),
+			want: want{
+				cr: note(
+					withProjectID(),
+					withExternalName("7"),
+					withMergeRequest(),
+					withBody("Failed."),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.NoteObservation{ID: noteID}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr   *v1alpha1.Note
+		body *string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.Note
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: note(),
+			want: want{
+				cr:  note(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			cr: note(withProjectID()),
+			want: want{
+				cr:   note(withProjectID(), withExternalName("7"), withConditions(xpv1.Creating())),
+				body: &body,
+			},
+		},
+		"FailedCreation": {
+			err: errBoom,
+			cr:  note(withProjectID()),
+			want: want{
+				cr:   note(withProjectID(), withConditions(xpv1.Creating())),
+				body: &body,
+				err:  errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var b *string
+			client := &fake.MockClient{
+				MockCreateIssueNote: func(pid interface{}, issue int, opt *gitlab.CreateIssueNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+					b = opt.Body
+					return gitlabNote(noteID, *opt.Body), &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{client: client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.body, b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		cr   *v1alpha1.Note
+		want error
+	}{
+		"IDNotAnInt": {
+			cr:   note(withProjectID(), withMergeRequest()),
+			want: errors.Wrap(errors.New(`strconv.Atoi: parsing "": invalid syntax`), errIDNotAnInt),
+		},
+		"SuccessfulUpdate": {
+			cr: note(withProjectID(), withMergeRequest(), withExternalName("7")),
+		},
+		"FailedUpdate": {
+			err:  errBoom,
+			cr:   note(withProjectID(), withMergeRequest(), withExternalName("7")),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &fake.MockClient{
+				MockUpdateMergeRequestNote: func(pid interface{}, mr, id int, opt *gitlab.UpdateMergeRequestNoteOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Note, *gitlab.Response, error) {
+					if diff := cmp.Diff(body, *opt.Body); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return gitlabNote(id, *opt.Body), &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{client: client}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"SuccessfulDeletion": {},
+		"FailedDeletion": {
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &fake.MockClient{
+				MockDeleteIssueNote: func(pid interface{}, issue, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					return &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{client: client}
+			cr := note(withProjectID(), withExternalName("7"))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(note(withProjectID(), withExternalName("7"), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
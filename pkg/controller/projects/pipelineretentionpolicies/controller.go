@@ -0,0 +1,245 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelineretentionpolicies
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotPipelineRetentionPolicy = "managed resource is not a Gitlab pipeline retention policy custom resource"
+	errProjectIDMissing           = "ProjectID is missing"
+	errListFailed                 = "cannot list Gitlab project pipelines"
+	errDeleteFailed               = "cannot delete Gitlab pipeline %d"
+)
+
+// SetupPipelineRetentionPolicy adds a controller that reconciles
+// PipelineRetentionPolicies.
+func SetupPipelineRetentionPolicy(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PipelineRetentionPolicyKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.PipelineRetentionPolicyGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.PipelineRetentionPolicyGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewPipelineClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PipelineRetentionPolicyGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.PipelineRetentionPolicy{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.PipelineRetentionPolicy{}, &v1alpha1.PipelineRetentionPolicyList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.PipelineClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PipelineRetentionPolicy)
+	if !ok {
+		return nil, errors.New(errNotPipelineRetentionPolicy)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), now: time.Now}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.PipelineClient
+	now    func() time.Time
+
+	// expired are the pipelines Observe found due for deletion, which
+	// Update deletes without listing the pipelines again.
+	expired []*gitlab.PipelineInfo
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PipelineRetentionPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPipelineRetentionPolicy)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	pipelines, expired, err := e.list(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	e.expired = expired
+
+	cr.Status.AtProvider.Pipelines = len(pipelines)
+	cr.Status.AtProvider.ExpiredPipelines = len(expired)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(expired) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PipelineRetentionPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPipelineRetentionPolicy)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.ProjectID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PipelineRetentionPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPipelineRetentionPolicy)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PipelineRetentionPolicy)
+	if !ok {
+		return errors.New(errNotPipelineRetentionPolicy)
+	}
+
+	// The policy only exists in the cluster, deleted pipelines are not
+	// restored.
+	cr.Status.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// list returns the pipelines the policy applies to and the ones of them that
+// are expired, at most MaxDeletionsPerRun.
+func (e *external) list(ctx context.Context, cr *v1alpha1.PipelineRetentionPolicy) (pipelines, expired []*gitlab.PipelineInfo, err error) {
+	now := e.now()
+	pipelines, err = projects.ListRetainedPipelines(e.client, *cr.Spec.ForProvider.ProjectID, &cr.Spec.ForProvider, now, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errListFailed)
+	}
+
+	expired = projects.ExpiredPipelines(&cr.Spec.ForProvider, pipelines, now)
+	if limit := ptr.Deref(cr.Spec.ForProvider.MaxDeletionsPerRun, projects.DefaultMaxPipelineDeletions); len(expired) > limit {
+		expired = expired[:limit]
+	}
+	return pipelines, expired, nil
+}
+
+// apply deletes the expired pipelines of the project, at most
+// MaxDeletionsPerRun of them. The pipelines found by Observe are used if
+// there are any.
+func (e *external) apply(ctx context.Context, cr *v1alpha1.PipelineRetentionPolicy) error {
+	pid := *cr.Spec.ForProvider.ProjectID
+
+	expired := e.expired
+	if expired == nil {
+		var err error
+		if _, expired, err = e.list(ctx, cr); err != nil {
+			return err
+		}
+	}
+
+	deleted := 0
+	defer func() {
+		now := metav1.NewTime(e.now())
+		cr.Status.AtProvider.LastRunTime = &now
+		cr.Status.AtProvider.LastRunDeletedPipelines = deleted
+	}()
+	for _, p := range expired {
+		res, err := e.client.DeletePipeline(pid, p.ID, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errDeleteFailed, p.ID)
+		}
+		deleted++
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelineretentionpolicies
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	now       = time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	old       = now.AddDate(0, 0, -60)
+	recent    = now.AddDate(0, 0, -1)
+)
+
+type policyModifier func(*v1alpha1.PipelineRetentionPolicy)
+
+func withConditions(c ...xpv1.Condition) policyModifier {
+	return func(r *v1alpha1.PipelineRetentionPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() policyModifier {
+	return func(r *v1alpha1.PipelineRetentionPolicy) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withOlderThanDays(d int) policyModifier {
+	return func(r *v1alpha1.PipelineRetentionPolicy) { r.Spec.ForProvider.OlderThanDays = &d }
+}
+
+func withMaxDeletionsPerRun(n int) policyModifier {
+	return func(r *v1alpha1.PipelineRetentionPolicy) { r.Spec.ForProvider.MaxDeletionsPerRun = &n }
+}
+
+func withExternalName(n string) policyModifier {
+	return func(r *v1alpha1.PipelineRetentionPolicy) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.PipelineRetentionPolicyObservation) policyModifier {
+	return func(r *v1alpha1.PipelineRetentionPolicy) { r.Status.AtProvider = s }
+}
+
+func policy(m ...policyModifier) *v1alpha1.PipelineRetentionPolicy {
+	cr := &v1alpha1.PipelineRetentionPolicy{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func pipelines(_ interface{}, opt *gitlab.ListProjectPipelinesOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+	var listed []*gitlab.PipelineInfo
+	for _, p := range []*gitlab.PipelineInfo{
+		{ID: 3, Status: "success", CreatedAt: &recent, UpdatedAt: &recent},
+		{ID: 2, Status: "failed", CreatedAt: &old, UpdatedAt: &old},
+		{ID: 1, Status: "success", CreatedAt: &old, UpdatedAt: &old},
+	} {
+		if opt.UpdatedBefore == nil || p.UpdatedAt.Before(*opt.UpdatedBefore) {
+			listed = append(listed, p)
+		}
+	}
+	return listed, &gitlab.Response{}, nil
+}
+
+func clock() time.Time { return now }
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.PipelineRetentionPolicy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.PipelineRetentionPolicy
+		want
+	}{
+		"NoExternalName": {
+			cr: policy(withProjectID()),
+			want: want{
+				cr: policy(withProjectID()),
+			},
+		},
+		"ProjectIDMissing": {
+			cr: policy(withExternalName(projectID)),
+			want: want{
+				cr:  policy(withExternalName(projectID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedList": {
+			client: &fake.MockClient{
+				MockListProjectPipelines: func(pid interface{}, opt *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: policy(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr:  policy(withProjectID(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errListFailed),
+			},
+		},
+		"ExpiredPipelines": {
+			client: &fake.MockClient{MockListProjectPipelines: pipelines},
+			cr:     policy(withProjectID(), withExternalName(projectID), withOlderThanDays(30)),
+			want: want{
+				cr: policy(
+					withProjectID(),
+					withExternalName(projectID),
+					withOlderThanDays(30),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.PipelineRetentionPolicyObservation{Pipelines: 2, ExpiredPipelines: 2}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExpiredPipelines": {
+			client: &fake.MockClient{MockListProjectPipelines: pipelines},
+			cr:     policy(withProjectID(), withExternalName(projectID), withOlderThanDays(90)),
+			want: want{
+				cr: policy(
+					withProjectID(),
+					withExternalName(projectID),
+					withOlderThanDays(90),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, now: clock}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	runTime := metav1.NewTime(now)
+
+	type want struct {
+		cr      *v1alpha1.PipelineRetentionPolicy
+		deleted []int
+		err     error
+	}
+
+	cases := map[string]struct {
+		deleteErr error
+		status    int
+		cr        *v1alpha1.PipelineRetentionPolicy
+		want
+	}{
+		"SuccessfulDeletion": {
+			cr: policy(withProjectID(), withExternalName(projectID), withOlderThanDays(30)),
+			want: want{
+				cr: policy(
+					withProjectID(),
+					withExternalName(projectID),
+					withOlderThanDays(30),
+					withStatus(v1alpha1.PipelineRetentionPolicyObservation{LastRunTime: &runTime, LastRunDeletedPipelines: 2}),
+				),
+				deleted: []int{2, 1},
+			},
+		},
+		"MaxDeletionsPerRun": {
+			cr: policy(withProjectID(), withExternalName(projectID), withOlderThanDays(30), withMaxDeletionsPerRun(1)),
+			want: want{
+				cr: policy(
+					withProjectID(),
+					withExternalName(projectID),
+					withOlderThanDays(30),
+					withMaxDeletionsPerRun(1),
+					withStatus(v1alpha1.PipelineRetentionPolicyObservation{LastRunTime: &runTime, LastRunDeletedPipelines: 1}),
+				),
+				deleted: []int{2},
+			},
+		},
+		"AlreadyDeleted": {
+			deleteErr: errBoom,
+			status:    http.StatusNotFound,
+			cr:        policy(withProjectID(), withExternalName(projectID), withOlderThanDays(30)),
+			want: want{
+				cr: policy(
+					withProjectID(),
+					withExternalName(projectID),
+					withOlderThanDays(30),
+					withStatus(v1alpha1.PipelineRetentionPolicyObservation{LastRunTime: &runTime, LastRunDeletedPipelines: 2}),
+				),
+				deleted: []int{2, 1},
+			},
+		},
+		"FailedDeletion": {
+			deleteErr: errBoom,
+			status:    http.StatusInternalServerError,
+			cr:        policy(withProjectID(), withExternalName(projectID), withOlderThanDays(30)),
+			want: want{
+				cr: policy(
+					withProjectID(),
+					withExternalName(projectID),
+					withOlderThanDays(30),
+					withStatus(v1alpha1.PipelineRetentionPolicyObservation{LastRunTime: &runTime}),
+				),
+				deleted: []int{2},
+				err:     errors.Wrapf(errBoom, errDeleteFailed, 2),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []int
+			client := &fake.MockClient{
+				MockListProjectPipelines: pipelines,
+				MockDeletePipeline: func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					deleted = append(deleted, pipeline)
+					return &gitlab.Response{Response: &http.Response{StatusCode: tc.status}}, tc.deleteErr
+				},
+			}
+			e := &external{client: client, now: clock}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateReusesObservation(t *testing.T) {
+	lists := 0
+	var deleted []int
+	client := &fake.MockClient{
+		MockListProjectPipelines: func(pid interface{}, opt *gitlab.ListProjectPipelinesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+			lists++
+			return pipelines(pid, opt, options...)
+		},
+		MockDeletePipeline: func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			deleted = append(deleted, pipeline)
+			return &gitlab.Response{}, nil
+		},
+	}
+	cr := policy(withProjectID(), withExternalName(projectID), withOlderThanDays(30))
+	e := &external{client: client, now: clock}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): unexpected error: %v", err)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	if lists != 1 {
+		t.Errorf("Observe and Update listed pipelines %d times, want 1", lists)
+	}
+	if diff := cmp.Diff([]int{2, 1}, deleted); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cr := policy(withProjectID(), withExternalName(projectID), withOlderThanDays(30))
+	e := &external{client: &fake.MockClient{}, now: clock}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(policy(withProjectID(), withExternalName(projectID), withOlderThanDays(30), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
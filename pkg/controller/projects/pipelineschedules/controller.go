@@ -30,15 +30,28 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretversion"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -53,6 +66,8 @@ const (
 	errCreatePipelineScheduleVariable = "failed to create PipelineScheduleVariable %v"
 	errUpdatePipelineScheduleVariable = "failed to update PipelineScheduleVariable %v"
 	errDeletePipelineScheduleVariable = "failed to delete PipelineScheduleVariable %v"
+	errGetSecretFailed                = "cannot get secret for value of PipelineScheduleVariable %v"
+	errSecretKeyNotFound              = "cannot find key in secret for value of PipelineScheduleVariable %v"
 )
 
 // SetupPipelineSchedule adds a controller that reconciles PipelineSchedule.
@@ -65,8 +80,9 @@ func SetupPipelineSchedule(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: newPipelineScheduleClient}),
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.PipelineScheduleGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.PipelineScheduleGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, secretversion.NewConnecter(mgr.GetClient(), &connector{kube: mgr.GetClient(), newGitlabClientFn: newPipelineScheduleClient})))))))))),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -77,14 +93,21 @@ func SetupPipelineSchedule(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.PipelineScheduleGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.PipelineSchedule{}).
-		Complete(r)
+		For(&v1alpha1.PipelineSchedule{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.PipelineSchedule{}, &v1alpha1.PipelineScheduleList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type external struct {
@@ -136,7 +159,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNoProjectID)
 	}
 
-	ps, res, err := e.client.GetPipelineSchedule(*cr.Spec.ForProvider.ProjectID, id)
+	ps, res, err := e.client.GetPipelineSchedule(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
 
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
@@ -147,12 +170,19 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitialize(&cr.Spec.ForProvider, ps)
+	vars, err := e.resolveVariables(ctx, cr.Spec.ForProvider.Variables)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	generateObservation(cr, ps)
 	cr.Status.SetConditions(xpv1.Available())
+	if c, ok := lastRunCondition(cr.Status.AtProvider.LastPipeline); ok {
+		cr.Status.SetConditions(c)
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isUpToDate(cr, ps),
+		ResourceUpToDate:        isUpToDate(cr, vars, ps),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -167,6 +197,10 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.ProjectID == nil {
 		return managed.ExternalCreation{}, errors.New(errNoProjectID)
 	}
+	vars, err := e.resolveVariables(ctx, cr.Spec.ForProvider.Variables)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 
 	opt := &gitlab.CreatePipelineScheduleOptions{
 		Description:  &cr.Spec.ForProvider.Description,
@@ -176,7 +210,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		Active:       cr.Spec.ForProvider.Active,
 	}
 
-	ps, _, err := e.client.CreatePipelineSchedule(*cr.Spec.ForProvider.ProjectID, opt)
+	ps, _, err := e.client.CreatePipelineSchedule(*cr.Spec.ForProvider.ProjectID, opt, gitlab.WithContext(ctx))
 
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePipelineSchedule)
@@ -184,7 +218,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	meta.SetExternalName(cr, strconv.Itoa(ps.ID))
 
-	for _, v := range cr.Spec.ForProvider.Variables {
+	for _, v := range vars {
 		opt := &gitlab.CreatePipelineScheduleVariableOptions{
 			Key:          &v.Key,   //nolint:gosec
 			Value:        &v.Value, //nolint:gosec
@@ -194,9 +228,10 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			*cr.Spec.ForProvider.ProjectID,
 			ps.ID,
 			opt,
+			gitlab.WithContext(ctx),
 		)
 		if err != nil {
-			return managed.ExternalCreation{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v)
+			return managed.ExternalCreation{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v.Key)
 		}
 	}
 
@@ -220,6 +255,10 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.ProjectID == nil {
 		return managed.ExternalUpdate{}, errors.New(errNoProjectID)
 	}
+	vars, err := e.resolveVariables(ctx, cr.Spec.ForProvider.Variables)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 
 	opt := &gitlab.EditPipelineScheduleOptions{
 		Description:  &cr.Spec.ForProvider.Description,
@@ -233,6 +272,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		*cr.Spec.ForProvider.ProjectID,
 		id,
 		opt,
+		gitlab.WithContext(ctx),
 	)
 
 	if err != nil {
@@ -240,11 +280,11 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	if hasVariables(cr, ps) {
-		ps, _, err := e.client.GetPipelineSchedule(*cr.Spec.ForProvider.ProjectID, id)
+		ps, _, err := e.client.GetPipelineSchedule(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
 		if err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errGetPipelineSchedule)
 		}
-		for _, v := range cr.Spec.ForProvider.Variables {
+		for _, v := range vars {
 			if notSaved(v, ps.Variables) {
 				opt := &gitlab.CreatePipelineScheduleVariableOptions{
 					Key:          &v.Key,   //nolint:gosec
@@ -255,9 +295,10 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 					*cr.Spec.ForProvider.ProjectID,
 					ps.ID,
 					opt,
+					gitlab.WithContext(ctx),
 				)
 				if err != nil {
-					return managed.ExternalUpdate{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v)
+					return managed.ExternalUpdate{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v.Key)
 				}
 			}
 			if notUpdated(v, ps.Variables) {
@@ -270,9 +311,10 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 					ps.ID,
 					v.Key,
 					opt,
+					gitlab.WithContext(ctx),
 				)
 				if err != nil {
-					return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdatePipelineScheduleVariable, v)
+					return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdatePipelineScheduleVariable, v.Key)
 				}
 			}
 		}
@@ -282,9 +324,10 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 					*cr.Spec.ForProvider.ProjectID,
 					ps.ID,
 					v.Key,
+					gitlab.WithContext(ctx),
 				)
 				if err != nil {
-					return managed.ExternalUpdate{}, errors.Wrapf(err, errDeletePipelineScheduleVariable, v)
+					return managed.ExternalUpdate{}, errors.Wrapf(err, errDeletePipelineScheduleVariable, v.Key)
 				}
 			}
 		}
@@ -312,6 +355,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	_, err = e.client.DeletePipelineSchedule(
 		*cr.Spec.ForProvider.ProjectID,
 		id,
+		gitlab.WithContext(ctx),
 	)
 
 	return errors.Wrap(err, errDeletePipelineSchedule)
@@ -344,7 +388,9 @@ func lateInitialize(cr *v1alpha1.PipelineScheduleParameters, ps *gitlab.Pipeline
 	}
 }
 
-func isUpToDate(cr *v1alpha1.PipelineSchedule, ps *gitlab.PipelineSchedule) bool {
+// isUpToDate checks whether the schedule is in line with the spec of cr.
+// vars are the variables of the spec with their values resolved.
+func isUpToDate(cr *v1alpha1.PipelineSchedule, vars []v1alpha1.PipelineVariable, ps *gitlab.PipelineSchedule) bool {
 	if cr.Spec.ForProvider.Cron != ps.Cron {
 		return false
 	}
@@ -357,7 +403,7 @@ func isUpToDate(cr *v1alpha1.PipelineSchedule, ps *gitlab.PipelineSchedule) bool
 	if !clients.IsBoolEqualToBoolPtr(cr.Spec.ForProvider.Active, ps.Active) {
 		return false
 	}
-	if !isVariablesUpToDate(cr.Spec.ForProvider.Variables, ps.Variables) {
+	if !isVariablesUpToDate(vars, ps.Variables) {
 		return false
 	}
 
@@ -370,7 +416,7 @@ func isVariablesUpToDate(crv []v1alpha1.PipelineVariable, inv []*gitlab.Pipeline
 	}
 	for _, v := range crv {
 
-		if notSaved(v, inv) {
+		if notSaved(v, inv) || valueChanged(v, inv) {
 			return false
 		}
 	}
@@ -400,6 +446,22 @@ func notSaved(crv v1alpha1.PipelineVariable, invArr []*gitlab.PipelineVariable)
 	return true
 }
 
+// valueChanged returns true if the value or the type of the Gitlab variable
+// with the key of crv differs from crv. The type is only compared if both
+// sides report one.
+func valueChanged(crv v1alpha1.PipelineVariable, invArr []*gitlab.PipelineVariable) bool {
+	for _, v := range invArr {
+		if v.Key != crv.Key {
+			continue
+		}
+		if v.Value != crv.Value {
+			return true
+		}
+		return crv.VariableType != nil && v.VariableType != "" && *crv.VariableType != v.VariableType
+	}
+	return false
+}
+
 func notUpdated(crv v1alpha1.PipelineVariable, invArr []*gitlab.PipelineVariable) bool {
 	victim := gitlab.PipelineVariable{
 		Key:   crv.Key,
@@ -439,6 +501,56 @@ func generateObservation(cr *v1alpha1.PipelineSchedule, ps *gitlab.PipelineSched
 	cr.Status.AtProvider = o
 }
 
+// lastRunCondition returns the LastRunSucceeded condition for the last
+// pipeline ran by a schedule. Pipelines that did not finish yet, or were
+// canceled or skipped, keep the condition of the pipeline before.
+func lastRunCondition(p *v1alpha1.LastPipeline) (xpv1.Condition, bool) {
+	if p == nil {
+		return xpv1.Condition{}, false
+	}
+	switch p.Status {
+	case v1alpha1.PipelineStatusSuccess:
+		return v1alpha1.LastRunSucceeded(), true
+	case v1alpha1.PipelineStatusFailed:
+		return v1alpha1.LastRunFailed(p), true
+	default:
+		return xpv1.Condition{}, false
+	}
+}
+
+// resolveVariables returns a copy of vars with the values of the variables
+// that reference a secret read from it. The values are not written to the
+// spec, so they do not end up in the custom resource.
+func (e *external) resolveVariables(ctx context.Context, vars []v1alpha1.PipelineVariable) ([]v1alpha1.PipelineVariable, error) {
+	if vars == nil {
+		return nil, nil
+	}
+	out := make([]v1alpha1.PipelineVariable, len(vars))
+	for i := range vars {
+		out[i] = vars[i]
+		selector := vars[i].ValueSecretRef
+		if selector == nil {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		nn := types.NamespacedName{
+			Namespace: selector.Namespace,
+			Name:      selector.Name,
+		}
+		if err := e.kube.Get(ctx, nn, secret); err != nil {
+			return nil, errors.Wrapf(err, errGetSecretFailed, vars[i].Key)
+		}
+
+		raw, ok := secret.Data[selector.Key]
+		if raw == nil || !ok {
+			return nil, errors.Errorf(errSecretKeyNotFound, vars[i].Key)
+		}
+		out[i].Value = string(raw)
+	}
+	return out, nil
+}
+
 func hasVariables(cr *v1alpha1.PipelineSchedule, ps *gitlab.PipelineSchedule) bool {
 	return cr.Spec.ForProvider.Variables != nil || ps.Variables != nil
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
@@ -75,6 +76,11 @@ var (
 		Value:        "testValue2Update",
 		VariableType: &s,
 	}
+	pvSecret = &v1alpha1.PipelineVariable{
+		Key:            "testKey1",
+		ValueSecretRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "token", Namespace: "default"}, Key: "value"},
+		VariableType:   &s,
+	}
 	gPvArr = []*gitlab.PipelineVariable{
 		{
 			Key:          "testKey1",
@@ -113,6 +119,10 @@ func withConditions(c xpv1.Condition) psModifier {
 	return func(ps *v1alpha1.PipelineSchedule) { ps.Status.SetConditions(c) }
 }
 
+func withLastPipeline(lp *v1alpha1.LastPipeline) psModifier {
+	return func(ps *v1alpha1.PipelineSchedule) { ps.Status.AtProvider.LastPipeline = lp }
+}
+
 func withVariables(varr ...*v1alpha1.PipelineVariable) psModifier {
 	return func(ps *v1alpha1.PipelineSchedule) {
 		ps.Spec.ForProvider.Variables = make([]v1alpha1.PipelineVariable, len(varr))
@@ -126,6 +136,17 @@ func withProjectID() psModifier {
 	return func(ps *v1alpha1.PipelineSchedule) { ps.Spec.ForProvider.ProjectID = &extName }
 }
 
+// secretKube returns a kube client holding the secret referenced by
+// pvSecret with the given value.
+func secretKube(value string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"value": []byte(value)}
+			return nil
+		},
+	}
+}
+
 func buildPs(m ...psModifier) *v1alpha1.PipelineSchedule {
 	ps := &v1alpha1.PipelineSchedule{}
 	for _, psm := range m {
@@ -295,6 +316,36 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"SecretValueChanged": {
+			args: args{
+				kube: secretKube("rotated"),
+				client: &fake.MockClient{
+					MockGetPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{Variables: gPvArr[:1]}, nil, nil
+					},
+				},
+				cr: buildPs(
+					withExternalName(extName),
+					withProjectID(),
+					withParams(standardPsParams),
+					withVariables(pvSecret),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withExternalName(extName),
+					withProjectID(),
+					withParams(standardPsParams),
+					withID(standardID),
+					withConditions(xpv1.Available()),
+					withVariables(pvSecret),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"SuccessUpToDateFalse": {
 			args: args{
 				client: &fake.MockClient{
@@ -322,6 +373,89 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"LastRunFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{LastPipeline: &gitlab.LastPipeline{ID: 7, Ref: "main", Status: "failed"}}, nil, nil
+					},
+				},
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withConditions(v1alpha1.LastRunSucceeded()),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withID(standardID),
+					withLastPipeline(&v1alpha1.LastPipeline{ID: 7, Ref: "main", Status: "failed"}),
+					withConditions(xpv1.Available()),
+					withConditions(v1alpha1.LastRunFailed(&v1alpha1.LastPipeline{ID: 7, Ref: "main"})),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LastRunSucceeded": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{LastPipeline: &gitlab.LastPipeline{ID: 7, Ref: "main", Status: "success"}}, nil, nil
+					},
+				},
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withID(standardID),
+					withLastPipeline(&v1alpha1.LastPipeline{ID: 7, Ref: "main", Status: "success"}),
+					withConditions(xpv1.Available()),
+					withConditions(v1alpha1.LastRunSucceeded()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LastRunStillRunning": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{LastPipeline: &gitlab.LastPipeline{ID: 8, Ref: "main", Status: "running"}}, nil, nil
+					},
+				},
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withConditions(v1alpha1.LastRunSucceeded()),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withID(standardID),
+					withLastPipeline(&v1alpha1.LastPipeline{ID: 8, Ref: "main", Status: "running"}),
+					withConditions(xpv1.Available()),
+					withConditions(v1alpha1.LastRunSucceeded()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 	}
 
 	for tn, tc := range tcs {
@@ -392,6 +526,53 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"CreateWithSecretValue": {
+			args: args{
+				kube: secretKube("testValue1"),
+				client: &fake.MockClient{
+					MockCreatePipelineSchedule: func(pid interface{}, opt *gitlab.CreatePipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{ID: id}, nil, nil
+					},
+					MockCreatePipelineScheduleVariable: func(pid interface{}, schedule int, opt *gitlab.CreatePipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error) {
+						if *opt.Value != "testValue1" {
+							return nil, nil, errors.New("unexpected value")
+						}
+						return nil, nil, nil
+					},
+				},
+				cr: buildPs(
+					withProjectID(),
+					withVariables(pvSecret),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withProjectID(),
+					withExternalName(extName),
+					withVariables(pvSecret),
+				),
+				result: managed.ExternalCreation{},
+			},
+		},
+		"SecretKeyNotFound": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: buildPs(
+					withProjectID(),
+					withVariables(pvSecret),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withProjectID(),
+					withVariables(pvSecret),
+				),
+				result: managed.ExternalCreation{},
+				err:    errors.Errorf(errSecretKeyNotFound, "testKey1"),
+			},
+		},
 	}
 
 	for tn, tc := range tcs {
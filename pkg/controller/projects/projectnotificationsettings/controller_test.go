@@ -0,0 +1,276 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectnotificationsettings
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+)
+
+type settingsModifier func(*v1alpha1.ProjectNotificationSettings)
+
+func withConditions(c ...xpv1.Condition) settingsModifier {
+	return func(r *v1alpha1.ProjectNotificationSettings) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() settingsModifier {
+	return func(r *v1alpha1.ProjectNotificationSettings) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withExternalName(n string) settingsModifier {
+	return func(r *v1alpha1.ProjectNotificationSettings) { meta.SetExternalName(r, n) }
+}
+
+func withLevel(l string) settingsModifier {
+	return func(r *v1alpha1.ProjectNotificationSettings) { r.Spec.ForProvider.Level = &l }
+}
+
+func withStatus(s v1alpha1.ProjectNotificationSettingsObservation) settingsModifier {
+	return func(r *v1alpha1.ProjectNotificationSettings) { r.Status.AtProvider = s }
+}
+
+func settings(m ...settingsModifier) *v1alpha1.ProjectNotificationSettings {
+	cr := &v1alpha1.ProjectNotificationSettings{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func notificationSettings(_ interface{}, _ ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error) {
+	return &gitlab.NotificationSettings{Level: gitlab.GlobalNotificationLevel}, &gitlab.Response{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProjectNotificationSettings
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := v1alpha1.ProjectNotificationSettingsObservation{Level: "global"}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ProjectNotificationSettings
+		want
+	}{
+		"NoExternalName": {
+			cr: settings(withProjectID()),
+			want: want{
+				cr: settings(withProjectID()),
+			},
+		},
+		"ProjectIDMissing": {
+			cr: settings(withExternalName(projectID)),
+			want: want{
+				cr:  settings(withExternalName(projectID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockGetSettingsForProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: settings(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr:  settings(withProjectID(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockGetSettingsForProject: notificationSettings},
+			cr:     settings(withProjectID(), withExternalName(projectID)),
+			want: want{
+				cr: settings(
+					withProjectID(),
+					withExternalName(projectID),
+					withLevel("global"),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			client: &fake.MockClient{MockGetSettingsForProject: notificationSettings},
+			cr:     settings(withProjectID(), withExternalName(projectID), withLevel("disabled")),
+			want: want{
+				cr: settings(
+					withProjectID(),
+					withExternalName(projectID),
+					withLevel("disabled"),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ProjectNotificationSettings
+		opt *gitlab.NotificationSettingsOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.ProjectNotificationSettings
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: settings(),
+			want: want{
+				cr:  settings(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			cr: settings(withProjectID(), withLevel("disabled")),
+			want: want{
+				cr: settings(
+					withProjectID(),
+					withLevel("disabled"),
+					withExternalName(projectID),
+					withConditions(xpv1.Creating()),
+				),
+				opt: &gitlab.NotificationSettingsOptions{Level: gitlab.NotificationLevel(gitlab.DisabledNotificationLevel)},
+			},
+		},
+		"FailedCreation": {
+			err: errBoom,
+			cr:  settings(withProjectID(), withLevel("disabled")),
+			want: want{
+				cr: settings(
+					withProjectID(),
+					withLevel("disabled"),
+					withConditions(xpv1.Creating()),
+				),
+				opt: &gitlab.NotificationSettingsOptions{Level: gitlab.NotificationLevel(gitlab.DisabledNotificationLevel)},
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opt *gitlab.NotificationSettingsOptions
+			client := &fake.MockClient{
+				MockUpdateSettingsForProject: func(pid interface{}, o *gitlab.NotificationSettingsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error) {
+					opt = o
+					return &gitlab.NotificationSettings{}, &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{client: client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.opt, opt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"SuccessfulDeletion": {},
+		"FailedDeletion": {
+			err:  errBoom,
+			want: errors.Wrap(errBoom, errResetFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opt *gitlab.NotificationSettingsOptions
+			client := &fake.MockClient{
+				MockUpdateSettingsForProject: func(pid interface{}, o *gitlab.NotificationSettingsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.NotificationSettings, *gitlab.Response, error) {
+					opt = o
+					return &gitlab.NotificationSettings{}, &gitlab.Response{}, tc.err
+				},
+			}
+			e := &external{client: client}
+			cr := settings(withProjectID(), withExternalName(projectID), withLevel("disabled"))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(ptr.To(gitlab.GlobalNotificationLevel), opt.Level); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(settings(withProjectID(), withExternalName(projectID), withLevel("disabled"), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,12 +23,13 @@ import (
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -38,9 +39,22 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/operations"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/saas"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretversion"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/timeouts"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -50,6 +64,26 @@ const (
 	errUpdateFailed     = "cannot update Gitlab project"
 	errDeleteFailed     = "cannot delete Gitlab project"
 	errGetFailed        = "cannot retrieve Gitlab project with"
+	errGetMirrorFailed  = "cannot retrieve Gitlab project pull mirror details"
+	errMirrorPullFailed = "cannot start Gitlab project pull mirroring"
+	errRestoreFailed    = "cannot restore Gitlab project marked for deletion"
+	errImportPassword   = "cannot get password of Gitlab project import URL"
+	errImportURLInvalid = "cannot parse Gitlab project import URL"
+	errGetForkFailed    = "cannot retrieve Gitlab project fork settings"
+	errUpdateForkFailed = "cannot update Gitlab project fork settings"
+	errGetAttrsFailed   = "cannot list Gitlab project custom attributes"
+	errSetAttrFailed    = "cannot set Gitlab project custom attribute"
+	errDeleteAttrFailed = "cannot delete Gitlab project custom attribute"
+	errGetScanFailed    = "cannot retrieve Gitlab project security scanning variable"
+	errUpdateScanFailed = "cannot update Gitlab project security scanning variable"
+	errExportFailed     = "cannot export Gitlab project before deleting it"
+	errExportStatus     = "cannot retrieve export status of Gitlab project"
+	errExportPending    = "waiting for the export of the Gitlab project to finish before deleting it"
+	errExportBroken     = "export of Gitlab project failed, remove the " + v1alpha1.AnnotationKeyExportBeforeDelete + " annotation to delete it without export: %s"
+	errExportNoUpload   = "cannot export Gitlab project before deleting it without the " + v1alpha1.AnnotationKeyExportUploadURL + " annotation, Gitlab deletes exports together with their project"
+
+	reasonRestored event.Reason = "RestoredExternalResource"
+	reasonExported event.Reason = "ExportedExternalResource"
 )
 
 // SetupProject adds a controller that reconciles Projects.
@@ -61,12 +95,22 @@ func SetupProject(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	newGitlabClientFn := projects.NewProjectClient
+	if o.Features.Enabled(features.EnableProjectCache) {
+		cache := projects.NewProjectCache(o.PollInterval)
+		newGitlabClientFn = func(cfg clients.Config) projects.Client {
+			return cache.Client(cfg, projects.NewProjectClient(cfg))
+		}
+	}
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProjectClient}),
+		managed.WithExternalConnecter(timeouts.NewConnecter(protection.NewConnecter(saas.NewConnecter(mgr.GetClient(), maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.ProjectGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.ProjectGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, secretversion.NewConnecter(mgr.GetClient(), &connector{kube: mgr.GetClient(), recorder: recorder, newGitlabClientFn: newGitlabClientFn})))))))))))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -74,18 +118,26 @@ func SetupProject(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ProjectGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.Project{}).
-		Complete(r)
+		For(&v1alpha1.Project{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Project{}, &v1alpha1.ProjectList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
 	kube              client.Client
+	recorder          event.Recorder
 	newGitlabClientFn func(cfg clients.Config) projects.Client
 }
 
@@ -98,15 +150,16 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	return &external{kube: c.kube, recorder: c.recorder, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client projects.Client
+	kube     client.Client
+	recorder event.Recorder
+	client   projects.Client
 }
 
-func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
 	cr, ok := mg.(*v1alpha1.Project)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotProject)
@@ -122,7 +175,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotProject)
 	}
 
-	prj, res, err := e.client.GetProject(projectID, nil)
+	prj, res, err := e.client.GetProject(projectID, projects.GenerateGetProjectOptions(cr.Spec.Observation), gitlab.WithContext(ctx))
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -130,15 +183,65 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
+	// A project marked for deletion is restored by Update rather than
+	// recreated while the managed resource still wants it. If the managed
+	// resource is being deleted, the pending deletion is all we asked for.
+	if prj.MarkedForDeletionAt != nil && meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitialize(&cr.Spec.ForProvider, prj)
 
+	var lastPullRequest string
+	if cr.Status.AtProvider.PullMirror != nil {
+		lastPullRequest = cr.Status.AtProvider.PullMirror.LastPullRequest
+	}
+	export := cr.Status.AtProvider.Export
 	cr.Status.AtProvider = projects.GenerateObservation(prj)
-	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider.Export = export
+	if prj.Mirror {
+		mirror, _, err := e.client.GetProjectPullMirrorDetails(projectID, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetMirrorFailed)
+		}
+		cr.Status.AtProvider.PullMirror = projects.GeneratePullMirrorObservation(mirror, lastPullRequest)
+	}
+	operations.Observed(cr, operations.ImportPhase(prj.ImportStatus), prj.ImportError)
+
+	forkUpToDate := true
+	if projects.HasForkSettings(&cr.Spec.ForProvider) {
+		fs, _, err := e.client.GetProjectForkSettings(projectID, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetForkFailed)
+		}
+		forkUpToDate = projects.IsForkSettingsUpToDate(&cr.Spec.ForProvider, fs)
+	}
+
+	attrsUpToDate := true
+	if projects.HasCustomAttributes(&cr.Spec.ForProvider) {
+		attrs, _, err := e.client.ListCustomProjectAttributes(projectID, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetAttrsFailed)
+		}
+		attrsUpToDate = projects.DiffCustomAttributes(&cr.Spec.ForProvider, attrs).IsEmpty()
+	}
+
+	scanUpToDate := true
+	for _, sv := range projects.SecurityScanningVariables(&cr.Spec.ForProvider) {
+		v, err := e.getVariable(ctx, projectID, sv.Key)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetScanFailed)
+		}
+		if !projects.IsScanningVariableUpToDate(sv, v) {
+			scanUpToDate = false
+			break
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isProjectUpToDate(&cr.Spec.ForProvider, prj),
+		ResourceUpToDate:        prj.MarkedForDeletionAt == nil && isProjectUpToDate(&cr.Spec.ForProvider, prj) && forkUpToDate && attrsUpToDate && scanUpToDate && !(prj.Mirror && projects.IsMirrorPullRequested(cr)),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 		ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte(prj.RunnersToken)},
 	}, nil
@@ -150,10 +253,15 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotProject)
 	}
 
-	prj, _, err := e.client.CreateProject(
-		projects.GenerateCreateProjectOptions(cr.Name, &cr.Spec.ForProvider),
-		gitlab.WithContext(ctx),
-	)
+	opt := projects.GenerateCreateProjectOptions(cr.Name, &cr.Spec.ForProvider)
+	importURL, err := e.importURL(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	opt.ImportURL = importURL
+
+	operations.Started(cr)
+	prj, _, err := e.client.CreateProject(opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
@@ -168,13 +276,125 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotProject)
 	}
 
-	_, _, err := e.client.EditProject(
-		meta.GetExternalName(cr),
-		projects.GenerateEditProjectOptions(cr.Name, &cr.Spec.ForProvider),
-		gitlab.WithContext(ctx),
-	)
+	if cr.Status.AtProvider.MarkedForDeletionAt != nil {
+		if _, _, err := e.client.RestoreProject(meta.GetExternalName(cr), gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRestoreFailed)
+		}
+		cr.Status.AtProvider.MarkedForDeletionAt = nil
+		e.recorder.Event(cr, event.Normal(reasonRestored, "Restored Gitlab project marked for deletion"))
+	}
+
+	opt := projects.GenerateEditProjectOptions(cr.Name, &cr.Spec.ForProvider)
+	importURL, err := e.importURL(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	opt.ImportURL = importURL
+
+	_, _, err = e.client.EditProject(meta.GetExternalName(cr), opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	if projects.HasForkSettings(&cr.Spec.ForProvider) {
+		if _, _, err := e.client.EditProjectForkSettings(meta.GetExternalName(cr), projects.GenerateEditProjectForkSettingsOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateForkFailed)
+		}
+	}
+
+	if projects.HasCustomAttributes(&cr.Spec.ForProvider) {
+		if err := e.updateCustomAttributes(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	for _, sv := range projects.SecurityScanningVariables(&cr.Spec.ForProvider) {
+		if err := e.updateScanningVariable(ctx, meta.GetExternalName(cr), sv); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateScanFailed)
+		}
+	}
+
+	if cr.Status.AtProvider.PullMirror != nil && projects.IsMirrorPullRequested(cr) {
+		if _, err := e.client.StartMirroringProject(meta.GetExternalName(cr), gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errMirrorPullFailed)
+		}
+		cr.Status.AtProvider.PullMirror.LastPullRequest = cr.GetAnnotations()[v1alpha1.AnnotationKeyMirrorPull]
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// importURL returns the import URL of cr with the credentials to pull from
+// it embedded.
+func (e *external) importURL(ctx context.Context, cr *v1alpha1.Project) (*string, error) {
+	password := ""
+	if ref := cr.Spec.ForProvider.ImportURLPasswordSecretRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+			return nil, errors.Wrap(err, errImportPassword)
+		}
+		password = string(secret.Data[ref.Key])
+	}
+	u, err := projects.GenerateImportURL(&cr.Spec.ForProvider, password)
+	return u, errors.Wrap(err, errImportURLInvalid)
+}
+
+func (e *external) updateCustomAttributes(ctx context.Context, cr *v1alpha1.Project) error {
+	projectID, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errNotProject)
+	}
+
+	attrs, _, err := e.client.ListCustomProjectAttributes(projectID, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errGetAttrsFailed)
+	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	d := projects.DiffCustomAttributes(&cr.Spec.ForProvider, attrs)
+	for _, a := range d.Set {
+		if _, _, err := e.client.SetCustomProjectAttribute(projectID, gitlab.CustomAttribute{Key: a.Key, Value: a.Value}, gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrap(err, errSetAttrFailed)
+		}
+	}
+	for _, key := range d.Delete {
+		if res, err := e.client.DeleteCustomProjectAttribute(projectID, key, gitlab.WithContext(ctx)); err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrap(err, errDeleteAttrFailed)
+		}
+	}
+	return nil
+}
+
+// getVariable returns the project variable with the given key, or nil if
+// there is none.
+func (e *external) getVariable(ctx context.Context, pid interface{}, key string) (*gitlab.ProjectVariable, error) {
+	v, res, err := e.client.GetVariable(pid, key, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+func (e *external) updateScanningVariable(ctx context.Context, pid interface{}, sv projects.ScanningVariable) error {
+	v, err := e.getVariable(ctx, pid, sv.Key)
+	if err != nil || projects.IsScanningVariableUpToDate(sv, v) {
+		return err
+	}
+
+	switch {
+	case !sv.Disabled:
+		res, err := e.client.RemoveVariable(pid, sv.Key, nil, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return err
+		}
+	case v == nil:
+		_, _, err = e.client.CreateVariable(pid, &gitlab.CreateProjectVariableOptions{Key: &sv.Key, Value: gitlab.String("true")}, gitlab.WithContext(ctx))
+	default:
+		_, _, err = e.client.UpdateVariable(pid, sv.Key, &gitlab.UpdateProjectVariableOptions{Value: gitlab.String("true")}, gitlab.WithContext(ctx))
+	}
+	return err
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -183,10 +403,53 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotProject)
 	}
 
+	if cr.GetAnnotations()[v1alpha1.AnnotationKeyExportBeforeDelete] == "true" {
+		if cr.GetAnnotations()[v1alpha1.AnnotationKeyExportUploadURL] == "" {
+			return errors.New(errExportNoUpload)
+		}
+		if err := e.export(ctx, cr); err != nil {
+			return err
+		}
+	}
+
 	_, err := e.client.DeleteProject(meta.GetExternalName(cr), gitlab.WithContext(ctx))
 	return errors.Wrap(err, errDeleteFailed)
 }
 
+// export exports the project to its upload URL before it is deleted. It
+// returns an error until the export finished, which keeps the deletion
+// pending meanwhile.
+func (e *external) export(ctx context.Context, cr *v1alpha1.Project) error {
+	pid := meta.GetExternalName(cr)
+
+	if ex := cr.Status.AtProvider.Export; ex != nil && ex.RequestedAt != nil {
+		s, _, err := e.client.ExportStatus(pid, gitlab.WithContext(ctx))
+		if err != nil {
+			return errors.Wrap(err, errExportStatus)
+		}
+		ex.Status = s.ExportStatus
+		switch s.ExportStatus {
+		case projects.ExportStatusFinished:
+			e.recorder.Event(cr, event.Normal(reasonExported, "Exported Gitlab project to its export upload URL before deleting it"))
+			return nil
+		case projects.ExportStatusFailed:
+			return errors.Errorf(errExportBroken, s.Message)
+		case projects.ExportStatusNone:
+			// The scheduled export is gone, schedule it again.
+		default:
+			return errors.New(errExportPending)
+		}
+	}
+
+	opt := projects.GenerateScheduleExportOptions(cr.GetAnnotations()[v1alpha1.AnnotationKeyExportUploadURL])
+	if _, err := e.client.ScheduleExport(pid, opt, gitlab.WithContext(ctx)); err != nil {
+		return errors.Wrap(err, errExportFailed)
+	}
+	now := metav1.Now()
+	cr.Status.AtProvider.Export = &v1alpha1.ProjectExportObservation{RequestedAt: &now, Status: projects.ExportStatusQueued}
+	return errors.New(errExportPending)
+}
+
 // lateInitialize fills the empty fields in the project spec with the
 // values seen in gitlab.Project.
 func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { // nolint:gocyclo
@@ -203,6 +466,13 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 		in.AutocloseReferencedIssues = &project.AutocloseReferencedIssues
 	}
 
+	in.AutoCancelPendingPipelines = clients.LateInitializeStringPtr(in.AutoCancelPendingPipelines, project.AutoCancelPendingPipelines)
+	in.AutoDevopsDeployStrategy = clients.LateInitializeStringPtr(in.AutoDevopsDeployStrategy, project.AutoDevopsDeployStrategy)
+
+	if in.AutoDevopsEnabled == nil {
+		in.AutoDevopsEnabled = &project.AutoDevopsEnabled
+	}
+
 	in.BuildCoverageRegex = clients.LateInitializeStringPtr(in.BuildCoverageRegex, project.BuildCoverageRegex)
 	in.BuildsAccessLevel = clients.LateInitializeAccessControlValue(in.BuildsAccessLevel, project.BuildsAccessLevel)
 	in.CIConfigPath = clients.LateInitializeStringPtr(in.CIConfigPath, project.CIConfigPath)
@@ -213,8 +483,10 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 	if in.CIForwardDeploymentEnabled == nil {
 		in.CIForwardDeploymentEnabled = &project.CIForwardDeploymentEnabled
 	}
+	// Specs predating ContainerRegistryAccessLevel keep the deprecated field
+	// they were late initialized with.
 	if in.ContainerRegistryEnabled == nil {
-		in.ContainerRegistryEnabled = &project.ContainerRegistryEnabled
+		in.ContainerRegistryAccessLevel = clients.LateInitializeAccessControlValue(in.ContainerRegistryAccessLevel, project.ContainerRegistryAccessLevel)
 	}
 
 	in.DefaultBranch = clients.LateInitializeStringPtr(in.DefaultBranch, project.DefaultBranch)
@@ -230,6 +502,7 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 	in.MergeMethod = clients.LateInitializeMergeMethodValue(in.MergeMethod, project.MergeMethod)
 	in.MergeRequestsAccessLevel = clients.LateInitializeAccessControlValue(in.MergeRequestsAccessLevel, project.MergeRequestsAccessLevel)
 	in.MergeRequestsTemplate = clients.LateInitializeStringPtr(in.MergeRequestsTemplate, project.MergeRequestsTemplate)
+	in.MergeCommitTemplate = clients.LateInitializeStringPtr(in.MergeCommitTemplate, project.MergeCommitTemplate)
 
 	if in.Mirror == nil {
 		in.Mirror = &project.Mirror
@@ -262,7 +535,7 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 	in.PagesAccessLevel = clients.LateInitializeAccessControlValue(in.PagesAccessLevel, project.PagesAccessLevel)
 	in.Path = clients.LateInitializeStringPtr(in.Path, project.Path)
 
-	if in.PublicBuilds == nil {
+	if in.PublicBuilds == nil && in.PublicJobs == nil {
 		in.PublicBuilds = &project.PublicJobs
 	}
 	if in.RemoveSourceBranchAfterMerge == nil {
@@ -285,6 +558,7 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 	}
 
 	in.SnippetsAccessLevel = clients.LateInitializeAccessControlValue(in.SnippetsAccessLevel, project.SnippetsAccessLevel)
+	in.SquashCommitTemplate = clients.LateInitializeStringPtr(in.SquashCommitTemplate, project.SquashCommitTemplate)
 	in.SuggestionCommitMessage = clients.LateInitializeStringPtr(in.SuggestionCommitMessage, project.SuggestionCommitMessage)
 
 	if len(in.TagList) == 0 && len(project.TagList) > 0 {
@@ -309,6 +583,15 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsBoolEqualToBoolPtr(p.AutocloseReferencedIssues, g.AutocloseReferencedIssues) {
 		return false
 	}
+	if p.AutoCancelPendingPipelines != nil && *p.AutoCancelPendingPipelines != g.AutoCancelPendingPipelines {
+		return false
+	}
+	if p.AutoDevopsDeployStrategy != nil && *p.AutoDevopsDeployStrategy != g.AutoDevopsDeployStrategy {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.AutoDevopsEnabled, g.AutoDevopsEnabled) {
+		return false
+	}
 	if !cmp.Equal(p.BuildCoverageRegex, clients.StringToPtr(g.BuildCoverageRegex)) {
 		return false
 	}
@@ -324,7 +607,13 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsBoolEqualToBoolPtr(p.CIForwardDeploymentEnabled, g.CIForwardDeploymentEnabled) {
 		return false
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.ContainerRegistryEnabled, g.ContainerRegistryEnabled) {
+	if !projects.IsContainerExpirationPolicyUpToDate(p.ContainerExpirationPolicyAttributes, g.ContainerExpirationPolicy) {
+		return false
+	}
+	if p.ContainerRegistryAccessLevel != nil && !cmp.Equal(string(*p.ContainerRegistryAccessLevel), string(g.ContainerRegistryAccessLevel)) {
+		return false
+	}
+	if p.ContainerRegistryAccessLevel == nil && !clients.IsBoolEqualToBoolPtr(p.ContainerRegistryEnabled, g.ContainerRegistryEnabled) {
 		return false
 	}
 	if !cmp.Equal(p.DefaultBranch, clients.StringToPtr(g.DefaultBranch)) {
@@ -333,6 +622,9 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !cmp.Equal(p.Description, clients.StringToPtr(g.Description)) {
 		return false
 	}
+	if p.EmailsEnabled != nil && *p.EmailsEnabled == g.EmailsDisabled {
+		return false
+	}
 	if p.ForkingAccessLevel != nil && !cmp.Equal(string(*p.ForkingAccessLevel), string(g.ForkingAccessLevel)) {
 		return false
 	}
@@ -345,6 +637,9 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsBoolEqualToBoolPtr(p.LFSEnabled, g.LFSEnabled) {
 		return false
 	}
+	if !cmp.Equal(p.MergeCommitTemplate, clients.StringToPtr(g.MergeCommitTemplate)) {
+		return false
+	}
 	if p.MergeMethod != nil && !cmp.Equal(string(*p.MergeMethod), string(g.MergeMethod)) {
 		return false
 	}
@@ -384,10 +679,10 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if p.PagesAccessLevel != nil && !cmp.Equal(string(*p.PagesAccessLevel), string(g.PagesAccessLevel)) {
 		return false
 	}
-	if !cmp.Equal(p.Path, clients.StringToPtr(g.Path)) {
+	if !cmp.Equal(p.Path, clients.StringToPtr(g.Path), clients.EquateNormalized(clients.NormalizePath)) {
 		return false
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.PublicBuilds, g.PublicJobs) {
+	if !clients.IsBoolEqualToBoolPtr(projects.PublicJobs(p), g.PublicJobs) {
 		return false
 	}
 	if !clients.IsBoolEqualToBoolPtr(p.RemoveSourceBranchAfterMerge, g.RemoveSourceBranchAfterMerge) {
@@ -396,6 +691,10 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if p.RepositoryAccessLevel != nil && !cmp.Equal(string(*p.RepositoryAccessLevel), string(g.RepositoryAccessLevel)) {
 		return false
 	}
+	// Gitlab only returns the repository storage to administrators.
+	if p.RepositoryStorage != nil && g.RepositoryStorage != "" && *p.RepositoryStorage != g.RepositoryStorage {
+		return false
+	}
 	if !clients.IsBoolEqualToBoolPtr(p.RequestAccessEnabled, g.RequestAccessEnabled) {
 		return false
 	}
@@ -411,10 +710,13 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if p.SnippetsAccessLevel != nil && !cmp.Equal(string(*p.SnippetsAccessLevel), string(g.SnippetsAccessLevel)) {
 		return false
 	}
+	if !cmp.Equal(p.SquashCommitTemplate, clients.StringToPtr(g.SquashCommitTemplate)) {
+		return false
+	}
 	if !cmp.Equal(p.SuggestionCommitMessage, clients.StringToPtr(g.SuggestionCommitMessage)) {
 		return false
 	}
-	if !cmp.Equal(p.TagList, g.TagList, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(clients.NormalizeStringList(p.TagList), clients.NormalizeStringList(g.TagList)) {
 		return false
 	}
 	if p.Visibility != nil && !cmp.Equal(string(*p.Visibility), string(g.Visibility)) {
@@ -0,0 +1,373 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectshares
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	groupID   = "5"
+	expiresAt = gitlab.ISOTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+)
+
+type shareModifier func(*v1alpha1.ProjectShare)
+
+func withConditions(c ...xpv1.Condition) shareModifier {
+	return func(r *v1alpha1.ProjectShare) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() shareModifier {
+	return func(r *v1alpha1.ProjectShare) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withGroupID(id string) shareModifier {
+	return func(r *v1alpha1.ProjectShare) { r.Spec.ForProvider.GroupID = &id }
+}
+
+func withGroupAccess(l int) shareModifier {
+	return func(r *v1alpha1.ProjectShare) { r.Spec.ForProvider.GroupAccess = v1alpha1.AccessLevelValue(l) }
+}
+
+func withExpiresAt(d string) shareModifier {
+	return func(r *v1alpha1.ProjectShare) { r.Spec.ForProvider.ExpiresAt = &d }
+}
+
+func withExternalName(n string) shareModifier {
+	return func(r *v1alpha1.ProjectShare) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.ProjectShareObservation) shareModifier {
+	return func(r *v1alpha1.ProjectShare) { r.Status.AtProvider = s }
+}
+
+func share(m ...shareModifier) *v1alpha1.ProjectShare {
+	cr := &v1alpha1.ProjectShare{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func sharedWithGroups() []*projects.SharedWithGroup {
+	return []*projects.SharedWithGroup{
+		{GroupID: 6, GroupName: "ops", GroupFullPath: "org/ops", GroupAccessLevel: 20},
+		{GroupID: 5, GroupName: "devs", GroupFullPath: "org/devs", GroupAccessLevel: 30, ExpiresAt: &expiresAt},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProjectShare
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := v1alpha1.ProjectShareObservation{
+		GroupName:        "devs",
+		GroupFullPath:    "org/devs",
+		GroupAccessLevel: 30,
+		ExpiresAt:        "2030-01-01",
+	}
+	list := func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]*projects.SharedWithGroup, *gitlab.Response, error) {
+		return sharedWithGroups(), &gitlab.Response{}, nil
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ProjectShare
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: share(withGroupID(groupID)),
+			want: want{
+				cr:  share(withGroupID(groupID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"GroupIDMissing": {
+			cr: share(withProjectID()),
+			want: want{
+				cr:  share(withProjectID()),
+				err: errors.New(errGroupIDMissing),
+			},
+		},
+		"GroupIDNotAnInt": {
+			cr: share(withProjectID(), withGroupID("devs")),
+			want: want{
+				cr:  share(withProjectID(), withGroupID("devs")),
+				err: errors.Wrap(errors.New(`strconv.Atoi: parsing "devs": invalid syntax`), errGroupIDNotAnInt),
+			},
+		},
+		"ProjectNotFound": {
+			client: &fake.MockClient{
+				MockListProjectSharedWithGroups: func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]*projects.SharedWithGroup, *gitlab.Response, error) {
+					return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+				},
+			},
+			cr: share(withProjectID(), withGroupID(groupID)),
+			want: want{
+				cr: share(withProjectID(), withGroupID(groupID)),
+			},
+		},
+		"FailedGet": {
+			client: &fake.MockClient{
+				MockListProjectSharedWithGroups: func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]*projects.SharedWithGroup, *gitlab.Response, error) {
+					return nil, &gitlab.Response{}, errBoom
+				},
+			},
+			cr: share(withProjectID(), withGroupID(groupID)),
+			want: want{
+				cr:  share(withProjectID(), withGroupID(groupID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"NotShared": {
+			client: &fake.MockClient{MockListProjectSharedWithGroups: list},
+			cr:     share(withProjectID(), withGroupID("7")),
+			want: want{
+				cr: share(withProjectID(), withGroupID("7")),
+			},
+		},
+		"Adopted": {
+			client: &fake.MockClient{MockListProjectSharedWithGroups: list},
+			cr:     share(withProjectID(), withGroupID(groupID), withGroupAccess(30), withExpiresAt("2030-01-01")),
+			want: want{
+				cr: share(
+					withProjectID(),
+					withGroupID(groupID),
+					withGroupAccess(30),
+					withExpiresAt("2030-01-01"),
+					withExternalName(groupID),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"AccessLevelChanged": {
+			client: &fake.MockClient{MockListProjectSharedWithGroups: list},
+			cr:     share(withProjectID(), withGroupID(groupID), withGroupAccess(40), withExpiresAt("2030-01-01"), withExternalName(groupID)),
+			want: want{
+				cr: share(
+					withProjectID(),
+					withGroupID(groupID),
+					withGroupAccess(40),
+					withExpiresAt("2030-01-01"),
+					withExternalName(groupID),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ProjectShare
+		opt *gitlab.ShareWithGroupOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		err error
+		cr  *v1alpha1.ProjectShare
+		want
+	}{
+		"ProjectIDMissing": {
+			cr: share(withGroupID(groupID)),
+			want: want{
+				cr:  share(withGroupID(groupID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			cr: share(withProjectID(), withGroupID(groupID), withGroupAccess(30), withExpiresAt("2030-01-01")),
+			want: want{
+				cr: share(
+					withProjectID(),
+					withGroupID(groupID),
+					withGroupAccess(30),
+					withExpiresAt("2030-01-01"),
+					withExternalName(groupID),
+					withConditions(xpv1.Creating()),
+				),
+				opt: &gitlab.ShareWithGroupOptions{
+					GroupID:     ptr.To(5),
+					GroupAccess: ptr.To(gitlab.DeveloperPermissions),
+					ExpiresAt:   ptr.To("2030-01-01"),
+				},
+			},
+		},
+		"FailedCreation": {
+			err: errBoom,
+			cr:  share(withProjectID(), withGroupID(groupID), withGroupAccess(30)),
+			want: want{
+				cr: share(withProjectID(), withGroupID(groupID), withGroupAccess(30), withConditions(xpv1.Creating())),
+				opt: &gitlab.ShareWithGroupOptions{
+					GroupID:     ptr.To(5),
+					GroupAccess: ptr.To(gitlab.DeveloperPermissions),
+				},
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opt *gitlab.ShareWithGroupOptions
+			e := &external{client: &fake.MockClient{
+				MockShareProjectWithGroup: func(pid interface{}, o *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					opt = o
+					return &gitlab.Response{}, tc.err
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.opt, opt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		deleteErr error
+		shareErr  error
+		want
+	}{
+		"SharedAgain": {
+			want: want{
+				calls: []string{"delete", "share"},
+			},
+		},
+		"FailedUnshare": {
+			deleteErr: errBoom,
+			want: want{
+				calls: []string{"delete"},
+				err:   errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"FailedShare": {
+			shareErr: errBoom,
+			want: want{
+				calls: []string{"delete", "share"},
+				err:   errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &fake.MockClient{
+				MockDeleteSharedProjectFromGroup: func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					calls = append(calls, "delete")
+					return &gitlab.Response{}, tc.deleteErr
+				},
+				MockShareProjectWithGroup: func(pid interface{}, o *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					calls = append(calls, "share")
+					return &gitlab.Response{}, tc.shareErr
+				},
+			}}
+			_, err := e.Update(context.Background(), share(withProjectID(), withGroupID(groupID), withGroupAccess(40), withExternalName(groupID)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	var id int
+	cr := share(withProjectID(), withGroupID(groupID), withExternalName(groupID))
+	e := &external{client: &fake.MockClient{
+		MockDeleteSharedProjectFromGroup: func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			id = groupID
+			return &gitlab.Response{}, nil
+		},
+	}}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(5, id); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(share(withProjectID(), withGroupID(groupID), withExternalName(groupID), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protectedbranchsets
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotProtectedBranchSet = "managed resource is not a Gitlab project protected branch set custom resource"
+	errProjectIDMissing      = "ProjectID is missing"
+	errListFailed            = "cannot list Gitlab project protected branches"
+	errProtectFailed         = "cannot protect Gitlab project branch %q"
+	errUpdateFailed          = "cannot update Gitlab project protected branch %q"
+	errUnprotectFailed       = "cannot unprotect Gitlab project branch %q"
+)
+
+// SetupProtectedBranchSet adds a controller that reconciles
+// ProtectedBranchSets.
+func SetupProtectedBranchSet(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProtectedBranchSetKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.ProtectedBranchSetGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.ProtectedBranchSetGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProtectedBranchClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProtectedBranchSetGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ProtectedBranchSet{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.ProtectedBranchSet{}, &v1alpha1.ProtectedBranchSetList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ProtectedBranchClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedBranchSet)
+	if !ok {
+		return nil, errors.New(errNotProtectedBranchSet)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ProtectedBranchClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedBranchSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProtectedBranchSet)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	branches, err := projects.ListProjectProtectedBranches(e.client, *cr.Spec.ForProvider.ProjectID, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateProtectedBranchSetObservation(branches)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.DiffProtectedBranchSet(&cr.Spec.ForProvider, branches).IsEmpty(),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedBranchSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProtectedBranchSet)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.ProjectID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedBranchSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProtectedBranchSet)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProtectedBranchSet)
+	if !ok {
+		return errors.New(errNotProtectedBranchSet)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	for _, bp := range cr.Spec.ForProvider.Protections {
+		res, err := e.client.UnprotectRepositoryBranches(*cr.Spec.ForProvider.ProjectID, bp.Name, gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errUnprotectFailed, bp.Name)
+		}
+	}
+	return nil
+}
+
+// apply protects, updates and unprotects project branches until they match
+// the spec. Undeclared protections are removed last, so the branches are
+// never less protected than declared while the set is applied.
+func (e *external) apply(ctx context.Context, cr *v1alpha1.ProtectedBranchSet) error {
+	pid := *cr.Spec.ForProvider.ProjectID
+
+	branches, err := projects.ListProjectProtectedBranches(e.client, pid, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errListFailed)
+	}
+
+	d := projects.DiffProtectedBranchSet(&cr.Spec.ForProvider, branches)
+	for i := range d.Protect {
+		if _, _, err := e.client.ProtectRepositoryBranches(pid, projects.GenerateProtectBranchOptions(&d.Protect[i]), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errProtectFailed, d.Protect[i].Name)
+		}
+	}
+	for i := range d.Update {
+		u := &d.Update[i]
+		if _, _, err := e.client.UpdateProtectedBranch(pid, u.Protection.Name, projects.GenerateUpdateProtectedBranchOptions(&u.Protection, u.Current), gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errUpdateFailed, u.Protection.Name)
+		}
+	}
+	for _, name := range d.Unprotect {
+		if res, err := e.client.UnprotectRepositoryBranches(pid, name, gitlab.WithContext(ctx)); err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrapf(err, errUnprotectFailed, name)
+		}
+	}
+	return nil
+}
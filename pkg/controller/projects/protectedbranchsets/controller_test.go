@@ -0,0 +1,267 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protectedbranchsets
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+
+	main    = v1alpha1.BranchProtection{Name: "main"}
+	release = v1alpha1.BranchProtection{Name: "release/*"}
+
+	maintainers = []*gitlab.BranchAccessDescription{{AccessLevel: gitlab.MaintainerPermissions}}
+	mainBranch  = &gitlab.ProtectedBranch{ID: 1, Name: "main", PushAccessLevels: maintainers, MergeAccessLevels: maintainers, UnprotectAccessLevels: maintainers}
+	manual      = &gitlab.ProtectedBranch{ID: 2, Name: "feature/*", PushAccessLevels: maintainers, MergeAccessLevels: maintainers, UnprotectAccessLevels: maintainers, AllowForcePush: true}
+)
+
+type args struct {
+	client projects.ProtectedBranchClient
+	cr     *v1alpha1.ProtectedBranchSet
+}
+
+type protectedBranchSetModifier func(*v1alpha1.ProtectedBranchSet)
+
+func withConditions(c ...xpv1.Condition) protectedBranchSetModifier {
+	return func(r *v1alpha1.ProtectedBranchSet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() protectedBranchSetModifier {
+	return func(r *v1alpha1.ProtectedBranchSet) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withProtections(p ...v1alpha1.BranchProtection) protectedBranchSetModifier {
+	return func(r *v1alpha1.ProtectedBranchSet) { r.Spec.ForProvider.Protections = p }
+}
+
+func withExternalName(n string) protectedBranchSetModifier {
+	return func(r *v1alpha1.ProtectedBranchSet) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.ProtectedBranchSetObservation) protectedBranchSetModifier {
+	return func(r *v1alpha1.ProtectedBranchSet) { r.Status.AtProvider = s }
+}
+
+func protectedBranchSet(m ...protectedBranchSetModifier) *v1alpha1.ProtectedBranchSet {
+	cr := &v1alpha1.ProtectedBranchSet{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listBranches(b ...*gitlab.ProtectedBranch) func(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return func(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error) {
+		return b, &gitlab.Response{}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProtectedBranchSet
+		result managed.ExternalObservation
+		err    error
+	}
+
+	maintainer := []v1alpha1.AccessLevelValue{v1alpha1.AccessLevelValue(gitlab.MaintainerPermissions)}
+	mainObservation := v1alpha1.BranchProtectionObservation{ID: 1, Name: "main", PushAccessLevels: maintainer, MergeAccessLevels: maintainer, UnprotectAccessLevels: maintainer}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: protectedBranchSet(withProjectID()),
+			},
+			want: want{
+				cr: protectedBranchSet(withProjectID()),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockListProtectedBranches: listBranches(mainBranch),
+				},
+				cr: protectedBranchSet(withProjectID(), withExternalName(projectID), withProtections(main)),
+			},
+			want: want{
+				cr: protectedBranchSet(
+					withProjectID(),
+					withExternalName(projectID),
+					withProtections(main),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProtectedBranchSetObservation{Protections: []v1alpha1.BranchProtectionObservation{mainObservation}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDateWithManualProtection": {
+			args: args{
+				client: &fake.MockClient{
+					MockListProtectedBranches: listBranches(mainBranch, manual),
+				},
+				cr: protectedBranchSet(withProjectID(), withExternalName(projectID), withProtections(main)),
+			},
+			want: want{
+				cr: protectedBranchSet(
+					withProjectID(),
+					withExternalName(projectID),
+					withProtections(main),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProtectedBranchSetObservation{Protections: []v1alpha1.BranchProtectionObservation{
+						mainObservation,
+						{ID: 2, Name: "feature/*", PushAccessLevels: maintainer, MergeAccessLevels: maintainer, UnprotectAccessLevels: maintainer, AllowForcePush: true},
+					}}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ErrList": {
+			args: args{
+				client: &fake.MockClient{
+					MockListProtectedBranches: func(pid interface{}, opt *gitlab.ListProtectedBranchesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProtectedBranch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedBranchSet(withProjectID(), withExternalName(projectID)),
+			},
+			want: want{
+				cr:  protectedBranchSet(withProjectID(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errListFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var calls []string
+	e := &external{client: &fake.MockClient{
+		MockListProtectedBranches: listBranches(
+			&gitlab.ProtectedBranch{ID: 1, Name: "main", PushAccessLevels: maintainers, MergeAccessLevels: maintainers, UnprotectAccessLevels: maintainers, AllowForcePush: true},
+			manual,
+		),
+		MockProtectRepositoryBranches: func(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+			calls = append(calls, "protect "+*opt.Name)
+			return &gitlab.ProtectedBranch{}, &gitlab.Response{}, nil
+		},
+		MockUpdateProtectedBranch: func(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+			calls = append(calls, "update "+branch)
+			return &gitlab.ProtectedBranch{}, &gitlab.Response{}, nil
+		},
+		MockUnprotectRepositoryBranches: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			calls = append(calls, "unprotect "+branch)
+			return &gitlab.Response{}, nil
+		},
+	}}
+
+	_, err := e.Update(context.Background(), protectedBranchSet(withProjectID(), withExternalName(projectID), withProtections(main, release)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"protect release/*", "update main", "unprotect feature/*"}
+	if diff := cmp.Diff(want, calls); diff != "" {
+		t.Errorf("calls: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ProtectedBranchSet
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockUnprotectRepositoryBranches: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedBranchSet(withProjectID(), withProtections(main)),
+			},
+			want: want{
+				cr: protectedBranchSet(withProjectID(), withProtections(main), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockUnprotectRepositoryBranches: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedBranchSet(withProjectID(), withProtections(main)),
+			},
+			want: want{
+				cr:  protectedBranchSet(withProjectID(), withProtections(main), withConditions(xpv1.Deleting())),
+				err: errors.Wrapf(errBoom, errUnprotectFailed, "main"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releases
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotRelease         = "managed resource is not a Gitlab project release custom resource"
+	errProjectIDMissing   = "ProjectID is missing"
+	errGetFailed          = "cannot get Gitlab project release"
+	errCreateFailed       = "cannot create Gitlab project release"
+	errUpdateFailed       = "cannot update Gitlab project release"
+	errDeleteFailed       = "cannot delete Gitlab project release"
+	errCreateLinkFailed   = "cannot create asset link %q of Gitlab project release"
+	errUpdateLinkFailed   = "cannot update asset link %q of Gitlab project release"
+	errDeleteLinkFailed   = "cannot delete asset link %d of Gitlab project release"
+	errReleaseNotReturned = "Gitlab did not return the updated project release"
+)
+
+// SetupRelease adds a controller that reconciles Releases.
+func SetupRelease(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ReleaseKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.ReleaseGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.ReleaseGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewReleaseClient}))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ReleaseGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Release{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Release{}, &v1alpha1.ReleaseList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ReleaseClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return nil, errors.New(errNotRelease)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client projects.ReleaseClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRelease)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	rel, res, err := e.client.GetReleaseDetails(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.TagName, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeRelease(&cr.Spec.ForProvider, &rel.Release)
+
+	cr.Status.AtProvider = projects.GenerateReleaseObservation(rel)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsReleaseUpToDate(&cr.Spec.ForProvider, rel),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRelease)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	_, _, err := e.client.CreateRelease(*cr.Spec.ForProvider.ProjectID, projects.GenerateCreateReleaseOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRelease)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	pid := *cr.Spec.ForProvider.ProjectID
+	tag := cr.Spec.ForProvider.TagName
+
+	rel, _, err := e.client.UpdateRelease(pid, tag, projects.GenerateUpdateReleaseOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	if rel == nil {
+		return managed.ExternalUpdate{}, errors.New(errReleaseNotReturned)
+	}
+
+	// Links are matched by name, which Gitlab requires to be unique within
+	// a release, so stale links are removed first to free their names.
+	d := projects.DiffReleaseAssetLinks(&cr.Spec.ForProvider, rel)
+	for _, id := range d.Delete {
+		if _, _, err := e.client.DeleteReleaseLink(pid, tag, id, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errDeleteLinkFailed, id)
+		}
+	}
+	for i := range d.Update {
+		u := &d.Update[i]
+		if _, _, err := e.client.UpdateReleaseLink(pid, tag, u.ID, projects.GenerateUpdateReleaseLinkOptions(&u.Link), gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateLinkFailed, u.Link.Name)
+		}
+	}
+	for i := range d.Create {
+		if _, _, err := e.client.CreateReleaseLink(pid, tag, projects.GenerateCreateReleaseLinkOptions(&d.Create[i]), gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errCreateLinkFailed, d.Create[i].Name)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return errors.New(errNotRelease)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	// Deleting a release keeps its tag.
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, res, err := e.client.DeleteRelease(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.TagName, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	return nil
+}
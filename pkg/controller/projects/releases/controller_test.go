@@ -0,0 +1,389 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releases
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	tagName   = "v1.0.0"
+
+	runbook = v1alpha1.ReleaseAssetLink{Name: "runbook", URL: "https://example.com/runbook"}
+)
+
+type args struct {
+	client projects.ReleaseClient
+	cr     *v1alpha1.Release
+}
+
+type releaseModifier func(*v1alpha1.Release)
+
+func withConditions(c ...xpv1.Condition) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() releaseModifier {
+	return func(r *v1alpha1.Release) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withName(n string) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Spec.ForProvider.Name = &n }
+}
+
+func withMilestones(m ...string) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Spec.ForProvider.Milestones = m }
+}
+
+func withAssetLinks(l ...v1alpha1.ReleaseAssetLink) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Spec.ForProvider.AssetLinks = l }
+}
+
+func withStatus(s v1alpha1.ReleaseObservation) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Status.AtProvider = s }
+}
+
+func release(m ...releaseModifier) *v1alpha1.Release {
+	cr := &v1alpha1.Release{Spec: v1alpha1.ReleaseSpec{ForProvider: v1alpha1.ReleaseParameters{TagName: tagName}}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func gitlabRelease(links ...*gitlab.ReleaseLink) *gitlab.Release {
+	r := &gitlab.Release{TagName: tagName, Name: tagName}
+	r.Commit.ID = "abc"
+	r.Assets.Links = links
+	return r
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Release
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ProjectIDMissing": {
+			args: args{
+				cr: release(),
+			},
+			want: want{
+				cr:  release(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetReleaseDetails: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.ReleaseDetails, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: release(withProjectID()),
+			},
+			want: want{
+				cr: release(withProjectID()),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetReleaseDetails: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.ReleaseDetails, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: release(withProjectID()),
+			},
+			want: want{
+				cr:  release(withProjectID()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitAndUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetReleaseDetails: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.ReleaseDetails, *gitlab.Response, error) {
+						return &projects.ReleaseDetails{Release: *gitlabRelease()}, &gitlab.Response{}, nil
+					},
+				},
+				cr: release(withProjectID()),
+			},
+			want: want{
+				cr: release(
+					withProjectID(),
+					withName(tagName),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ReleaseObservation{CommitID: "abc"}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"MilestonesChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetReleaseDetails: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.ReleaseDetails, *gitlab.Response, error) {
+						return &projects.ReleaseDetails{
+							Release:    *gitlabRelease(),
+							Milestones: []*projects.ReleaseMilestone{{ID: 1, Title: "v1.0"}},
+							Evidences:  []*projects.ReleaseEvidence{{SHA: "e1"}},
+						}, &gitlab.Response{}, nil
+					},
+				},
+				cr: release(withProjectID(), withName(tagName), withMilestones("v1.0", "v1.1")),
+			},
+			want: want{
+				cr: release(
+					withProjectID(),
+					withName(tagName),
+					withMilestones("v1.0", "v1.1"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ReleaseObservation{CommitID: "abc", Milestones: []string{"v1.0"}, EvidenceSHA: "e1"}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LinkMissing": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetReleaseDetails: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.ReleaseDetails, *gitlab.Response, error) {
+						return &projects.ReleaseDetails{Release: *gitlabRelease()}, &gitlab.Response{}, nil
+					},
+				},
+				cr: release(withProjectID(), withName(tagName), withAssetLinks(runbook)),
+			},
+			want: want{
+				cr: release(
+					withProjectID(),
+					withName(tagName),
+					withAssetLinks(runbook),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ReleaseObservation{CommitID: "abc"}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var got *gitlab.CreateReleaseOptions
+
+	cases := map[string]struct {
+		args
+		want    *gitlab.CreateReleaseOptions
+		wantErr error
+	}{
+		"SuccessfulWithLinks": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateRelease: func(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						got = opts
+						return gitlabRelease(), &gitlab.Response{}, nil
+					},
+				},
+				cr: release(withProjectID(), withAssetLinks(runbook)),
+			},
+			want: &gitlab.CreateReleaseOptions{
+				TagName: &tagName,
+				Assets: &gitlab.ReleaseAssetsOptions{Links: []*gitlab.ReleaseAssetLinkOptions{
+					{Name: ptr.To("runbook"), URL: ptr.To("https://example.com/runbook")},
+				}},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateRelease: func(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						got = opts
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: release(withProjectID()),
+			},
+			want:    &gitlab.CreateReleaseOptions{TagName: &tagName},
+			wantErr: errors.Wrap(errBoom, errCreateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.args.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type calls struct {
+		created []string
+		updated []int
+		deleted []int
+	}
+	var got calls
+
+	client := func(r *gitlab.Release, updateErr error) *fake.MockClient {
+		return &fake.MockClient{
+			MockUpdateRelease: func(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+				return r, &gitlab.Response{}, updateErr
+			},
+			MockCreateReleaseLink: func(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+				got.created = append(got.created, *opt.Name)
+				return &gitlab.ReleaseLink{}, &gitlab.Response{}, nil
+			},
+			MockUpdateReleaseLink: func(pid interface{}, tagName string, link int, opt *gitlab.UpdateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+				got.updated = append(got.updated, link)
+				return &gitlab.ReleaseLink{}, &gitlab.Response{}, nil
+			},
+			MockDeleteReleaseLink: func(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+				got.deleted = append(got.deleted, link)
+				return &gitlab.ReleaseLink{}, &gitlab.Response{}, nil
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want    calls
+		wantErr error
+	}{
+		"SyncsLinks": {
+			args: args{
+				client: client(gitlabRelease(
+					&gitlab.ReleaseLink{ID: 1, Name: "runbook", URL: "https://example.com/old"},
+					&gitlab.ReleaseLink{ID: 2, Name: "stale", URL: "https://example.com/stale"},
+				), nil),
+				cr: release(withProjectID(), withAssetLinks(runbook, v1alpha1.ReleaseAssetLink{Name: "binary", URL: "https://example.com/binary"})),
+			},
+			want: calls{created: []string{"binary"}, updated: []int{1}, deleted: []int{2}},
+		},
+		"Failed": {
+			args: args{
+				client: client(nil, errBoom),
+				cr:     release(withProjectID()),
+			},
+			wantErr: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = calls{}
+			e := &external{client: tc.args.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(calls{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteRelease: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						return &gitlab.Release{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: release(withProjectID()),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteRelease: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: release(withProjectID()),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteRelease: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: release(withProjectID()),
+			},
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
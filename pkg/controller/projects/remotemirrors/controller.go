@@ -0,0 +1,290 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remotemirrors
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretversion"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotRemoteMirror  = "managed resource is not a Gitlab remote mirror custom resource"
+	errProjectIDMissing = "ProjectID is missing"
+	errIDNotAnInt       = "ID is not an integer"
+	errGetFailed        = "cannot get Gitlab remote mirror"
+	errAdoptFailed      = "cannot look up existing Gitlab remote mirrors"
+	errPasswordMissing  = "cannot get password of Gitlab remote mirror"
+	errURLInvalid       = "cannot parse URL of Gitlab remote mirror"
+	errCreateFailed     = "cannot create Gitlab remote mirror"
+	errUpdateFailed     = "cannot update Gitlab remote mirror"
+	errDeleteFailed     = "cannot delete Gitlab remote mirror"
+	errKubeUpdateFailed = "cannot update Gitlab remote mirror custom resource"
+)
+
+// SetupRemoteMirror adds a controller that reconciles RemoteMirrors.
+func SetupRemoteMirror(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RemoteMirrorKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.RemoteMirrorGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.RemoteMirrorGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, secretversion.NewConnecter(mgr.GetClient(), &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewRemoteMirrorClient})))))))))),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RemoteMirrorGroupVersionKind),
+		reconcilerOpts...)
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.RemoteMirror{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.RemoteMirror{}, &v1alpha1.RemoteMirrorList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.RemoteMirrorClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RemoteMirror)
+	if !ok {
+		return nil, errors.New(errNotRemoteMirror)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.RemoteMirrorClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RemoteMirror)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRemoteMirror)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	adopted := false
+	if err != nil {
+		// The mirror is not known yet. Adopt an existing mirror pushing to
+		// the same URL instead of creating another one.
+		m, err := projects.FindRemoteMirrorByURL(e.client, *cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.URL, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errAdoptFailed)
+		}
+		if m == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		meta.SetExternalName(cr, strconv.Itoa(m.ID))
+		id, adopted = m.ID, true
+	}
+
+	m, res, err := e.client.GetProjectMirror(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeRemoteMirror(&cr.Spec.ForProvider, m)
+
+	cr.Status.AtProvider = projects.GenerateRemoteMirrorObservation(m)
+	if m.UpdateStatus == projects.RemoteMirrorUpdateStatusFailed {
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(m.LastError))
+	} else {
+		cr.Status.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsRemoteMirrorUpToDate(&cr.Spec.ForProvider, m),
+		ResourceLateInitialized: adopted || !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RemoteMirror)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRemoteMirror)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	opt, err := e.addOptions(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	m, _, err := e.client.AddProjectMirror(*cr.Spec.ForProvider.ProjectID, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(m.ID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RemoteMirror)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRemoteMirror)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errIDNotAnInt)
+	}
+
+	// The credentials of a mirror cannot be edited, so the mirror is
+	// replaced if its password was rotated.
+	rotated, err := secretversion.Changed(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPasswordMissing)
+	}
+	if rotated {
+		return managed.ExternalUpdate{}, e.replace(ctx, cr, id)
+	}
+
+	_, _, err = e.client.EditProjectMirror(*cr.Spec.ForProvider.ProjectID, id, projects.GenerateEditRemoteMirrorOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RemoteMirror)
+	if !ok {
+		return errors.New(errNotRemoteMirror)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.Wrap(err, errIDNotAnInt)
+	}
+
+	_, err = e.client.DeleteProjectMirror(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errDeleteFailed)
+}
+
+// addOptions returns the options to add the mirror of cr with, including
+// the password read from its PasswordSecretRef.
+func (e *external) addOptions(ctx context.Context, cr *v1alpha1.RemoteMirror) (*gitlab.AddProjectMirrorOptions, error) {
+	password := ""
+	if ref := cr.Spec.ForProvider.PasswordSecretRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+			return nil, errors.Wrap(err, errPasswordMissing)
+		}
+		password = string(secret.Data[ref.Key])
+	}
+
+	opt, err := projects.GenerateAddRemoteMirrorOptions(&cr.Spec.ForProvider, password)
+	return opt, errors.Wrap(err, errURLInvalid)
+}
+
+// replace deletes mirror id and adds the mirror of cr again. The old mirror
+// is deleted first, as a project cannot mirror to the same URL twice.
+func (e *external) replace(ctx context.Context, cr *v1alpha1.RemoteMirror, id int) error {
+	opt, err := e.addOptions(ctx, cr)
+	if err != nil {
+		return err
+	}
+	res, err := e.client.DeleteProjectMirror(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	m, _, err := e.client.AddProjectMirror(*cr.Spec.ForProvider.ProjectID, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errCreateFailed)
+	}
+	meta.SetExternalName(cr, strconv.Itoa(m.ID))
+	return errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
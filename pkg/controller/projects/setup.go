@@ -21,14 +21,42 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/accesstokenrotations"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/accesstokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/approvalrulesets"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/approvals"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/approvalsettings"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/badges"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/blueprints"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/clusteragents"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/defaultreviewers"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/deploykeyenablements"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/deploykeys"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/deploytokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/externalstatuschecks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/forkrelationships"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/freezeperiods"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/gitlabimports"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/grouppolicies"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/hooks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/issuelinks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/jiraintegrations"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/jobtokenscopes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/labelsets"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/members"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/milestones"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/notes"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/pipelineretentionpolicies"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/pipelineschedules"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/projectnotificationsettings"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/projectshares"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/protectedbranchsets"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/releases"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/remotemirrors"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/snippets"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/variables"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/wikipages"
 )
 
 // Setup all project controllers
@@ -42,6 +70,34 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		variables.SetupVariable,
 		deploykeys.SetupDeployKey,
 		pipelineschedules.SetupPipelineSchedule,
+		labelsets.SetupLabelSet,
+		badges.SetupBadge,
+		blueprints.SetupProjectBlueprint,
+		approvalrulesets.SetupApprovalRuleSet,
+		protectedbranchsets.SetupProtectedBranchSet,
+		grouppolicies.SetupGroupPolicy,
+		releases.SetupRelease,
+		freezeperiods.SetupFreezePeriod,
+		externalstatuschecks.SetupExternalStatusCheck,
+		issuelinks.SetupIssueLink,
+		pipelineretentionpolicies.SetupPipelineRetentionPolicy,
+		approvalsettings.SetupApprovalSettings,
+		remotemirrors.SetupRemoteMirror,
+		accesstokenrotations.SetupAccessTokenRotation,
+		projectshares.SetupProjectShare,
+		clusteragents.SetupClusterAgent,
+		defaultreviewers.SetupDefaultReviewers,
+		snippets.SetupSnippet,
+		wikipages.SetupWikiPage,
+		deploykeyenablements.SetupDeployKeyEnablement,
+		gitlabimports.SetupGitLabImport,
+		jobtokenscopes.SetupJobTokenScope,
+		forkrelationships.SetupForkRelationship,
+		jiraintegrations.SetupJiraIntegration,
+		projectnotificationsettings.SetupProjectNotificationSettings,
+		notes.SetupNote,
+		approvals.SetupApproval,
+		milestones.SetupMilestone,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
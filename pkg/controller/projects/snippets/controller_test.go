@@ -0,0 +1,397 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snippets
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	snippetID = 1
+	title     = "scripts"
+	rawURL    = "https://gitlab.com/group/project/-/snippets/1/raw/main/setup.sh"
+
+	setup = v1alpha1.SnippetFile{FilePath: "setup.sh", Content: "#!/bin/sh\n"}
+)
+
+type args struct {
+	client projects.SnippetClient
+	cr     *v1alpha1.Snippet
+}
+
+type snippetModifier func(*v1alpha1.Snippet)
+
+func withConditions(c ...xpv1.Condition) snippetModifier {
+	return func(r *v1alpha1.Snippet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() snippetModifier {
+	return func(r *v1alpha1.Snippet) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withVisibility(v v1alpha1.VisibilityValue) snippetModifier {
+	return func(r *v1alpha1.Snippet) { r.Spec.ForProvider.Visibility = &v }
+}
+
+func withFiles(f ...v1alpha1.SnippetFile) snippetModifier {
+	return func(r *v1alpha1.Snippet) { r.Spec.ForProvider.Files = f }
+}
+
+func withExternalName(n string) snippetModifier {
+	return func(r *v1alpha1.Snippet) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.SnippetObservation) snippetModifier {
+	return func(r *v1alpha1.Snippet) { r.Status.AtProvider = s }
+}
+
+func snippet(m ...snippetModifier) *v1alpha1.Snippet {
+	cr := &v1alpha1.Snippet{Spec: v1alpha1.SnippetSpec{ForProvider: v1alpha1.SnippetParameters{Title: title}}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func gitlabSnippet() *gitlab.Snippet {
+	s := &gitlab.Snippet{ID: snippetID, Title: title, Visibility: "private"}
+	s.Files = append(s.Files, struct {
+		Path   string `json:"path"`
+		RawURL string `json:"raw_url"`
+	}{Path: "setup.sh", RawURL: rawURL})
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	observed := v1alpha1.SnippetObservation{ID: snippetID, Files: []v1alpha1.SnippetFileObservation{{Path: "setup.sh", RawURL: rawURL}}}
+
+	type want struct {
+		cr     *v1alpha1.Snippet
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: snippet(withProjectID()),
+			},
+			want: want{
+				cr: snippet(withProjectID()),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: snippet(withProjectID(), withExternalName("fr")),
+			},
+			want: want{
+				cr:  snippet(withProjectID(), withExternalName("fr")),
+				err: errors.New(errIDNotAnInt),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: snippet(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr: snippet(withProjectID(), withExternalName("1")),
+			},
+		},
+		"GetFileFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return gitlabSnippet(), &gitlab.Response{}, nil
+					},
+					MockSnippetFileContent: func(pid interface{}, snippet int, ref, filename string, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: snippet(withProjectID(), withExternalName("1")),
+			},
+			want: want{
+				cr:  snippet(withProjectID(), withExternalName("1")),
+				err: errors.Wrapf(errBoom, errGetFileFailed, "setup.sh"),
+			},
+		},
+		"LateInitAndUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return gitlabSnippet(), &gitlab.Response{}, nil
+					},
+					MockSnippetFileContent: func(pid interface{}, snippet int, ref, filename string, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+						if ref != "main" || filename != "setup.sh" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return []byte(setup.Content), &gitlab.Response{}, nil
+					},
+				},
+				cr: snippet(withProjectID(), withExternalName("1"), withFiles(setup)),
+			},
+			want: want{
+				cr: snippet(
+					withProjectID(),
+					withExternalName("1"),
+					withFiles(setup),
+					withVisibility(v1alpha1.PrivateVisibility),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return gitlabSnippet(), &gitlab.Response{}, nil
+					},
+					MockSnippetFileContent: func(pid interface{}, snippet int, ref, filename string, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+						return []byte("#!/bin/bash\n"), &gitlab.Response{}, nil
+					},
+				},
+				cr: snippet(withProjectID(), withExternalName("1"), withFiles(setup), withVisibility(v1alpha1.PrivateVisibility)),
+			},
+			want: want{
+				cr: snippet(
+					withProjectID(),
+					withExternalName("1"),
+					withFiles(setup),
+					withVisibility(v1alpha1.PrivateVisibility),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Snippet
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ProjectIDMissing": {
+			args: args{
+				cr: snippet(),
+			},
+			want: want{
+				cr:  snippet(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateSnippet: func(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return gitlabSnippet(), &gitlab.Response{}, nil
+					},
+				},
+				cr: snippet(withProjectID(), withFiles(setup)),
+			},
+			want: want{
+				cr: snippet(withProjectID(), withFiles(setup), withExternalName("1"), withConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateSnippet: func(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: snippet(withProjectID(), withFiles(setup)),
+			},
+			want: want{
+				cr:  snippet(withProjectID(), withFiles(setup), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var got *gitlab.UpdateProjectSnippetOptions
+
+	cases := map[string]struct {
+		args
+		want    *gitlab.UpdateProjectSnippetOptions
+		wantErr error
+	}{
+		"ReplacesFiles": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return gitlabSnippet(), &gitlab.Response{}, nil
+					},
+					MockUpdateSnippet: func(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						got = opt
+						return gitlabSnippet(), &gitlab.Response{}, nil
+					},
+				},
+				cr: snippet(withProjectID(), withExternalName("1"), withFiles(v1alpha1.SnippetFile{FilePath: "teardown.sh", Content: "exit 0"})),
+			},
+			want: &gitlab.UpdateProjectSnippetOptions{
+				Title: &title,
+				Files: &[]*gitlab.UpdateSnippetFileOptions{
+					{Action: ptr.To("create"), FilePath: ptr.To("teardown.sh"), Content: ptr.To("exit 0")},
+					{Action: ptr.To("delete"), FilePath: ptr.To("setup.sh")},
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return gitlabSnippet(), &gitlab.Response{}, nil
+					},
+					MockUpdateSnippet: func(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: snippet(withProjectID(), withExternalName("1"), withFiles(setup)),
+			},
+			wantErr: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.args.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want != nil {
+				if diff := cmp.Diff(tc.want, got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: snippet(withProjectID(), withExternalName("1")),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: snippet(withProjectID(), withExternalName("1")),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: snippet(withProjectID(), withExternalName("1")),
+			},
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,11 +18,13 @@ package variables
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/xanzy/go-gitlab"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -31,27 +33,72 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/audit"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/changes"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/dryrun"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/maintenance"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/protection"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretversion"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretwatch"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/selector"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/sensitive"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/stagger"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
 	errNotVariable       = "managed resource is not a Gitlab variable custom resource"
+	errKubeUpdateFailed  = "cannot update Gitlab variable custom resource"
 	errGetFailed         = "cannot get Gitlab variable"
 	errCreateFailed      = "cannot create Gitlab variable"
 	errUpdateFailed      = "cannot update Gitlab variable"
 	errDeleteFailed      = "cannot delete Gitlab variable"
 	errGetSecretFailed   = "cannot get secret for Gitlab variable value"
 	errSecretKeyNotFound = "cannot find key in secret for Gitlab variable value"
-	errProjectIDMissing  = "ProjectID is missing"
+	errProjectIDMissing  = "ProjectID or GroupID is missing"
+	errScopesConflict    = "EnvironmentScope and Scopes are mutually exclusive"
+	errInheritScopes     = "InheritancePolicy Inherit is not supported together with Scopes"
+	errInheritedFailed   = "cannot look up inherited Gitlab group variables"
 )
 
+// variableCacheTTL is how long the variables of a project are cached when
+// the variable cache is enabled. It is kept short, so changes made outside
+// of the provider are noticed within about a poll interval. Group variables
+// are not cached.
+const variableCacheTTL = 30 * time.Second
+
+// valueField is the plaintext Value of a Variable, moved into a Secret if
+// secret migration is enabled. Masked and Raw default to true for values
+// read from a secret, so they are pinned to keep the variable as it is.
+var valueField = sensitive.Field{
+	Path: "spec.forProvider.value",
+	Key:  "value",
+	Get: func(mg resource.Managed) *string {
+		p := &mg.(*v1alpha1.Variable).Spec.ForProvider
+		if p.ValueSecretRef != nil {
+			return nil
+		}
+		return p.Value
+	},
+	Set: func(mg resource.Managed, ref *xpv1.SecretKeySelector) {
+		p := &mg.(*v1alpha1.Variable).Spec.ForProvider
+		p.Value = nil
+		p.ValueSecretRef = ref
+		p.Masked = ptr.To(ptr.Deref(p.Masked, false))
+		p.Raw = ptr.To(ptr.Deref(p.Raw, false))
+	},
+}
+
 // SetupVariable adds a controller that reconciles Variables.
 func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.VariableKind)
@@ -61,9 +108,18 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	newGitlabClientFn := projects.NewVariableClient
+	if o.Features.Enabled(features.EnableVariableCache) {
+		cache := projects.NewVariableCache(variableCacheTTL)
+		newGitlabClientFn = func(cfg clients.Config) projects.VariableClient {
+			return cache.Client(cfg, projects.NewVariableClient(cfg))
+		}
+	}
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewVariableClient}),
+		managed.WithExternalConnecter(protection.NewConnecter(maintenance.NewConnecter(mgr.GetClient(), dryrun.NewConnecter(o.Features.Enabled(features.EnableDryRun), audit.NewConnecter(o.Features.Enabled(features.EnableAuditLog), o.Logger, v1alpha1.VariableGroupVersionKind, changes.NewConnecter(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), metrics.NewConnecter(v1alpha1.VariableGroupVersionKind, clients.NewBreakerConnecter(tracing.NewConnecter(name, secretversion.NewConnecter(mgr.GetClient(), &connector{kube: mgr.GetClient(), newGitlabClientFn: newGitlabClientFn, newGroupClientFn: projects.NewGroupVariableClient})))))))))),
 		managed.WithInitializers(),
+		managed.WithReferenceResolver(selector.NewReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -74,19 +130,31 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
+	if o.Features.Enabled(features.EnablePollStaggering) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithPollIntervalHook(stagger.PollInterval))
+	}
+
+	if o.Features.Enabled(features.EnableSecretMigration) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithInitializers(sensitive.NewInitializer(mgr.GetClient(), v1alpha1.VariableGroupVersionKind, valueField)))
+	}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.VariableGroupVersionKind),
 		reconcilerOpts...)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&v1alpha1.Variable{}).
-		Complete(r)
+		For(&v1alpha1.Variable{})
+	if err := secretwatch.Watch(mgr, b, &v1alpha1.Variable{}, &v1alpha1.VariableList{}); err != nil {
+		return err
+	}
+	return b.Complete(r)
 }
 
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg clients.Config) projects.VariableClient
+	newGroupClientFn  func(cfg clients.Config) projects.VariableClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -98,7 +166,21 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	if cr.Spec.ForProvider.GroupID != nil {
+		return &external{kube: c.kube, client: c.newGroupClientFn(*cfg)}, nil
+	}
+	cl := c.newGitlabClientFn(*cfg)
+	if cr.Spec.ForProvider.ProjectID == nil && cr.Spec.ForProvider.ProjectPath != nil {
+		if cr.Spec.ForProvider.ProjectID, err = projects.ResolveProjectID(ctx, cl, *cr.Spec.ForProvider.ProjectPath); err != nil {
+			return nil, err
+		}
+		// Persist the ID like a resolved reference, so the path is only
+		// resolved once.
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return nil, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+	return &external{kube: c.kube, client: cl}, nil
 }
 
 type external struct {
@@ -111,12 +193,40 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotVariable)
 	}
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.VariableScopeID(&cr.Spec.ForProvider) == nil {
 		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
 	}
 
+	if !meta.WasDeleted(cr) {
+		waiting, err := projects.UnmetRequirement(ctx, e.kube, cr.Spec.Requires, cr.Spec.ForProvider.ProjectIDRef)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if waiting != "" {
+			// Report the resource as it is rather than creating or
+			// changing it before its Project is ready.
+			cr.Status.SetConditions(v1alpha1.WaitingForProject(waiting))
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+	}
+	if len(cr.Spec.ForProvider.Scopes) > 0 {
+		return e.observeScopes(ctx, cr)
+	}
+
+	inherited, err := e.isInherited(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if inherited {
+		cr.Status.SetConditions(xpv1.Available())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
 	variable, res, err := e.client.GetVariable(
-		*cr.Spec.ForProvider.ProjectID,
+		*projects.VariableScopeID(&cr.Spec.ForProvider),
 		cr.Spec.ForProvider.Key,
 		projects.GenerateGetVariableOptions(&cr.Spec.ForProvider),
 		gitlab.WithContext(ctx))
@@ -128,20 +238,20 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
-	if cr.Spec.ForProvider.ValueSecretRef != nil {
-		if err = e.updateVariableFromSecret(ctx, cr.Spec.ForProvider.ValueSecretRef, &cr.Spec.ForProvider); err != nil {
-			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateFailed)
-		}
+	params, err := e.resolveSecretValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateFailed)
 	}
 
 	current := cr.Spec.ForProvider.DeepCopy()
 	projects.LateInitializeVariable(&cr.Spec.ForProvider, variable)
+	projects.LateInitializeVariable(params, variable)
 
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        projects.IsVariableUpToDate(&cr.Spec.ForProvider, variable),
+		ResourceUpToDate:        projects.IsVariableUpToDate(params, variable),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -152,24 +262,31 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotVariable)
 	}
 
-	if cr.Spec.ForProvider.ValueSecretRef != nil {
-		if err := e.updateVariableFromSecret(ctx, cr.Spec.ForProvider.ValueSecretRef, &cr.Spec.ForProvider); err != nil {
-			return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
-		}
+	params, err := e.resolveSecretValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.VariableScopeID(&cr.Spec.ForProvider) == nil {
 		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
 	}
 
-	cr.Status.SetConditions(xpv1.Creating())
-	_, _, err := e.client.CreateVariable(
-		*cr.Spec.ForProvider.ProjectID,
-		projects.GenerateCreateVariableOptions(&cr.Spec.ForProvider),
-		gitlab.WithContext(ctx))
+	if cr.Spec.ForProvider.EnvironmentScope != nil && len(cr.Spec.ForProvider.Scopes) > 0 {
+		return managed.ExternalCreation{}, errors.New(errScopesConflict)
+	}
 
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	cr.Status.SetConditions(xpv1.Creating())
+	variables := projects.ExpandVariableScopes(params)
+	for i := range variables {
+		_, _, err := e.client.CreateVariable(
+			*projects.VariableScopeID(params),
+			projects.GenerateCreateVariableOptions(&variables[i]),
+			gitlab.WithContext(ctx))
+
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+		}
 	}
+	cr.Status.AtProvider.Scopes = cr.Spec.ForProvider.Scopes
 	return managed.ExternalCreation{}, nil
 }
 
@@ -179,19 +296,21 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotVariable)
 	}
 
-	if cr.Spec.ForProvider.ValueSecretRef != nil {
-		if err := e.updateVariableFromSecret(ctx, cr.Spec.ForProvider.ValueSecretRef, &cr.Spec.ForProvider); err != nil {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
-		}
+	params, err := e.resolveSecretValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 	}
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.VariableScopeID(&cr.Spec.ForProvider) == nil {
 		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
 	}
+	if len(cr.Spec.ForProvider.Scopes) > 0 {
+		return managed.ExternalUpdate{}, e.updateScopes(ctx, cr, params)
+	}
 
-	_, _, err := e.client.UpdateVariable(
-		*cr.Spec.ForProvider.ProjectID,
-		cr.Spec.ForProvider.Key,
-		projects.GenerateUpdateVariableOptions(&cr.Spec.ForProvider),
+	_, _, err = e.client.UpdateVariable(
+		*projects.VariableScopeID(params),
+		params.Key,
+		projects.GenerateUpdateVariableOptions(params),
 		gitlab.WithContext(ctx),
 	)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
@@ -203,22 +322,159 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotVariable)
 	}
 
-	if cr.Spec.ForProvider.ProjectID == nil {
+	if projects.VariableScopeID(&cr.Spec.ForProvider) == nil {
 		return errors.New(errProjectIDMissing)
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := e.client.RemoveVariable(
-		*cr.Spec.ForProvider.ProjectID,
+	if len(cr.Spec.ForProvider.Scopes) > 0 {
+		if err := e.removeScopes(ctx, cr, cr.Spec.ForProvider.Scopes); err != nil {
+			return err
+		}
+		return e.removeScopes(ctx, cr, projects.StaleVariableScopes(&cr.Spec.ForProvider, cr.Status.AtProvider.Scopes))
+	}
+
+	res, err := e.client.RemoveVariable(
+		*projects.VariableScopeID(&cr.Spec.ForProvider),
 		cr.Spec.ForProvider.Key,
 		projects.GenerateRemoveVariableOptions(&cr.Spec.ForProvider),
 		gitlab.WithContext(ctx),
 	)
+	if cr.Status.AtProvider.InheritedFrom != "" && clients.IsResponseNotFound(res) {
+		// An inherited variable may never have been created in the project.
+		return nil
+	}
 	return errors.Wrap(err, errDeleteFailed)
 }
 
-func (e *external) updateVariableFromSecret(ctx context.Context, selector *xpv1.SecretKeySelector, params *v1alpha1.VariableParameters) error {
+// isInherited reports whether the variable defers to a group variable it
+// inherits, and records the group in the status.
+func (e *external) isInherited(ctx context.Context, cr *v1alpha1.Variable) (bool, error) {
+	cr.Status.AtProvider.InheritedFrom = ""
+
+	p := &cr.Spec.ForProvider
+	if ptr.Deref(p.InheritancePolicy, v1alpha1.VariableInheritancePolicyOverride) != v1alpha1.VariableInheritancePolicyInherit {
+		return false, nil
+	}
+
+	var inherited *projects.InheritedVariable
+	var err error
+	if p.GroupID != nil {
+		inherited, err = projects.FindInheritedGroupVariable(e.client, *p.GroupID, p.Key, ptr.Deref(p.EnvironmentScope, "*"), gitlab.WithContext(ctx))
+	} else {
+		inherited, err = projects.FindInheritedVariable(e.client, *p.ProjectID, p.Key, ptr.Deref(p.EnvironmentScope, "*"), gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errInheritedFailed)
+	}
+	if inherited == nil {
+		return false, nil
+	}
+
+	cr.Status.AtProvider.InheritedFrom = inherited.GroupPath
+	return true, nil
+}
+
+// observeScopes observes the Gitlab variables of each environment scope in
+// Scopes. The variable exists as soon as one of them exists, and it is up to
+// date once all of them match the spec and no stale scope is left.
+func (e *external) observeScopes(ctx context.Context, cr *v1alpha1.Variable) (managed.ExternalObservation, error) {
+	p := &cr.Spec.ForProvider
+	if p.EnvironmentScope != nil {
+		return managed.ExternalObservation{}, errors.New(errScopesConflict)
+	}
+	if ptr.Deref(p.InheritancePolicy, v1alpha1.VariableInheritancePolicyOverride) == v1alpha1.VariableInheritancePolicyInherit {
+		return managed.ExternalObservation{}, errors.New(errInheritScopes)
+	}
+	params, err := e.resolveSecretValue(ctx, p)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	current := p.DeepCopy()
+	exists, upToDate := false, len(projects.StaleVariableScopes(p, cr.Status.AtProvider.Scopes)) == 0
+	variables := projects.ExpandVariableScopes(params)
+	for i := range variables {
+		v := &variables[i]
+		variable, res, err := e.client.GetVariable(*projects.VariableScopeID(p), p.Key, projects.GenerateGetVariableOptions(v), gitlab.WithContext(ctx))
+		if err != nil {
+			if clients.IsResponseNotFound(res) {
+				upToDate = false
+				continue
+			}
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+		}
+		exists = true
+		projects.LateInitializeVariable(p, variable)
+		projects.LateInitializeVariable(v, variable)
+		upToDate = upToDate && projects.IsVariableUpToDate(v, variable)
+	}
+	if !exists {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, p),
+	}, nil
+}
+
+// updateScopes creates or updates the Gitlab variable of each environment
+// scope in Scopes, and removes the ones of scopes dropped from the spec. The
+// parameters p are the ones resolved from the spec of cr.
+func (e *external) updateScopes(ctx context.Context, cr *v1alpha1.Variable, p *v1alpha1.VariableParameters) error {
+	variables := projects.ExpandVariableScopes(p)
+	for i := range variables {
+		v := &variables[i]
+		_, res, err := e.client.GetVariable(*projects.VariableScopeID(p), p.Key, projects.GenerateGetVariableOptions(v), gitlab.WithContext(ctx))
+		switch {
+		case err == nil:
+			if _, _, err := e.client.UpdateVariable(*projects.VariableScopeID(p), p.Key, projects.GenerateUpdateVariableOptions(v), gitlab.WithContext(ctx)); err != nil {
+				return errors.Wrap(err, errUpdateFailed)
+			}
+		case clients.IsResponseNotFound(res):
+			if _, _, err := e.client.CreateVariable(*projects.VariableScopeID(p), projects.GenerateCreateVariableOptions(v), gitlab.WithContext(ctx)); err != nil {
+				return errors.Wrap(err, errCreateFailed)
+			}
+		default:
+			return errors.Wrap(err, errGetFailed)
+		}
+	}
+
+	if err := e.removeScopes(ctx, cr, projects.StaleVariableScopes(p, cr.Status.AtProvider.Scopes)); err != nil {
+		return err
+	}
+	cr.Status.AtProvider.Scopes = p.Scopes
+	return nil
+}
+
+// removeScopes removes the Gitlab variables of the given environment scopes,
+// ignoring the ones that are already gone.
+func (e *external) removeScopes(ctx context.Context, cr *v1alpha1.Variable, scopes []string) error {
+	for _, scope := range scopes {
+		v := v1alpha1.VariableParameters{EnvironmentScope: gitlab.String(scope)}
+		res, err := e.client.RemoveVariable(*projects.VariableScopeID(&cr.Spec.ForProvider), cr.Spec.ForProvider.Key, projects.GenerateRemoveVariableOptions(&v), gitlab.WithContext(ctx))
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrap(err, errDeleteFailed)
+		}
+	}
+	return nil
+}
+
+// resolveSecretValue returns the parameters to send to Gitlab. A value read
+// from ValueSecretRef is only set on a copy of params, so it is never written
+// back into the spec. Masked and Raw default to true for such a value, and
+// these defaults are set on params itself.
+func (e *external) resolveSecretValue(ctx context.Context, params *v1alpha1.VariableParameters) (*v1alpha1.VariableParameters, error) {
+	if params.ValueSecretRef == nil {
+		return params, nil
+	}
+
 	// Fetch the Kubernetes secret.
+	selector := params.ValueSecretRef
 	secret := &corev1.Secret{}
 	nn := types.NamespacedName{
 		Namespace: selector.Namespace,
@@ -227,13 +483,13 @@ func (e *external) updateVariableFromSecret(ctx context.Context, selector *xpv1.
 
 	err := e.kube.Get(ctx, nn, secret)
 	if err != nil {
-		return errors.Wrap(err, errGetSecretFailed)
+		return nil, errors.Wrap(err, errGetSecretFailed)
 	}
 
 	// Obtain the data from the secret.
 	raw, ok := secret.Data[selector.Key]
 	if raw == nil || !ok {
-		return errors.New(errSecretKeyNotFound)
+		return nil, errors.New(errSecretKeyNotFound)
 	}
 
 	// Mask variable if it hasn't already been explicitly configured.
@@ -246,8 +502,9 @@ func (e *external) updateVariableFromSecret(ctx context.Context, selector *xpv1.
 		params.Raw = gitlab.Bool(true)
 	}
 
+	resolved := params.DeepCopy()
 	value := string(raw)
-	params.Value = &value
+	resolved.Value = &value
 
-	return nil
+	return resolved, nil
 }
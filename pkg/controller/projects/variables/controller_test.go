@@ -97,6 +97,12 @@ func withValue(value string) variableModifier {
 	}
 }
 
+func withoutValue() variableModifier {
+	return func(r *v1alpha1.Variable) {
+		r.Spec.ForProvider.Value = nil
+	}
+}
+
 func withValueSecretRef(selector *xpv1.SecretKeySelector) variableModifier {
 	return func(r *v1alpha1.Variable) {
 		r.Spec.ForProvider.ValueSecretRef = selector
@@ -315,6 +321,7 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: variable(
 					withDefaultValues(),
+					withoutValue(),
 					withValueSecretRef(&xpv1.SecretKeySelector{
 						SecretReference: xpv1.SecretReference{},
 						Key:             "blah",
@@ -458,6 +465,9 @@ func TestCreate(t *testing.T) {
 				},
 				variable: &fake.MockClient{
 					MockCreateVariable: func(pid interface{}, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+						if opt.Value == nil || *opt.Value != variableValue {
+							return nil, nil, errBoom
+						}
 						return &gitlab.ProjectVariable{}, &gitlab.Response{}, nil
 					},
 				},
@@ -479,7 +489,6 @@ func TestCreate(t *testing.T) {
 						SecretReference: xpv1.SecretReference{},
 						Key:             "blah",
 					}),
-					withValue(variableValue),
 					withMasked(true),
 					withRaw(true),
 				),
@@ -610,6 +619,9 @@ func TestUpdate(t *testing.T) {
 				},
 				variable: &fake.MockClient{
 					MockUpdateVariable: func(pid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+						if opt.Value == nil || *opt.Value != variableValue {
+							return nil, nil, errBoom
+						}
 						return &gitlab.ProjectVariable{}, &gitlab.Response{}, nil
 					},
 				},
@@ -630,7 +642,6 @@ func TestUpdate(t *testing.T) {
 						SecretReference: xpv1.SecretReference{},
 						Key:             "blah",
 					}),
-					withValue(variableValue),
 					withMasked(true),
 					withRaw(true),
 				),
@@ -781,3 +792,292 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func withScopes(scopes ...string) variableModifier {
+	return func(r *v1alpha1.Variable) {
+		r.Spec.ForProvider.EnvironmentScope = nil
+		r.Spec.ForProvider.Scopes = scopes
+	}
+}
+
+func withAppliedScopes(scopes ...string) variableModifier {
+	return func(r *v1alpha1.Variable) {
+		r.Status.AtProvider.Scopes = scopes
+	}
+}
+
+// scopedVariables fakes a project with the variable defined in the given
+// environment scopes and records every call made per scope.
+type scopedVariables struct {
+	fake.MockClient
+	existing                  map[string]bool
+	created, updated, removed []string
+}
+
+func newScopedVariables(existing ...string) *scopedVariables {
+	c := &scopedVariables{existing: map[string]bool{}}
+	for _, s := range existing {
+		c.existing[s] = true
+	}
+	c.MockGetVariable = func(pid interface{}, key string, opt *gitlab.GetProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+		if !c.existing[opt.Filter.EnvironmentScope] {
+			return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+		}
+		rv := pv
+		rv.EnvironmentScope = opt.Filter.EnvironmentScope
+		return &rv, &gitlab.Response{}, nil
+	}
+	c.MockCreateVariable = func(pid interface{}, opt *gitlab.CreateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+		c.created = append(c.created, *opt.EnvironmentScope)
+		return &gitlab.ProjectVariable{}, &gitlab.Response{}, nil
+	}
+	c.MockUpdateVariable = func(pid interface{}, key string, opt *gitlab.UpdateProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+		c.updated = append(c.updated, opt.Filter.EnvironmentScope)
+		return &gitlab.ProjectVariable{}, &gitlab.Response{}, nil
+	}
+	c.MockRemoveVariable = func(pid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+		c.removed = append(c.removed, opt.Filter.EnvironmentScope)
+		return &gitlab.Response{}, nil
+	}
+	return c
+}
+
+func TestScopes(t *testing.T) {
+	t.Run("ObserveMissingScope", func(t *testing.T) {
+		e := &external{client: newScopedVariables("production")}
+		cr := variable(withDefaultValues(), withScopes("production", "staging"))
+
+		o, err := e.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, o); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("ObserveUpToDate", func(t *testing.T) {
+		e := &external{client: newScopedVariables("production", "staging")}
+		cr := variable(withDefaultValues(), withScopes("production", "staging"), withAppliedScopes("production", "staging"))
+
+		o, err := e.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, o); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("ObserveStaleScope", func(t *testing.T) {
+		e := &external{client: newScopedVariables("production")}
+		cr := variable(withDefaultValues(), withScopes("production"), withAppliedScopes("production", "review"))
+
+		o, err := e.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, o); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("ObserveConflict", func(t *testing.T) {
+		e := &external{client: newScopedVariables()}
+		cr := variable(withDefaultValues(), withScopes("production"), withEnvironmentScope("*"))
+
+		_, err := e.Observe(context.Background(), cr)
+		if diff := cmp.Diff(errors.New(errScopesConflict), err, test.EquateErrors()); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		c := newScopedVariables()
+		e := &external{client: c}
+		cr := variable(withDefaultValues(), withScopes("production", "staging"))
+
+		if _, err := e.Create(context.Background(), cr); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff([]string{"production", "staging"}, c.created); diff != "" {
+			t.Errorf("created: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"production", "staging"}, cr.Status.AtProvider.Scopes); diff != "" {
+			t.Errorf("applied: -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		c := newScopedVariables("production", "review")
+		e := &external{client: c}
+		cr := variable(withDefaultValues(), withScopes("production", "staging"), withAppliedScopes("production", "review"))
+
+		if _, err := e.Update(context.Background(), cr); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff([]string{"production"}, c.updated); diff != "" {
+			t.Errorf("updated: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"staging"}, c.created); diff != "" {
+			t.Errorf("created: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"review"}, c.removed); diff != "" {
+			t.Errorf("removed: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"production", "staging"}, cr.Status.AtProvider.Scopes); diff != "" {
+			t.Errorf("applied: -want, +got:\n%s", diff)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		c := newScopedVariables("production", "staging")
+		e := &external{client: c}
+		cr := variable(withDefaultValues(), withScopes("production", "staging"), withAppliedScopes("production", "review"))
+
+		if err := e.Delete(context.Background(), cr); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff([]string{"production", "staging", "review"}, c.removed); diff != "" {
+			t.Errorf("removed: -want, +got:\n%s", diff)
+		}
+	})
+}
+
+func withInheritancePolicy(policy v1alpha1.VariableInheritancePolicy) variableModifier {
+	return func(r *v1alpha1.Variable) {
+		r.Spec.ForProvider.InheritancePolicy = &policy
+	}
+}
+
+func withInheritedFrom(group string) variableModifier {
+	return func(r *v1alpha1.Variable) {
+		r.Status.AtProvider.InheritedFrom = group
+	}
+}
+
+func withGroupID() variableModifier {
+	return func(r *v1alpha1.Variable) {
+		r.Spec.ForProvider.ProjectID = nil
+		r.Spec.ForProvider.GroupID = &projectID
+	}
+}
+
+func inheritingClient(vars ...*gitlab.GroupVariable) *fake.MockClient {
+	return &fake.MockClient{
+		MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+			return &gitlab.Project{Namespace: &gitlab.ProjectNamespace{ID: 1, Kind: "group"}}, &gitlab.Response{}, nil
+		},
+		MockGetGroup: func(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+			return &gitlab.Group{ID: 1, FullPath: "parent"}, &gitlab.Response{}, nil
+		},
+		MockListGroupVariables: func(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+			return vars, &gitlab.Response{}, nil
+		},
+		MockGetVariable: func(pid interface{}, key string, opt *gitlab.GetProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+			return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+		},
+		MockRemoveVariable: func(pid interface{}, key string, opt *gitlab.RemoveProjectVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+		},
+	}
+}
+
+func TestInheritancePolicy(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Variable
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InheritedFromGroup": {
+			args: args{
+				variable: inheritingClient(&gitlab.GroupVariable{Key: variableKey, EnvironmentScope: "*"}),
+				cr:       variable(withDefaultValues(), withInheritancePolicy(v1alpha1.VariableInheritancePolicyInherit)),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withInheritancePolicy(v1alpha1.VariableInheritancePolicyInherit),
+					withInheritedFrom("parent"),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GroupInheritedFromParent": {
+			args: args{
+				variable: func() *fake.MockClient {
+					c := inheritingClient(&gitlab.GroupVariable{Key: variableKey, EnvironmentScope: "*"})
+					c.MockGetGroup = func(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if gid == projectID {
+							return &gitlab.Group{ID: projectID, FullPath: "parent/child", ParentID: 1}, &gitlab.Response{}, nil
+						}
+						return &gitlab.Group{ID: 1, FullPath: "parent"}, &gitlab.Response{}, nil
+					}
+					return c
+				}(),
+				cr: variable(withDefaultValues(), withGroupID(), withInheritancePolicy(v1alpha1.VariableInheritancePolicyInherit)),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withGroupID(),
+					withInheritancePolicy(v1alpha1.VariableInheritancePolicyInherit),
+					withInheritedFrom("parent"),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotInherited": {
+			args: args{
+				variable: inheritingClient(&gitlab.GroupVariable{Key: "OTHER", EnvironmentScope: "*"}),
+				cr:       variable(withDefaultValues(), withInheritancePolicy(v1alpha1.VariableInheritancePolicyInherit), withInheritedFrom("parent")),
+			},
+			want: want{
+				cr:     variable(withDefaultValues(), withInheritancePolicy(v1alpha1.VariableInheritancePolicyInherit)),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"Override": {
+			args: args{
+				variable: inheritingClient(&gitlab.GroupVariable{Key: variableKey, EnvironmentScope: "*"}),
+				cr:       variable(withDefaultValues(), withInheritancePolicy(v1alpha1.VariableInheritancePolicyOverride)),
+			},
+			want: want{
+				cr:     variable(withDefaultValues(), withInheritancePolicy(v1alpha1.VariableInheritancePolicyOverride)),
+				result: managed.ExternalObservation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.variable}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("DeleteInherited", func(t *testing.T) {
+		e := &external{client: inheritingClient()}
+		cr := variable(withDefaultValues(), withInheritedFrom("parent"))
+		if err := e.Delete(context.Background(), cr); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
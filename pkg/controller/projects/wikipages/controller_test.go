@@ -0,0 +1,398 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wikipages
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	slug      = "Runbook"
+	title     = "Runbook"
+	content   = "# Runbook"
+)
+
+type args struct {
+	kube   client.Client
+	client projects.WikiPageClient
+	cr     *v1alpha1.WikiPage
+}
+
+type wikiPageModifier func(*v1alpha1.WikiPage)
+
+func withConditions(c ...xpv1.Condition) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID() wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Spec.ForProvider.ProjectID = &projectID }
+}
+
+func withTitle(t string) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Spec.ForProvider.Title = t }
+}
+
+func withFormat(f v1alpha1.WikiFormatValue) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Spec.ForProvider.Format = &f }
+}
+
+func withExternalName(n string) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.WikiPageObservation) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Status.AtProvider = s }
+}
+
+func wikiPage(m ...wikiPageModifier) *v1alpha1.WikiPage {
+	cr := &v1alpha1.WikiPage{Spec: v1alpha1.WikiPageSpec{ForProvider: v1alpha1.WikiPageParameters{Title: title, Content: content}}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func gitlabWikiPage() *gitlab.Wiki {
+	return &gitlab.Wiki{Title: title, Slug: slug, Content: content, Format: gitlab.WikiFormatMarkdown, Encoding: "UTF-8"}
+}
+
+func TestObserve(t *testing.T) {
+	observed := v1alpha1.WikiPageObservation{Slug: slug, Encoding: "UTF-8"}
+
+	type want struct {
+		cr     *v1alpha1.WikiPage
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: wikiPage(withProjectID()),
+			},
+			want: want{
+				cr: wikiPage(withProjectID()),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: wikiPage(withExternalName(slug)),
+			},
+			want: want{
+				cr:  wikiPage(withExternalName(slug)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWikiPage: func(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+			want: want{
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWikiPage: func(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+			want: want{
+				cr:  wikiPage(withProjectID(), withExternalName(slug)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitAndUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWikiPage: func(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return gitlabWikiPage(), &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+			want: want{
+				cr: wikiPage(
+					withProjectID(),
+					withExternalName(slug),
+					withFormat(v1alpha1.WikiFormatMarkdown),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"TitleChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWikiPage: func(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return gitlabWikiPage(), &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug), withTitle("On-call"), withFormat(v1alpha1.WikiFormatMarkdown)),
+			},
+			want: want{
+				cr: wikiPage(
+					withProjectID(),
+					withExternalName(slug),
+					withTitle("On-call"),
+					withFormat(v1alpha1.WikiFormatMarkdown),
+					withConditions(xpv1.Available()),
+					withStatus(observed),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.WikiPage
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ProjectIDMissing": {
+			args: args{
+				cr: wikiPage(),
+			},
+			want: want{
+				cr:  wikiPage(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateWikiPage: func(pid interface{}, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return gitlabWikiPage(), &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withProjectID()),
+			},
+			want: want{
+				cr: wikiPage(withProjectID(), withExternalName(slug), withConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateWikiPage: func(pid interface{}, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: wikiPage(withProjectID()),
+			},
+			want: want{
+				cr:  wikiPage(withProjectID(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.WikiPage
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockEditWikiPage: func(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return gitlabWikiPage(), &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+			want: want{
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+		},
+		"Renamed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockClient{
+					MockEditWikiPage: func(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return &gitlab.Wiki{Title: *opt.Title, Slug: "On-call"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug), withTitle("On-call")),
+			},
+			want: want{
+				cr: wikiPage(withProjectID(), withExternalName("On-call"), withTitle("On-call")),
+			},
+		},
+		"KubeUpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockClient{
+					MockEditWikiPage: func(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return &gitlab.Wiki{Title: *opt.Title, Slug: "On-call"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug), withTitle("On-call")),
+			},
+			want: want{
+				cr:  wikiPage(withProjectID(), withExternalName("On-call"), withTitle("On-call")),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockEditWikiPage: func(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+			want: want{
+				cr:  wikiPage(withProjectID(), withExternalName(slug)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.args.kube, client: tc.args.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteWikiPage: func(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteWikiPage: func(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteWikiPage: func(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: wikiPage(withProjectID(), withExternalName(slug)),
+			},
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/config"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
 )
 
 // Setup creates all Gitlab API controllers with the supplied logger and adds
@@ -38,5 +39,5 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 			return err
 		}
 	}
-	return nil
+	return metrics.Setup(mgr)
 }
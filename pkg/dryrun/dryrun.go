@@ -0,0 +1,108 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun lets controllers report the changes they would make to
+// Gitlab without making them.
+package dryrun
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyDryRun enables ("true") or disables ("false") dry run for a
+// single managed resource, overriding the provider wide setting.
+const AnnotationKeyDryRun = "gitlab.crossplane.io/dry-run"
+
+const (
+	errWouldCreate = "dry run: would create external resource"
+	errWouldUpdate = "dry run: would update external resource"
+	errWouldDelete = "dry run: would delete external resource"
+)
+
+// IsDryRun returns true if changes to the external resource of mg must not
+// be made. The annotation of mg takes precedence over the provider wide
+// default.
+func IsDryRun(mg resource.Managed, defaultDryRun bool) bool {
+	v, ok := mg.GetAnnotations()[AnnotationKeyDryRun]
+	if !ok {
+		return defaultDryRun
+	}
+	dryRun, err := strconv.ParseBool(v)
+	if err != nil {
+		// Err on the side of not touching the external resource.
+		return true
+	}
+	return dryRun
+}
+
+// NewConnecter wraps c so that the clients it returns skip Create, Update
+// and Delete for managed resources in dry run. The skipped change is
+// returned as an error instead, which the managed reconciler reports in the
+// Synced condition and an event of the managed resource. Observe is not
+// affected, so the reported changes are always computed from the current
+// state of Gitlab.
+func NewConnecter(defaultDryRun bool, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{defaultDryRun: defaultDryRun, connecter: c}
+}
+
+type connecter struct {
+	defaultDryRun bool
+	connecter     managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{defaultDryRun: c.defaultDryRun, client: ec}, nil
+}
+
+type external struct {
+	defaultDryRun bool
+	client        managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	return e.client.Observe(ctx, mg)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if IsDryRun(mg, e.defaultDryRun) {
+		return managed.ExternalCreation{}, errors.New(errWouldCreate)
+	}
+	return e.client.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if IsDryRun(mg, e.defaultDryRun) {
+		return managed.ExternalUpdate{}, errors.New(errWouldUpdate)
+	}
+	return e.client.Update(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if IsDryRun(mg, e.defaultDryRun) {
+		return errors.New(errWouldDelete)
+	}
+	return e.client.Delete(ctx, mg)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func managedWithAnnotations(a map[string]string) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetAnnotations(a)
+	return mg
+}
+
+func TestIsDryRun(t *testing.T) {
+	cases := map[string]struct {
+		mg            resource.Managed
+		defaultDryRun bool
+		want          bool
+	}{
+		"Default": {
+			mg:            &fake.Managed{},
+			defaultDryRun: true,
+			want:          true,
+		},
+		"AnnotationEnables": {
+			mg:   managedWithAnnotations(map[string]string{AnnotationKeyDryRun: "true"}),
+			want: true,
+		},
+		"AnnotationDisables": {
+			mg:            managedWithAnnotations(map[string]string{AnnotationKeyDryRun: "false"}),
+			defaultDryRun: true,
+			want:          false,
+		},
+		"InvalidAnnotation": {
+			mg:   managedWithAnnotations(map[string]string{AnnotationKeyDryRun: "maybe"}),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsDryRun(tc.mg, tc.defaultDryRun)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnecter(t *testing.T) {
+	errBoom := errors.New("boom")
+	ec := &managed.ExternalClientFns{
+		CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+			return managed.ExternalCreation{}, errBoom
+		},
+		UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+			return managed.ExternalUpdate{}, errBoom
+		},
+		DeleteFn: func(_ context.Context, _ resource.Managed) error {
+			return errBoom
+		},
+	}
+
+	cases := map[string]struct {
+		defaultDryRun bool
+		want          []error
+	}{
+		"DryRun": {
+			defaultDryRun: true,
+			want:          []error{errors.New(errWouldCreate), errors.New(errWouldUpdate), errors.New(errWouldDelete)},
+		},
+		"Disabled": {
+			want: []error{errBoom, errBoom, errBoom},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewConnecter(tc.defaultDryRun, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return ec, nil
+			}))
+			mg := &fake.Managed{}
+			e, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect: %v", err)
+			}
+			_, errCreate := e.Create(context.Background(), mg)
+			_, errUpdate := e.Update(context.Background(), mg)
+			errDelete := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, []error{errCreate, errUpdate, errDelete}, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
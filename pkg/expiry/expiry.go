@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package expiry warns before resources with an expiry date, such as
+// tokens, expire.
+package expiry
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+)
+
+// Warning is how long before a resource expires it is reported as expiring.
+const Warning = 14 * 24 * time.Hour
+
+const reasonExpiring event.Reason = "Expiring"
+
+// An Expiring resource expires at a point in time, if it has one.
+type Expiring interface {
+	GetExpiresAt() *metav1.Time
+}
+
+// NewConnecter wraps c so that the resources it observes report in their
+// Expiring condition whether they expire within Warning, with an event
+// when they start to.
+func NewConnecter(record event.Recorder, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{connecter: c, record: record, now: time.Now}
+}
+
+type connecter struct {
+	connecter managed.ExternalConnecter
+	record    event.Recorder
+	now       func() time.Time
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec, record: c.record, now: c.now}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	record event.Recorder
+	now    func() time.Time
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err == nil && o.ResourceExists {
+		Check(e.record, mg, e.now())
+	}
+	return o, err
+}
+
+// Check sets the Expiring condition of mg, if it can expire, and records an
+// event when mg starts expiring. Resources without an expiry date only get
+// the condition if they had an expiry date before.
+func Check(record event.Recorder, mg resource.Managed, now time.Time) {
+	ex, ok := mg.(Expiring)
+	if !ok {
+		return
+	}
+	was := mg.GetCondition(v1alpha1.TypeExpiring)
+	at := ex.GetExpiresAt()
+	switch {
+	case at != nil && at.Sub(now) < Warning:
+		c := v1alpha1.Expiring(at.Time, now)
+		if was.Status != corev1.ConditionTrue || was.Reason != c.Reason {
+			record.Event(mg, event.Warning(reasonExpiring, errors.New(c.Message)))
+		}
+		mg.SetConditions(c)
+	case at != nil || was.Status != corev1.ConditionUnknown:
+		mg.SetConditions(v1alpha1.NotExpiring())
+	}
+}
+
+// Extend returns the expiry date, formatted as YEAR-MONTH-DAY, extended to
+// by after now if it expires within Warning or half of by, whichever is
+// shorter. It returns false if the date does not need to be extended.
+func Extend(expiresAt *string, by *metav1.Duration, now time.Time) (string, bool) {
+	if expiresAt == nil || by == nil || by.Duration <= 0 {
+		return "", false
+	}
+	at, err := time.Parse(time.DateOnly, *expiresAt)
+	if err != nil {
+		return "", false
+	}
+	window := Warning
+	if by.Duration/2 < window {
+		window = by.Duration / 2
+	}
+	if at.Sub(now) >= window {
+		return "", false
+	}
+	extended := now.Add(by.Duration).UTC().Format(time.DateOnly)
+	return extended, extended > *expiresAt
+}
+
+// Extended returns the date, formatted as YEAR-MONTH-DAY, a resource
+// configured to expire at expiresAt and extended to extended before is
+// extended to by after now if it is about to expire, as Extend does. It
+// returns nil if the configured date is not extended.
+func Extended(expiresAt, extended *string, by *metav1.Duration, now time.Time) *string {
+	if expiresAt == nil || by == nil || by.Duration <= 0 {
+		return nil
+	}
+	at := *expiresAt
+	if extended != nil && *extended > at {
+		at = *extended
+	}
+	if e, ok := Extend(&at, by, now); ok {
+		at = e
+	}
+	if at == *expiresAt {
+		return nil
+	}
+	return &at
+}
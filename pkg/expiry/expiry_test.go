@@ -0,0 +1,256 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expiry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+)
+
+type expiring struct {
+	fake.Managed
+	expiresAt *metav1.Time
+}
+
+func (e *expiring) GetExpiresAt() *metav1.Time {
+	return e.expiresAt
+}
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestCheck(t *testing.T) {
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(d)}
+	}
+
+	type want struct {
+		conditions []xpv1.Condition
+		events     int
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"CannotExpire": {
+			mg:   &fake.Managed{},
+			want: want{},
+		},
+		"NeverExpires": {
+			mg:   &expiring{},
+			want: want{},
+		},
+		"NoLongerExpires": {
+			mg: func() resource.Managed {
+				e := &expiring{}
+				e.SetConditions(v1alpha1.Expiring(now, now))
+				return e
+			}(),
+			want: want{conditions: []xpv1.Condition{v1alpha1.NotExpiring()}},
+		},
+		"ExpiresLater": {
+			mg:   &expiring{expiresAt: at(2 * Warning)},
+			want: want{conditions: []xpv1.Condition{v1alpha1.NotExpiring()}},
+		},
+		"StartsExpiring": {
+			mg: &expiring{expiresAt: at(24 * time.Hour)},
+			want: want{
+				conditions: []xpv1.Condition{v1alpha1.Expiring(now.Add(24*time.Hour), now)},
+				events:     1,
+			},
+		},
+		"StillExpiring": {
+			mg: func() resource.Managed {
+				e := &expiring{expiresAt: at(24 * time.Hour)}
+				e.SetConditions(v1alpha1.Expiring(now.Add(24*time.Hour), now))
+				return e
+			}(),
+			want: want{conditions: []xpv1.Condition{v1alpha1.Expiring(now.Add(24*time.Hour), now)}},
+		},
+		"Expired": {
+			mg: func() resource.Managed {
+				e := &expiring{expiresAt: at(-time.Hour)}
+				e.SetConditions(v1alpha1.Expiring(now.Add(24*time.Hour), now))
+				return e
+			}(),
+			want: want{
+				conditions: []xpv1.Condition{v1alpha1.Expiring(now.Add(-time.Hour), now)},
+				events:     1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+			Check(r, tc.mg, now)
+
+			var got []xpv1.Condition
+			if c := tc.mg.GetCondition(v1alpha1.TypeExpiring); c.Reason != "" {
+				got = append(got, c)
+			}
+			if diff := cmp.Diff(tc.want.conditions, got, test.EquateConditions()); diff != "" {
+				t.Errorf("Check(...): -want conditions, +got conditions:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, len(r.events)); diff != "" {
+				t.Errorf("Check(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExtend(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	days := func(n int) *metav1.Duration {
+		return &metav1.Duration{Duration: time.Duration(n) * 24 * time.Hour}
+	}
+
+	type want struct {
+		date     string
+		extended bool
+	}
+
+	cases := map[string]struct {
+		expiresAt *string
+		by        *metav1.Duration
+		want      want
+	}{
+		"NoExpiry": {
+			by:   days(30),
+			want: want{},
+		},
+		"NoExtension": {
+			expiresAt: ptr.To("2024-05-02"),
+			want:      want{},
+		},
+		"Invalid": {
+			expiresAt: ptr.To("tomorrow"),
+			by:        days(30),
+			want:      want{},
+		},
+		"NotDue": {
+			expiresAt: ptr.To("2024-05-20"),
+			by:        days(30),
+			want:      want{},
+		},
+		"Due": {
+			expiresAt: ptr.To("2024-05-10"),
+			by:        days(30),
+			want:      want{date: "2024-05-31", extended: true},
+		},
+		"Expired": {
+			expiresAt: ptr.To("2024-04-01"),
+			by:        days(30),
+			want:      want{date: "2024-05-31", extended: true},
+		},
+		"ShortExtensionNotDue": {
+			expiresAt: ptr.To("2024-05-04"),
+			by:        days(4),
+			want:      want{},
+		},
+		"ShortExtensionDue": {
+			expiresAt: ptr.To("2024-05-02"),
+			by:        days(4),
+			want:      want{date: "2024-05-05", extended: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			date, extended := Extend(tc.expiresAt, tc.by, now)
+			if diff := cmp.Diff(tc.want, want{date: date, extended: extended}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Extend(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExtended(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	month := &metav1.Duration{Duration: 30 * 24 * time.Hour}
+
+	cases := map[string]struct {
+		expiresAt *string
+		extended  *string
+		by        *metav1.Duration
+		want      *string
+	}{
+		"NoExtension": {
+			expiresAt: ptr.To("2024-05-10"),
+			extended:  ptr.To("2024-05-31"),
+		},
+		"NotDue": {
+			expiresAt: ptr.To("2024-05-20"),
+			by:        month,
+		},
+		"Due": {
+			expiresAt: ptr.To("2024-05-10"),
+			by:        month,
+			want:      ptr.To("2024-05-31"),
+		},
+		"ExtendedNotDue": {
+			expiresAt: ptr.To("2024-04-01"),
+			extended:  ptr.To("2024-05-20"),
+			by:        month,
+			want:      ptr.To("2024-05-20"),
+		},
+		"ExtendedDue": {
+			expiresAt: ptr.To("2024-04-01"),
+			extended:  ptr.To("2024-05-10"),
+			by:        month,
+			want:      ptr.To("2024-05-31"),
+		},
+		"ConfiguredLater": {
+			expiresAt: ptr.To("2024-07-01"),
+			extended:  ptr.To("2024-05-20"),
+			by:        month,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Extended(tc.expiresAt, tc.extended, tc.by, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Extended(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
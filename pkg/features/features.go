@@ -29,4 +29,33 @@ const (
 	// Management Policies. See the below design for more details.
 	// https://github.com/crossplane/crossplane/pull/3531
 	EnableAlphaManagementPolicies feature.Flag = "EnableAlphaManagementPolicies"
+
+	// EnableDryRun makes controllers report the changes they would make to
+	// Gitlab instead of making them. Managed resources can override it with
+	// the gitlab.crossplane.io/dry-run annotation.
+	EnableDryRun feature.Flag = "EnableDryRun"
+
+	// EnableVariableCache makes the project Variable controller observe the
+	// Variables of a project from a short lived cache of its variables, so
+	// they are listed once instead of requested one by one.
+	EnableVariableCache feature.Flag = "EnableVariableCache"
+
+	// EnableProjectCache makes the Project controller observe the Projects
+	// of a group from a list of its projects refreshed once per poll
+	// interval, instead of requesting them one by one.
+	EnableProjectCache feature.Flag = "EnableProjectCache"
+
+	// EnableAuditLog makes controllers log an audit record for every
+	// change they make to Gitlab.
+	EnableAuditLog feature.Flag = "EnableAuditLog"
+
+	// EnablePollStaggering makes controllers poll every managed resource at
+	// a fixed offset within the poll interval derived from its UID, so the
+	// polls of many resources are spread evenly instead of bursting.
+	EnablePollStaggering feature.Flag = "EnablePollStaggering"
+
+	// EnableSecretMigration makes controllers move the plaintext sensitive
+	// fields of managed resources, e.g. the value of a Variable, into
+	// Secrets and replace them by references to these Secrets.
+	EnableSecretMigration feature.Flag = "EnableSecretMigration"
 )
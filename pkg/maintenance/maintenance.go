@@ -0,0 +1,312 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package maintenance limits the changes controllers make to Gitlab to
+// maintenance windows.
+package maintenance
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+// AnnotationKeyMaintenanceWindow sets the maintenance windows of a single
+// managed resource, overriding the windows of its ProviderConfig. Windows
+// are separated by semicolons and written as [DAYS ]START-END[ TIMEZONE],
+// e.g. "Sat,Sun 02:00-06:00 Europe/Berlin; 22:00-23:00". An empty
+// annotation lets changes be made at any time.
+const AnnotationKeyMaintenanceWindow = "gitlab.crossplane.io/maintenance-window"
+
+const (
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errParseAnnotation   = "cannot parse " + AnnotationKeyMaintenanceWindow + " annotation"
+	errParseWindow       = "cannot parse maintenance window of ProviderConfig"
+	errInvalidTime       = "invalid time of day %q"
+	errInvalidDay        = "invalid day of the week %q"
+	errInvalidWindow     = "invalid maintenance window %q"
+
+	errWouldCreate = "outside of maintenance window: would create external resource"
+	errWouldUpdate = "outside of maintenance window: would update external resource"
+	errWouldDelete = "outside of maintenance window: would delete external resource"
+	errNextWindow  = "%s, next window starts at %s"
+)
+
+var weekdays = map[string]time.Weekday{}
+
+func init() {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		weekdays[strings.ToLower(d.String())] = d
+		weekdays[strings.ToLower(d.String()[:3])] = d
+	}
+}
+
+// A Window is a recurring period of time in which changes are made to
+// Gitlab.
+type Window struct {
+	// days the window starts on, every day if nil.
+	days map[time.Weekday]bool
+
+	// start and end of the window in minutes after midnight.
+	start, end int
+
+	loc *time.Location
+}
+
+// NewWindow returns the Window described by w.
+func NewWindow(w v1beta1.MaintenanceWindow) (Window, error) {
+	days := make([]string, len(w.Days))
+	for i, d := range w.Days {
+		days[i] = string(d)
+	}
+	tz := ""
+	if w.TimeZone != nil {
+		tz = *w.TimeZone
+	}
+	return newWindow(days, w.Start, w.End, tz)
+}
+
+// Parse returns the windows described by the value of a
+// AnnotationKeyMaintenanceWindow annotation.
+func Parse(s string) ([]Window, error) {
+	var ws []Window
+	for _, part := range strings.Split(s, ";") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		// The time range is the only field containing a colon.
+		i := 0
+		for i < len(fields) && !strings.Contains(fields[i], ":") {
+			i++
+		}
+		if i > 1 || i == len(fields) || len(fields) > i+2 {
+			return nil, errors.Errorf(errInvalidWindow, strings.TrimSpace(part))
+		}
+		var days []string
+		if i == 1 {
+			days = strings.Split(fields[0], ",")
+		}
+		start, end, ok := strings.Cut(fields[i], "-")
+		if !ok {
+			return nil, errors.Errorf(errInvalidWindow, strings.TrimSpace(part))
+		}
+		tz := ""
+		if len(fields) == i+2 {
+			tz = fields[i+1]
+		}
+		w, err := newWindow(days, start, end, tz)
+		if err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, nil
+}
+
+func newWindow(days []string, start, end, tz string) (Window, error) {
+	w := Window{loc: time.UTC}
+	for _, d := range days {
+		wd, ok := weekdays[strings.ToLower(d)]
+		if !ok {
+			return Window{}, errors.Errorf(errInvalidDay, d)
+		}
+		if w.days == nil {
+			w.days = map[time.Weekday]bool{}
+		}
+		w.days[wd] = true
+	}
+	var err error
+	if w.start, err = minutes(start); err != nil {
+		return Window{}, err
+	}
+	if w.end, err = minutes(end); err != nil {
+		return Window{}, err
+	}
+	if tz != "" {
+		if w.loc, err = time.LoadLocation(tz); err != nil {
+			return Window{}, err
+		}
+	}
+	return w, nil
+}
+
+// minutes returns the minutes after midnight of a time of day written as
+// HH:MM.
+func minutes(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errors.Errorf(errInvalidTime, s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (w Window) startsOn(d time.Weekday) bool {
+	return w.days == nil || w.days[d]
+}
+
+// Contains returns true if t is within the window.
+func (w Window) Contains(t time.Time) bool {
+	t = t.In(w.loc)
+	m := t.Hour()*60 + t.Minute()
+	if w.start < w.end {
+		return w.startsOn(t.Weekday()) && m >= w.start && m < w.end
+	}
+	// The window ends on the next day.
+	return (w.startsOn(t.Weekday()) && m >= w.start) ||
+		(w.startsOn(t.AddDate(0, 0, -1).Weekday()) && m < w.end)
+}
+
+// Next returns the start of the first window after t.
+func (w Window) Next(t time.Time) time.Time {
+	t = t.In(w.loc)
+	for i := 0; i <= 7; i++ {
+		d := t.AddDate(0, 0, i)
+		s := time.Date(d.Year(), d.Month(), d.Day(), w.start/60, w.start%60, 0, 0, w.loc)
+		if s.After(t) && w.startsOn(s.Weekday()) {
+			return s
+		}
+	}
+	return time.Time{}
+}
+
+// IsOpen returns true if changes are made to Gitlab at t, which is the
+// case if there are no windows or t is within one of them.
+func IsOpen(ws []Window, t time.Time) bool {
+	if len(ws) == 0 {
+		return true
+	}
+	for _, w := range ws {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the start of the first of the windows after t.
+func Next(ws []Window, t time.Time) time.Time {
+	var next time.Time
+	for _, w := range ws {
+		if n := w.Next(t); next.IsZero() || (!n.IsZero() && n.Before(next)) {
+			next = n
+		}
+	}
+	return next
+}
+
+// Windows returns the maintenance windows of mg. The windows of its
+// AnnotationKeyMaintenanceWindow annotation take precedence over the
+// windows of its ProviderConfig.
+func Windows(ctx context.Context, kube client.Reader, mg resource.Managed) ([]Window, error) {
+	if v, ok := mg.GetAnnotations()[AnnotationKeyMaintenanceWindow]; ok {
+		ws, err := Parse(v)
+		return ws, errors.Wrap(err, errParseAnnotation)
+	}
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return nil, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	ws := make([]Window, 0, len(pc.Spec.MaintenanceWindows))
+	for _, mw := range pc.Spec.MaintenanceWindows {
+		w, err := NewWindow(mw)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseWindow)
+		}
+		ws = append(ws, w)
+	}
+	return ws, nil
+}
+
+// NewConnecter wraps c so that the clients it returns skip Create, Update
+// and Delete outside of the maintenance windows of a managed resource. The
+// skipped change is returned as an error instead, which the managed
+// reconciler reports in the Synced condition of the managed resource and
+// retries until a window opens. Observe is not affected.
+func NewConnecter(kube client.Reader, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{kube: kube, connecter: c, now: time.Now}
+}
+
+type connecter struct {
+	kube      client.Reader
+	connecter managed.ExternalConnecter
+	now       func() time.Time
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	// An invalid window blocks changes rather than observations, erring on
+	// the side of not touching the external resource.
+	ws, err := Windows(ctx, c.kube, mg)
+	return &external{ExternalClient: ec, windows: ws, err: err, now: c.now}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	windows []Window
+	err     error
+	now     func() time.Time
+}
+
+// closed returns an error describing the skipped change if changes must not
+// be made now.
+func (e *external) closed(change string) error {
+	if e.err != nil {
+		return e.err
+	}
+	now := e.now()
+	if IsOpen(e.windows, now) {
+		return nil
+	}
+	return errors.Errorf(errNextWindow, change, Next(e.windows, now).Format(time.RFC3339))
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if err := e.closed(errWouldCreate); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return e.ExternalClient.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if err := e.closed(errWouldUpdate); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if err := e.closed(errWouldDelete); err != nil {
+		return err
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+// 2024-01-06 is a Saturday.
+var saturday = time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC)
+
+func at(day time.Time, hour, minute int) time.Time {
+	return day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+}
+
+func metaWithAnnotation(v string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyMaintenanceWindow: v}}
+}
+
+func TestIsOpen(t *testing.T) {
+	cases := map[string]struct {
+		windows string
+		t       time.Time
+		want    bool
+	}{
+		"NoWindows": {
+			windows: "",
+			t:       at(saturday, 12, 0),
+			want:    true,
+		},
+		"WithinDailyWindow": {
+			windows: "02:00-06:00",
+			t:       at(saturday, 2, 0),
+			want:    true,
+		},
+		"AfterDailyWindow": {
+			windows: "02:00-06:00",
+			t:       at(saturday, 6, 0),
+			want:    false,
+		},
+		"WithinWeekdayWindow": {
+			windows: "Sat,Sun 02:00-06:00",
+			t:       at(saturday, 3, 0),
+			want:    true,
+		},
+		"OtherWeekday": {
+			windows: "Friday 02:00-06:00",
+			t:       at(saturday, 3, 0),
+			want:    false,
+		},
+		"OvernightWindowAfterMidnight": {
+			windows: "Fri 22:00-02:00",
+			t:       at(saturday, 1, 30),
+			want:    true,
+		},
+		"OvernightWindowStartedOnOtherDay": {
+			windows: "Sat 22:00-02:00",
+			t:       at(saturday, 1, 30),
+			want:    false,
+		},
+		"TimeZone": {
+			windows: "Sat 02:00-06:00 Europe/Berlin",
+			t:       at(saturday, 5, 30),
+			want:    false,
+		},
+		"AnyOfWindows": {
+			windows: "Sun 02:00-06:00; Sat 12:00-13:00",
+			t:       at(saturday, 12, 30),
+			want:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ws, err := Parse(tc.windows)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if got := IsOpen(ws, tc.t); got != tc.want {
+				t.Errorf("IsOpen(%q, %s): want %t, got %t", tc.windows, tc.t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := map[string]struct {
+		s   string
+		err error
+	}{
+		"Valid": {
+			s: "Sat,sunday 02:00-06:00 Europe/Berlin; 22:00-23:00",
+		},
+		"InvalidDay": {
+			s:   "Caturday 02:00-06:00",
+			err: errors.Errorf(errInvalidDay, "Caturday"),
+		},
+		"InvalidTime": {
+			s:   "02:00-25:00",
+			err: errors.Errorf(errInvalidTime, "25:00"),
+		},
+		"MissingEnd": {
+			s:   "Sat 02:00",
+			err: errors.Errorf(errInvalidWindow, "Sat 02:00"),
+		},
+		"TooManyFields": {
+			s:   "Sat 02:00-06:00 UTC extra",
+			err: errors.Errorf(errInvalidWindow, "Sat 02:00-06:00 UTC extra"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(tc.s)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	ws, err := Parse("Mon 02:00-06:00; Sat 22:00-02:00")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if diff := cmp.Diff(at(saturday, 22, 0), Next(ws, at(saturday, 12, 0))); diff != "" {
+		t.Errorf("Next: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(at(saturday, 24*2+2, 0), Next(ws, at(saturday, 22, 30))); diff != "" {
+		t.Errorf("Next: -want, +got:\n%s", diff)
+	}
+}
+
+func TestConnect(t *testing.T) {
+	type want struct {
+		err     error
+		changed bool
+	}
+
+	closed := errors.Errorf(errNextWindow, errWouldUpdate, at(saturday, 22, 0).Format(time.RFC3339))
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		kube client.Reader
+		want want
+	}{
+		"NoWindows": {
+			mg:   &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "pc"}}},
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			want: want{changed: true},
+		},
+		"ProviderConfigWindowClosed": {
+			mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "pc"}}},
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*v1beta1.ProviderConfig).Spec.MaintenanceWindows = []v1beta1.MaintenanceWindow{{Start: "22:00", End: "23:00"}}
+				return nil
+			})},
+			want: want{err: closed},
+		},
+		"AnnotationOverridesProviderConfig": {
+			mg: &fake.Managed{
+				ObjectMeta:               metaWithAnnotation("Sat 12:00-13:00"),
+				ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "pc"}},
+			},
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New("not called"))},
+			want: want{changed: true},
+		},
+		"InvalidAnnotation": {
+			mg:   &fake.Managed{ObjectMeta: metaWithAnnotation("Sat")},
+			kube: &test.MockClient{},
+			want: want{err: errors.Wrap(errors.Errorf(errInvalidWindow, "Sat"), errParseAnnotation)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := false
+			c := &connecter{
+				kube: tc.kube,
+				connecter: managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+					return &managed.ExternalClientFns{
+						UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+							changed = true
+							return managed.ExternalUpdate{}, nil
+						},
+					}, nil
+				}),
+				now: func() time.Time { return at(saturday, 12, 30) },
+			}
+			e, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect: %v", err)
+			}
+			_, err = e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("changed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// NewConnecter wraps c so that failed operations of the clients it returns
+// are counted for the managed resource kind gvk.
+func NewConnecter(gvk schema.GroupVersionKind, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{kind: gvk.GroupKind().String(), connecter: c}
+}
+
+type connecter struct {
+	kind      string
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		countError(c.kind, "connect")
+		return nil, err
+	}
+	return &external{kind: c.kind, client: ec}, nil
+}
+
+type external struct {
+	kind   string
+	client managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.client.Observe(ctx, mg)
+	if err != nil {
+		countError(e.kind, "observe")
+	}
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.client.Create(ctx, mg)
+	if err != nil {
+		countError(e.kind, "create")
+	}
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.client.Update(ctx, mg)
+	if err != nil {
+		countError(e.kind, "update")
+	}
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.client.Delete(ctx, mg)
+	if err != nil {
+		countError(e.kind, "delete")
+	}
+	return err
+}
+
+func countError(kind, operation string) {
+	managedResourceErrors.WithLabelValues(kind, operation).Inc()
+}
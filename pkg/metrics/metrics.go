@@ -0,0 +1,128 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics exports the health of managed resources per kind.
+package metrics
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	apiGroupSuffix = "gitlab.crossplane.io"
+	listTimeout    = 10 * time.Second
+)
+
+var (
+	managedResourceDesc = prometheus.NewDesc(
+		"gitlab_managed_resource",
+		"Number of managed resources by kind and by the status of their Ready and Synced conditions.",
+		[]string{"kind", "ready", "synced"}, nil,
+	)
+
+	managedResourceErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gitlab_managed_resource_errors_total",
+		Help: "Number of failed operations on external resources by kind and operation.",
+	}, []string{"kind", "operation"})
+)
+
+// Setup registers the managed resource metrics with the metrics registry
+// of the controller-runtime. The managed kinds are discovered from the
+// scheme of mgr.
+func Setup(mgr ctrl.Manager) error {
+	if err := crmetrics.Registry.Register(managedResourceErrors); err != nil {
+		return err
+	}
+	return crmetrics.Registry.Register(&managedResourceCollector{
+		client: mgr.GetClient(),
+		scheme: mgr.GetScheme(),
+		kinds:  managedKinds(mgr.GetScheme()),
+	})
+}
+
+// managedKinds returns the managed resource kinds of this provider known to
+// scheme.
+func managedKinds(scheme *runtime.Scheme) []schema.GroupVersionKind {
+	managed := reflect.TypeOf((*resource.Managed)(nil)).Elem()
+	kinds := []schema.GroupVersionKind{}
+	for gvk, t := range scheme.AllKnownTypes() {
+		if strings.HasSuffix(gvk.Group, apiGroupSuffix) && reflect.PointerTo(t).Implements(managed) {
+			kinds = append(kinds, gvk)
+		}
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i].String() < kinds[j].String() })
+	return kinds
+}
+
+// managedResourceCollector counts the managed resources of each kind by
+// their conditions whenever metrics are scraped.
+type managedResourceCollector struct {
+	client client.Reader
+	scheme *runtime.Scheme
+	kinds  []schema.GroupVersionKind
+}
+
+func (c *managedResourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- managedResourceDesc
+}
+
+func (c *managedResourceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+
+	for _, gvk := range c.kinds {
+		o, err := c.scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err != nil {
+			ch <- prometheus.NewInvalidMetric(managedResourceDesc, err)
+			continue
+		}
+		l, ok := o.(resource.ManagedList)
+		if !ok {
+			continue
+		}
+		if err := c.client.List(ctx, l); err != nil {
+			ch <- prometheus.NewInvalidMetric(managedResourceDesc, err)
+			continue
+		}
+
+		type status struct{ ready, synced string }
+		counts := map[status]int{}
+		for _, mg := range l.GetItems() {
+			s := status{
+				ready:  string(mg.GetCondition(xpv1.TypeReady).Status),
+				synced: string(mg.GetCondition(xpv1.TypeSynced).Status),
+			}
+			counts[s]++
+		}
+		for s, n := range counts {
+			ch <- prometheus.MustNewConstMetric(managedResourceDesc, prometheus.GaugeValue, float64(n), gvk.GroupKind().String(), s.ready, s.synced)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func project(name string, c ...xpv1.Condition) *v1alpha1.Project {
+	p := &v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	p.SetConditions(c...)
+	return p
+}
+
+func TestManagedResourceCollector(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		project("a", xpv1.Available(), xpv1.ReconcileSuccess()),
+		project("b", xpv1.Available(), xpv1.ReconcileSuccess()),
+		project("c", xpv1.Creating(), xpv1.ReconcileError(errors.New("boom"))),
+	).Build()
+
+	collector := &managedResourceCollector{
+		client: c,
+		scheme: s,
+		kinds:  []schema.GroupVersionKind{v1alpha1.ProjectGroupVersionKind},
+	}
+
+	want := `
+# HELP gitlab_managed_resource Number of managed resources by kind and by the status of their Ready and Synced conditions.
+# TYPE gitlab_managed_resource gauge
+gitlab_managed_resource{kind="Project.projects.gitlab.crossplane.io",ready="False",synced="False"} 1
+gitlab_managed_resource{kind="Project.projects.gitlab.crossplane.io",ready="True",synced="True"} 2
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestManagedKinds(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, gvk := range managedKinds(s) {
+		if gvk == v1alpha1.ProjectGroupVersionKind {
+			found = true
+		}
+		if gvk.Kind == "ProviderConfig" {
+			t.Errorf("ProviderConfig is not a managed resource")
+		}
+	}
+	if !found {
+		t.Errorf("Project is a managed resource")
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package operations tracks the operations Gitlab keeps running after a
+// request creating a resource returned, such as imports of projects
+// created from a template or another repository.
+package operations
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+)
+
+// A Tracked resource reports the phase of its operation in its status.
+type Tracked interface {
+	resource.Conditioned
+	GetPhase() v1alpha1.OperationPhase
+	SetPhase(p v1alpha1.OperationPhase)
+}
+
+// Started records that the operation of mg was requested.
+func Started(mg Tracked) {
+	mg.SetPhase(v1alpha1.PhaseCreating)
+	mg.SetConditions(xpv1.Creating())
+}
+
+// Observed records the phase of the operation of mg as observed in Gitlab
+// and sets the Ready condition accordingly. The message explains a failed
+// operation.
+func Observed(mg Tracked, p v1alpha1.OperationPhase, message string) {
+	mg.SetPhase(p)
+	switch p {
+	case v1alpha1.PhaseReady:
+		mg.SetConditions(xpv1.Available())
+	case v1alpha1.PhaseFailed:
+		mg.SetConditions(xpv1.Unavailable().WithMessage(message))
+	case v1alpha1.PhaseCreating, v1alpha1.PhaseImporting:
+		mg.SetConditions(xpv1.Creating())
+	}
+}
+
+// ImportPhase returns the phase of an operation from the import status
+// Gitlab reports for projects.
+func ImportPhase(status string) v1alpha1.OperationPhase {
+	switch status {
+	case "", "none", "finished":
+		return v1alpha1.PhaseReady
+	case "failed", "canceled":
+		return v1alpha1.PhaseFailed
+	default:
+		return v1alpha1.PhaseImporting
+	}
+}
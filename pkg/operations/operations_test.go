@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+)
+
+type tracked struct {
+	xpv1.ConditionedStatus
+	phase v1alpha1.OperationPhase
+}
+
+func (t *tracked) GetPhase() v1alpha1.OperationPhase  { return t.phase }
+func (t *tracked) SetPhase(p v1alpha1.OperationPhase) { t.phase = p }
+
+func TestObserved(t *testing.T) {
+	cases := map[string]struct {
+		status    string
+		message   string
+		wantPhase v1alpha1.OperationPhase
+		wantReady xpv1.Condition
+	}{
+		"NotImported": {
+			status:    "none",
+			wantPhase: v1alpha1.PhaseReady,
+			wantReady: xpv1.Available(),
+		},
+		"Imported": {
+			status:    "finished",
+			wantPhase: v1alpha1.PhaseReady,
+			wantReady: xpv1.Available(),
+		},
+		"Importing": {
+			status:    "scheduled",
+			wantPhase: v1alpha1.PhaseImporting,
+			wantReady: xpv1.Creating(),
+		},
+		"Failed": {
+			status:    "failed",
+			message:   "repository not found",
+			wantPhase: v1alpha1.PhaseFailed,
+			wantReady: xpv1.Unavailable().WithMessage("repository not found"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &tracked{}
+			Started(mg)
+			Observed(mg, ImportPhase(tc.status), tc.message)
+			if diff := cmp.Diff(tc.wantPhase, mg.GetPhase()); diff != "" {
+				t.Errorf("phase: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantReady, mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Ready: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
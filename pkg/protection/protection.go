@@ -0,0 +1,156 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package protection keeps controllers from deleting critical Gitlab
+// resources.
+package protection
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyDeletionProtection blocks the deletion of the external
+// resource of a managed resource while set to "true". The managed resource
+// stays in deletion until the annotation is removed. Use the Orphan deletion
+// policy to delete the managed resource but keep the external resource.
+const AnnotationKeyDeletionProtection = "gitlab.crossplane.io/deletion-protection"
+
+// AnnotationKeyConfirmDelete confirms the deletion of the external resource
+// of a Confirmable managed resource while set to "true".
+const AnnotationKeyConfirmDelete = "gitlab.crossplane.io/confirm-delete"
+
+// TypeBlocked is the type of the condition reporting whether the deletion of
+// the external resource is blocked.
+const TypeBlocked xpv1.ConditionType = "Blocked"
+
+// Reasons of the Blocked condition.
+const (
+	ReasonDeletionProtected   xpv1.ConditionReason = "DeletionProtected"
+	ReasonDeletionUnconfirmed xpv1.ConditionReason = "DeletionUnconfirmed"
+	ReasonDeletionAllowed     xpv1.ConditionReason = "DeletionAllowed"
+)
+
+const (
+	errDeletionProtected   = "deletion of the external resource is blocked by the " + AnnotationKeyDeletionProtection + " annotation"
+	errDeletionUnconfirmed = "deletion of the external resource is blocked until confirmed by spec.forProvider.confirmDelete or the " + AnnotationKeyConfirmDelete + " annotation"
+)
+
+// A Confirmable resource requires the deletion of its external resource to
+// be confirmed, because deleting it by mistake loses data that cannot be
+// restored, such as the repositories of Gitlab projects.
+type Confirmable interface {
+	GetConfirmDelete() *bool
+}
+
+// IsDeletionProtected returns true if the external resource of mg must not
+// be deleted.
+func IsDeletionProtected(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeyDeletionProtection] == "true"
+}
+
+// IsDeletionUnconfirmed returns true if mg requires the deletion of its
+// external resource to be confirmed and it is not.
+func IsDeletionUnconfirmed(mg resource.Managed) bool {
+	c, ok := mg.(Confirmable)
+	if !ok {
+		return false
+	}
+	if v := c.GetConfirmDelete(); v != nil && *v {
+		return false
+	}
+	return mg.GetAnnotations()[AnnotationKeyConfirmDelete] != "true"
+}
+
+// DeletionBlocked returns a condition that indicates the deletion of the
+// external resource is blocked.
+func DeletionBlocked() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionProtected,
+		Message:            errDeletionProtected,
+	}
+}
+
+// DeletionUnconfirmed returns a condition that indicates the deletion of the
+// external resource is blocked until it is confirmed.
+func DeletionUnconfirmed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionUnconfirmed,
+		Message:            errDeletionUnconfirmed,
+	}
+}
+
+// DeletionAllowed returns a condition that indicates the deletion of the
+// external resource is no longer blocked.
+func DeletionAllowed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBlocked,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionAllowed,
+	}
+}
+
+// NewConnecter wraps c so that the clients it returns refuse to delete the
+// external resource of deletion protected managed resources, and of
+// Confirmable managed resources until the deletion is confirmed.
+func NewConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{connecter: c}
+}
+
+type connecter struct {
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if IsDeletionProtected(mg) {
+		mg.SetConditions(DeletionBlocked())
+		return errors.New(errDeletionProtected)
+	}
+	if IsDeletionUnconfirmed(mg) {
+		mg.SetConditions(DeletionUnconfirmed())
+		return errors.New(errDeletionUnconfirmed)
+	}
+	if mg.GetCondition(TypeBlocked).Status == corev1.ConditionTrue {
+		mg.SetConditions(DeletionAllowed())
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type managedModifier func(*fake.Managed)
+
+func withAnnotations(a map[string]string) managedModifier {
+	return func(mg *fake.Managed) { mg.SetAnnotations(a) }
+}
+
+func withConditions(c ...xpv1.Condition) managedModifier {
+	return func(mg *fake.Managed) { mg.SetConditions(c...) }
+}
+
+func newManaged(m ...managedModifier) *fake.Managed {
+	mg := &fake.Managed{}
+	for _, f := range m {
+		f(mg)
+	}
+	return mg
+}
+
+type confirmableManaged struct {
+	fake.Managed
+	ConfirmDelete *bool
+}
+
+func (mg *confirmableManaged) GetConfirmDelete() *bool { return mg.ConfirmDelete }
+
+func newConfirmable(confirm *bool, m ...managedModifier) *confirmableManaged {
+	return &confirmableManaged{Managed: *newManaged(m...), ConfirmDelete: confirm}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg      resource.Managed
+		err     error
+		deleted bool
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"Unprotected": {
+			mg: newManaged(),
+			want: want{
+				mg:      newManaged(),
+				deleted: true,
+			},
+		},
+		"Protected": {
+			mg: newManaged(withAnnotations(map[string]string{AnnotationKeyDeletionProtection: "true"})),
+			want: want{
+				mg: newManaged(
+					withAnnotations(map[string]string{AnnotationKeyDeletionProtection: "true"}),
+					withConditions(DeletionBlocked()),
+				),
+				err: errors.New(errDeletionProtected),
+			},
+		},
+		"Unconfirmed": {
+			mg: newConfirmable(nil),
+			want: want{
+				mg:  newConfirmable(nil, withConditions(DeletionUnconfirmed())),
+				err: errors.New(errDeletionUnconfirmed),
+			},
+		},
+		"ConfirmedBySpec": {
+			mg: newConfirmable(ptr.To(true), withConditions(DeletionUnconfirmed())),
+			want: want{
+				mg:      newConfirmable(ptr.To(true), withConditions(DeletionAllowed())),
+				deleted: true,
+			},
+		},
+		"ConfirmedByAnnotation": {
+			mg: newConfirmable(ptr.To(false), withAnnotations(map[string]string{AnnotationKeyConfirmDelete: "true"})),
+			want: want{
+				mg:      newConfirmable(ptr.To(false), withAnnotations(map[string]string{AnnotationKeyConfirmDelete: "true"})),
+				deleted: true,
+			},
+		},
+		"ConfirmedButProtected": {
+			mg: newConfirmable(ptr.To(true), withAnnotations(map[string]string{AnnotationKeyDeletionProtection: "true"})),
+			want: want{
+				mg: newConfirmable(ptr.To(true),
+					withAnnotations(map[string]string{AnnotationKeyDeletionProtection: "true"}),
+					withConditions(DeletionBlocked()),
+				),
+				err: errors.New(errDeletionProtected),
+			},
+		},
+		"ProtectionRemoved": {
+			mg: newManaged(withConditions(DeletionBlocked())),
+			want: want{
+				mg:      newManaged(withConditions(DeletionAllowed())),
+				deleted: true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			c := NewConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					DeleteFn: func(_ context.Context, _ resource.Managed) error {
+						deleted = true
+						return nil
+					},
+				}, nil
+			}))
+			e, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect: %v", err)
+			}
+			err = e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rotation lets access tokens be rotated on request, one by one or
+// fleet-wide by an AccessTokenRotation.
+package rotation
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+)
+
+// AnnotationKeyRotate requests the rotation of an access token. Its value
+// identifies the request; the token is revoked and recreated once for every
+// new value.
+const AnnotationKeyRotate = "gitlab.crossplane.io/rotate"
+
+// AnnotationKeyRotated records the value of AnnotationKeyRotate of the last
+// rotation that completed.
+const AnnotationKeyRotated = "gitlab.crossplane.io/rotated"
+
+// Requested returns true if o was requested to rotate and the rotation did
+// not complete yet.
+func Requested(o metav1.Object) bool {
+	a := o.GetAnnotations()
+	return a[AnnotationKeyRotate] != "" && a[AnnotationKeyRotate] != a[AnnotationKeyRotated]
+}
+
+// Request requests the rotation of o, identified by id.
+func Request(o metav1.Object, id string) {
+	meta.AddAnnotations(o, map[string]string{AnnotationKeyRotate: id})
+}
+
+// Completed records that the requested rotation of o completed. It does
+// nothing if no rotation was requested.
+func Completed(o metav1.Object) {
+	if !Requested(o) {
+		return
+	}
+	meta.AddAnnotations(o, map[string]string{AnnotationKeyRotated: o.GetAnnotations()[AnnotationKeyRotate]})
+}
+
+// IsCompleted returns true if the rotation of o identified by id completed.
+func IsCompleted(o metav1.Object, id string) bool {
+	return o.GetAnnotations()[AnnotationKeyRotated] == id
+}
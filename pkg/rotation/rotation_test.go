@@ -0,0 +1,49 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rotation
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRotation(t *testing.T) {
+	o := &metav1.ObjectMeta{}
+	if Requested(o) {
+		t.Errorf("Requested: want false without annotation")
+	}
+	Completed(o)
+	if diff := cmp.Diff(map[string]string(nil), o.GetAnnotations()); diff != "" {
+		t.Errorf("Completed without request: -want, +got:\n%s", diff)
+	}
+
+	Request(o, "incident-1")
+	if !Requested(o) {
+		t.Errorf("Requested: want true after Request")
+	}
+	Completed(o)
+	if Requested(o) || !IsCompleted(o, "incident-1") {
+		t.Errorf("Requested, IsCompleted: want false, true after Completed")
+	}
+
+	Request(o, "incident-2")
+	if !Requested(o) || IsCompleted(o, "incident-2") {
+		t.Errorf("Requested, IsCompleted: want true, false after another Request")
+	}
+}
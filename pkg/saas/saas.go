@@ -0,0 +1,166 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package saas keeps controllers from using fields that require an
+// administrator token against gitlab.com, where no token has administrator
+// privileges.
+package saas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// TypeUnsupported is the type of the condition reporting whether a managed
+// resource uses fields its Gitlab instance does not support.
+const TypeUnsupported xpv1.ConditionType = "Unsupported"
+
+// Reasons of the Unsupported condition.
+const (
+	ReasonUnsupportedOnSaaS xpv1.ConditionReason = "UnsupportedOnSaaS"
+	ReasonSupported         xpv1.ConditionReason = "Supported"
+)
+
+const (
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errUnsupportedOnSaaS = "fields require an administrator token, which gitlab.com does not grant: %s"
+)
+
+// An AdminOnly managed resource has fields that require an administrator
+// token.
+type AdminOnly interface {
+	// GetAdminOnlyFields returns the paths of the fields that are set and
+	// require an administrator token.
+	GetAdminOnlyFields() []string
+}
+
+// UnsupportedOnSaaS returns a condition that indicates the managed resource
+// uses fields that require an administrator token against gitlab.com.
+func UnsupportedOnSaaS(fields []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUnsupported,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnsupportedOnSaaS,
+		Message:            fmt.Sprintf(errUnsupportedOnSaaS, strings.Join(fields, ", ")),
+	}
+}
+
+// Supported returns a condition that indicates the managed resource no
+// longer uses unsupported fields.
+func Supported() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUnsupported,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSupported,
+	}
+}
+
+// UnsupportedFields returns the fields of mg that require an administrator
+// token if its ProviderConfig points to gitlab.com, and nil otherwise.
+func UnsupportedFields(ctx context.Context, kube client.Reader, mg resource.Managed) ([]string, error) {
+	ao, ok := mg.(AdminOnly)
+	if !ok {
+		return nil, nil
+	}
+	fields := ao.GetAdminOnlyFields()
+	ref := mg.GetProviderConfigReference()
+	if len(fields) == 0 || ref == nil {
+		return nil, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	if !clients.IsSaaS(pc.Spec.BaseURL) {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// NewConnecter wraps c so that the clients it returns fail fast with an
+// UnsupportedOnSaaS condition instead of making requests that gitlab.com
+// denies, for AdminOnly managed resources that use fields requiring an
+// administrator token. The external resource of such a managed resource can
+// still be deleted.
+func NewConnecter(kube client.Reader, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{kube: kube, connecter: c}
+}
+
+type connecter struct {
+	kube      client.Reader
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := UnsupportedFields(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		if mg.GetCondition(TypeUnsupported).Status == corev1.ConditionTrue {
+			mg.SetConditions(Supported())
+		}
+		return ec, nil
+	}
+	return &external{ExternalClient: ec, fields: fields}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	fields []string
+}
+
+func (e *external) unsupported(mg resource.Managed) error {
+	mg.SetConditions(UnsupportedOnSaaS(e.fields))
+	return errors.Errorf(errUnsupportedOnSaaS, strings.Join(e.fields, ", "))
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	// The external resource must be observed to be deleted.
+	if meta.WasDeleted(mg) {
+		return e.ExternalClient.Observe(ctx, mg)
+	}
+	return managed.ExternalObservation{}, e.unsupported(mg)
+}
+
+func (e *external) Create(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, e.unsupported(mg)
+}
+
+func (e *external) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, e.unsupported(mg)
+}
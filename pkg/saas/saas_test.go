@@ -0,0 +1,141 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package saas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+type adminOnlyManaged struct {
+	fake.Managed
+	fields []string
+}
+
+func (mg *adminOnlyManaged) GetAdminOnlyFields() []string { return mg.fields }
+
+func newAdminOnly(fields ...string) *adminOnlyManaged {
+	return &adminOnlyManaged{
+		Managed: fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "pc"}}},
+		fields:  fields,
+	}
+}
+
+func withBaseURL(u string) client.Reader {
+	return &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+		obj.(*v1beta1.ProviderConfig).Spec.BaseURL = u
+		return nil
+	})}
+}
+
+func TestConnect(t *testing.T) {
+	type want struct {
+		err       error
+		observed  bool
+		condition xpv1.ConditionReason
+	}
+
+	fields := "spec.forProvider.repositoryStorage"
+	deleted := newAdminOnly(fields)
+	deleted.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	supported := newAdminOnly()
+	supported.SetConditions(UnsupportedOnSaaS([]string{fields}))
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		kube client.Reader
+		want want
+	}{
+		"NotAdminOnly": {
+			mg:   &fake.Managed{},
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New("not called"))},
+			want: want{observed: true},
+		},
+		"NoAdminOnlyFields": {
+			mg:   newAdminOnly(),
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New("not called"))},
+			want: want{observed: true},
+		},
+		"SelfManaged": {
+			mg:   newAdminOnly(fields),
+			kube: withBaseURL("https://gitlab.example.com/"),
+			want: want{observed: true},
+		},
+		"SaaS": {
+			mg:   newAdminOnly(fields),
+			kube: withBaseURL("https://gitlab.com/"),
+			want: want{
+				err:       errors.Errorf(errUnsupportedOnSaaS, fields),
+				condition: ReasonUnsupportedOnSaaS,
+			},
+		},
+		"SaaSDeleted": {
+			mg:   deleted,
+			kube: withBaseURL(""),
+			want: want{observed: true},
+		},
+		"NoLongerUnsupported": {
+			mg:   supported,
+			kube: withBaseURL("https://gitlab.com/"),
+			want: want{observed: true, condition: ReasonSupported},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := false
+			c := &connecter{
+				kube: tc.kube,
+				connecter: managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+					return &managed.ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+							observed = true
+							return managed.ExternalObservation{}, nil
+						},
+					}, nil
+				}),
+			}
+			e, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect: %v", err)
+			}
+			_, err = e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.observed, observed); diff != "" {
+				t.Errorf("observed: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.mg.GetCondition(TypeUnsupported).Reason); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretversion propagates rotations of the Secrets managed
+// resources read, such as the value of a Variable, to Gitlab. The version of
+// the Secret values last sent to Gitlab is recorded in an annotation. Use
+// package secretwatch to reconcile managed resources as soon as a Secret
+// they read changes.
+package secretversion
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeySecretVersion records the version of the values of the Secret
+// keys a managed resource reads that were last sent to Gitlab.
+const AnnotationKeySecretVersion = "gitlab.crossplane.io/secret-version"
+
+const (
+	errGetSecret     = "cannot get secret %s/%s"
+	errRecordVersion = "cannot record secret version"
+)
+
+// A SecretReferencer reads the values of Secret keys.
+type SecretReferencer interface {
+	// GetSecretRefs returns the Secret keys that are set.
+	GetSecretRefs() []xpv1.SecretKeySelector
+}
+
+// Version returns the version of the values of the Secret keys mg reads, or
+// an empty string if it reads none.
+func Version(ctx context.Context, kube client.Reader, mg resource.Managed) (string, error) {
+	sr, ok := mg.(SecretReferencer)
+	if !ok || len(sr.GetSecretRefs()) == 0 {
+		return "", nil
+	}
+	h := sha256.New()
+	for _, ref := range sr.GetSecretRefs() {
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return "", errors.Wrapf(err, errGetSecret, ref.Namespace, ref.Name)
+		}
+		_, _ = fmt.Fprintf(h, "%s/%s/%s=%x\n", ref.Namespace, ref.Name, ref.Key, s.Data[ref.Key])
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Changed returns true if the values of the Secret keys mg reads changed
+// since they were last sent to Gitlab. It returns false if no version was
+// recorded yet.
+func Changed(ctx context.Context, kube client.Reader, mg resource.Managed) (bool, error) {
+	recorded, ok := mg.GetAnnotations()[AnnotationKeySecretVersion]
+	if !ok {
+		return false, nil
+	}
+	v, err := Version(ctx, kube, mg)
+	return err == nil && v != recorded, err
+}
+
+// NewConnecter wraps c so that the managed resources it observes are not up
+// to date while the Secret keys they read changed since their values were
+// last sent to Gitlab, and records the version of the values after they were
+// created or updated. Managed resources observed to be up to date before a
+// version was recorded are assumed to have sent the current values.
+func NewConnecter(kube client.Client, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{kube: kube, connecter: c}
+}
+
+type connecter struct {
+	kube      client.Client
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := mg.(SecretReferencer); !ok {
+		return ec, nil
+	}
+	return &external{ExternalClient: ec, kube: c.kube}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	kube client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil || !o.ResourceExists || meta.WasDeleted(mg) {
+		return o, err
+	}
+	v, err := Version(ctx, e.kube, mg)
+	if err != nil {
+		return o, err
+	}
+	recorded, ok := mg.GetAnnotations()[AnnotationKeySecretVersion]
+	switch {
+	case ok && recorded != v:
+		o.ResourceUpToDate = false
+	case !ok && v != "" && o.ResourceUpToDate:
+		return o, e.record(ctx, mg, v)
+	}
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	v, err := Version(ctx, e.kube, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	c, err := e.ExternalClient.Create(ctx, mg)
+	if err != nil || v == "" {
+		return c, err
+	}
+	// The external name is not persisted yet, so the version is recorded
+	// together with it.
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeySecretVersion: v})
+	return c, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	v, err := Version(ctx, e.kube, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	u, err := e.ExternalClient.Update(ctx, mg)
+	if err != nil || mg.GetAnnotations()[AnnotationKeySecretVersion] == v {
+		return u, err
+	}
+	return u, e.record(ctx, mg, v)
+}
+
+// record persists version v in the annotations of mg. Only the annotation
+// is patched, so the status of mg that is not written yet is kept.
+func (e *external) record(ctx context.Context, mg resource.Managed, v string) error {
+	o := mg.DeepCopyObject().(client.Object)
+	p := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, AnnotationKeySecretVersion, v)
+	if err := e.kube.Patch(ctx, o, client.RawPatch(types.MergePatchType, []byte(p))); err != nil {
+		return errors.Wrap(err, errRecordVersion)
+	}
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeySecretVersion: v})
+	mg.SetResourceVersion(o.GetResourceVersion())
+	return nil
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var errBoom = errors.New("boom")
+
+type referencing struct {
+	fake.Managed
+	refs []xpv1.SecretKeySelector
+}
+
+func (r *referencing) GetSecretRefs() []xpv1.SecretKeySelector {
+	return r.refs
+}
+
+// referencingToken returns a managed resource reading the token of a
+// Secret, with version v recorded unless it is empty.
+func referencingToken(v string) *referencing {
+	mg := &referencing{refs: []xpv1.SecretKeySelector{{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "token"}}}
+	if v != "" {
+		meta.AddAnnotations(mg, map[string]string{AnnotationKeySecretVersion: v})
+	}
+	return mg
+}
+
+// secretClient serves a Secret whose token is value and counts patches.
+func secretClient(value string, patches *int) *test.MockClient {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte(value)}
+			return nil
+		},
+		MockPatch: func(_ context.Context, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+			*patches++
+			return nil
+		},
+	}
+}
+
+func version(t *testing.T, value string) string {
+	t.Helper()
+	v, err := Version(context.Background(), secretClient(value, nil), referencingToken(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestVersion(t *testing.T) {
+	if version(t, "a") == version(t, "b") {
+		t.Errorf("Version: want different versions for different values")
+	}
+	if diff := cmp.Diff(version(t, "a"), version(t, "a")); diff != "" {
+		t.Errorf("Version: -want, +got:\n%s", diff)
+	}
+	v, err := Version(context.Background(), secretClient("a", nil), &fake.Managed{})
+	if diff := cmp.Diff("", v); diff != "" || err != nil {
+		t.Errorf("Version: not referencing: -want, +got:\n%s %v", diff, err)
+	}
+	kube := &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}
+	if _, err := Version(context.Background(), kube, referencingToken("")); err == nil {
+		t.Errorf("Version: want error if the secret cannot be read")
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o       managed.ExternalObservation
+		version string
+		patches int
+	}
+
+	cases := map[string]struct {
+		mg    resource.Managed
+		value string
+		o     managed.ExternalObservation
+		want  want
+	}{
+		"Unchanged": {
+			mg:    referencingToken(version(t, "a")),
+			value: "a",
+			o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				version: version(t, "a"),
+			},
+		},
+		"Rotated": {
+			mg:    referencingToken(version(t, "a")),
+			value: "b",
+			o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				version: version(t, "a"),
+			},
+		},
+		"NotRecorded": {
+			mg:    referencingToken(""),
+			value: "a",
+			o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				version: version(t, "a"),
+				patches: 1,
+			},
+		},
+		"NotRecordedNotUpToDate": {
+			mg:    referencingToken(""),
+			value: "a",
+			o:     managed.ExternalObservation{ResourceExists: true},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotExists": {
+			mg:    referencingToken(version(t, "a")),
+			value: "b",
+			want: want{
+				version: version(t, "a"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patches := 0
+			c := NewConnecter(secretClient(tc.value, &patches), managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return tc.o, nil
+					},
+				}, nil
+			}))
+			ec, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			o, err := ec.Observe(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.version, tc.mg.GetAnnotations()[AnnotationKeySecretVersion]); diff != "" {
+				t.Errorf("Observe: version: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.patches, patches); diff != "" {
+				t.Errorf("Observe: patches: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err     error
+		version string
+		patches int
+	}
+
+	cases := map[string]struct {
+		mg        resource.Managed
+		updateErr error
+		want      want
+	}{
+		"Rotated": {
+			mg: referencingToken(version(t, "a")),
+			want: want{
+				version: version(t, "b"),
+				patches: 1,
+			},
+		},
+		"Unchanged": {
+			mg: referencingToken(version(t, "b")),
+			want: want{
+				version: version(t, "b"),
+			},
+		},
+		"UpdateFailed": {
+			mg:        referencingToken(version(t, "a")),
+			updateErr: errBoom,
+			want: want{
+				err:     errBoom,
+				version: version(t, "a"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patches := 0
+			e := &external{
+				ExternalClient: &managed.ExternalClientFns{
+					UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+						return managed.ExternalUpdate{}, tc.updateErr
+					},
+				},
+				kube: secretClient("b", &patches),
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update: error: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.version, tc.mg.GetAnnotations()[AnnotationKeySecretVersion]); diff != "" {
+				t.Errorf("Update: version: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.patches, patches); diff != "" {
+				t.Errorf("Update: patches: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChanged(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want bool
+	}{
+		"NotRecorded": {mg: referencingToken(""), want: false},
+		"Unchanged":   {mg: referencingToken(version(t, "a")), want: false},
+		"Rotated":     {mg: referencingToken(version(t, "b")), want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Changed(context.Background(), secretClient("a", nil), tc.mg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Changed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
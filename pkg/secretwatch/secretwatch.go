@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretwatch reconciles managed resources as soon as a Secret they
+// reference changes, such as a rotated credentials token, instead of at
+// their next poll.
+package secretwatch
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/secretversion"
+)
+
+// indexKey indexes managed resources by the Secrets they reference.
+const indexKey = "secretwatch.gitlab.crossplane.io/secret"
+
+const (
+	errIndex = "cannot index %T by the secrets it references"
+)
+
+// A Manager indexes and lists managed resources.
+type Manager interface {
+	GetClient() client.Client
+	GetFieldIndexer() client.FieldIndexer
+}
+
+// Watch makes the controller built by b enqueue the managed resources of the
+// kind of mg that reference a Secret when it changes. The managed resources
+// are indexed by the Secrets they authenticate to Gitlab with and the
+// Secrets they read values from, and listed into copies of l. Only the
+// metadata of Secrets is cached, and only changes of Secrets referenced by
+// a managed resource of the kind are handled.
+func Watch(mgr Manager, b *builder.Builder, mg resource.Managed, l resource.ManagedList) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), mg, indexKey, secrets); err != nil {
+		return errors.Wrapf(err, errIndex, mg)
+	}
+	kube := mgr.GetClient()
+	b.WatchesMetadata(&corev1.Secret{},
+		handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, s client.Object) []reconcile.Request {
+			var reqs []reconcile.Request
+			for _, mg := range referencedBy(ctx, kube, l, s) {
+				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: mg.GetName()}})
+			}
+			return reqs
+		}),
+		builder.WithPredicates(Referenced(kube, l)),
+	)
+	return nil
+}
+
+// Referenced returns a predicate that passes the Secrets referenced by a
+// managed resource listed into copies of l.
+func Referenced(kube client.Reader, l resource.ManagedList) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(s client.Object) bool {
+		return len(referencedBy(context.Background(), kube, l, s)) > 0
+	})
+}
+
+// referencedBy returns the managed resources listed into a copy of l that
+// reference the Secret s.
+func referencedBy(ctx context.Context, kube client.Reader, l resource.ManagedList, s client.Object) []resource.Managed {
+	l = l.DeepCopyObject().(resource.ManagedList)
+	if err := kube.List(ctx, l, client.MatchingFields{indexKey: key(s.GetNamespace(), s.GetName())}); err != nil {
+		return nil
+	}
+	return l.GetItems()
+}
+
+// secrets returns the keys of the Secrets o references.
+func secrets(o client.Object) []string {
+	var keys []string
+	if cr, ok := o.(clients.CredentialsReferencer); ok && cr.GetCredentialsSecretRef() != nil {
+		ref := cr.GetCredentialsSecretRef()
+		keys = append(keys, key(ref.Namespace, ref.Name))
+	}
+	if sr, ok := o.(secretversion.SecretReferencer); ok {
+		for _, ref := range sr.GetSecretRefs() {
+			keys = append(keys, key(ref.Namespace, ref.Name))
+		}
+	}
+	return keys
+}
+
+func key(namespace, name string) string {
+	return namespace + "/" + name
+}
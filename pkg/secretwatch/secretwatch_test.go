@@ -0,0 +1,126 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretwatch
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	kubefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+type authenticating struct {
+	fake.Managed
+	ref *xpv1.SecretKeySelector
+}
+
+func (a *authenticating) GetCredentialsSecretRef() *xpv1.SecretKeySelector {
+	return a.ref
+}
+
+type referencing struct {
+	authenticating
+	refs []xpv1.SecretKeySelector
+}
+
+func (r *referencing) GetSecretRefs() []xpv1.SecretKeySelector {
+	return r.refs
+}
+
+func selector(namespace, name string) xpv1.SecretKeySelector {
+	return xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: namespace, Name: name}, Key: "token"}
+}
+
+func TestSecrets(t *testing.T) {
+	credentials := selector("crossplane-system", "gitlab-token")
+
+	cases := map[string]struct {
+		o    client.Object
+		want []string
+	}{
+		"NoReferences": {
+			o: &fake.Managed{},
+		},
+		"CredentialsNotSet": {
+			o: &authenticating{},
+		},
+		"Credentials": {
+			o:    &authenticating{ref: &credentials},
+			want: []string{"crossplane-system/gitlab-token"},
+		},
+		"CredentialsAndValues": {
+			o: &referencing{
+				authenticating: authenticating{ref: &credentials},
+				refs:           []xpv1.SecretKeySelector{selector("default", "variable"), selector("default", "key")},
+			},
+			want: []string{"crossplane-system/gitlab-token", "default/variable", "default/key"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, secrets(tc.o)); diff != "" {
+				t.Errorf("secrets(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReferenced(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	credentials := selector("crossplane-system", "gitlab-token")
+	badge := &v1alpha1.Badge{ObjectMeta: metav1.ObjectMeta{Name: "badge"}}
+	badge.Spec.ForProvider.CredentialsSecretRef = &credentials
+	kube := kubefake.NewClientBuilder().WithScheme(s).WithObjects(badge).WithIndex(&v1alpha1.Badge{}, indexKey, secrets).Build()
+
+	p := Referenced(kube, &v1alpha1.BadgeList{})
+
+	cases := map[string]struct {
+		secret client.Object
+		want   bool
+	}{
+		"Referenced": {
+			secret: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "gitlab-token"}},
+			want:   true,
+		},
+		"NotReferenced": {
+			secret: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "other"}},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := p.Update(event.UpdateEvent{ObjectOld: tc.secret, ObjectNew: tc.secret}); got != tc.want {
+				t.Errorf("Update(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package selector lets the project selectors of managed resources match
+// the observed state of projects, e.g. their topics or path, in addition to
+// their labels.
+package selector
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+// FieldPrefix marks the keys of the matchLabels of a project selector that
+// match a field of the observed state of a project instead of a label. The
+// key status.atProvider.pathWithNamespace matches the project with that
+// path, the key status.atProvider.topics matches the projects with that
+// topic. A list field matches if any of its items equals the value.
+const FieldPrefix = "status.atProvider."
+
+const (
+	errConvert           = "cannot convert managed resource"
+	errListProjects      = "cannot list projects"
+	errNoMatches         = "no projects matched %s"
+	errResolveReferences = "cannot resolve references"
+	errUpdateManaged     = "cannot update managed resource"
+)
+
+// projectSelectors are the fields of spec.forProvider that select a
+// project, along with the fields of the reference they resolve to.
+var projectSelectors = []struct {
+	selector, reference, value string
+}{
+	{selector: "projectIdSelector", reference: "projectIdRef", value: "projectId"},
+	{selector: "targetProjectIdSelector", reference: "targetProjectIdRef", value: "targetProjectId"},
+}
+
+// NewReferenceResolver returns a managed.ReferenceResolver that resolves the
+// project selectors of a managed resource that match fields of the observed
+// state of projects to a reference, before it resolves all references of
+// the managed resource like managed.APISimpleReferenceResolver.
+func NewReferenceResolver(kube client.Client) managed.ReferenceResolver {
+	return &resolver{kube: kube}
+}
+
+type resolver struct {
+	kube client.Client
+}
+
+func (r *resolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	rr, ok := mg.(interface {
+		ResolveReferences(context.Context, client.Reader) error
+	})
+	if !ok {
+		return nil
+	}
+
+	existing := mg.DeepCopyObject()
+	selectors, err := r.selectProjects(ctx, mg)
+	if err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+	// The selectors that match fields are left out while the references
+	// are resolved, as their keys are no valid labels.
+	if err := setSelectors(mg, selectors, false); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+	if err := rr.ResolveReferences(ctx, r.kube); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+	if err := setSelectors(mg, selectors, true); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+
+	if cmp.Equal(existing, mg) {
+		return nil
+	}
+	return errors.Wrap(r.kube.Update(ctx, mg), errUpdateManaged)
+}
+
+// selectProjects sets the reference of every project selector of mg that
+// matches fields to the first project it matches. It returns these
+// selectors keyed by their field.
+func (r *resolver) selectProjects(ctx context.Context, mg resource.Managed) (map[string]interface{}, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConvert)
+	}
+	fp, _, _ := unstructured.NestedMap(u, "spec", "forProvider")
+	if fp == nil {
+		return nil, nil
+	}
+
+	selectors := map[string]interface{}{}
+	for _, s := range projectSelectors {
+		raw, ok := fp[s.selector].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sel := &xpv1.Selector{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, sel); err != nil {
+			return nil, errors.Wrap(err, errConvert)
+		}
+		labels, fields := Split(sel.MatchLabels)
+		if len(fields) == 0 {
+			continue
+		}
+		selectors[s.selector] = raw
+
+		// Like the selectors of crossplane-runtime, a selector is only
+		// resolved once unless it is to be resolved always.
+		always := sel.Policy != nil && sel.Policy.Resolve != nil && *sel.Policy.Resolve == xpv1.ResolvePolicyAlways
+		if !always && (fp[s.reference] != nil || fp[s.value] != nil) {
+			continue
+		}
+
+		name, err := r.selectProject(ctx, mg, sel, labels, fields)
+		if err != nil {
+			return nil, errors.Wrap(err, "spec.forProvider."+s.selector)
+		}
+		if name == "" {
+			continue
+		}
+		fp[s.reference] = map[string]interface{}{"name": name}
+		if always {
+			// The value is resolved from the reference again.
+			delete(fp, s.value)
+		}
+	}
+	if len(selectors) == 0 {
+		return nil, nil
+	}
+
+	if err := unstructured.SetNestedMap(u, fp, "spec", "forProvider"); err != nil {
+		return nil, errors.Wrap(err, errConvert)
+	}
+	return selectors, errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(u, mg), errConvert)
+}
+
+// setSelectors sets or removes the selectors of mg.
+func setSelectors(mg resource.Managed, selectors map[string]interface{}, set bool) error {
+	if len(selectors) == 0 {
+		return nil
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return errors.Wrap(err, errConvert)
+	}
+	for field, sel := range selectors {
+		path := []string{"spec", "forProvider", field}
+		if !set {
+			unstructured.RemoveNestedField(u, path...)
+			continue
+		}
+		if err := unstructured.SetNestedField(u, sel, path...); err != nil {
+			return errors.Wrap(err, errConvert)
+		}
+	}
+	return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(u, mg), errConvert)
+}
+
+// selectProject returns the name of the first project with labels that
+// matches fields, or an empty name if no project matches and resolution is
+// optional.
+func (r *resolver) selectProject(ctx context.Context, mg resource.Managed, sel *xpv1.Selector, labels, fields map[string]string) (string, error) {
+	l := &v1alpha1.ProjectList{}
+	if err := r.kube.List(ctx, l, client.MatchingLabels(labels)); err != nil {
+		return "", errors.Wrap(err, errListProjects)
+	}
+	for i := range l.Items {
+		p := &l.Items[i]
+		if reference.ControllersMustMatch(sel) && !meta.HaveSameController(mg, p) {
+			continue
+		}
+		if Matches(p, fields) {
+			return p.GetName(), nil
+		}
+	}
+	if sel.Policy != nil && sel.Policy.Resolution != nil && *sel.Policy.Resolution == xpv1.ResolutionPolicyOptional {
+		return "", nil
+	}
+	return "", errors.Errorf(errNoMatches, describe(fields))
+}
+
+// Split the matchLabels of a selector into the labels to match and the
+// fields of the observed state to match, keyed by their path below
+// status.atProvider.
+func Split(matchLabels map[string]string) (labels, fields map[string]string) {
+	for k, v := range matchLabels {
+		if path, ok := strings.CutPrefix(k, FieldPrefix); ok {
+			if fields == nil {
+				fields = map[string]string{}
+			}
+			fields[path] = v
+			continue
+		}
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[k] = v
+	}
+	return labels, fields
+}
+
+// Matches returns true if every field of the observed state of p equals
+// its value in fields, or for a list field contains it.
+func Matches(p *v1alpha1.Project, fields map[string]string) bool {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&p.Status.AtProvider)
+	if err != nil {
+		return false
+	}
+	for path, want := range fields {
+		v, found, err := unstructured.NestedFieldNoCopy(u, strings.Split(path, ".")...)
+		if err != nil || !found || !matches(v, want) {
+			return false
+		}
+	}
+	return true
+}
+
+func matches(v interface{}, want string) bool {
+	if l, ok := v.([]interface{}); ok {
+		for _, i := range l {
+			if fmt.Sprint(i) == want {
+				return true
+			}
+		}
+		return false
+	}
+	return fmt.Sprint(v) == want
+}
+
+func describe(fields map[string]string) string {
+	d := make([]string, 0, len(fields))
+	for k, v := range fields {
+		d = append(d, FieldPrefix+k+"="+v)
+	}
+	sort.Strings(d)
+	return strings.Join(d, ", ")
+}
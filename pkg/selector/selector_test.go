@@ -0,0 +1,188 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func project(name, path string, topics ...string) v1alpha1.Project {
+	p := v1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	p.Status.AtProvider = v1alpha1.ProjectObservation{PathWithNamespace: path, Topics: topics}
+	meta.SetExternalName(&p, name+"-id")
+	return p
+}
+
+func TestSplit(t *testing.T) {
+	labels, fields := Split(map[string]string{
+		"team":                                "platform",
+		"status.atProvider.topics":            "go",
+		"status.atProvider.pathWithNamespace": "org/app",
+	})
+	if diff := cmp.Diff(map[string]string{"team": "platform"}, labels); diff != "" {
+		t.Errorf("labels: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"topics": "go", "pathWithNamespace": "org/app"}, fields); diff != "" {
+		t.Errorf("fields: -want, +got:\n%s", diff)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	p := project("app", "org/app", "go", "api")
+
+	cases := map[string]struct {
+		fields map[string]string
+		want   bool
+	}{
+		"Path": {
+			fields: map[string]string{"pathWithNamespace": "org/app"},
+			want:   true,
+		},
+		"OtherPath": {
+			fields: map[string]string{"pathWithNamespace": "org/other"},
+			want:   false,
+		},
+		"Topic": {
+			fields: map[string]string{"topics": "api"},
+			want:   true,
+		},
+		"MissingTopic": {
+			fields: map[string]string{"topics": "rust"},
+			want:   false,
+		},
+		"All": {
+			fields: map[string]string{"topics": "go", "pathWithNamespace": "org/app"},
+			want:   true,
+		},
+		"UnknownField": {
+			fields: map[string]string{"namespace.fullPath": "org"},
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Matches(&p, tc.fields)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveReferences(t *testing.T) {
+	errBoom := errors.New("boom")
+	projects := []v1alpha1.Project{
+		project("app", "org/app", "go"),
+		project("api", "org/api", "go", "api"),
+	}
+	badge := func(sel *xpv1.Selector) *v1alpha1.Badge {
+		b := &v1alpha1.Badge{}
+		b.Spec.ForProvider.ProjectIDSelector = sel
+		return b
+	}
+	kube := func(listErr error) *test.MockClient {
+		return &test.MockClient{
+			MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+				obj.(*v1alpha1.ProjectList).Items = projects
+				return listErr
+			},
+			MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+				for i := range projects {
+					if projects[i].Name == key.Name {
+						projects[i].DeepCopyInto(obj.(*v1alpha1.Project))
+					}
+				}
+				return nil
+			},
+			MockUpdate: test.NewMockUpdateFn(nil),
+		}
+	}
+
+	cases := map[string]struct {
+		kube    *test.MockClient
+		sel     *xpv1.Selector
+		wantRef *xpv1.Reference
+		wantID  *string
+		err     error
+	}{
+		"Topic": {
+			kube:    kube(nil),
+			sel:     &xpv1.Selector{MatchLabels: map[string]string{"status.atProvider.topics": "api"}},
+			wantRef: &xpv1.Reference{Name: "api"},
+			wantID:  ptr.To("api-id"),
+		},
+		"Path": {
+			kube:    kube(nil),
+			sel:     &xpv1.Selector{MatchLabels: map[string]string{"status.atProvider.pathWithNamespace": "org/app"}},
+			wantRef: &xpv1.Reference{Name: "app"},
+			wantID:  ptr.To("app-id"),
+		},
+		"LabelsOnly": {
+			kube:    kube(nil),
+			sel:     &xpv1.Selector{MatchLabels: map[string]string{"team": "platform"}},
+			wantRef: &xpv1.Reference{Name: "app"},
+			wantID:  ptr.To("app-id"),
+		},
+		"NoMatch": {
+			kube: kube(nil),
+			sel:  &xpv1.Selector{MatchLabels: map[string]string{"status.atProvider.topics": "rust"}},
+			err:  errors.Wrap(errors.Wrap(errors.Errorf(errNoMatches, "status.atProvider.topics=rust"), "spec.forProvider.projectIdSelector"), errResolveReferences),
+		},
+		"NoMatchOptional": {
+			kube: kube(nil),
+			sel: &xpv1.Selector{
+				MatchLabels: map[string]string{"status.atProvider.topics": "rust"},
+				Policy:      &xpv1.Policy{Resolution: ptr.To(xpv1.ResolutionPolicyOptional)},
+			},
+		},
+		"ListFailed": {
+			kube: kube(errBoom),
+			sel:  &xpv1.Selector{MatchLabels: map[string]string{"status.atProvider.topics": "api"}},
+			err:  errors.Wrap(errors.Wrap(errors.Wrap(errBoom, errListProjects), "spec.forProvider.projectIdSelector"), errResolveReferences),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := badge(tc.sel)
+			err := NewReferenceResolver(tc.kube).ResolveReferences(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRef, cr.Spec.ForProvider.ProjectIDRef); diff != "" {
+				t.Errorf("ref: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantID, cr.Spec.ForProvider.ProjectID); diff != "" {
+				t.Errorf("id: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.sel, cr.Spec.ForProvider.ProjectIDSelector); diff != "" {
+				t.Errorf("selector: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
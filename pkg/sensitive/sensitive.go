@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sensitive moves the plaintext sensitive fields of managed
+// resources, e.g. the value of a Variable, into Secrets and replaces them by
+// references to these Secrets.
+package sensitive
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+const (
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errNoNamespace       = "cannot choose a namespace for the secret of %s: set spec.writeConnectionSecretToRef"
+	errApplySecret       = "cannot apply secret holding %s"
+	errUpdateManaged     = "cannot replace %s by a secret reference"
+)
+
+// A Field is a plaintext sensitive field of a managed resource.
+type Field struct {
+	// Path of the field, e.g. spec.forProvider.value.
+	Path string
+
+	// Key the value of the field is stored under in the Secret.
+	Key string
+
+	// Get returns the plaintext value of the field, or nil if it is not
+	// set or the managed resource already references a secret instead.
+	Get func(mg resource.Managed) *string
+
+	// Set replaces the plaintext value of the field by ref.
+	Set func(mg resource.Managed, ref *xpv1.SecretKeySelector)
+}
+
+// NewInitializer returns a managed.Initializer that moves the plaintext
+// value of f into a Secret and replaces it by a reference to the Secret.
+// The Secret is named after the managed resource and f.Key, and is
+// controlled by the managed resource so it is deleted together with it.
+// It is created in the namespace of the connection secret of the managed
+// resource, or else in the namespace of the credentials of its
+// ProviderConfig.
+func NewInitializer(kube client.Client, gvk schema.GroupVersionKind, f Field) managed.Initializer {
+	return &initializer{kube: kube, client: resource.NewAPIPatchingApplicator(kube), gvk: gvk, field: f}
+}
+
+type initializer struct {
+	kube   client.Client
+	client resource.Applicator
+	gvk    schema.GroupVersionKind
+	field  Field
+}
+
+func (i *initializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	v := i.field.Get(mg)
+	if v == nil {
+		return nil
+	}
+	ns, err := i.namespace(ctx, mg)
+	if err != nil {
+		return err
+	}
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            mg.GetName() + "-" + i.field.Key,
+			Namespace:       ns,
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, i.gvk))},
+		},
+		Data: map[string][]byte{i.field.Key: []byte(*v)},
+	}
+	if err := i.client.Apply(ctx, s, resource.MustBeControllableBy(mg.GetUID())); err != nil {
+		return errors.Wrapf(err, errApplySecret, i.field.Path)
+	}
+	i.field.Set(mg, &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: s.Name, Namespace: s.Namespace},
+		Key:             i.field.Key,
+	})
+	return errors.Wrapf(i.kube.Update(ctx, mg), errUpdateManaged, i.field.Path)
+}
+
+func (i *initializer) namespace(ctx context.Context, mg resource.Managed) (string, error) {
+	if ref := mg.GetWriteConnectionSecretToReference(); ref != nil {
+		return ref.Namespace, nil
+	}
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		pc := &v1beta1.ProviderConfig{}
+		if err := i.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+			return "", errors.Wrap(err, errGetProviderConfig)
+		}
+		if ref := pc.Spec.Credentials.SecretRef; ref != nil {
+			return ref.Namespace, nil
+		}
+	}
+	return "", errors.Errorf(errNoNamespace, i.field.Path)
+}
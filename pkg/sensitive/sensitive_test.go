@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+var errBoom = errors.New("boom")
+
+// field is a Field of a fake managed resource kept in the test.
+type field struct {
+	value *string
+	ref   *xpv1.SecretKeySelector
+}
+
+func (f *field) Field() Field {
+	return Field{
+		Path: "spec.forProvider.value",
+		Key:  "value",
+		Get: func(_ resource.Managed) *string {
+			if f.ref != nil {
+				return nil
+			}
+			return f.value
+		},
+		Set: func(_ resource.Managed, ref *xpv1.SecretKeySelector) {
+			f.value = nil
+			f.ref = ref
+		},
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	value := "s3cr3t"
+
+	type want struct {
+		f      field
+		secret *corev1.Secret
+		err    error
+	}
+
+	cases := map[string]struct {
+		kube *test.MockClient
+		mg   *fake.Managed
+		f    field
+		want want
+	}{
+		"NoValue": {
+			mg: &fake.Managed{},
+		},
+		"AlreadyReferenced": {
+			mg: &fake.Managed{},
+			f:  field{value: &value, ref: &xpv1.SecretKeySelector{Key: "value"}},
+			want: want{
+				f: field{value: &value, ref: &xpv1.SecretKeySelector{Key: "value"}},
+			},
+		},
+		"ConnectionSecretNamespace": {
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				MockCreate: test.NewMockCreateFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			mg: &fake.Managed{
+				ObjectMeta:               metav1.ObjectMeta{Name: "var", UID: "uid"},
+				ConnectionSecretWriterTo: fake.ConnectionSecretWriterTo{Ref: &xpv1.SecretReference{Name: "conn", Namespace: "team"}},
+			},
+			f: field{value: &value},
+			want: want{
+				f: field{ref: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "var-value", Namespace: "team"}, Key: "value"}},
+				secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "var-value", Namespace: "team"},
+					Data:       map[string][]byte{"value": []byte(value)},
+				},
+			},
+		},
+		"ProviderConfigNamespace": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					pc, ok := obj.(*v1beta1.ProviderConfig)
+					if !ok {
+						return kerrors.NewNotFound(schema.GroupResource{}, "")
+					}
+					pc.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "gitlab", Namespace: "crossplane-system"}}
+					return nil
+				},
+				MockCreate: test.NewMockCreateFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			mg: &fake.Managed{
+				ObjectMeta:               metav1.ObjectMeta{Name: "var", UID: "uid"},
+				ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "default"}},
+			},
+			f: field{value: &value},
+			want: want{
+				f: field{ref: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "var-value", Namespace: "crossplane-system"}, Key: "value"}},
+				secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "var-value", Namespace: "crossplane-system"},
+					Data:       map[string][]byte{"value": []byte(value)},
+				},
+			},
+		},
+		"NoNamespace": {
+			mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "var"}},
+			f:  field{value: &value},
+			want: want{
+				f:   field{value: &value},
+				err: errors.Errorf(errNoNamespace, "spec.forProvider.value"),
+			},
+		},
+		"ApplyFailed": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			mg: &fake.Managed{
+				ObjectMeta:               metav1.ObjectMeta{Name: "var"},
+				ConnectionSecretWriterTo: fake.ConnectionSecretWriterTo{Ref: &xpv1.SecretReference{Name: "conn", Namespace: "team"}},
+			},
+			f: field{value: &value},
+			want: want{
+				f:   field{value: &value},
+				err: errors.Wrapf(errors.Wrap(errBoom, "cannot get object"), errApplySecret, "spec.forProvider.value"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created *corev1.Secret
+			kube := tc.kube
+			if kube == nil {
+				kube = &test.MockClient{}
+			}
+			if kube.MockCreate != nil {
+				kube.MockCreate = func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					created = obj.(*corev1.Secret)
+					return nil
+				}
+			}
+			i := NewInitializer(kube, schema.GroupVersionKind{Kind: "Variable"}, tc.f.Field())
+			err := i.Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.f, tc.f, cmp.AllowUnexported(field{})); diff != "" {
+				t.Errorf("Initialize(...): -want field, +got field:\n%s", diff)
+			}
+			if created != nil {
+				created.OwnerReferences = nil
+			}
+			if diff := cmp.Diff(tc.want.secret, created); diff != "" {
+				t.Errorf("Initialize(...): -want secret, +got secret:\n%s", diff)
+			}
+		})
+	}
+}
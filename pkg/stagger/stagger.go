@@ -0,0 +1,60 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package stagger spreads the polls of managed resources evenly over the
+// poll interval, so resources created or requeued at the same time do not
+// poll Gitlab in bursts.
+package stagger
+
+import (
+	"hash/fnv"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// PollInterval returns how long to wait before mg is polled again. Every
+// managed resource is assigned a fixed offset within the poll interval by
+// the hash of its UID, and is polled when the offset comes around next,
+// but no sooner than half a poll interval. On average mg is still polled
+// once per poll interval. It is a managed.PollIntervalHook.
+func PollInterval(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	return pollIntervalAt(mg, pollInterval, time.Now())
+}
+
+func pollIntervalAt(mg resource.Managed, pollInterval time.Duration, now time.Time) time.Duration {
+	if pollInterval <= 0 {
+		return pollInterval
+	}
+	offset := time.Duration(hash(mg) % uint64(pollInterval))
+	d := offset - time.Duration(now.UnixNano()%int64(pollInterval))
+	for d < pollInterval/2 {
+		d += pollInterval
+	}
+	return d
+}
+
+// hash of the identity of mg. The UID is unique across kinds, the name is
+// a fallback for resources that were not created yet.
+func hash(mg resource.Managed) uint64 {
+	h := fnv.New64a()
+	id := string(mg.GetUID())
+	if id == "" {
+		id = mg.GetObjectKind().GroupVersionKind().Kind + "/" + mg.GetName()
+	}
+	_, _ = h.Write([]byte(id))
+	return h.Sum64()
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stagger
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func managed(uid string) *fake.Managed {
+	return &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid)}}
+}
+
+func TestPollInterval(t *testing.T) {
+	interval := time.Minute
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Bounds", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			d := pollIntervalAt(managed(fmt.Sprint(i)), interval, now)
+			if d < interval/2 || d >= interval*3/2 {
+				t.Errorf("pollIntervalAt(%d): want within [%s, %s), got %s", i, interval/2, interval*3/2, d)
+			}
+		}
+	})
+
+	t.Run("FixedOffset", func(t *testing.T) {
+		// Wherever a poll happens, the next one is at the same offset
+		// within the poll interval.
+		mg := managed("uid")
+		next := now.Add(pollIntervalAt(mg, interval, now))
+		later := now.Add(17 * time.Second)
+		if got := later.Add(pollIntervalAt(mg, interval, later)); got.Sub(next)%interval != 0 {
+			t.Errorf("pollIntervalAt: want offset of %s, got %s", next, got)
+		}
+	})
+
+	t.Run("Spread", func(t *testing.T) {
+		// Resources polled at the same time are spread over the interval.
+		buckets := make([]int, 6)
+		for i := 0; i < 600; i++ {
+			d := now.Add(pollIntervalAt(managed(fmt.Sprint(i)), interval, now))
+			buckets[d.Second()/10]++
+		}
+		for i, n := range buckets {
+			if n < 50 || n > 150 {
+				t.Errorf("bucket %d: want about 100 polls, got %d", i, n)
+			}
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		if d := pollIntervalAt(managed("uid"), 0, now); d != 0 {
+			t.Errorf("pollIntervalAt: want 0, got %s", d)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package timeouts bounds the calls made to Gitlab by the timeouts
+// configured on a managed resource.
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+)
+
+const (
+	// Default is the timeout of calls without a configured timeout. It is
+	// the timeout of the managed reconciler.
+	Default = time.Minute
+
+	// Max is the longest timeout a resource may configure. Only deleting a
+	// resource may take longer than Default, see NewConnecter.
+	Max = 30 * time.Minute
+)
+
+// A Configurable resource has timeouts for the calls made to Gitlab.
+type Configurable interface {
+	GetTimeouts() *v1alpha1.Timeouts
+}
+
+// NewConnecter wraps c so that the calls of the clients it returns are
+// bounded by the timeouts of the resource they are made for. The timeout of
+// the reconcile still bounds all calls but Delete, whose timeout replaces it
+// so that slow deletions, such as exporting a project first, are not cut off.
+func NewConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{connecter: c}
+}
+
+type connecter struct {
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout(mg, read))
+	defer cancel()
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: ec}, nil
+}
+
+type external struct {
+	client managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout(mg, read))
+	defer cancel()
+	return e.client.Observe(ctx, mg)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout(mg, create))
+	defer cancel()
+	return e.client.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout(mg, update))
+	defer cancel()
+	return e.client.Update(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout(mg, del))
+	defer cancel()
+	return e.client.Delete(ctx, mg)
+}
+
+func read(t *v1alpha1.Timeouts) *metav1.Duration   { return t.Read }
+func create(t *v1alpha1.Timeouts) *metav1.Duration { return t.Create }
+func update(t *v1alpha1.Timeouts) *metav1.Duration { return t.Update }
+func del(t *v1alpha1.Timeouts) *metav1.Duration    { return t.Delete }
+
+// timeout returns the timeout of mg selected by op, Default if mg has none
+// and at most Max.
+func timeout(mg resource.Managed, op func(*v1alpha1.Timeouts) *metav1.Duration) time.Duration {
+	c, ok := mg.(Configurable)
+	if !ok || c.GetTimeouts() == nil {
+		return Default
+	}
+	d := op(c.GetTimeouts())
+	switch {
+	case d == nil || d.Duration <= 0:
+		return Default
+	case d.Duration > Max:
+		return Max
+	default:
+		return d.Duration
+	}
+}
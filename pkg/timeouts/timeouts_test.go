@@ -0,0 +1,150 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+)
+
+type configurable struct {
+	fake.Managed
+	timeouts *v1alpha1.Timeouts
+}
+
+func (c *configurable) GetTimeouts() *v1alpha1.Timeouts {
+	return c.timeouts
+}
+
+func TestConnecter(t *testing.T) {
+	cases := map[string]struct {
+		mg         resource.Managed
+		wantRead   time.Duration
+		wantCreate time.Duration
+	}{
+		"NotConfigurable": {
+			mg:         &fake.Managed{},
+			wantRead:   Default,
+			wantCreate: Default,
+		},
+		"NoTimeouts": {
+			mg:         &configurable{},
+			wantRead:   Default,
+			wantCreate: Default,
+		},
+		"Configured": {
+			mg:         &configurable{timeouts: &v1alpha1.Timeouts{Create: &metav1.Duration{Duration: 10 * time.Minute}}},
+			wantRead:   Default,
+			wantCreate: 10 * time.Minute,
+		},
+		"Capped": {
+			mg:         &configurable{timeouts: &v1alpha1.Timeouts{Read: &metav1.Duration{Duration: 2 * Max}, Create: &metav1.Duration{}}},
+			wantRead:   Max,
+			wantCreate: Default,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var read, create time.Duration
+			remaining := func(ctx context.Context) time.Duration {
+				d, ok := ctx.Deadline()
+				if !ok {
+					t.Fatal("context has no deadline")
+				}
+				return time.Until(d)
+			}
+			c := NewConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						read = remaining(ctx)
+						return managed.ExternalObservation{}, nil
+					},
+					CreateFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						create = remaining(ctx)
+						return managed.ExternalCreation{}, nil
+					},
+				}, nil
+			}))
+
+			ec, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect: %v", err)
+			}
+			if _, err := ec.Observe(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Observe: %v", err)
+			}
+			if _, err := ec.Create(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+			if read > tc.wantRead || read < tc.wantRead-time.Second {
+				t.Errorf("Observe deadline: want %s, got %s", tc.wantRead, read)
+			}
+			if create > tc.wantCreate || create < tc.wantCreate-time.Second {
+				t.Errorf("Create deadline: want %s, got %s", tc.wantCreate, create)
+			}
+		})
+	}
+}
+
+func TestConnecterDelete(t *testing.T) {
+	mg := &configurable{timeouts: &v1alpha1.Timeouts{Delete: &metav1.Duration{Duration: 10 * time.Minute}, Read: &metav1.Duration{Duration: 10 * time.Minute}}}
+	var read, del time.Duration
+	c := NewConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				d, _ := ctx.Deadline()
+				read = time.Until(d)
+				return managed.ExternalObservation{}, nil
+			},
+			DeleteFn: func(ctx context.Context, _ resource.Managed) error {
+				d, _ := ctx.Deadline()
+				del = time.Until(d)
+				return nil
+			},
+		}, nil
+	}))
+
+	// The reconcile deadline bounds every call but Delete.
+	ctx, cancel := context.WithTimeout(context.Background(), Default)
+	defer cancel()
+	ec, err := c.Connect(ctx, mg)
+	if err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	if _, err := ec.Observe(ctx, mg); err != nil {
+		t.Fatalf("Observe: %v", err)
+	}
+	if err := ec.Delete(ctx, mg); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if read > Default {
+		t.Errorf("Observe deadline: want at most %s, got %s", Default, read)
+	}
+	if want := 10 * time.Minute; del > want || del < want-time.Second {
+		t.Errorf("Delete deadline: want %s, got %s", want, del)
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing instruments the provider with OpenTelemetry spans.
+//
+// Spans are recorded by the global tracer provider, which is a no-op until
+// Setup installs one exporting them via OTLP.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	tracerName  = "github.com/crossplane-contrib/provider-gitlab"
+	serviceName = "provider-gitlab"
+)
+
+// Setup installs a global tracer provider exporting spans via OTLP over
+// HTTP. The exporter is configured with the standard OTEL_EXPORTER_OTLP_*
+// environment variables. The returned function flushes and stops it.
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	exp, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create OTLP trace exporter")
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create trace resource")
+	}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exp), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// NewTransport wraps base so every Gitlab API request is recorded as a span
+// named after its method and path.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base, otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		return r.Method + " " + r.URL.Path
+	}))
+}
+
+// NewConnecter wraps c so that connecting to Gitlab and every operation of
+// the returned client is recorded as a span of the named controller.
+func NewConnecter(controller string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{controller: controller, connecter: c}
+}
+
+type connecter struct {
+	controller string
+	connecter  managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg xpresource.Managed) (managed.ExternalClient, error) {
+	ctx, span := start(ctx, c.controller, "Connect", mg)
+	defer span.End()
+	ec, err := c.connecter.Connect(ctx, mg)
+	record(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return &external{controller: c.controller, client: ec}, nil
+}
+
+type external struct {
+	controller string
+	client     managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg xpresource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := start(ctx, e.controller, "Observe", mg)
+	defer span.End()
+	o, err := e.client.Observe(ctx, mg)
+	span.SetAttributes(attribute.Bool("resource.exists", o.ResourceExists), attribute.Bool("resource.up_to_date", o.ResourceUpToDate))
+	record(span, err)
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg xpresource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := start(ctx, e.controller, "Create", mg)
+	defer span.End()
+	c, err := e.client.Create(ctx, mg)
+	record(span, err)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg xpresource.Managed) (managed.ExternalUpdate, error) {
+	ctx, span := start(ctx, e.controller, "Update", mg)
+	defer span.End()
+	u, err := e.client.Update(ctx, mg)
+	record(span, err)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg xpresource.Managed) error {
+	ctx, span := start(ctx, e.controller, "Delete", mg)
+	defer span.End()
+	err := e.client.Delete(ctx, mg)
+	record(span, err)
+	return err
+}
+
+func start(ctx context.Context, controller, op string, mg xpresource.Managed) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, controller+"/"+op, trace.WithAttributes(
+		attribute.String("crossplane.controller", controller),
+		attribute.String("crossplane.resource.name", mg.GetName()),
+		attribute.String("crossplane.external_name", meta.GetExternalName(mg)),
+	))
+}
+
+func record(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
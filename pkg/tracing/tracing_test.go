@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestConnecter(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+
+	c := NewConnecter("managed/test", managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: true}, nil
+			},
+			DeleteFn: func(_ context.Context, _ resource.Managed) error {
+				return errBoom
+			},
+		}, nil
+	}))
+
+	mg := &fake.Managed{}
+	ec, err := c.Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	if _, err := ec.Observe(context.Background(), mg); err != nil {
+		t.Fatalf("Observe: %v", err)
+	}
+	if err := ec.Delete(context.Background(), mg); !errors.Is(err, errBoom) {
+		t.Fatalf("Delete: want %v, got %v", errBoom, err)
+	}
+
+	type span struct {
+		Name   string
+		Status codes.Code
+	}
+	got := []span{}
+	for _, s := range sr.Ended() {
+		got = append(got, span{Name: s.Name(), Status: s.Status().Code})
+	}
+	want := []span{
+		{Name: "managed/test/Connect", Status: codes.Unset},
+		{Name: "managed/test/Observe", Status: codes.Unset},
+		{Name: "managed/test/Delete", Status: codes.Error},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("spans: -want, +got:\n%s", diff)
+	}
+}
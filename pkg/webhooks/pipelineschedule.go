@@ -0,0 +1,360 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Validate IANA time zones without relying on the image.
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	projectsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+const errNotPipelineSchedule = "managed resource is not a PipelineSchedule"
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-projects-gitlab-crossplane-io-v1alpha1-pipelineschedule,mutating=false,failurePolicy=fail,groups=projects.gitlab.crossplane.io,resources=pipelineschedules,versions=v1alpha1,name=pipelineschedules.projects.gitlab.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// pipelineScheduleValidator rejects PipelineSchedules with a cron schedule
+// or time zone Gitlab does not accept.
+type pipelineScheduleValidator struct{}
+
+func (v *pipelineScheduleValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*projectsv1alpha1.PipelineSchedule)
+	if !ok {
+		return nil, errors.New(errNotPipelineSchedule)
+	}
+	return v.validate(cr)
+}
+
+func (v *pipelineScheduleValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	cr, ok := newObj.(*projectsv1alpha1.PipelineSchedule)
+	if !ok {
+		return nil, errors.New(errNotPipelineSchedule)
+	}
+	return v.validate(cr)
+}
+
+func (v *pipelineScheduleValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *pipelineScheduleValidator) validate(cr *projectsv1alpha1.PipelineSchedule) (admission.Warnings, error) {
+	p := &cr.Spec.ForProvider
+	errs := field.ErrorList{}
+	if err := validateCron(p.Cron); err != nil {
+		errs = append(errs, field.Invalid(forProvider.Child("cron"), p.Cron, err.Error()))
+	}
+	if p.CronTimezone != nil && !validTimezone(*p.CronTimezone) {
+		errs = append(errs, field.Invalid(forProvider.Child("cronTimezone"), *p.CronTimezone, "must be a time zone name of ActiveSupport, e.g. Pacific Time (US & Canada), or an IANA time zone, e.g. America/Los_Angeles"))
+	}
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(projectsv1alpha1.PipelineScheduleGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
+
+// cronMacros are the shorthands Gitlab accepts for whole cron schedules.
+var cronMacros = map[string]bool{
+	"@yearly":   true,
+	"@annually": true,
+	"@monthly":  true,
+	"@weekly":   true,
+	"@daily":    true,
+	"@midnight": true,
+	"@hourly":   true,
+}
+
+// A cronField is a field of a cron schedule.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var (
+	cronSecond  = cronField{name: "second", max: 59}
+	cronMinute  = cronField{name: "minute", max: 59}
+	cronHour    = cronField{name: "hour", max: 23}
+	cronDay     = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth   = cronField{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	cronWeekday = cronField{name: "day of week", max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+)
+
+// validateCron returns an error if s is not a cron schedule Gitlab accepts:
+// five fields, or six with leading seconds, or a macro like @daily.
+func validateCron(s string) error {
+	if cronMacros[strings.TrimSpace(s)] {
+		return nil
+	}
+	fields := strings.Fields(s)
+	var spec []cronField
+	switch len(fields) {
+	case 5:
+		spec = []cronField{cronMinute, cronHour, cronDay, cronMonth, cronWeekday}
+	case 6:
+		spec = []cronField{cronSecond, cronMinute, cronHour, cronDay, cronMonth, cronWeekday}
+	default:
+		return errors.Errorf("must have 5 fields, minute hour day-of-month month day-of-week, got %d", len(fields))
+	}
+	for i, f := range spec {
+		if err := f.validate(fields[i]); err != nil {
+			return errors.Wrapf(err, "invalid %s %q", f.name, fields[i])
+		}
+	}
+	return nil
+}
+
+// validate a comma separated list of values, ranges and steps.
+func (f cronField) validate(s string) error {
+	for _, item := range strings.Split(s, ",") {
+		if err := f.validateItem(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f cronField) validateItem(item string) error {
+	base, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 {
+			return errors.Errorf("step %q must be a positive number", step)
+		}
+	}
+	switch {
+	case base == "*":
+		return nil
+	case f.name == cronDay.name && base == "L":
+		return nil
+	case f.name == cronWeekday.name && strings.Contains(base, "#"):
+		day, nth, _ := strings.Cut(base, "#")
+		if _, err := f.value(day); err != nil {
+			return err
+		}
+		if n, err := strconv.Atoi(nth); err != nil || n < -5 || n > 5 || n == 0 {
+			return errors.Errorf("occurrence %q must be between 1 and 5, or -1 to -5", nth)
+		}
+		return nil
+	}
+	from, to, isRange := strings.Cut(base, "-")
+	lo, err := f.value(from)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	hi, err := f.value(to)
+	if err != nil {
+		return err
+	}
+	if lo > hi && f.name != cronWeekday.name {
+		return errors.Errorf("range %s is reversed", base)
+	}
+	return nil
+}
+
+// value parses a single number or name of the field.
+func (f cronField) value(s string) (int, error) {
+	for i, n := range f.names {
+		if strings.EqualFold(s, n) {
+			return i + f.min, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, errors.Errorf("value %q must be between %d and %d", s, f.min, f.max)
+	}
+	return v, nil
+}
+
+// validTimezone returns true if tz is a time zone name of ActiveSupport,
+// which Gitlab uses for pipeline schedules, or an IANA time zone.
+func validTimezone(tz string) bool {
+	if activeSupportZones[tz] {
+		return true
+	}
+	if tz == "" || tz == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(tz)
+	return err == nil
+}
+
+// activeSupportZones are the names of ActiveSupport::TimeZone::MAPPING.
+var activeSupportZones = map[string]bool{
+	"International Date Line West": true,
+	"Midway Island":                true,
+	"American Samoa":               true,
+	"Hawaii":                       true,
+	"Alaska":                       true,
+	"Pacific Time (US & Canada)":   true,
+	"Tijuana":                      true,
+	"Mountain Time (US & Canada)":  true,
+	"Arizona":                      true,
+	"Chihuahua":                    true,
+	"Mazatlan":                     true,
+	"Central Time (US & Canada)":   true,
+	"Saskatchewan":                 true,
+	"Guadalajara":                  true,
+	"Mexico City":                  true,
+	"Monterrey":                    true,
+	"Central America":              true,
+	"Eastern Time (US & Canada)":   true,
+	"Indiana (East)":               true,
+	"Bogota":                       true,
+	"Lima":                         true,
+	"Quito":                        true,
+	"Atlantic Time (Canada)":       true,
+	"Caracas":                      true,
+	"La Paz":                       true,
+	"Santiago":                     true,
+	"Newfoundland":                 true,
+	"Brasilia":                     true,
+	"Buenos Aires":                 true,
+	"Montevideo":                   true,
+	"Georgetown":                   true,
+	"Puerto Rico":                  true,
+	"Greenland":                    true,
+	"Mid-Atlantic":                 true,
+	"Azores":                       true,
+	"Cape Verde Is.":               true,
+	"Dublin":                       true,
+	"Edinburgh":                    true,
+	"Lisbon":                       true,
+	"London":                       true,
+	"Casablanca":                   true,
+	"Monrovia":                     true,
+	"UTC":                          true,
+	"Belgrade":                     true,
+	"Bratislava":                   true,
+	"Budapest":                     true,
+	"Ljubljana":                    true,
+	"Prague":                       true,
+	"Sarajevo":                     true,
+	"Skopje":                       true,
+	"Warsaw":                       true,
+	"Zagreb":                       true,
+	"Brussels":                     true,
+	"Copenhagen":                   true,
+	"Madrid":                       true,
+	"Paris":                        true,
+	"Amsterdam":                    true,
+	"Berlin":                       true,
+	"Bern":                         true,
+	"Zurich":                       true,
+	"Rome":                         true,
+	"Stockholm":                    true,
+	"Vienna":                       true,
+	"West Central Africa":          true,
+	"Bucharest":                    true,
+	"Cairo":                        true,
+	"Helsinki":                     true,
+	"Kyiv":                         true,
+	"Riga":                         true,
+	"Sofia":                        true,
+	"Tallinn":                      true,
+	"Vilnius":                      true,
+	"Athens":                       true,
+	"Istanbul":                     true,
+	"Minsk":                        true,
+	"Jerusalem":                    true,
+	"Harare":                       true,
+	"Pretoria":                     true,
+	"Kaliningrad":                  true,
+	"Moscow":                       true,
+	"St. Petersburg":               true,
+	"Volgograd":                    true,
+	"Samara":                       true,
+	"Kuwait":                       true,
+	"Riyadh":                       true,
+	"Nairobi":                      true,
+	"Baghdad":                      true,
+	"Tehran":                       true,
+	"Abu Dhabi":                    true,
+	"Muscat":                       true,
+	"Baku":                         true,
+	"Tbilisi":                      true,
+	"Yerevan":                      true,
+	"Kabul":                        true,
+	"Ekaterinburg":                 true,
+	"Islamabad":                    true,
+	"Karachi":                      true,
+	"Tashkent":                     true,
+	"Chennai":                      true,
+	"Kolkata":                      true,
+	"Mumbai":                       true,
+	"New Delhi":                    true,
+	"Kathmandu":                    true,
+	"Dhaka":                        true,
+	"Sri Jayawardenepura":          true,
+	"Almaty":                       true,
+	"Astana":                       true,
+	"Novosibirsk":                  true,
+	"Rangoon":                      true,
+	"Bangkok":                      true,
+	"Hanoi":                        true,
+	"Jakarta":                      true,
+	"Krasnoyarsk":                  true,
+	"Beijing":                      true,
+	"Chongqing":                    true,
+	"Hong Kong":                    true,
+	"Urumqi":                       true,
+	"Kuala Lumpur":                 true,
+	"Singapore":                    true,
+	"Taipei":                       true,
+	"Perth":                        true,
+	"Irkutsk":                      true,
+	"Ulaanbaatar":                  true,
+	"Seoul":                        true,
+	"Osaka":                        true,
+	"Sapporo":                      true,
+	"Tokyo":                        true,
+	"Yakutsk":                      true,
+	"Darwin":                       true,
+	"Adelaide":                     true,
+	"Canberra":                     true,
+	"Melbourne":                    true,
+	"Sydney":                       true,
+	"Brisbane":                     true,
+	"Hobart":                       true,
+	"Vladivostok":                  true,
+	"Guam":                         true,
+	"Port Moresby":                 true,
+	"Magadan":                      true,
+	"Srednekolymsk":                true,
+	"Solomon Is.":                  true,
+	"New Caledonia":                true,
+	"Fiji":                         true,
+	"Kamchatka":                    true,
+	"Marshall Is.":                 true,
+	"Auckland":                     true,
+	"Wellington":                   true,
+	"Nuku'alofa":                   true,
+	"Tokelau Is.":                  true,
+	"Chatham Is.":                  true,
+	"Samoa":                        true,
+}
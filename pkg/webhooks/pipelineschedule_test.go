@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	projectsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestPipelineScheduleValidator(t *testing.T) {
+	schedule := func(cron string, tz *string) *projectsv1alpha1.PipelineSchedule {
+		return &projectsv1alpha1.PipelineSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "s"},
+			Spec: projectsv1alpha1.PipelineScheduleSpec{ForProvider: projectsv1alpha1.PipelineScheduleParameters{
+				Cron:         cron,
+				CronTimezone: tz,
+			}},
+		}
+	}
+
+	cases := map[string]struct {
+		cr          *projectsv1alpha1.PipelineSchedule
+		wantInvalid bool
+	}{
+		"Valid": {
+			cr: schedule("0 1 * * *", nil),
+		},
+		"Lists": {
+			cr: schedule("0,30 8-18/2 1,15 jan-jun mon-fri", nil),
+		},
+		"Seconds": {
+			cr: schedule("30 0 1 * * *", nil),
+		},
+		"Macro": {
+			cr: schedule("@daily", nil),
+		},
+		"LastDayOfMonth": {
+			cr: schedule("0 0 L * *", nil),
+		},
+		"NthWeekday": {
+			cr: schedule("0 0 * * mon#2", nil),
+		},
+		"TooFewFields": {
+			cr:          schedule("0 1 * *", nil),
+			wantInvalid: true,
+		},
+		"OutOfRange": {
+			cr:          schedule("60 1 * * *", nil),
+			wantInvalid: true,
+		},
+		"UnknownName": {
+			cr:          schedule("0 1 * foo *", nil),
+			wantInvalid: true,
+		},
+		"ReversedRange": {
+			cr:          schedule("0 18-8 * * *", nil),
+			wantInvalid: true,
+		},
+		"ZeroStep": {
+			cr:          schedule("*/0 * * * *", nil),
+			wantInvalid: true,
+		},
+		"ActiveSupportZone": {
+			cr: schedule("0 1 * * *", ptr.To("Pacific Time (US & Canada)")),
+		},
+		"IANAZone": {
+			cr: schedule("0 1 * * *", ptr.To("Europe/Berlin")),
+		},
+		"UnknownZone": {
+			cr:          schedule("0 1 * * *", ptr.To("Mars/Olympus_Mons")),
+			wantInvalid: true,
+		},
+		"LocalZone": {
+			cr:          schedule("0 1 * * *", ptr.To("Local")),
+			wantInvalid: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &pipelineScheduleValidator{}
+			_, err := v.ValidateCreate(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.wantInvalid, kerrors.IsInvalid(err)); diff != "" {
+				t.Errorf("validate: invalid: -want, +got:\n%s\n%v", diff, err)
+			}
+		})
+	}
+}
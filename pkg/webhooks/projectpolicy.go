@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+const (
+	errNotProject   = "managed resource is not a Project"
+	errListPolicies = "cannot list ProjectPolicies"
+	errBadSelector  = "cannot parse project selector of ProjectPolicy"
+)
+
+// +kubebuilder:webhook:verbs=create,path=/mutate-projects-gitlab-crossplane-io-v1alpha1-project,mutating=true,failurePolicy=fail,groups=projects.gitlab.crossplane.io,resources=projects,versions=v1alpha1,name=projects.projects.gitlab.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// projectDefaulter sets the defaults of the ProjectPolicies selecting a
+// Project on the parameters the Project does not set.
+type projectDefaulter struct {
+	kube client.Reader
+}
+
+func (d *projectDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	cr, ok := obj.(*v1alpha1.Project)
+	if !ok {
+		return errors.New(errNotProject)
+	}
+	l := &v1alpha1.ProjectPolicyList{}
+	if err := d.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListPolicies)
+	}
+	sort.Slice(l.Items, func(i, j int) bool { return l.Items[i].GetName() < l.Items[j].GetName() })
+	for i := range l.Items {
+		pol := &l.Items[i]
+		if pol.Spec.ProjectSelector != nil {
+			s, err := metav1.LabelSelectorAsSelector(pol.Spec.ProjectSelector)
+			if err != nil {
+				return errors.Wrapf(err, "%s %s", errBadSelector, pol.GetName())
+			}
+			if !s.Matches(labels.Set(cr.GetLabels())) {
+				continue
+			}
+		}
+		applyProjectDefaults(&cr.Spec.ForProvider, &pol.Spec.Defaults)
+	}
+	return nil
+}
+
+// applyProjectDefaults sets the defaults on the parameters p does not set.
+func applyProjectDefaults(p *v1alpha1.ProjectParameters, d *v1alpha1.ProjectDefaults) {
+	if p.Visibility == nil {
+		p.Visibility = d.Visibility
+	}
+	if p.MergeMethod == nil {
+		p.MergeMethod = d.MergeMethod
+	}
+	if p.DefaultBranch == nil {
+		p.DefaultBranch = d.DefaultBranch
+	}
+	if p.OnlyAllowMergeIfPipelineSucceeds == nil {
+		p.OnlyAllowMergeIfPipelineSucceeds = d.OnlyAllowMergeIfPipelineSucceeds
+	}
+	if p.OnlyAllowMergeIfAllDiscussionsAreResolved == nil {
+		p.OnlyAllowMergeIfAllDiscussionsAreResolved = d.OnlyAllowMergeIfAllDiscussionsAreResolved
+	}
+	if p.RemoveSourceBranchAfterMerge == nil {
+		p.RemoveSourceBranchAfterMerge = d.RemoveSourceBranchAfterMerge
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestProjectDefaulter(t *testing.T) {
+	errBoom := errors.New("boom")
+	internal := v1alpha1.InternalVisibility
+	private := v1alpha1.PrivateVisibility
+	ff := v1alpha1.FastForwardMerge
+
+	policies := func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*v1alpha1.ProjectPolicyList).Items = []v1alpha1.ProjectPolicy{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "b-all"},
+				Spec: v1alpha1.ProjectPolicySpec{Defaults: v1alpha1.ProjectDefaults{
+					Visibility:    &private,
+					DefaultBranch: ptr.To("main"),
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "a-platform"},
+				Spec: v1alpha1.ProjectPolicySpec{
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "platform"}},
+					Defaults: v1alpha1.ProjectDefaults{
+						Visibility:  &internal,
+						MergeMethod: &ff,
+					},
+				},
+			},
+		}
+		return nil
+	}
+
+	type want struct {
+		p   v1alpha1.ProjectParameters
+		err error
+	}
+
+	cases := map[string]struct {
+		list   test.MockListFn
+		labels map[string]string
+		p      v1alpha1.ProjectParameters
+		want   want
+	}{
+		"Unselected": {
+			list: policies,
+			want: want{p: v1alpha1.ProjectParameters{Visibility: &private, DefaultBranch: ptr.To("main")}},
+		},
+		"SelectedFirstPolicyWins": {
+			list:   policies,
+			labels: map[string]string{"team": "platform"},
+			want:   want{p: v1alpha1.ProjectParameters{Visibility: &internal, MergeMethod: &ff, DefaultBranch: ptr.To("main")}},
+		},
+		"ProjectSetsParameter": {
+			list:   policies,
+			labels: map[string]string{"team": "platform"},
+			p:      v1alpha1.ProjectParameters{Visibility: &private, DefaultBranch: ptr.To("develop")},
+			want:   want{p: v1alpha1.ProjectParameters{Visibility: &private, MergeMethod: &ff, DefaultBranch: ptr.To("develop")}},
+		},
+		"ListFailed": {
+			list: test.NewMockListFn(errBoom),
+			want: want{err: errors.Wrap(errBoom, errListPolicies)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Labels: tc.labels},
+				Spec:       v1alpha1.ProjectSpec{ForProvider: tc.p},
+			}
+			d := &projectDefaulter{kube: &test.MockClient{MockList: tc.list}}
+			err := d.Default(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Default: error: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.p, cr.Spec.ForProvider); diff != "" {
+				t.Errorf("Default: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
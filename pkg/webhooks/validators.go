@@ -0,0 +1,401 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	groupsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	projectsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+)
+
+const (
+	errNotVariable      = "managed resource is not a project Variable"
+	errNotHook          = "managed resource is not a Hook"
+	errNotGroupVariable = "managed resource is not a group Variable"
+	errNotDeployToken   = "managed resource is not a project DeployToken"
+	errNotGroupToken    = "managed resource is not a group DeployToken"
+	errList             = "cannot list existing resources"
+)
+
+// defaultScope is the environment scope Gitlab uses when none is given.
+const defaultScope = "*"
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-projects-gitlab-crossplane-io-v1alpha1-variable,mutating=false,failurePolicy=fail,groups=projects.gitlab.crossplane.io,resources=variables,versions=v1alpha1,name=variables.projects.gitlab.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// variableValidator rejects project Variables that set the same key in the
+// same environment scope of a project or group as another Variable.
+type variableValidator struct {
+	kube client.Reader
+}
+
+func (v *variableValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*projectsv1alpha1.Variable)
+	if !ok {
+		return nil, errors.New(errNotVariable)
+	}
+	return v.validate(ctx, cr, nil)
+}
+
+func (v *variableValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*projectsv1alpha1.Variable)
+	if !ok {
+		return nil, errors.New(errNotVariable)
+	}
+	cr, ok := newObj.(*projectsv1alpha1.Variable)
+	if !ok {
+		return nil, errors.New(errNotVariable)
+	}
+	return v.validate(ctx, cr, old)
+}
+
+func (v *variableValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *variableValidator) validate(ctx context.Context, cr, old *projectsv1alpha1.Variable) (admission.Warnings, error) {
+	p := &cr.Spec.ForProvider
+	errs := field.ErrorList{}
+	if p.EnvironmentScope != nil && len(p.Scopes) > 0 {
+		errs = append(errs, field.Forbidden(forProvider.Child("scopes"), "scopes cannot be set together with environmentScope"))
+	}
+	if old != nil {
+		if err := immutableInt(forProvider.Child("projectId"), old.Spec.ForProvider.ProjectID, p.ProjectID); err != nil {
+			errs = append(errs, err)
+		}
+		if err := immutableInt(forProvider.Child("groupId"), old.Spec.ForProvider.GroupID, p.GroupID); err != nil {
+			errs = append(errs, err)
+		}
+		if err := immutableString(forProvider.Child("key"), old.Spec.ForProvider.Key, p.Key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 && projects.VariableScopeID(p) != nil {
+		l := &projectsv1alpha1.VariableList{}
+		if err := v.kube.List(ctx, l); err != nil {
+			return nil, errors.Wrap(err, errList)
+		}
+		scopes := variableScopes(p)
+		for i := range l.Items {
+			other := &l.Items[i]
+			if other.GetName() == cr.GetName() || other.GetDeletionTimestamp() != nil {
+				continue
+			}
+			op := &other.Spec.ForProvider
+			if !sameVariableScope(op, p) || op.Key != p.Key {
+				continue
+			}
+			for s := range variableScopes(op) {
+				if scopes[s] {
+					errs = append(errs, duplicate(forProvider.Child("key"), fmt.Sprintf("%s (environment scope %s)", p.Key, s), other.GetName()))
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(projectsv1alpha1.VariableGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
+
+// sameVariableScope returns true if both variables belong to the same
+// project or group.
+func sameVariableScope(a, b *projectsv1alpha1.VariableParameters) bool {
+	ida, idb := projects.VariableScopeID(a), projects.VariableScopeID(b)
+	return ida != nil && idb != nil && *ida == *idb && (a.GroupID != nil) == (b.GroupID != nil)
+}
+
+// variableScopes returns the environment scopes a project Variable is
+// applied to.
+func variableScopes(p *projectsv1alpha1.VariableParameters) map[string]bool {
+	scopes := map[string]bool{}
+	for _, v := range projects.ExpandVariableScopes(p) {
+		scopes[ptr.Deref(v.EnvironmentScope, defaultScope)] = true
+	}
+	return scopes
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-projects-gitlab-crossplane-io-v1alpha1-hook,mutating=false,failurePolicy=fail,groups=projects.gitlab.crossplane.io,resources=hooks,versions=v1alpha1,name=hooks.projects.gitlab.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// hookValidator rejects Hooks that call the same URL for a project or group
+// as another Hook.
+type hookValidator struct {
+	kube client.Reader
+}
+
+func (v *hookValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*projectsv1alpha1.Hook)
+	if !ok {
+		return nil, errors.New(errNotHook)
+	}
+	return v.validate(ctx, cr, nil)
+}
+
+func (v *hookValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*projectsv1alpha1.Hook)
+	if !ok {
+		return nil, errors.New(errNotHook)
+	}
+	cr, ok := newObj.(*projectsv1alpha1.Hook)
+	if !ok {
+		return nil, errors.New(errNotHook)
+	}
+	return v.validate(ctx, cr, old)
+}
+
+func (v *hookValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *hookValidator) validate(ctx context.Context, cr, old *projectsv1alpha1.Hook) (admission.Warnings, error) {
+	p := &cr.Spec.ForProvider
+	errs := field.ErrorList{}
+	if old != nil {
+		if err := immutableInt(forProvider.Child("projectId"), old.Spec.ForProvider.ProjectID, p.ProjectID); err != nil {
+			errs = append(errs, err)
+		}
+		if err := immutableInt(forProvider.Child("groupId"), old.Spec.ForProvider.GroupID, p.GroupID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 && projects.HookScopeID(p) != nil && p.URL != nil {
+		l := &projectsv1alpha1.HookList{}
+		if err := v.kube.List(ctx, l); err != nil {
+			return nil, errors.Wrap(err, errList)
+		}
+		for i := range l.Items {
+			other := &l.Items[i]
+			if other.GetName() == cr.GetName() || other.GetDeletionTimestamp() != nil {
+				continue
+			}
+			op := &other.Spec.ForProvider
+			if sameHookScope(op, p) && op.URL != nil && *op.URL == *p.URL {
+				errs = append(errs, duplicate(forProvider.Child("url"), *p.URL, other.GetName()))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(projectsv1alpha1.HookGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
+
+// sameHookScope returns true if both hooks belong to the same project or
+// group.
+func sameHookScope(a, b *projectsv1alpha1.HookParameters) bool {
+	ida, idb := projects.HookScopeID(a), projects.HookScopeID(b)
+	return ida != nil && idb != nil && *ida == *idb && (a.GroupID != nil) == (b.GroupID != nil)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-groups-gitlab-crossplane-io-v1alpha1-variable,mutating=false,failurePolicy=fail,groups=groups.gitlab.crossplane.io,resources=variables,versions=v1alpha1,name=variables.groups.gitlab.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// groupVariableValidator rejects group Variables that set the same key in
+// the same environment scope of a group as another Variable.
+type groupVariableValidator struct {
+	kube client.Reader
+}
+
+func (v *groupVariableValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*groupsv1alpha1.Variable)
+	if !ok {
+		return nil, errors.New(errNotGroupVariable)
+	}
+	return v.validate(ctx, cr, nil)
+}
+
+func (v *groupVariableValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*groupsv1alpha1.Variable)
+	if !ok {
+		return nil, errors.New(errNotGroupVariable)
+	}
+	cr, ok := newObj.(*groupsv1alpha1.Variable)
+	if !ok {
+		return nil, errors.New(errNotGroupVariable)
+	}
+	return v.validate(ctx, cr, old)
+}
+
+func (v *groupVariableValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *groupVariableValidator) validate(ctx context.Context, cr, old *groupsv1alpha1.Variable) (admission.Warnings, error) {
+	p := &cr.Spec.ForProvider
+	errs := field.ErrorList{}
+	if old != nil {
+		if err := immutableInt(forProvider.Child("groupId"), old.Spec.ForProvider.GroupID, p.GroupID); err != nil {
+			errs = append(errs, err)
+		}
+		if err := immutableString(forProvider.Child("key"), old.Spec.ForProvider.Key, p.Key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 && p.GroupID != nil {
+		l := &groupsv1alpha1.VariableList{}
+		if err := v.kube.List(ctx, l); err != nil {
+			return nil, errors.Wrap(err, errList)
+		}
+		scope := ptr.Deref(p.EnvironmentScope, defaultScope)
+		for i := range l.Items {
+			other := &l.Items[i]
+			if other.GetName() == cr.GetName() || other.GetDeletionTimestamp() != nil {
+				continue
+			}
+			op := &other.Spec.ForProvider
+			if op.GroupID != nil && *op.GroupID == *p.GroupID && op.Key == p.Key && ptr.Deref(op.EnvironmentScope, defaultScope) == scope {
+				errs = append(errs, duplicate(forProvider.Child("key"), fmt.Sprintf("%s (environment scope %s)", p.Key, scope), other.GetName()))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(groupsv1alpha1.VariableGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
+
+// deployTokenScopes are the scopes Gitlab accepts for deploy tokens.
+var deployTokenScopes = map[string]bool{
+	"read_repository":        true,
+	"read_registry":          true,
+	"write_registry":         true,
+	"read_package_registry":  true,
+	"write_package_registry": true,
+}
+
+// validateDeployTokenScopes rejects unknown and repeated deploy token scopes
+// and, on update, scopes that differ from the old ones other than in order.
+func validateDeployTokenScopes(scopes, old []string, isUpdate bool) field.ErrorList {
+	path := forProvider.Child("scopes")
+	errs := field.ErrorList{}
+	seen := map[string]bool{}
+	for i, s := range scopes {
+		switch {
+		case !deployTokenScopes[s]:
+			errs = append(errs, field.NotSupported(path.Index(i), s, []string{"read_repository", "read_registry", "write_registry", "read_package_registry", "write_package_registry"}))
+		case seen[s]:
+			errs = append(errs, field.Duplicate(path.Index(i), s))
+		}
+		seen[s] = true
+	}
+	if isUpdate && len(errs) == 0 {
+		was := map[string]bool{}
+		for _, s := range old {
+			was[s] = true
+		}
+		if len(was) != len(seen) {
+			return append(errs, field.Invalid(path, scopes, "field is immutable"))
+		}
+		for s := range seen {
+			if !was[s] {
+				return append(errs, field.Invalid(path, scopes, "field is immutable"))
+			}
+		}
+	}
+	return errs
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-projects-gitlab-crossplane-io-v1alpha1-deploytoken,mutating=false,failurePolicy=fail,groups=projects.gitlab.crossplane.io,resources=deploytokens,versions=v1alpha1,name=deploytokens.projects.gitlab.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// deployTokenValidator rejects project DeployTokens with unknown or repeated
+// scopes, or with changed scopes.
+type deployTokenValidator struct{}
+
+func (v *deployTokenValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*projectsv1alpha1.DeployToken)
+	if !ok {
+		return nil, errors.New(errNotDeployToken)
+	}
+	return v.validate(cr, nil)
+}
+
+func (v *deployTokenValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*projectsv1alpha1.DeployToken)
+	if !ok {
+		return nil, errors.New(errNotDeployToken)
+	}
+	cr, ok := newObj.(*projectsv1alpha1.DeployToken)
+	if !ok {
+		return nil, errors.New(errNotDeployToken)
+	}
+	return v.validate(cr, old)
+}
+
+func (v *deployTokenValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *deployTokenValidator) validate(cr, old *projectsv1alpha1.DeployToken) (admission.Warnings, error) {
+	var oldScopes []string
+	if old != nil {
+		oldScopes = old.Spec.ForProvider.Scopes
+	}
+	if errs := validateDeployTokenScopes(cr.Spec.ForProvider.Scopes, oldScopes, old != nil); len(errs) > 0 {
+		return nil, kerrors.NewInvalid(projectsv1alpha1.DeployTokenGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-groups-gitlab-crossplane-io-v1alpha1-deploytoken,mutating=false,failurePolicy=fail,groups=groups.gitlab.crossplane.io,resources=deploytokens,versions=v1alpha1,name=deploytokens.groups.gitlab.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// groupDeployTokenValidator rejects group DeployTokens with unknown or
+// repeated scopes, or with changed scopes.
+type groupDeployTokenValidator struct{}
+
+func (v *groupDeployTokenValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*groupsv1alpha1.DeployToken)
+	if !ok {
+		return nil, errors.New(errNotGroupToken)
+	}
+	return v.validate(cr, nil)
+}
+
+func (v *groupDeployTokenValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*groupsv1alpha1.DeployToken)
+	if !ok {
+		return nil, errors.New(errNotGroupToken)
+	}
+	cr, ok := newObj.(*groupsv1alpha1.DeployToken)
+	if !ok {
+		return nil, errors.New(errNotGroupToken)
+	}
+	return v.validate(cr, old)
+}
+
+func (v *groupDeployTokenValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *groupDeployTokenValidator) validate(cr, old *groupsv1alpha1.DeployToken) (admission.Warnings, error) {
+	var oldScopes []string
+	if old != nil {
+		oldScopes = old.Spec.ForProvider.Scopes
+	}
+	if errs := validateDeployTokenScopes(cr.Spec.ForProvider.Scopes, oldScopes, old != nil); len(errs) > 0 {
+		return nil, kerrors.NewInvalid(groupsv1alpha1.DeployTokenGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	groupsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	projectsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func projectVariable(name string, p projectsv1alpha1.VariableParameters) projectsv1alpha1.Variable {
+	return projectsv1alpha1.Variable{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       projectsv1alpha1.VariableSpec{ForProvider: p},
+	}
+}
+
+func listProjectVariables(items ...projectsv1alpha1.Variable) test.MockListFn {
+	return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*projectsv1alpha1.VariableList).Items = items
+		return nil
+	}
+}
+
+func TestVariableValidator(t *testing.T) {
+	errBoom := kerrors.NewBadRequest("boom")
+
+	cases := map[string]struct {
+		list        test.MockListFn
+		old         *projectsv1alpha1.Variable
+		cr          projectsv1alpha1.Variable
+		wantInvalid bool
+		wantErr     bool
+	}{
+		"Unique": {
+			list: listProjectVariables(projectVariable("other", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "OTHER"})),
+			cr:   projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"}),
+		},
+		"SameKeyOtherScope": {
+			list: listProjectVariables(projectVariable("other", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY", EnvironmentScope: ptr.To("prod")})),
+			cr:   projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"}),
+		},
+		"Duplicate": {
+			list:        listProjectVariables(projectVariable("other", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"})),
+			cr:          projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY", EnvironmentScope: ptr.To("*")}),
+			wantInvalid: true,
+		},
+		"DuplicateScope": {
+			list:        listProjectVariables(projectVariable("other", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY", EnvironmentScope: ptr.To("prod")})),
+			cr:          projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY", Scopes: []string{"dev", "prod"}}),
+			wantInvalid: true,
+		},
+		"GroupWithSameID": {
+			list: listProjectVariables(projectVariable("other", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"})),
+			cr:   projectVariable("v", projectsv1alpha1.VariableParameters{GroupID: ptr.To(1), Key: "KEY"}),
+		},
+		"DuplicateInGroup": {
+			list:        listProjectVariables(projectVariable("other", projectsv1alpha1.VariableParameters{GroupID: ptr.To(1), Key: "KEY"})),
+			cr:          projectVariable("v", projectsv1alpha1.VariableParameters{GroupID: ptr.To(1), Key: "KEY"}),
+			wantInvalid: true,
+		},
+		"Self": {
+			list: listProjectVariables(projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"})),
+			cr:   projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"}),
+		},
+		"UnresolvedProject": {
+			cr: projectVariable("v", projectsv1alpha1.VariableParameters{Key: "KEY"}),
+		},
+		"ScopesWithEnvironmentScope": {
+			cr:          projectVariable("v", projectsv1alpha1.VariableParameters{Key: "KEY", EnvironmentScope: ptr.To("*"), Scopes: []string{"prod"}}),
+			wantInvalid: true,
+		},
+		"ProjectResolved": {
+			list: listProjectVariables(),
+			old:  ptr.To(projectVariable("v", projectsv1alpha1.VariableParameters{Key: "KEY"})),
+			cr:   projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"}),
+		},
+		"ProjectChanged": {
+			old:         ptr.To(projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(2), Key: "KEY"})),
+			cr:          projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"}),
+			wantInvalid: true,
+		},
+		"GroupChanged": {
+			old:         ptr.To(projectVariable("v", projectsv1alpha1.VariableParameters{GroupID: ptr.To(2), Key: "KEY"})),
+			cr:          projectVariable("v", projectsv1alpha1.VariableParameters{GroupID: ptr.To(1), Key: "KEY"}),
+			wantInvalid: true,
+		},
+		"KeyChanged": {
+			old:         ptr.To(projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "OLD"})),
+			cr:          projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"}),
+			wantInvalid: true,
+		},
+		"ListFailed": {
+			list:    test.NewMockListFn(errBoom),
+			cr:      projectVariable("v", projectsv1alpha1.VariableParameters{ProjectID: ptr.To(1), Key: "KEY"}),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &variableValidator{kube: &test.MockClient{MockList: tc.list}}
+			var err error
+			if tc.old != nil {
+				_, err = v.ValidateUpdate(context.Background(), tc.old, &tc.cr)
+			} else {
+				_, err = v.ValidateCreate(context.Background(), &tc.cr)
+			}
+			if diff := cmp.Diff(tc.wantInvalid || tc.wantErr, err != nil); diff != "" {
+				t.Errorf("validate: error: -want, +got:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.wantInvalid, kerrors.IsInvalid(err)); diff != "" {
+				t.Errorf("validate: invalid: -want, +got:\n%s\n%v", diff, err)
+			}
+		})
+	}
+}
+
+func hook(name string, projectID int, url string) projectsv1alpha1.Hook {
+	return projectsv1alpha1.Hook{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: projectsv1alpha1.HookSpec{ForProvider: projectsv1alpha1.HookParameters{
+			ProjectID: ptr.To(projectID),
+			URL:       ptr.To(url),
+		}},
+	}
+}
+
+func TestHookValidator(t *testing.T) {
+	existing := func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*projectsv1alpha1.HookList).Items = []projectsv1alpha1.Hook{hook("other", 1, "https://example.org")}
+		return nil
+	}
+
+	cases := map[string]struct {
+		old         *projectsv1alpha1.Hook
+		cr          projectsv1alpha1.Hook
+		wantInvalid bool
+	}{
+		"Unique": {
+			cr: hook("h", 1, "https://example.com"),
+		},
+		"OtherProject": {
+			cr: hook("h", 2, "https://example.org"),
+		},
+		"GroupWithSameID": {
+			cr: func() projectsv1alpha1.Hook {
+				h := hook("h", 1, "https://example.org")
+				h.Spec.ForProvider.ProjectID, h.Spec.ForProvider.GroupID = nil, ptr.To(1)
+				return h
+			}(),
+		},
+		"Duplicate": {
+			cr:          hook("h", 1, "https://example.org"),
+			wantInvalid: true,
+		},
+		"ProjectChanged": {
+			old:         ptr.To(hook("h", 2, "https://example.com")),
+			cr:          hook("h", 1, "https://example.com"),
+			wantInvalid: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &hookValidator{kube: &test.MockClient{MockList: existing}}
+			var err error
+			if tc.old != nil {
+				_, err = v.ValidateUpdate(context.Background(), tc.old, &tc.cr)
+			} else {
+				_, err = v.ValidateCreate(context.Background(), &tc.cr)
+			}
+			if diff := cmp.Diff(tc.wantInvalid, kerrors.IsInvalid(err)); diff != "" {
+				t.Errorf("validate: invalid: -want, +got:\n%s\n%v", diff, err)
+			}
+		})
+	}
+}
+
+func groupVariable(name string, groupID int, key string, scope *string) groupsv1alpha1.Variable {
+	return groupsv1alpha1.Variable{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: groupsv1alpha1.VariableSpec{ForProvider: groupsv1alpha1.VariableParameters{
+			GroupID:          ptr.To(groupID),
+			Key:              key,
+			EnvironmentScope: scope,
+		}},
+	}
+}
+
+func TestGroupVariableValidator(t *testing.T) {
+	existing := func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*groupsv1alpha1.VariableList).Items = []groupsv1alpha1.Variable{groupVariable("other", 1, "KEY", nil)}
+		return nil
+	}
+
+	cases := map[string]struct {
+		old         *groupsv1alpha1.Variable
+		cr          groupsv1alpha1.Variable
+		wantInvalid bool
+	}{
+		"Unique": {
+			cr: groupVariable("v", 1, "OTHER", nil),
+		},
+		"OtherScope": {
+			cr: groupVariable("v", 1, "KEY", ptr.To("prod")),
+		},
+		"Duplicate": {
+			cr:          groupVariable("v", 1, "KEY", ptr.To("*")),
+			wantInvalid: true,
+		},
+		"GroupChanged": {
+			old:         ptr.To(groupVariable("v", 2, "OTHER", nil)),
+			cr:          groupVariable("v", 1, "OTHER", nil),
+			wantInvalid: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &groupVariableValidator{kube: &test.MockClient{MockList: existing}}
+			var err error
+			if tc.old != nil {
+				_, err = v.ValidateUpdate(context.Background(), tc.old, &tc.cr)
+			} else {
+				_, err = v.ValidateCreate(context.Background(), &tc.cr)
+			}
+			if diff := cmp.Diff(tc.wantInvalid, kerrors.IsInvalid(err)); diff != "" {
+				t.Errorf("validate: invalid: -want, +got:\n%s\n%v", diff, err)
+			}
+		})
+	}
+}
+
+func TestDeployTokenValidator(t *testing.T) {
+	token := func(scopes ...string) *projectsv1alpha1.DeployToken {
+		return &projectsv1alpha1.DeployToken{
+			ObjectMeta: metav1.ObjectMeta{Name: "t"},
+			Spec:       projectsv1alpha1.DeployTokenSpec{ForProvider: projectsv1alpha1.DeployTokenParameters{Scopes: scopes}},
+		}
+	}
+
+	cases := map[string]struct {
+		old         *projectsv1alpha1.DeployToken
+		cr          *projectsv1alpha1.DeployToken
+		wantInvalid bool
+	}{
+		"Valid": {
+			cr: token("read_repository", "read_registry"),
+		},
+		"UnknownScope": {
+			cr:          token("read_repository", "api"),
+			wantInvalid: true,
+		},
+		"RepeatedScope": {
+			cr:          token("read_repository", "read_repository"),
+			wantInvalid: true,
+		},
+		"Reordered": {
+			old: token("read_repository", "read_registry"),
+			cr:  token("read_registry", "read_repository"),
+		},
+		"ScopesChanged": {
+			old:         token("read_repository"),
+			cr:          token("read_repository", "read_registry"),
+			wantInvalid: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &deployTokenValidator{}
+			var err error
+			if tc.old != nil {
+				_, err = v.ValidateUpdate(context.Background(), tc.old, tc.cr)
+			} else {
+				_, err = v.ValidateCreate(context.Background(), tc.cr)
+			}
+			if diff := cmp.Diff(tc.wantInvalid, kerrors.IsInvalid(err)); diff != "" {
+				t.Errorf("validate: invalid: -want, +got:\n%s\n%v", diff, err)
+			}
+		})
+	}
+}
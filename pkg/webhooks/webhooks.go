@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks rejects managed resources that would fight over the same
+// Gitlab resource, change fields that cannot be changed in Gitlab or set
+// values Gitlab does not accept, and defaults Projects according to
+// ProjectPolicies.
+package webhooks
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	groupsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	projectsv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+// Setup adds the validating and defaulting webhooks of all kinds to the
+// webhook server of mgr.
+func Setup(mgr ctrl.Manager) error {
+	for _, wh := range []struct {
+		obj       runtime.Object
+		validator admission.CustomValidator
+	}{
+		{obj: &projectsv1alpha1.Variable{}, validator: &variableValidator{kube: mgr.GetClient()}},
+		{obj: &projectsv1alpha1.Hook{}, validator: &hookValidator{kube: mgr.GetClient()}},
+		{obj: &groupsv1alpha1.Variable{}, validator: &groupVariableValidator{kube: mgr.GetClient()}},
+		{obj: &projectsv1alpha1.DeployToken{}, validator: &deployTokenValidator{}},
+		{obj: &groupsv1alpha1.DeployToken{}, validator: &groupDeployTokenValidator{}},
+		{obj: &projectsv1alpha1.PipelineSchedule{}, validator: &pipelineScheduleValidator{}},
+	} {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(wh.obj).WithValidator(wh.validator).Complete(); err != nil {
+			return err
+		}
+	}
+	return ctrl.NewWebhookManagedBy(mgr).For(&projectsv1alpha1.Project{}).WithDefaulter(&projectDefaulter{kube: mgr.GetClient()}).Complete()
+}
+
+var forProvider = field.NewPath("spec", "forProvider")
+
+// immutableInt returns an error if a set value was changed.
+func immutableInt(path *field.Path, old, updated *int) *field.Error {
+	if old != nil && (updated == nil || *old != *updated) {
+		return field.Invalid(path, updated, "field is immutable once set")
+	}
+	return nil
+}
+
+// immutableString returns an error if the value was changed.
+func immutableString(path *field.Path, old, updated string) *field.Error {
+	if old != updated {
+		return field.Invalid(path, updated, "field is immutable")
+	}
+	return nil
+}
+
+// duplicate returns an error reporting that the managed resource name
+// already manages the Gitlab resource identified by value.
+func duplicate(path *field.Path, value any, name string) *field.Error {
+	err := field.Duplicate(path, value)
+	err.Detail = "already managed by " + name
+	return err
+}